@@ -30,6 +30,7 @@ SUITE_PATH = Path("suite").resolve()
 CMD_PATH = Path("../runtime/cmd").resolve()
 PARSER_PATH = Path("parse").resolve()
 CHECKER_PATH = Path("check").resolve()
+MAIN_PATH = Path("main").resolve()
 
 ansi_escape_pattern = re.compile(r'\x1b[^m]*m')
 
@@ -87,6 +88,11 @@ class File:
         ]
         return self._run(CHECKER_PATH, "Checking", path, use_json, bench, extra_args)
 
+    @classmethod
+    def format(cls, path: Path) -> bool:
+        succeeded, _ = cls._run(MAIN_PATH, "Formatting", path, False, False, ["fmt", "-idempotent"])
+        return succeeded
+
     @staticmethod
     def _run(
             tool_path: Path,
@@ -213,6 +219,7 @@ class Description:
         use_json: bool,
         bench: bool,
         check: bool,
+        fmt: bool,
         go_test: bool,
         go_test_ref: Optional[str],
     ) -> (bool, List[Result]):
@@ -227,13 +234,17 @@ class Description:
         if check:
             check_succeeded, results = self.check(working_dir, prepare=prepare, use_json=use_json, bench=bench)
 
+        fmt_succeeded = True
+        if fmt:
+            fmt_succeeded = self.format(working_dir)
+
         go_tests_succeeded = True
         if go_test:
             for test in self.go_tests:
                 if not test.run(working_dir, prepare=prepare, go_test_ref=go_test_ref):
                     go_tests_succeeded = False
 
-        succeeded = check_succeeded and go_tests_succeeded
+        succeeded = check_succeeded and fmt_succeeded and go_tests_succeeded
 
         return succeeded, results
 
@@ -274,6 +285,19 @@ class Description:
         return run_succeeded, results
 
 
+    def format(self, working_dir: Path) -> bool:
+
+        run_succeeded = True
+
+        for file in self.files:
+            path = working_dir.joinpath(file.path)
+
+            if not File.format(path):
+                run_succeeded = False
+
+        return run_succeeded
+
+
 class Git:
 
     @staticmethod
@@ -661,7 +685,7 @@ def json_serialize(data) -> str:
 
 
 def build_all():
-    for name in ("parse", "check"):
+    for name in ("parse", "check", "main"):
         build(name)
 
 
@@ -690,6 +714,12 @@ def build_all():
     default=True,
     help="Parse and check the suite files"
 )
+@click.option(
+    "--fmt/--no-fmt",
+    is_flag=True,
+    default=True,
+    help="Check that formatting the suite files is idempotent"
+)
 @click.option(
     "--go-test/--no-go-test",
     is_flag=True,
@@ -727,6 +757,7 @@ def main(
         format: Format,
         bench: bool,
         check: bool,
+        fmt: bool,
         go_test: bool,
         go_test_ref: Optional[str],
         output: Optional[LazyFile],
@@ -752,6 +783,7 @@ def main(
         use_json=use_json_for_run,
         bench=bench,
         check=check,
+        fmt=fmt,
         go_test=go_test,
         go_test_ref=go_test_ref,
         names=names
@@ -770,6 +802,7 @@ def main(
                 use_json=use_json_for_run,
                 bench=bench,
                 check=check,
+                fmt=fmt,
                 go_test=go_test,
                 names=names
             )
@@ -803,6 +836,7 @@ def run(
         use_json: bool,
         bench: bool,
         check: bool,
+        fmt: bool,
         go_test: bool,
         go_test_ref: Optional[str],
         names: Collection[str]
@@ -827,6 +861,7 @@ def run(
             use_json=use_json,
             bench=bench,
             check=check,
+            fmt=fmt,
             go_test=go_test,
             go_test_ref=go_test_ref,
         )
@@ -178,14 +178,24 @@ func decodeJSON(v interface{}) cadence.Value {
 		return decodeWord32(valueJSON)
 	case word64TypeStr:
 		return decodeWord64(valueJSON)
+	case word128TypeStr:
+		return decodeWord128(valueJSON)
+	case word256TypeStr:
+		return decodeWord256(valueJSON)
 	case fix64TypeStr:
 		return decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return decodeUFix64(valueJSON)
+	case fix128TypeStr:
+		return decodeFix128(valueJSON)
+	case ufix128TypeStr:
+		return decodeUFix128(valueJSON)
 	case arrayTypeStr:
 		return decodeArray(valueJSON)
 	case dictionaryTypeStr:
 		return decodeDictionary(valueJSON)
+	case setTypeStr:
+		return decodeSet(valueJSON)
 	case resourceTypeStr:
 		return decodeResource(valueJSON)
 	case structTypeStr:
@@ -468,6 +478,26 @@ func decodeWord64(valueJSON interface{}) cadence.Word64 {
 	return cadence.NewWord64(i)
 }
 
+func decodeWord128(valueJSON interface{}) cadence.Word128 {
+	bigInt := decodeBigInt(valueJSON)
+	value, err := cadence.NewWord128FromBig(bigInt)
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return value
+}
+
+func decodeWord256(valueJSON interface{}) cadence.Word256 {
+	bigInt := decodeBigInt(valueJSON)
+	value, err := cadence.NewWord256FromBig(bigInt)
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return value
+}
+
 func decodeFix64(valueJSON interface{}) cadence.Fix64 {
 	v, err := cadence.NewFix64(toString(valueJSON))
 	if err != nil {
@@ -486,6 +516,24 @@ func decodeUFix64(valueJSON interface{}) cadence.UFix64 {
 	return v
 }
 
+func decodeFix128(valueJSON interface{}) cadence.Fix128 {
+	v, err := cadence.NewFix128(toString(valueJSON))
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
+func decodeUFix128(valueJSON interface{}) cadence.UFix128 {
+	v, err := cadence.NewUFix128(toString(valueJSON))
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
 func decodeValues(valueJSON interface{}) []cadence.Value {
 	v := toSlice(valueJSON)
 
@@ -514,6 +562,10 @@ func decodeDictionary(valueJSON interface{}) cadence.Dictionary {
 	return cadence.NewDictionary(pairs)
 }
 
+func decodeSet(valueJSON interface{}) cadence.Set {
+	return cadence.NewSet(decodeValues(valueJSON))
+}
+
 func decodeKeyValuePair(valueJSON interface{}) cadence.KeyValuePair {
 	obj := toObject(valueJSON)
 
@@ -829,6 +881,10 @@ func decodeType(valueJSON interface{}) cadence.Type {
 			KeyType:     decodeType(obj.Get(keyKey)),
 			ElementType: decodeType(obj.Get(valueKey)),
 		}
+	case "Set":
+		return cadence.SetType{
+			ElementType: decodeType(obj.Get(typeKey)),
+		}
 	case "ConstantSizedArray":
 		size := toUInt(obj.Get(sizeKey))
 		return cadence.ConstantSizedArrayType{
@@ -911,10 +967,18 @@ func decodeType(valueJSON interface{}) cadence.Type {
 		return cadence.Word32Type{}
 	case "Word64":
 		return cadence.Word64Type{}
+	case "Word128":
+		return cadence.Word128Type{}
+	case "Word256":
+		return cadence.Word256Type{}
 	case "Fix64":
 		return cadence.Fix64Type{}
 	case "UFix64":
 		return cadence.UFix64Type{}
+	case "Fix128":
+		return cadence.Fix128Type{}
+	case "UFix128":
+		return cadence.UFix128Type{}
 	case "Path":
 		return cadence.PathType{}
 	case "CapabilityPath":
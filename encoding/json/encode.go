@@ -229,10 +229,15 @@ const (
 	word16TypeStr     = "Word16"
 	word32TypeStr     = "Word32"
 	word64TypeStr     = "Word64"
+	word128TypeStr    = "Word128"
+	word256TypeStr    = "Word256"
 	fix64TypeStr      = "Fix64"
 	ufix64TypeStr     = "UFix64"
+	fix128TypeStr     = "Fix128"
+	ufix128TypeStr    = "UFix128"
 	arrayTypeStr      = "Array"
 	dictionaryTypeStr = "Dictionary"
+	setTypeStr        = "Set"
 	structTypeStr     = "Struct"
 	resourceTypeStr   = "Resource"
 	eventTypeStr      = "Event"
@@ -294,14 +299,24 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareWord32(x)
 	case cadence.Word64:
 		return prepareWord64(x)
+	case cadence.Word128:
+		return prepareWord128(x)
+	case cadence.Word256:
+		return prepareWord256(x)
 	case cadence.Fix64:
 		return prepareFix64(x)
 	case cadence.UFix64:
 		return prepareUFix64(x)
+	case cadence.Fix128:
+		return prepareFix128(x)
+	case cadence.UFix128:
+		return prepareUFix128(x)
 	case cadence.Array:
 		return prepareArray(x)
 	case cadence.Dictionary:
 		return prepareDictionary(x)
+	case cadence.Set:
+		return prepareSet(x)
 	case cadence.Struct:
 		return prepareStruct(x)
 	case cadence.Resource:
@@ -489,6 +504,20 @@ func prepareWord64(v cadence.Word64) jsonValue {
 	}
 }
 
+func prepareWord128(v cadence.Word128) jsonValue {
+	return jsonValueObject{
+		Type:  word128TypeStr,
+		Value: encodeBig(v.Big()),
+	}
+}
+
+func prepareWord256(v cadence.Word256) jsonValue {
+	return jsonValueObject{
+		Type:  word256TypeStr,
+		Value: encodeBig(v.Big()),
+	}
+}
+
 func prepareFix64(v cadence.Fix64) jsonValue {
 	return jsonValueObject{
 		Type:  fix64TypeStr,
@@ -503,6 +532,20 @@ func prepareUFix64(v cadence.UFix64) jsonValue {
 	}
 }
 
+func prepareFix128(v cadence.Fix128) jsonValue {
+	return jsonValueObject{
+		Type:  fix128TypeStr,
+		Value: v.String(),
+	}
+}
+
+func prepareUFix128(v cadence.UFix128) jsonValue {
+	return jsonValueObject{
+		Type:  ufix128TypeStr,
+		Value: v.String(),
+	}
+}
+
 func prepareArray(v cadence.Array) jsonValue {
 	values := make([]jsonValue, len(v.Values))
 
@@ -532,6 +575,19 @@ func prepareDictionary(v cadence.Dictionary) jsonValue {
 	}
 }
 
+func prepareSet(v cadence.Set) jsonValue {
+	values := make([]jsonValue, len(v.Values))
+
+	for i, value := range v.Values {
+		values[i] = Prepare(value)
+	}
+
+	return jsonValueObject{
+		Type:  setTypeStr,
+		Value: values,
+	}
+}
+
 func prepareStruct(v cadence.Struct) jsonValue {
 	return prepareComposite(structTypeStr, v.StructType.ID(), v.StructType.Fields, v.Fields)
 }
@@ -685,8 +741,12 @@ func prepareType(typ cadence.Type) jsonValue {
 		cadence.Word16Type,
 		cadence.Word32Type,
 		cadence.Word64Type,
+		cadence.Word128Type,
+		cadence.Word256Type,
 		cadence.Fix64Type,
 		cadence.UFix64Type,
+		cadence.Fix128Type,
+		cadence.UFix128Type,
 		cadence.BlockType,
 		cadence.PathType,
 		cadence.CapabilityPathType,
@@ -726,6 +786,11 @@ func prepareType(typ cadence.Type) jsonValue {
 			KeyType:   prepareType(typ.KeyType),
 			ValueType: prepareType(typ.ElementType),
 		}
+	case cadence.SetType:
+		return jsonUnaryType{
+			Kind: "Set",
+			Type: prepareType(typ.ElementType),
+		}
 	case *cadence.StructType:
 		return jsonNominalType{
 			Kind:         "Struct",
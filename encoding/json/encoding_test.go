@@ -34,6 +34,7 @@ import (
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
@@ -490,6 +491,42 @@ func TestEncodeWord64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeWord128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.NewWord128(0),
+			`{"type":"Word128","value":"0"}`,
+		},
+		{
+			"Max",
+			cadence.Word128{Value: sema.Word128TypeMaxIntBig},
+			`{"type":"Word128","value":"340282366920938463463374607431768211455"}`,
+		},
+	}...)
+}
+
+func TestEncodeWord256(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.NewWord256(0),
+			`{"type":"Word256","value":"0"}`,
+		},
+		{
+			"Max",
+			cadence.Word256{Value: sema.Word256TypeMaxIntBig},
+			`{"type":"Word256","value":"115792089237316195423570985008687907853269984665640564039457584007913129639935"}`,
+		},
+	}...)
+}
+
 func TestEncodeFix64(t *testing.T) {
 
 	t.Parallel()
@@ -541,6 +578,50 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.Fix128{Value: big.NewInt(0)},
+			`{"type":"Fix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"-12345.000000000000000000006789",
+			cadence.Fix128{Value: big.NewInt(0).Sub(
+				big.NewInt(0).Mul(big.NewInt(-12345), fixedpoint.Fix128FactorBig),
+				big.NewInt(6789),
+			)},
+			`{"type":"Fix128","value":"-12345.000000000000000000006789"}`,
+		},
+		{
+			"Min",
+			cadence.Fix128{Value: sema.Int128TypeMinIntBig},
+			`{"type":"Fix128","value":"-170141183460469.231731687303715884105728"}`,
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.UFix128{Value: big.NewInt(0)},
+			`{"type":"UFix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"Max",
+			cadence.UFix128{Value: sema.UInt128TypeMaxIntBig},
+			`{"type":"UFix128","value":"340282366920938.463463374607431768211455"}`,
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -584,6 +665,32 @@ func TestEncodeArray(t *testing.T) {
 	)
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	emptySet := encodeTest{
+		"Empty",
+		cadence.NewSet([]cadence.Value{}),
+		`{"type":"Set","value":[]}`,
+	}
+
+	intSet := encodeTest{
+		"Integers",
+		cadence.NewSet([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(2),
+			cadence.NewInt(3),
+		}),
+		`{"type":"Set","value":[{"type":"Int","value":"1"},{"type":"Int","value":"2"},{"type":"Int","value":"3"}]}`,
+	}
+
+	testAllEncodeAndDecode(t,
+		emptySet,
+		intSet,
+	)
+}
+
 func TestEncodeDictionary(t *testing.T) {
 
 	t.Parallel()
@@ -1039,8 +1146,12 @@ func TestEncodeSimpleTypes(t *testing.T) {
 		cadence.Word16Type{},
 		cadence.Word32Type{},
 		cadence.Word64Type{},
+		cadence.Word128Type{},
+		cadence.Word256Type{},
 		cadence.Fix64Type{},
 		cadence.UFix64Type{},
+		cadence.Fix128Type{},
+		cadence.UFix128Type{},
 		cadence.BlockType{},
 		cadence.PathType{},
 		cadence.CapabilityPathType{},
@@ -1134,6 +1245,20 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static {int}", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: cadence.SetType{
+					ElementType: cadence.IntType{},
+				},
+			},
+			`{"type":"Type","value":{"staticType":{"kind":"Set", "type" : {"kind" : "Int"}}}}`,
+		)
+
+	})
+
 	t.Run("with static struct", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -57,3 +57,61 @@ func TestParseFixedPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFix128(t *testing.T) {
+
+	t.Parallel()
+
+	for input, expected := range map[string]string{
+		"0.1":                         "100000000000000000000000",
+		"-0.000000000000000000000001": "-1",
+		"1.5":                         "1500000000000000000000000",
+		"170141183460469.231731687303715884105727":  "170141183460469231731687303715884105727",
+		"-170141183460469.231731687303715884105728": "-170141183460469231731687303715884105728",
+	} {
+		t.Run(input, func(t *testing.T) {
+			result, err := ParseFix128(input)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result.String())
+		})
+	}
+
+	for _, input := range []string{
+		"170141183460469.231731687303715884105728",
+		"-170141183460469.231731687303715884105729",
+		"0.0000000000000000000000001",
+		"1",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseFix128(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	for input, expected := range map[string]string{
+		"0.1":                        "100000000000000000000000",
+		"0.000000000000000000000001": "1",
+		"340282366920938.463463374607431768211455": "340282366920938463463374607431768211455",
+	} {
+		t.Run(input, func(t *testing.T) {
+			result, err := ParseUFix128(input)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result.String())
+		})
+	}
+
+	for _, input := range []string{
+		"340282366920938.463463374607431768211456",
+		"-0.1",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseUFix128(input)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -11,5 +11,8 @@ require (
 	github.com/onflow/flow-go-sdk v0.24.0
 	github.com/sourcegraph/jsonrpc2 v0.0.0-20191222043438-96c4efab7ee2
 	github.com/spf13/afero v1.6.0
-	github.com/stretchr/testify v1.7.0
+	github.com/stretchr/testify v1.7.1-0.20210824115523-ab6dc3262822
+	google.golang.org/grpc v1.40.0
 )
+
+replace github.com/onflow/cadence => ../
@@ -0,0 +1,318 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-cli/pkg/flowkit"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"google.golang.org/grpc"
+)
+
+// maxGRPCMessageSize is the maximum size of a message received from the access node,
+// matching the value set in onflow/flow-go
+const maxGRPCMessageSize = 1024 * 1024 * 16
+
+// transactionSealTimeout is the maximum duration to wait for a sent transaction to be sealed
+const transactionSealTimeout = time.Minute
+
+// transactionResultPollInterval is the duration between requests for the result of a sent transaction
+const transactionResultPollInterval = time.Second
+
+// flowClient submits scripts and transactions to a Flow network using the Access API.
+//
+// The flowkit services are not used, as they depend on the emulator,
+// which depends on a specific version of Cadence
+type flowClient struct {
+	client *client.Client
+	ctx    context.Context
+}
+
+func newFlowClient(host string) (*flowClient, error) {
+	grpcClient, err := client.New(
+		host,
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxGRPCMessageSize)),
+	)
+	if err != nil || grpcClient == nil {
+		return nil, fmt.Errorf("failed to connect to host %s", host)
+	}
+
+	return &flowClient{
+		client: grpcClient,
+		ctx:    context.Background(),
+	}, nil
+}
+
+// Ping checks if the access node is available
+func (c *flowClient) Ping() error {
+	return c.client.Ping(c.ctx)
+}
+
+// GetAccount returns the account with the given address at the latest block
+func (c *flowClient) GetAccount(address flow.Address) (*flow.Account, error) {
+	account, err := c.client.GetAccountAtLatestBlock(c.ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account with address %s: %w", address, err)
+	}
+
+	return account, nil
+}
+
+// ExecuteScript executes the given script at the latest block
+func (c *flowClient) ExecuteScript(code []byte, args []cadence.Value) (cadence.Value, error) {
+	value, err := c.client.ExecuteScriptAtLatestBlock(c.ctx, code, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute script: %w", err)
+	}
+
+	return value, nil
+}
+
+// BuildTransaction returns an unsigned transaction with the given code and arguments,
+// which references the latest block
+func (c *flowClient) BuildTransaction(
+	proposer flow.Address,
+	proposerKeyIndex int,
+	authorizers []flow.Address,
+	payer flow.Address,
+	code []byte,
+	gasLimit uint64,
+	args []cadence.Value,
+) (*flowkit.Transaction, error) {
+
+	tx := flowkit.NewTransaction().
+		SetPayer(payer).
+		SetGasLimit(gasLimit)
+
+	err := c.setProposal(tx, proposer, proposerKeyIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.SetScriptWithArgs(code, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.AddAuthorizers(authorizers)
+}
+
+// SendTransaction builds a transaction with the given code and arguments,
+// which is proposed, authorized, paid, and signed by the given signer,
+// sends it, and waits for it to be sealed
+func (c *flowClient) SendTransaction(
+	signer *flowkit.Account,
+	code []byte,
+	gasLimit uint64,
+	args []cadence.Value,
+) (*flow.TransactionResult, error) {
+
+	tx, err := c.BuildTransaction(
+		signer.Address(),
+		signer.Key().Index(),
+		[]flow.Address{signer.Address()},
+		signer.Address(),
+		code,
+		gasLimit,
+		args,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.SetSigner(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = tx.Sign()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SendSignedTransaction(tx)
+}
+
+// SendSignedTransaction sends the given signed transaction and waits for it to be sealed,
+// at most for the duration of transactionSealTimeout
+func (c *flowClient) SendSignedTransaction(tx *flowkit.Transaction) (*flow.TransactionResult, error) {
+	flowTransaction := tx.FlowTransaction()
+
+	ctx, cancel := context.WithTimeout(c.ctx, transactionSealTimeout)
+	defer cancel()
+
+	err := c.client.SendTransaction(ctx, *flowTransaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit transaction: %w", err)
+	}
+
+	ticker := time.NewTicker(transactionResultPollInterval)
+	defer ticker.Stop()
+
+	for {
+		result, err := c.client.GetTransactionResult(ctx, flowTransaction.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Status == flow.TransactionStatusSealed {
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf(
+				"transaction %s was not sealed within %s: %w",
+				flowTransaction.ID(),
+				transactionSealTimeout,
+				ctx.Err(),
+			)
+		case <-ticker.C:
+		}
+	}
+}
+
+// AddContract deploys the contract with the given name and code to the signer's account,
+// or updates the existing contract
+func (c *flowClient) AddContract(
+	signer *flowkit.Account,
+	name string,
+	code []byte,
+	update bool,
+) error {
+
+	var tx *flowkit.Transaction
+	var err error
+
+	if update {
+		tx, err = flowkit.NewUpdateAccountContractTransaction(signer, name, string(code))
+	} else {
+		tx, err = flowkit.NewAddAccountContractTransaction(signer, name, string(code), []cadence.Value{})
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := c.signAndSend(tx, signer)
+	if err != nil {
+		return err
+	}
+
+	return result.Error
+}
+
+// CreateAccount creates a new account with the given public keys,
+// which is paid for by the signer, and returns its address
+func (c *flowClient) CreateAccount(
+	signer *flowkit.Account,
+	keys []crypto.PublicKey,
+	sigAlgo crypto.SignatureAlgorithm,
+	hashAlgo crypto.HashAlgorithm,
+) (flow.Address, error) {
+
+	accountKeys := make([]*flow.AccountKey, len(keys))
+	for i, key := range keys {
+		accountKey := &flow.AccountKey{
+			PublicKey: key,
+			SigAlgo:   sigAlgo,
+			HashAlgo:  hashAlgo,
+			Weight:    flow.AccountKeyWeightThreshold,
+		}
+
+		err := accountKey.Validate()
+		if err != nil {
+			return flow.Address{}, fmt.Errorf("invalid account key: %w", err)
+		}
+
+		accountKeys[i] = accountKey
+	}
+
+	tx, err := flowkit.NewCreateAccountTransaction(signer, accountKeys, nil)
+	if err != nil {
+		return flow.Address{}, err
+	}
+
+	result, err := c.signAndSend(tx, signer)
+	if err != nil {
+		return flow.Address{}, err
+	}
+
+	if result.Error != nil {
+		return flow.Address{}, result.Error
+	}
+
+	events := flowkit.EventsFromTransaction(result)
+	address := events.GetAddress()
+	if address == nil {
+		return flow.Address{}, fmt.Errorf("new account address couldn't be fetched")
+	}
+
+	return *address, nil
+}
+
+// signAndSend sets the proposal key of the given transaction to the signer's key,
+// signs it, sends it, and waits for it to be sealed
+func (c *flowClient) signAndSend(
+	tx *flowkit.Transaction,
+	signer *flowkit.Account,
+) (*flow.TransactionResult, error) {
+
+	err := c.setProposal(tx, signer.Address(), signer.Key().Index())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = tx.Sign()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SendSignedTransaction(tx)
+}
+
+// setProposal sets the reference block of the given transaction to the latest block,
+// and sets the proposal key to the given key of the proposer
+func (c *flowClient) setProposal(
+	tx *flowkit.Transaction,
+	proposer flow.Address,
+	proposerKeyIndex int,
+) error {
+
+	latestBlock, err := c.client.GetLatestBlock(c.ctx, true)
+	if err != nil {
+		return fmt.Errorf("failed to get latest sealed block: %w", err)
+	}
+
+	proposerAccount, err := c.GetAccount(proposer)
+	if err != nil {
+		return err
+	}
+
+	tx.SetBlockReference(latestBlock).
+		SetProposer(proposerAccount, proposerKeyIndex)
+
+	return nil
+}
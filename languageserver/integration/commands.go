@@ -140,7 +140,7 @@ func (i *FlowIntegration) initAccountManager(conn protocol.Conn, args ...interfa
 		return nil, errorWithMessage(conn, fmt.Sprintf("can't read contract from account %s", serviceAddress), err)
 	}
 
-	_, deployError := i.sharedServices.Accounts.AddContract(
+	deployError := i.client.AddContract(
 		serviceAccount,
 		name,
 		code,
@@ -199,7 +199,7 @@ func (i *FlowIntegration) sendTransaction(conn protocol.Conn, args ...interface{
 		signers[i] = flow.HexToAddress(v.(string))
 	}
 
-	// Send transaction via shared library
+	// Send transaction via the Flow client
 	code, err := ioutil.ReadFile(path.Path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load transaction file: %s", path.Path)
@@ -242,16 +242,14 @@ func (i *FlowIntegration) sendTransaction(conn protocol.Conn, args ...interface{
 		signerAccounts = append(signerAccounts, *serviceAccount)
 	}
 
-	tx, err := i.sharedServices.Transactions.Build(
+	tx, err := i.client.BuildTransaction(
 		serviceAddress,
+		keyIndex,
 		authorizers,
 		serviceAddress,
-		keyIndex,
 		code,
-		"",
 		maxGasLimit,
 		txArgs,
-		"",
 	)
 
 	if err != nil {
@@ -270,11 +268,7 @@ func (i *FlowIntegration) sendTransaction(conn protocol.Conn, args ...interface{
 		}
 	}
 
-	// even though .Encode returns []byte, without this conversion there is an error:
-	// transaction error: &errors.errorString{s:"failed to decode partial transaction...
-	// ...encoding/hex: invalid byte: U+00F9 'ù'"
-	txBytes := []byte(fmt.Sprintf("%x", tx.FlowTransaction().Encode()))
-	_, txResult, err := i.sharedServices.Transactions.SendSigned(txBytes)
+	txResult, err := i.client.SendSignedTransaction(tx)
 
 	if err != nil {
 		return nil, errorWithMessage(conn, ErrorMessageTransactionError, err)
@@ -340,8 +334,8 @@ func (i *FlowIntegration) executeScript(conn protocol.Conn, args ...interface{})
 		)
 	}
 
-	// Execute script via shared library
-	scriptResult, err := i.sharedServices.Scripts.Execute(code, scriptArgs, "", "")
+	// Execute script via the Flow client
+	scriptResult, err := i.client.ExecuteScript(code, scriptArgs)
 	if err != nil {
 		return nil, errorWithMessage(conn, ErrorMessageScriptExecution, err)
 	}
@@ -517,7 +511,7 @@ func (i *FlowIntegration) deployContract(conn protocol.Conn, args ...interface{}
 
 	showMessage(conn, fmt.Sprintf("Deploying contract %s to account %s", name, to))
 
-	// Send transaction via shared library
+	// Send transaction via the Flow client
 	signer, err := i.state.EmulatorServiceAccount()
 	if err != nil {
 		return nil, errorWithMessage(conn, fmt.Sprintf("service account error"), err)
@@ -533,7 +527,7 @@ func (i *FlowIntegration) deployContract(conn protocol.Conn, args ...interface{}
 		return nil, errorWithMessage(conn, fmt.Sprintf("failed to load contract code: %s", path.Path), err)
 	}
 
-	_, deployError := i.sharedServices.Accounts.AddContract(signer, name, code, update)
+	deployError := i.client.AddContract(signer, name, code, update)
 	if deployError != nil {
 		return nil, errorWithMessage(conn, ErrorMessageDeploy, deployError)
 	}
@@ -565,21 +559,18 @@ func (i *FlowIntegration) createAccountHelper(conn protocol.Conn) (address flow.
 	}
 
 	keys := []crypto.PublicKey{(*pkey).PublicKey()}
-	weights := []int{flow.AccountKeyWeightThreshold}
 
-	newAccount, err := i.sharedServices.Accounts.Create(
+	address, err = i.client.CreateAccount(
 		signer,
 		keys,
-		weights,
 		crypto.ECDSA_P256,
 		crypto.SHA3_256,
-		nil,
 	)
 	if err != nil {
 		return flow.Address{}, errorWithMessage(conn, ErrorMessageAccountCreate, err)
 	}
 
-	return newAccount.Address, nil
+	return address, nil
 }
 
 // storeAccountHelper sends transaction to store account on chain
@@ -600,13 +591,11 @@ func (i *FlowIntegration) storeAccountHelper(conn protocol.Conn, address flow.Ad
 
 	const gasLimit uint64 = 1000
 
-	_, txResult, err := i.sharedServices.Transactions.Send(
+	txResult, err := i.client.SendTransaction(
 		serviceAccount,
 		code,
-		"",
 		gasLimit,
 		txArgs,
-		"",
 	)
 	if err != nil {
 		return ClientAccount{}, errorWithMessage(conn, ErrorMessageAccountStore, err)
@@ -624,7 +613,7 @@ func (i *FlowIntegration) storeAccountHelper(conn protocol.Conn, address flow.Ad
 }
 
 func (i *FlowIntegration) isContractDeployed(address flow.Address, name string) (bool, error) {
-	account, err := i.sharedServices.Accounts.Get(address)
+	account, err := i.client.GetAccount(address)
 
 	if err != nil {
 		return false, err
@@ -641,7 +630,7 @@ RetryLoop:
 		case <-timer.C:
 			return errors.New("emulator server timed out")
 		default:
-			_, err := i.sharedServices.Status.Ping("emulator")
+			err := i.client.Ping()
 			if err == nil {
 				break RetryLoop
 			}
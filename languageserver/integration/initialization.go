@@ -20,9 +20,6 @@ package integration
 
 import (
 	"github.com/onflow/flow-cli/pkg/flowkit"
-	"github.com/onflow/flow-cli/pkg/flowkit/gateway"
-	"github.com/onflow/flow-cli/pkg/flowkit/output"
-	"github.com/onflow/flow-cli/pkg/flowkit/services"
 	"github.com/spf13/afero"
 )
 
@@ -48,14 +45,12 @@ func (i *FlowIntegration) initialize(initializationOptions interface{}) error {
 	if err != nil {
 		return err
 	}
-	logger := output.NewStdoutLogger(output.NoneLog)
-
-	grpcGateway, err := gateway.NewGrpcGateway(network.Host)
+	client, err := newFlowClient(network.Host)
 	if err != nil {
 		return err
 	}
 
-	i.sharedServices = services.NewServices(grpcGateway, state, logger)
+	i.client = client
 	i.state = state
 
 	return nil
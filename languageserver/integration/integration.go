@@ -20,7 +20,6 @@ package integration
 
 import (
 	"github.com/onflow/flow-cli/pkg/flowkit"
-	"github.com/onflow/flow-cli/pkg/flowkit/services"
 
 	"github.com/onflow/cadence/languageserver/protocol"
 	"github.com/onflow/cadence/languageserver/server"
@@ -44,8 +43,8 @@ type FlowIntegration struct {
 	activeAccount ClientAccount
 	emulatorState EmulatorState
 
-	sharedServices *services.Services
-	state          *flowkit.State
+	client *flowClient
+	state  *flowkit.State
 }
 
 func NewFlowIntegration(s *server.Server, enableFlowClient bool) (*FlowIntegration, error) {
@@ -65,7 +65,7 @@ func (i *FlowIntegration) resolveAddressImport(location common.AddressLocation)
 }
 
 func (i *FlowIntegration) getAccount(address common.Address) (*flow.Account, error) {
-	account, err := i.sharedServices.Accounts.Get(
+	account, err := i.client.GetAccount(
 		flow.HexToAddress(address.String()),
 	)
 
@@ -93,7 +93,7 @@ func (i *FlowIntegration) getAccountAddress(name string) (flow.Address, error) {
 	args := []cadence.Value{cadenceName}
 
 	code := makeManagerCode(scriptGetAddress, serviceAccount.Address().String())
-	result, err := i.sharedServices.Scripts.Execute(code, args, "", "")
+	result, err := i.client.ExecuteScript(code, args)
 	if err != nil {
 		return flow.Address{}, err
 	}
@@ -85,6 +85,15 @@ func (s *Server) handleDocumentHighlight(req *json.RawMessage) (interface{}, err
 	return s.Handler.DocumentHighlight(s.conn, &params)
 }
 
+func (s *Server) handleReferences(req *json.RawMessage) (interface{}, error) {
+	var params ReferenceParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return s.Handler.References(s.conn, &params)
+}
+
 func (s *Server) handleRename(req *json.RawMessage) (interface{}, error) {
 	var params RenameParams
 	if err := json.Unmarshal(*req, &params); err != nil {
@@ -147,6 +156,51 @@ func (s *Server) handleDocumentSymbol(req *json.RawMessage) (interface{}, error)
 	return s.Handler.DocumentSymbol(s.conn, &params)
 }
 
+func (s *Server) handleDocumentFormatting(req *json.RawMessage) (interface{}, error) {
+	var params DocumentFormattingParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return s.Handler.DocumentFormatting(s.conn, &params)
+}
+
+func (s *Server) handleDocumentRangeFormatting(req *json.RawMessage) (interface{}, error) {
+	var params DocumentRangeFormattingParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return s.Handler.DocumentRangeFormatting(s.conn, &params)
+}
+
+func (s *Server) handleSemanticTokensFull(req *json.RawMessage) (interface{}, error) {
+	var params SemanticTokensParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return s.Handler.SemanticTokensFull(s.conn, &params)
+}
+
+func (s *Server) handleSemanticTokensRange(req *json.RawMessage) (interface{}, error) {
+	var params SemanticTokensRangeParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return s.Handler.SemanticTokensRange(s.conn, &params)
+}
+
+func (s *Server) handleInlayHint(req *json.RawMessage) (interface{}, error) {
+	var params InlayHintParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return s.Handler.InlayHint(s.conn, &params)
+}
+
 func (s *Server) handleShutdown(_ *json.RawMessage) (interface{}, error) {
 	err := s.Handler.Shutdown(s.conn)
 	return nil, err
@@ -85,6 +85,7 @@ type Handler interface {
 	Definition(conn Conn, params *TextDocumentPositionParams) (*Location, error)
 	SignatureHelp(conn Conn, params *TextDocumentPositionParams) (*SignatureHelp, error)
 	DocumentHighlight(conn Conn, params *TextDocumentPositionParams) ([]*DocumentHighlight, error)
+	References(conn Conn, params *ReferenceParams) ([]*Location, error)
 	Rename(conn Conn, params *RenameParams) (*WorkspaceEdit, error)
 	CodeAction(conn Conn, params *CodeActionParams) ([]*CodeAction, error)
 	CodeLens(conn Conn, params *CodeLensParams) ([]*CodeLens, error)
@@ -92,6 +93,11 @@ type Handler interface {
 	ResolveCompletionItem(conn Conn, item *CompletionItem) (*CompletionItem, error)
 	ExecuteCommand(conn Conn, params *ExecuteCommandParams) (interface{}, error)
 	DocumentSymbol(conn Conn, params *DocumentSymbolParams) ([]*DocumentSymbol, error)
+	DocumentFormatting(conn Conn, params *DocumentFormattingParams) ([]*TextEdit, error)
+	DocumentRangeFormatting(conn Conn, params *DocumentRangeFormattingParams) ([]*TextEdit, error)
+	SemanticTokensFull(conn Conn, params *SemanticTokensParams) (*SemanticTokens, error)
+	SemanticTokensRange(conn Conn, params *SemanticTokensRangeParams) (*SemanticTokens, error)
+	InlayHint(conn Conn, params *InlayHintParams) ([]*InlayHint, error)
 	Shutdown(conn Conn) error
 	Exit(conn Conn) error
 }
@@ -133,6 +139,9 @@ func NewServer(handler Handler) *Server {
 	jsonrpc2Server.Methods["textDocument/documentHighlight"] =
 		server.handleDocumentHighlight
 
+	jsonrpc2Server.Methods["textDocument/references"] =
+		server.handleReferences
+
 	jsonrpc2Server.Methods["textDocument/rename"] =
 		server.handleRename
 
@@ -151,6 +160,21 @@ func NewServer(handler Handler) *Server {
 	jsonrpc2Server.Methods["textDocument/documentSymbol"] =
 		server.handleDocumentSymbol
 
+	jsonrpc2Server.Methods["textDocument/formatting"] =
+		server.handleDocumentFormatting
+
+	jsonrpc2Server.Methods["textDocument/rangeFormatting"] =
+		server.handleDocumentRangeFormatting
+
+	jsonrpc2Server.Methods["textDocument/semanticTokens/full"] =
+		server.handleSemanticTokensFull
+
+	jsonrpc2Server.Methods["textDocument/semanticTokens/range"] =
+		server.handleSemanticTokensRange
+
+	jsonrpc2Server.Methods["textDocument/inlayHint"] =
+		server.handleInlayHint
+
 	jsonrpc2Server.Methods["shutdown"] =
 		server.handleShutdown
 
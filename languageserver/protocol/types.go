@@ -1646,6 +1646,16 @@ type ServerCapabilities struct {
 	 * The server provides selection range support.
 	 */
 	SelectionRangeProvider bool `json:"selectionRangeProvider,omitempty"` // boolean | (TextDocumentRegistrationOptions & StaticRegistrationOptions & SelectionRangeProviderOptions)
+
+	/*SemanticTokensProvider defined:
+	 * The server provides semantic tokens support.
+	 */
+	SemanticTokensProvider *SemanticTokensOptions `json:"semanticTokensProvider,omitempty"` // SemanticTokensOptions | SemanticTokensRegistrationOptions
+
+	/*InlayHintProvider defined:
+	 * The server provides inlay hints.
+	 */
+	InlayHintProvider bool `json:"inlayHintProvider,omitempty"` // boolean | InlayHintOptions | InlayHintRegistrationOptions
 }
 
 // InitializeParams is
@@ -2130,6 +2140,137 @@ type DocumentOnTypeFormattingParams struct {
 	Options FormattingOptions `json:"options"`
 }
 
+/*SemanticTokensLegend defined:
+ * The legend of the semantic tokens provided by the server.
+ */
+type SemanticTokensLegend struct {
+
+	/*TokenTypes defined:
+	 * The token types a server uses.
+	 */
+	TokenTypes []string `json:"tokenTypes"`
+
+	/*TokenModifiers defined:
+	 * The token modifiers a server uses.
+	 */
+	TokenModifiers []string `json:"tokenModifiers"`
+}
+
+// SemanticTokensOptions is
+type SemanticTokensOptions struct {
+
+	/*Legend defined:
+	 * The legend used by the server
+	 */
+	Legend SemanticTokensLegend `json:"legend"`
+
+	/*Range defined:
+	 * Server supports providing semantic tokens for a specific range
+	 * of a document.
+	 */
+	Range bool `json:"range,omitempty"`
+
+	/*Full defined:
+	 * Server supports providing semantic tokens for a full document.
+	 */
+	Full bool `json:"full,omitempty"`
+}
+
+// SemanticTokensParams is
+type SemanticTokensParams struct {
+
+	/*TextDocument defined:
+	 * The text document.
+	 */
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// SemanticTokensRangeParams is
+type SemanticTokensRangeParams struct {
+
+	/*TextDocument defined:
+	 * The text document.
+	 */
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	/*Range defined:
+	 * The range the semantic tokens are requested for.
+	 */
+	Range Range `json:"range"`
+}
+
+// SemanticTokens is
+type SemanticTokens struct {
+
+	/*ResultID defined:
+	 * An optional result id.
+	 */
+	ResultID string `json:"resultId,omitempty"`
+
+	/*Data defined:
+	 * The actual tokens. Each token is encoded as five integers,
+	 * relative to the previous token: the delta line, the delta start character,
+	 * the length, the token type, and the token modifiers.
+	 */
+	Data []uint32 `json:"data"`
+}
+
+// InlayHintParams is
+type InlayHintParams struct {
+
+	/*TextDocument defined:
+	 * The text document.
+	 */
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	/*Range defined:
+	 * The visible document range for which inlay hints should be computed.
+	 */
+	Range Range `json:"range"`
+}
+
+/*InlayHint defined:
+ * Inlay hint information.
+ */
+type InlayHint struct {
+
+	/*Position defined:
+	 * The position of this hint.
+	 */
+	Position Position `json:"position"`
+
+	/*Label defined:
+	 * The label of this hint.
+	 */
+	Label string `json:"label"`
+
+	/*Kind defined:
+	 * The kind of this hint. Can be omitted in which case the client
+	 * should fall back to a reasonable default.
+	 */
+	Kind InlayHintKind `json:"kind,omitempty"`
+
+	/*TextEdits defined:
+	 * Optional text edits that are performed when accepting this inlay hint.
+	 */
+	TextEdits []TextEdit `json:"textEdits,omitempty"`
+
+	/*Tooltip defined:
+	 * The tooltip text when you hover over this item.
+	 */
+	Tooltip string `json:"tooltip,omitempty"`
+
+	/*PaddingLeft defined:
+	 * Render padding before the hint.
+	 */
+	PaddingLeft bool `json:"paddingLeft,omitempty"`
+
+	/*PaddingRight defined:
+	 * Render padding after the hint.
+	 */
+	PaddingRight bool `json:"paddingRight,omitempty"`
+}
+
 /*DocumentOnTypeFormattingRegistrationOptions defined:
  * Format document on type options
  */
@@ -3506,6 +3647,9 @@ type ConnectionErrors float64
 // ConnectionState defines constants
 type ConnectionState float64
 
+// InlayHintKind defines constants
+type InlayHintKind float64
+
 const (
 
 	/*Comment defined:
@@ -3990,6 +4134,16 @@ const (
 
 	// Listening is
 	Listening ConnectionState = 2
+
+	/*Type defined:
+	 * An inlay hint that is for a type annotation.
+	 */
+	Type InlayHintKind = 1
+
+	/*Parameter defined:
+	 * An inlay hint that is for a parameter.
+	 */
+	Parameter InlayHintKind = 2
 )
 
 // DocumentFilter is a type
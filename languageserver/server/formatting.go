@@ -0,0 +1,258 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+	"github.com/onflow/cadence/runtime/format"
+)
+
+// maxFormattingDiffSize is the maximum number of line pairs that are compared
+// to determine the minimal edits for formatting a document.
+// Larger changes are returned as a single edit.
+//
+const maxFormattingDiffSize = 1 << 22
+
+// DocumentFormatting is called when the client requests the whole document to be formatted.
+//
+// The document is formatted canonically, independent of the given formatting options.
+// No edits are returned if the document has syntax errors.
+//
+func (s *Server) DocumentFormatting(
+	conn protocol.Conn,
+	params *protocol.DocumentFormattingParams,
+) (
+	[]*protocol.TextEdit,
+	error,
+) {
+	return s.formattingEdits(conn, params.TextDocument.URI, nil)
+}
+
+// DocumentRangeFormatting is called when the client requests a range of the document to be formatted.
+//
+// The whole document is formatted, but only the edits for the lines in the given range are returned.
+//
+func (s *Server) DocumentRangeFormatting(
+	conn protocol.Conn,
+	params *protocol.DocumentRangeFormattingParams,
+) (
+	[]*protocol.TextEdit,
+	error,
+) {
+	return s.formattingEdits(conn, params.TextDocument.URI, &params.Range)
+}
+
+func (s *Server) formattingEdits(
+	conn protocol.Conn,
+	uri protocol.DocumentUri,
+	lineRange *protocol.Range,
+) (
+	edits []*protocol.TextEdit,
+	err error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no edits
+	edits = []*protocol.TextEdit{}
+
+	document, ok := s.documents[uri]
+	if !ok {
+		return
+	}
+
+	program, err := parse(conn, document.Text, string(uri))
+	if err != nil {
+		// The syntax errors are already reported as diagnostics
+		return edits, nil
+	}
+
+	formatted := format.Program(program, format.WithComments(document.Text))
+
+	for _, hunk := range lineDiff(splitLines(document.Text), splitLines(formatted)) {
+		if lineRange != nil && !hunk.overlaps(int(lineRange.Start.Line), int(lineRange.End.Line)) {
+			continue
+		}
+
+		edits = append(edits, &protocol.TextEdit{
+			Range: protocol.Range{
+				Start: linePosition(document.Text, hunk.start),
+				End:   linePosition(document.Text, hunk.end),
+			},
+			NewText: strings.Join(hunk.lines, ""),
+		})
+	}
+
+	return
+}
+
+// splitLines returns the lines of the given text, including the line breaks
+//
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// linePosition returns the position of the start of the line with the given index.
+//
+// If the text does not end with a line break, the index after the last line
+// refers to the end of the text.
+//
+func linePosition(text string, line int) protocol.Position {
+	lines := splitLines(text)
+	if line < len(lines) || len(lines) == 0 || strings.HasSuffix(text, "\n") {
+		return protocol.Position{
+			Line: float64(line),
+		}
+	}
+
+	lastLine := lines[len(lines)-1]
+	return protocol.Position{
+		Line:      float64(len(lines) - 1),
+		Character: float64(utf8.RuneCountInString(lastLine)),
+	}
+}
+
+// lineHunk is a change of a text:
+// the original lines in the range from start (inclusive) to end (exclusive)
+// are replaced by the given lines
+//
+type lineHunk struct {
+	start int
+	end   int
+	lines []string
+}
+
+// overlaps returns true if the hunk changes a line in the given range (inclusive),
+// or inserts lines in it
+//
+func (h lineHunk) overlaps(startLine, endLine int) bool {
+	if h.start == h.end {
+		return h.start >= startLine && h.start <= endLine+1
+	}
+	return h.start <= endLine && h.end > startLine
+}
+
+// lineDiff returns the hunks which transform the original lines into the updated lines.
+//
+// The hunks are determined based on the longest common subsequence of lines,
+// after common leading and trailing lines are skipped.
+//
+func lineDiff(original, updated []string) []lineHunk {
+	prefix := 0
+	for prefix < len(original) &&
+		prefix < len(updated) &&
+		original[prefix] == updated[prefix] {
+
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(original)-prefix &&
+		suffix < len(updated)-prefix &&
+		original[len(original)-1-suffix] == updated[len(updated)-1-suffix] {
+
+		suffix++
+	}
+
+	original = original[prefix : len(original)-suffix]
+	updated = updated[prefix : len(updated)-suffix]
+
+	if len(original) == 0 && len(updated) == 0 {
+		return nil
+	}
+
+	if len(original)*len(updated) > maxFormattingDiffSize {
+		return []lineHunk{
+			{
+				start: prefix,
+				end:   prefix + len(original),
+				lines: updated,
+			},
+		}
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence
+	// of original[i:] and updated[j:]
+
+	lengths := make([][]int32, len(original)+1)
+	for i := range lengths {
+		lengths[i] = make([]int32, len(updated)+1)
+	}
+
+	for i := len(original) - 1; i >= 0; i-- {
+		for j := len(updated) - 1; j >= 0; j-- {
+			if original[i] == updated[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var hunks []lineHunk
+	var current *lineHunk
+
+	flush := func() {
+		if current != nil {
+			hunks = append(hunks, *current)
+			current = nil
+		}
+	}
+
+	change := func(i int) *lineHunk {
+		if current == nil {
+			current = &lineHunk{
+				start: prefix + i,
+				end:   prefix + i,
+			}
+		}
+		return current
+	}
+
+	i, j := 0, 0
+	for i < len(original) || j < len(updated) {
+		switch {
+		case i < len(original) && j < len(updated) && original[i] == updated[j]:
+			flush()
+			i++
+			j++
+
+		case j < len(updated) && (i == len(original) || lengths[i][j+1] >= lengths[i+1][j]):
+			hunk := change(i)
+			hunk.lines = append(hunk.lines, updated[j])
+			j++
+
+		default:
+			hunk := change(i)
+			hunk.end++
+			i++
+		}
+	}
+
+	flush()
+
+	return hunks
+}
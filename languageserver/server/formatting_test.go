@@ -0,0 +1,238 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const testUnformattedURI protocol.DocumentUri = "file:///project/c.cdc"
+
+const testUnformattedCode = `// Counts
+pub   contract C {
+    pub var count: Int
+
+    init() { self.count = 0 }
+}
+
+pub fun double(x:Int):Int {
+    /// the result
+    return x*2
+}
+`
+
+const testFormattedCode = `// Counts
+pub contract C {
+    pub var count: Int
+
+    init() {
+        self.count = 0
+    }
+}
+
+pub fun double(x: Int): Int {
+    /// the result
+    return x * 2
+}
+`
+
+func openTestDocument(t *testing.T, server *Server, uri protocol.DocumentUri, text string) {
+	err := server.DidOpenTextDocument(
+		testConn{},
+		&protocol.DidOpenTextDocumentParams{
+			TextDocument: protocol.TextDocumentItem{
+				URI:  uri,
+				Text: text,
+			},
+		},
+	)
+	require.NoError(t, err)
+}
+
+// applyTextEdits returns the given text with the given non-overlapping edits applied
+//
+func applyTextEdits(text string, edits []*protocol.TextEdit) string {
+	lines := strings.SplitAfter(text, "\n")
+
+	offset := func(position protocol.Position) int {
+		result := 0
+		for _, line := range lines[:int(position.Line)] {
+			result += len(line)
+		}
+		if int(position.Line) < len(lines) {
+			runes := []rune(lines[int(position.Line)])
+			result += len(string(runes[:int(position.Character)]))
+		}
+		return result
+	}
+
+	for i := len(edits) - 1; i >= 0; i-- {
+		edit := edits[i]
+		text = text[:offset(edit.Range.Start)] + edit.NewText + text[offset(edit.Range.End):]
+	}
+
+	return text
+}
+
+func TestServer_DocumentFormatting(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("document", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testUnformattedURI, testUnformattedCode)
+
+		edits, err := server.DocumentFormatting(
+			testConn{},
+			&protocol.DocumentFormattingParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testUnformattedURI},
+			},
+		)
+		require.NoError(t, err)
+
+		// Only the changed lines are edited
+
+		require.Len(t, edits, 4)
+		assert.Equal(t, float64(1), edits[0].Range.Start.Line)
+		assert.Equal(t, float64(2), edits[0].Range.End.Line)
+
+		assert.Equal(t, testFormattedCode, applyTextEdits(testUnformattedCode, edits))
+	})
+
+	t.Run("formatted", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testUnformattedURI, testFormattedCode)
+
+		edits, err := server.DocumentFormatting(
+			testConn{},
+			&protocol.DocumentFormattingParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testUnformattedURI},
+			},
+		)
+		require.NoError(t, err)
+		assert.Empty(t, edits)
+	})
+
+	t.Run("missing trailing line break", func(t *testing.T) {
+
+		t.Parallel()
+
+		code := "pub   let x = 1"
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testUnformattedURI, code)
+
+		edits, err := server.DocumentFormatting(
+			testConn{},
+			&protocol.DocumentFormattingParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testUnformattedURI},
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "pub let x = 1\n", applyTextEdits(code, edits))
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testUnformattedURI, "pub   fun (")
+
+		edits, err := server.DocumentFormatting(
+			testConn{},
+			&protocol.DocumentFormattingParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testUnformattedURI},
+			},
+		)
+		require.NoError(t, err)
+		assert.NotNil(t, edits)
+		assert.Empty(t, edits)
+	})
+}
+
+func TestServer_DocumentRangeFormatting(t *testing.T) {
+
+	t.Parallel()
+
+	server := newTestServer(t)
+	openTestDocument(t, server, testUnformattedURI, testUnformattedCode)
+
+	// Only format the function
+
+	edits, err := server.DocumentRangeFormatting(
+		testConn{},
+		&protocol.DocumentRangeFormattingParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: testUnformattedURI},
+			Range: protocol.Range{
+				Start: protocol.Position{Line: 7},
+				End:   protocol.Position{Line: 10},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, edits, 2)
+
+	assert.Equal(t,
+		`// Counts
+pub   contract C {
+    pub var count: Int
+
+    init() { self.count = 0 }
+}
+
+pub fun double(x: Int): Int {
+    /// the result
+    return x * 2
+}
+`,
+		applyTextEdits(testUnformattedCode, edits),
+	)
+}
+
+func TestLineDiff(t *testing.T) {
+
+	t.Parallel()
+
+	original := []string{"a\n", "b\n", "c\n", "d\n"}
+	updated := []string{"a\n", "x\n", "c\n", "y\n", "d\n", "e\n"}
+
+	assert.Equal(t,
+		[]lineHunk{
+			{start: 1, end: 2, lines: []string{"x\n"}},
+			{start: 3, end: 3, lines: []string{"y\n"}},
+			{start: 4, end: 4, lines: []string{"e\n"}},
+		},
+		lineDiff(original, updated),
+	)
+
+	assert.Nil(t, lineDiff(original, original))
+}
@@ -0,0 +1,56 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// checkImportHashes checks the hash of the code at the given imported location
+// against the hashes expected by the import declarations of the checker's program
+// which resolved to the given location, just like the runtime does
+//
+func (s *Server) checkImportHashes(
+	checker *sema.Checker,
+	resolvedLocation common.Location,
+	importedLocation common.Location,
+) error {
+	expectedHashes := runtime.ImportDeclarationHashes(checker, resolvedLocation)
+	if len(expectedHashes) == 0 {
+		return nil
+	}
+
+	code, ok, err := s.resolveImportCode(importedLocation)
+	if err != nil || !ok {
+		return err
+	}
+
+	actualHash := runtime.CodeHash([]byte(code))
+
+	err = runtime.CheckImportHashes(importedLocation, actualHash, expectedHashes)
+	if err != nil {
+		return &sema.CheckerError{
+			Errors: []error{err},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// diagnosticsConn is a connection which records the published diagnostics
+//
+type diagnosticsConn struct {
+	testConn
+	diagnostics []protocol.Diagnostic
+}
+
+func (c *diagnosticsConn) PublishDiagnostics(params *protocol.PublishDiagnosticsParams) error {
+	c.diagnostics = params.Diagnostics
+	return nil
+}
+
+func TestServer_ImportHash(t *testing.T) {
+
+	t.Parallel()
+
+	check := func(t *testing.T, hash []byte) []protocol.Diagnostic {
+		server, err := NewServer()
+		require.NoError(t, err)
+
+		err = server.SetOptions(
+			WithStringImportResolver(func(location common.StringLocation) (string, error) {
+				if location != "/project/b.cdc" {
+					return "", fmt.Errorf("unknown location: %s", location)
+				}
+				return testContractCode, nil
+			}),
+		)
+		require.NoError(t, err)
+
+		conn := &diagnosticsConn{}
+
+		err = server.DidOpenTextDocument(
+			conn,
+			&protocol.DidOpenTextDocumentParams{
+				TextDocument: protocol.TextDocumentItem{
+					URI: testScriptURI,
+					Text: fmt.Sprintf(
+						`
+                          import B from "./b.cdc" (hash: "%x")
+
+                          pub fun main(): Int {
+                              return B.count
+                          }
+                        `,
+						hash,
+					),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		return conn.diagnostics
+	}
+
+	t.Run("matching", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := check(t, runtime.CodeHash([]byte(testContractCode)))
+		assert.Empty(t, diagnostics)
+	})
+
+	t.Run("mismatching", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := check(t, make([]byte, 32))
+		require.NotEmpty(t, diagnostics)
+
+		assert.Equal(t,
+			"checking of imported program `./b.cdc` failed",
+			diagnostics[0].Message,
+		)
+	})
+}
@@ -0,0 +1,224 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// InlayHint is called when the client requests the inlay hints for a range of a document.
+//
+// Hints are provided for the inferred types of variable declarations without a type annotation,
+// the inferred type arguments of invocations of generic functions,
+// and the parameter names of arguments which require no label
+//
+func (s *Server) InlayHint(
+	_ protocol.Conn,
+	params *protocol.InlayHintParams,
+) (
+	[]*protocol.InlayHint,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	hints := []*protocol.InlayHint{}
+
+	checker := s.checkerForDocument(params.TextDocument.URI)
+	if checker == nil {
+		return hints, nil
+	}
+
+	startPosition := conversion.ProtocolToSemaPosition(params.Range.Start)
+	endPosition := conversion.ProtocolToSemaPosition(params.Range.End)
+
+	addHint := func(position ast.Position, hint *protocol.InlayHint) {
+		semaPosition := sema.ASTToSemaPosition(position)
+		if semaPosition.Compare(startPosition) < 0 || semaPosition.Compare(endPosition) > 0 {
+			return
+		}
+
+		hint.Position = conversion.ASTToProtocolPosition(position)
+		hints = append(hints, hint)
+	}
+
+	elaboration := checker.Elaboration
+
+	for declaration, targetType := range elaboration.VariableDeclarationTargetTypes {
+		if declaration.TypeAnnotation != nil || !isHintableType(targetType) {
+			continue
+		}
+
+		position := declaration.Identifier.EndPosition().Shifted(1)
+		addHint(position, typeInlayHint(position, ": "+typeAnnotationString(targetType)))
+	}
+
+	for invocation := range elaboration.InvocationExpressionArgumentTypes {
+		functionType := invokedFunctionType(elaboration, invocation)
+		if functionType == nil {
+			continue
+		}
+
+		if label, ok := inferredTypeArgumentsLabel(elaboration, invocation, functionType); ok {
+			position := invocation.InvokedExpression.EndPosition().Shifted(1)
+			addHint(position, typeInlayHint(position, label))
+		}
+
+		for i, argument := range invocation.Arguments {
+			if i >= len(functionType.Parameters) {
+				break
+			}
+
+			parameter := functionType.Parameters[i]
+			if argument.Label != "" ||
+				parameter.Label != sema.ArgumentLabelNotRequired ||
+				!isHintableParameterName(parameter.Identifier, argument.Expression) {
+
+				continue
+			}
+
+			addHint(
+				argument.Expression.StartPosition(),
+				&protocol.InlayHint{
+					Label:        fmt.Sprintf("%s:", parameter.Identifier),
+					Kind:         protocol.Parameter,
+					PaddingRight: true,
+				},
+			)
+		}
+	}
+
+	sort.Slice(hints, func(i, j int) bool {
+		a := hints[i].Position
+		b := hints[j].Position
+		return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+	})
+
+	return hints, nil
+}
+
+// typeInlayHint returns a type hint with the given label,
+// which inserts the label when accepted
+//
+func typeInlayHint(position ast.Position, label string) *protocol.InlayHint {
+	protocolPosition := conversion.ASTToProtocolPosition(position)
+
+	return &protocol.InlayHint{
+		Label: label,
+		Kind:  protocol.Type,
+		TextEdits: []protocol.TextEdit{
+			{
+				Range: protocol.Range{
+					Start: protocolPosition,
+					End:   protocolPosition,
+				},
+				NewText: label,
+			},
+		},
+	}
+}
+
+func isHintableType(ty sema.Type) bool {
+	return ty != nil && !ty.IsInvalidType()
+}
+
+// typeAnnotationString returns the given type as it has to be written in a type annotation,
+// i.e. including the resource annotation
+//
+func typeAnnotationString(ty sema.Type) string {
+	return sema.NewTypeAnnotation(ty).QualifiedString()
+}
+
+// isHintableParameterName returns true if the parameter name should be shown for the given argument.
+//
+// The name is not shown if the argument already has the same name, e.g. it is a variable with the same name
+//
+func isHintableParameterName(name string, argument ast.Expression) bool {
+	if name == "" || name == sema.ArgumentLabelNotRequired {
+		return false
+	}
+
+	switch argument := argument.(type) {
+	case *ast.IdentifierExpression:
+		return argument.Identifier.Identifier != name
+	case *ast.MemberExpression:
+		return argument.Identifier.Identifier != name
+	}
+
+	return true
+}
+
+// invokedFunctionType returns the type of the function invoked by the given invocation,
+// if the invoked expression is a variable or member
+//
+func invokedFunctionType(elaboration *sema.Elaboration, invocation *ast.InvocationExpression) *sema.FunctionType {
+	var ty sema.Type
+
+	switch invokedExpression := invocation.InvokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		ty = elaboration.IdentifierInInvocationTypes[invokedExpression]
+
+	case *ast.MemberExpression:
+		memberInfo, ok := elaboration.MemberExpressionMemberInfos[invokedExpression]
+		if ok && memberInfo.Member != nil && memberInfo.Member.TypeAnnotation != nil {
+			ty = memberInfo.Member.TypeAnnotation.Type
+		}
+	}
+
+	functionType, _ := ty.(*sema.FunctionType)
+	return functionType
+}
+
+// inferredTypeArgumentsLabel returns the label for the type arguments of the given invocation,
+// if the invocation has no explicit type arguments and all type arguments could be inferred
+//
+func inferredTypeArgumentsLabel(
+	elaboration *sema.Elaboration,
+	invocation *ast.InvocationExpression,
+	functionType *sema.FunctionType,
+) (string, bool) {
+	if len(invocation.TypeArguments) > 0 || len(functionType.TypeParameters) == 0 {
+		return "", false
+	}
+
+	typeArguments := elaboration.InvocationExpressionTypeArguments[invocation]
+	if typeArguments == nil {
+		return "", false
+	}
+
+	typeArgumentStrings := make([]string, len(functionType.TypeParameters))
+
+	for i, typeParameter := range functionType.TypeParameters {
+		typeArgument, ok := typeArguments.Get(typeParameter)
+		if !ok || !isHintableType(typeArgument) {
+			return "", false
+		}
+
+		typeArgumentStrings[i] = typeAnnotationString(typeArgument)
+	}
+
+	return fmt.Sprintf("<%s>", strings.Join(typeArgumentStrings, ", ")), true
+}
@@ -0,0 +1,183 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const testInlayHintsURI protocol.DocumentUri = "file:///project/e.cdc"
+
+const testInlayHintsCode = `
+pub resource R {}
+
+pub fun add(_ a: Int, _ b: Int): Int {
+    return a + b
+}
+
+pub fun test(account: AuthAccount) {
+    let a = 1
+    let b: Int = 2
+    let sum = add(a, b)
+    var r <- create R()
+    account.save(<-r, to: /storage/r)
+    let ref = account.borrow<&R>(from: /storage/r)
+}
+`
+
+func TestServer_InlayHint(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("document", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testInlayHintsURI, testInlayHintsCode)
+
+		hints, err := server.InlayHint(
+			testConn{},
+			&protocol.InlayHintParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testInlayHintsURI},
+				Range: protocol.Range{
+					End: protocol.Position{Line: 15},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		typeHint := func(line, character float64, label string) *protocol.InlayHint {
+			position := protocol.Position{Line: line, Character: character}
+			return &protocol.InlayHint{
+				Position: position,
+				Label:    label,
+				Kind:     protocol.Type,
+				TextEdits: []protocol.TextEdit{
+					{
+						Range:   protocol.Range{Start: position, End: position},
+						NewText: label,
+					},
+				},
+			}
+		}
+
+		assert.Equal(t,
+			[]*protocol.InlayHint{
+				// let a
+				typeHint(8, 9, ": Int"),
+				// let sum
+				typeHint(10, 11, ": Int"),
+				// var r
+				typeHint(11, 9, ": @R"),
+				// account.save
+				typeHint(12, 16, "<@R>"),
+				{
+					Position:     protocol.Position{Line: 12, Character: 17},
+					Label:        "value:",
+					Kind:         protocol.Parameter,
+					PaddingRight: true,
+				},
+				// let ref
+				typeHint(13, 11, ": &R?"),
+			},
+			hints,
+		)
+	})
+
+	t.Run("range", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testInlayHintsURI, testInlayHintsCode)
+
+		hints, err := server.InlayHint(
+			testConn{},
+			&protocol.InlayHintParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testInlayHintsURI},
+				Range: protocol.Range{
+					Start: protocol.Position{Line: 11},
+					End:   protocol.Position{Line: 11, Character: 20},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, hints, 1)
+		assert.Equal(t, ": @R", hints[0].Label)
+	})
+
+	t.Run("parameter names", func(t *testing.T) {
+
+		t.Parallel()
+
+		code := `
+pub fun add(_ a: Int, _ b: Int): Int {
+    return a + b
+}
+
+pub let sum = add(1, 2)
+`
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testInlayHintsURI, code)
+
+		hints, err := server.InlayHint(
+			testConn{},
+			&protocol.InlayHintParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testInlayHintsURI},
+				Range: protocol.Range{
+					Start: protocol.Position{Line: 5},
+					End:   protocol.Position{Line: 6},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		labels := make([]string, len(hints))
+		for i, hint := range hints {
+			labels[i] = hint.Label
+		}
+
+		assert.Equal(t, []string{": Int", "a:", "b:"}, labels)
+	})
+
+	t.Run("unchecked document", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+
+		hints, err := server.InlayHint(
+			testConn{},
+			&protocol.InlayHintParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testInlayHintsURI},
+			},
+		)
+		require.NoError(t, err)
+		assert.NotNil(t, hints)
+		assert.Empty(t, hints)
+	})
+}
@@ -66,3 +66,19 @@ func uriToLocation(uri protocol.DocumentUri) common.StringLocation {
 		strings.TrimPrefix(string(uri), filePrefix),
 	)
 }
+
+// locationToURI returns the URI of the document for the given location,
+// if the location is a path
+//
+func locationToURI(location common.Location) (protocol.DocumentUri, bool) {
+	locationPath := locationToPath(location)
+	if locationPath == "" {
+		return "", false
+	}
+
+	if path.IsAbs(locationPath) {
+		return protocol.DocumentUri(filePrefix + locationPath), true
+	}
+
+	return protocol.DocumentUri(locationPath), true
+}
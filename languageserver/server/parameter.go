@@ -27,6 +27,8 @@ import (
 type Parameter struct {
 	Name string `json:"name"`
 	Type string `json:"type"`
+	// Default is the source of the parameter's default argument, if any
+	Default string `json:"default,omitempty"`
 }
 
 func encodeParameters(parameters []*sema.Parameter) []Parameter {
@@ -44,9 +46,15 @@ func encodeParameters(parameters []*sema.Parameter) []Parameter {
 			typeID = parameterType.ID()
 		}
 
+		var defaultArgument string
+		if parameter.DefaultArgument != nil {
+			defaultArgument = parameter.DefaultArgument.String()
+		}
+
 		encodedParameters[i] = Parameter{
-			Name: parameter.EffectiveArgumentLabel(),
-			Type: typeID,
+			Name:    parameter.EffectiveArgumentLabel(),
+			Type:    typeID,
+			Default: defaultArgument,
 		}
 	}
 
@@ -0,0 +1,90 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestEncodeParameters(t *testing.T) {
+
+	t.Parallel()
+
+	encodedParameters := encodeParameters([]*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "a",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.IntType),
+		},
+		{
+			Identifier:     "b",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+	})
+
+	assert.Equal(t,
+		[]Parameter{
+			{Name: sema.ArgumentLabelNotRequired, Type: "Int"},
+			{Name: "b", Type: "String"},
+		},
+		encodedParameters,
+	)
+
+	// Parameters without a default argument have no default in the encoding
+
+	encoded, err := json.Marshal(encodedParameters[1])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "b", "type": "String"}`, string(encoded))
+}
+
+func TestEncodeParametersDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	encodedParameters := encodeParameters([]*sema.Parameter{
+		{
+			Identifier:     "a",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.IntType),
+			DefaultArgument: &ast.IntegerExpression{
+				PositiveLiteral: "42",
+				Value:           big.NewInt(42),
+				Base:            10,
+			},
+		},
+	})
+
+	assert.Equal(t,
+		[]Parameter{
+			{Name: "a", Type: "Int", Default: "42"},
+		},
+		encodedParameters,
+	)
+
+	encoded, err := json.Marshal(encodedParameters[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "a", "type": "Int", "default": "42"}`, string(encoded))
+}
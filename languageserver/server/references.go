@@ -0,0 +1,559 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// symbol identifies a declaration which can be referred to from other programs:
+// a composite or interface type, or a member of one.
+//
+// Occurrences are only related to their declaration within the checker of a single program,
+// so symbols are identified by type ID, which is the same in all programs
+//
+type symbol struct {
+	typeID common.TypeID
+	// member is the name of the member, or empty if the symbol is the type itself
+	member string
+}
+
+func (s symbol) String() string {
+	if s.member == "" {
+		return string(s.typeID)
+	}
+	return fmt.Sprintf("%s.%s", s.typeID, s.member)
+}
+
+// symbolOccurrence is an occurrence of a symbol in a program
+//
+type symbolOccurrence struct {
+	symbol        symbol
+	startPos      ast.Position
+	endPos        ast.Position
+	isDeclaration bool
+}
+
+func (o symbolOccurrence) contains(position sema.Position) bool {
+	return position.Compare(sema.ASTToSemaPosition(o.startPos)) >= 0 &&
+		position.Compare(sema.ASTToSemaPosition(o.endPos)) <= 0
+}
+
+func typeSymbol(ty sema.Type) (symbol, bool) {
+	switch ty := ty.(type) {
+	case *sema.CompositeType:
+		return symbol{typeID: ty.ID()}, true
+	case *sema.InterfaceType:
+		return symbol{typeID: ty.ID()}, true
+	}
+	return symbol{}, false
+}
+
+func memberSymbol(containerType sema.Type, member string) (symbol, bool) {
+	result, ok := typeSymbol(containerType)
+	if !ok {
+		return symbol{}, false
+	}
+	result.member = member
+	return result, true
+}
+
+// symbolOccurrences returns the occurrences of all symbols in the program checked by the given checker,
+// sorted by position
+//
+func symbolOccurrences(checker *sema.Checker) []symbolOccurrence {
+
+	type key struct {
+		symbol   symbol
+		startPos sema.Position
+	}
+
+	var occurrences []symbolOccurrence
+	seen := map[key]int{}
+
+	add := func(symbol symbol, startPos, endPos ast.Position, isDeclaration bool) {
+		k := key{
+			symbol:   symbol,
+			startPos: sema.ASTToSemaPosition(startPos),
+		}
+		if index, ok := seen[k]; ok {
+			if isDeclaration {
+				occurrences[index].isDeclaration = true
+			}
+			return
+		}
+		seen[k] = len(occurrences)
+		occurrences = append(occurrences, symbolOccurrence{
+			symbol:        symbol,
+			startPos:      startPos,
+			endPos:        endPos,
+			isDeclaration: isDeclaration,
+		})
+	}
+
+	addIdentifier := func(symbol symbol, identifier ast.Identifier, isDeclaration bool) {
+		add(symbol, identifier.StartPosition(), identifier.EndPosition(), isDeclaration)
+	}
+
+	addMembers := func(containerType sema.Type, members *ast.Members) {
+		for _, field := range members.Fields() {
+			if symbol, ok := memberSymbol(containerType, field.Identifier.Identifier); ok {
+				addIdentifier(symbol, field.Identifier, true)
+			}
+		}
+		for _, function := range members.Functions() {
+			if symbol, ok := memberSymbol(containerType, function.Identifier.Identifier); ok {
+				addIdentifier(symbol, function.Identifier, true)
+			}
+		}
+	}
+
+	elaboration := checker.Elaboration
+
+	// Declarations of types and their members
+
+	for declaration, compositeType := range elaboration.CompositeDeclarationTypes {
+		if symbol, ok := typeSymbol(compositeType); ok {
+			addIdentifier(symbol, declaration.Identifier, true)
+		}
+		addMembers(compositeType, declaration.Members)
+	}
+
+	for declaration, interfaceType := range elaboration.InterfaceDeclarationTypes {
+		if symbol, ok := typeSymbol(interfaceType); ok {
+			addIdentifier(symbol, declaration.Identifier, true)
+		}
+		addMembers(interfaceType, declaration.Members)
+	}
+
+	// Accesses of members
+
+	for expression, memberInfo := range elaboration.MemberExpressionMemberInfos {
+		member := memberInfo.Member
+		if member == nil {
+			continue
+		}
+		if symbol, ok := memberSymbol(member.ContainerType, member.Identifier.Identifier); ok {
+			addIdentifier(symbol, expression.Identifier, false)
+		}
+	}
+
+	// References to types.
+	// Occurrences are only recorded if position info is enabled
+
+	var typeOccurrences []sema.Occurrence
+	if checker.Occurrences != nil {
+		typeOccurrences = checker.Occurrences.All()
+	}
+
+	for _, occurrence := range typeOccurrences {
+		origin := occurrence.Origin
+		if origin == nil || !origin.DeclarationKind.IsTypeDeclaration() {
+			continue
+		}
+
+		symbol, ok := typeSymbol(origin.Type)
+		if !ok {
+			continue
+		}
+
+		// NOTE: occurrences only have a line and column
+
+		add(
+			symbol,
+			ast.Position{
+				Line:   occurrence.StartPos.Line,
+				Column: occurrence.StartPos.Column,
+			},
+			ast.Position{
+				Line:   occurrence.EndPos.Line,
+				Column: occurrence.EndPos.Column,
+			},
+			false,
+		)
+	}
+
+	// Imported types
+
+	if checker.Program != nil {
+		for _, declaration := range checker.Program.ImportDeclarations() {
+			location := declaration.Location
+			if isPathLocation(location) {
+				location = normalizePathLocation(checker.Location, location)
+			}
+			for _, identifier := range declaration.Identifiers {
+				addIdentifier(
+					symbol{typeID: location.TypeID(identifier.Identifier)},
+					identifier,
+					false,
+				)
+			}
+		}
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		a := sema.ASTToSemaPosition(occurrences[i].startPos)
+		b := sema.ASTToSemaPosition(occurrences[j].startPos)
+		return a.Compare(b) < 0
+	})
+
+	return occurrences
+}
+
+// conformanceSymbols returns pairs of symbols of the members of the composites declared
+// in the program checked by the given checker, and the members of the interfaces they conform to.
+//
+// A member of an interface is referred to through the interface type or a restricted type,
+// but is implemented by the members of all conforming composites, so these symbols are related
+//
+func conformanceSymbols(checker *sema.Checker) [][2]symbol {
+	var result [][2]symbol
+
+	for _, compositeType := range checker.Elaboration.CompositeDeclarationTypes {
+		for _, interfaceType := range compositeType.ExplicitInterfaceConformances {
+			interfaceType.Members.Foreach(func(name string, _ *sema.Member) {
+				if _, ok := compositeType.Members.Get(name); !ok {
+					return
+				}
+
+				result = append(result, [2]symbol{
+					{typeID: compositeType.ID(), member: name},
+					{typeID: interfaceType.ID(), member: name},
+				})
+			})
+		}
+	}
+
+	return result
+}
+
+// relatedSymbols returns the given symbol and all symbols related to it
+// in all open documents and resolved imports, see conformanceSymbols.
+//
+// For example, for a member of a composite, the result includes the member of the interface it implements,
+// and the members of all other composites which implement the same interface member
+//
+func (s *Server) relatedSymbols(target symbol) map[symbol]struct{} {
+
+	related := map[symbol][]symbol{}
+
+	for _, checker := range s.checkers {
+		for _, pair := range conformanceSymbols(checker) {
+			related[pair[0]] = append(related[pair[0]], pair[1])
+			related[pair[1]] = append(related[pair[1]], pair[0])
+		}
+	}
+
+	result := map[symbol]struct{}{
+		target: {},
+	}
+
+	pending := []symbol{target}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		for _, other := range related[current] {
+			if _, ok := result[other]; ok {
+				continue
+			}
+			result[other] = struct{}{}
+			pending = append(pending, other)
+		}
+	}
+
+	return result
+}
+
+// symbolAt returns the symbol at the given position in the program checked by the given checker.
+// If there is no symbol at the position, the preceding position is tried
+//
+func symbolAt(checker *sema.Checker, position sema.Position) (symbol, bool) {
+	occurrences := symbolOccurrences(checker)
+
+	find := func(position sema.Position) (symbol, bool) {
+		for _, occurrence := range occurrences {
+			if occurrence.contains(position) {
+				return occurrence.symbol, true
+			}
+		}
+		return symbol{}, false
+	}
+
+	result, ok := find(position)
+	if !ok && position.Column > 0 {
+		previousPosition := position
+		previousPosition.Column -= 1
+		result, ok = find(previousPosition)
+	}
+	return result, ok
+}
+
+// symbolLocation is the location of an occurrence of a symbol in a program
+//
+type symbolLocation struct {
+	location      common.Location
+	startPos      ast.Position
+	endPos        ast.Position
+	isDeclaration bool
+}
+
+// findSymbol returns the locations of all occurrences of the given symbol and its related symbols
+// in all open documents and resolved imports, ordered by location and position
+//
+func (s *Server) findSymbol(target symbol) []symbolLocation {
+
+	targets := s.relatedSymbols(target)
+
+	locationIDs := make([]string, 0, len(s.checkers))
+	for locationID := range s.checkers {
+		locationIDs = append(locationIDs, string(locationID))
+	}
+	sort.Strings(locationIDs)
+
+	var result []symbolLocation
+
+	for _, locationID := range locationIDs {
+		checker := s.checkers[common.LocationID(locationID)]
+
+		for _, occurrence := range symbolOccurrences(checker) {
+			if _, ok := targets[occurrence.symbol]; !ok {
+				continue
+			}
+
+			result = append(result, symbolLocation{
+				location:      checker.Location,
+				startPos:      occurrence.startPos,
+				endPos:        occurrence.endPos,
+				isDeclaration: occurrence.isDeclaration,
+			})
+		}
+	}
+
+	return result
+}
+
+// localOccurrences returns the ranges of all occurrences of the declarations at the given position
+// in the program checked by the given checker.
+// If there are no occurrences at the position, the preceding position is tried
+//
+func localOccurrences(checker *sema.Checker, position sema.Position) (ranges []ast.Range, declarations []ast.Range) {
+	occurrences := checker.Occurrences.FindAll(position)
+	if len(occurrences) == 0 && position.Column > 0 {
+		previousPosition := position
+		previousPosition.Column -= 1
+		occurrences = checker.Occurrences.FindAll(previousPosition)
+	}
+
+	for _, occurrence := range occurrences {
+
+		origin := occurrence.Origin
+		if origin == nil || origin.StartPos == nil || origin.EndPos == nil {
+			continue
+		}
+
+		declarations = append(declarations, ast.Range{
+			StartPos: *origin.StartPos,
+			EndPos:   *origin.EndPos,
+		})
+
+		ranges = append(ranges, origin.Occurrences...)
+	}
+
+	return
+}
+
+// References returns the locations of all references to the symbol at the given position,
+// in all open documents and resolved imports.
+//
+// References to local declarations are only found in the current document
+//
+func (s *Server) References(
+	_ protocol.Conn,
+	params *protocol.ReferenceParams,
+) (
+	[]*protocol.Location,
+	error,
+) {
+	uri := params.TextDocument.URI
+	checker := s.checkerForDocument(uri)
+	if checker == nil {
+		return nil, nil
+	}
+
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	locations := []*protocol.Location{}
+
+	includeDeclaration := params.Context.IncludeDeclaration
+
+	position := conversion.ProtocolToSemaPosition(params.Position)
+
+	if symbol, ok := symbolAt(checker, position); ok {
+		for _, symbolLocation := range s.findSymbol(symbol) {
+			if symbolLocation.isDeclaration && !includeDeclaration {
+				continue
+			}
+
+			locationURI, ok := locationToURI(symbolLocation.location)
+			if !ok {
+				continue
+			}
+
+			locations = append(locations, &protocol.Location{
+				URI: locationURI,
+				Range: conversion.ASTToProtocolRange(
+					symbolLocation.startPos,
+					symbolLocation.endPos,
+				),
+			})
+		}
+
+		return locations, nil
+	}
+
+	ranges, declarations := localOccurrences(checker, position)
+
+	isDeclaration := func(occurrenceRange ast.Range) bool {
+		for _, declaration := range declarations {
+			if declaration.StartPos == occurrenceRange.StartPos {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, occurrenceRange := range ranges {
+		if !includeDeclaration && isDeclaration(occurrenceRange) {
+			continue
+		}
+
+		locations = append(locations, &protocol.Location{
+			URI: uri,
+			Range: conversion.ASTToProtocolRange(
+				occurrenceRange.StartPos,
+				occurrenceRange.EndPos,
+			),
+		})
+	}
+
+	return locations, nil
+}
+
+// Rename renames the symbol at the given position.
+//
+// Types and their members are renamed in all open documents and resolved imports,
+// local declarations are only renamed in the current document.
+//
+// Members of interfaces are renamed together with the members of the conforming composites which implement them,
+// so the composites still conform to the interfaces
+//
+func (s *Server) Rename(
+	_ protocol.Conn,
+	params *protocol.RenameParams,
+) (
+	*protocol.WorkspaceEdit,
+	error,
+) {
+	uri := params.TextDocument.URI
+	checker := s.checkerForDocument(uri)
+	if checker == nil {
+		return nil, nil
+	}
+
+	changes := map[string][]protocol.TextEdit{}
+
+	position := conversion.ProtocolToSemaPosition(params.Position)
+
+	if symbol, ok := symbolAt(checker, position); ok {
+
+		symbolLocations := s.findSymbol(symbol)
+
+		// The declaration must be in an editable document
+
+		declared := false
+		for _, symbolLocation := range symbolLocations {
+			if !symbolLocation.isDeclaration {
+				continue
+			}
+
+			if _, ok := locationToURI(symbolLocation.location); !ok {
+				return nil, fmt.Errorf("cannot rename %s: declared in %s", symbol, symbolLocation.location)
+			}
+
+			declared = true
+		}
+
+		if !declared {
+			return nil, fmt.Errorf("cannot rename %s: declaration not found", symbol)
+		}
+
+		for _, symbolLocation := range symbolLocations {
+			locationURI, ok := locationToURI(symbolLocation.location)
+			if !ok {
+				continue
+			}
+
+			key := string(locationURI)
+			changes[key] = append(changes[key],
+				protocol.TextEdit{
+					Range: conversion.ASTToProtocolRange(
+						symbolLocation.startPos,
+						symbolLocation.endPos,
+					),
+					NewText: params.NewName,
+				},
+			)
+		}
+
+		return &protocol.WorkspaceEdit{
+			Changes: &changes,
+		}, nil
+	}
+
+	ranges, _ := localOccurrences(checker, position)
+
+	textEdits := make([]protocol.TextEdit, 0, len(ranges))
+
+	for _, occurrenceRange := range ranges {
+		textEdits = append(textEdits,
+			protocol.TextEdit{
+				Range: conversion.ASTToProtocolRange(
+					occurrenceRange.StartPos,
+					occurrenceRange.EndPos,
+				),
+				NewText: params.NewName,
+			},
+		)
+	}
+
+	changes[string(uri)] = textEdits
+
+	return &protocol.WorkspaceEdit{
+		Changes: &changes,
+	}, nil
+}
@@ -0,0 +1,378 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+type testConn struct{}
+
+var _ protocol.Conn = testConn{}
+
+func (testConn) Notify(_ string, _ interface{}) error {
+	return nil
+}
+
+func (testConn) ShowMessage(_ *protocol.ShowMessageParams) {}
+
+func (testConn) LogMessage(_ *protocol.LogMessageParams) {}
+
+func (testConn) PublishDiagnostics(_ *protocol.PublishDiagnosticsParams) error {
+	return nil
+}
+
+func (testConn) RegisterCapability(_ *protocol.RegistrationParams) error {
+	return nil
+}
+
+const testContractCode = `
+pub contract B {
+
+    pub var count: Int
+
+    init() {
+        self.count = 0
+    }
+
+    pub fun increment() {
+        self.count = self.count + 1
+    }
+}
+`
+
+const testScriptCode = `
+import B from "./b.cdc"
+
+pub fun main(): Int {
+    let count = B.count
+    B.increment()
+    return count
+}
+`
+
+const testContractURI protocol.DocumentUri = "file:///project/b.cdc"
+const testScriptURI protocol.DocumentUri = "file:///project/a.cdc"
+
+// newTestServer returns a server with the script opened.
+// The contract is not opened, but resolved as an import
+//
+func newTestServer(t *testing.T) *Server {
+	server, err := NewServer()
+	require.NoError(t, err)
+
+	err = server.SetOptions(
+		WithStringImportResolver(func(location common.StringLocation) (string, error) {
+			if location != "/project/b.cdc" {
+				return "", fmt.Errorf("unknown location: %s", location)
+			}
+			return testContractCode, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	err = server.DidOpenTextDocument(
+		testConn{},
+		&protocol.DidOpenTextDocumentParams{
+			TextDocument: protocol.TextDocumentItem{
+				URI:  testScriptURI,
+				Text: testScriptCode,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	return server
+}
+
+func testRange(line, column, length int) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{Line: float64(line - 1), Character: float64(column)},
+		End:   protocol.Position{Line: float64(line - 1), Character: float64(column + length)},
+	}
+}
+
+func TestServer_References(t *testing.T) {
+
+	t.Parallel()
+
+	references := func(t *testing.T, line, column int, includeDeclaration bool) []*protocol.Location {
+		server := newTestServer(t)
+
+		params := &protocol.ReferenceParams{
+			Context: protocol.ReferenceContext{
+				IncludeDeclaration: includeDeclaration,
+			},
+		}
+		params.TextDocument.URI = testScriptURI
+		params.Position = protocol.Position{
+			Line:      float64(line - 1),
+			Character: float64(column),
+		}
+
+		locations, err := server.References(testConn{}, params)
+		require.NoError(t, err)
+		return locations
+	}
+
+	t.Run("member of imported contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		// `count` in `B.count`
+
+		assert.Equal(t,
+			[]*protocol.Location{
+				{URI: testScriptURI, Range: testRange(5, 18, 5)},
+				{URI: testContractURI, Range: testRange(4, 12, 5)},
+				{URI: testContractURI, Range: testRange(7, 13, 5)},
+				{URI: testContractURI, Range: testRange(11, 13, 5)},
+				{URI: testContractURI, Range: testRange(11, 26, 5)},
+			},
+			references(t, 5, 19, true),
+		)
+	})
+
+	t.Run("member of imported contract, without declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		// `increment` in `B.increment()`
+
+		assert.Equal(t,
+			[]*protocol.Location{
+				{URI: testScriptURI, Range: testRange(6, 6, 9)},
+			},
+			references(t, 6, 8, false),
+		)
+	})
+
+	t.Run("imported contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		// `B` in `B.increment()`
+
+		assert.Equal(t,
+			[]*protocol.Location{
+				{URI: testScriptURI, Range: testRange(2, 7, 1)},
+				{URI: testScriptURI, Range: testRange(5, 16, 1)},
+				{URI: testScriptURI, Range: testRange(6, 4, 1)},
+				{URI: testContractURI, Range: testRange(2, 13, 1)},
+			},
+			references(t, 6, 4, true),
+		)
+	})
+
+	t.Run("local", func(t *testing.T) {
+
+		t.Parallel()
+
+		// `count` in `return count`
+
+		assert.Equal(t,
+			[]*protocol.Location{
+				{URI: testScriptURI, Range: testRange(7, 11, 5)},
+			},
+			references(t, 7, 12, false),
+		)
+	})
+}
+
+func TestServer_Rename(t *testing.T) {
+
+	t.Parallel()
+
+	rename := func(t *testing.T, line, column int) (*protocol.WorkspaceEdit, error) {
+		server := newTestServer(t)
+
+		params := &protocol.RenameParams{
+			NewName: "counter",
+		}
+		params.TextDocument.URI = testScriptURI
+		params.Position = protocol.Position{
+			Line:      float64(line - 1),
+			Character: float64(column),
+		}
+
+		return server.Rename(testConn{}, params)
+	}
+
+	textEdits := func(ranges ...protocol.Range) []protocol.TextEdit {
+		result := make([]protocol.TextEdit, len(ranges))
+		for i, r := range ranges {
+			result[i] = protocol.TextEdit{
+				Range:   r,
+				NewText: "counter",
+			}
+		}
+		return result
+	}
+
+	t.Run("member of imported contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		edit, err := rename(t, 5, 19)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			map[string][]protocol.TextEdit{
+				string(testScriptURI): textEdits(
+					testRange(5, 18, 5),
+				),
+				string(testContractURI): textEdits(
+					testRange(4, 12, 5),
+					testRange(7, 13, 5),
+					testRange(11, 13, 5),
+					testRange(11, 26, 5),
+				),
+			},
+			*edit.Changes,
+		)
+	})
+
+	t.Run("local", func(t *testing.T) {
+
+		t.Parallel()
+
+		edit, err := rename(t, 7, 12)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			map[string][]protocol.TextEdit{
+				string(testScriptURI): textEdits(
+					testRange(5, 8, 5),
+					testRange(7, 11, 5),
+				),
+			},
+			*edit.Changes,
+		)
+	})
+
+	t.Run("built-in member", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+
+		err := server.DidOpenTextDocument(
+			testConn{},
+			&protocol.DidOpenTextDocumentParams{
+				TextDocument: protocol.TextDocumentItem{
+					URI:  "file:///project/c.cdc",
+					Text: "pub let x = getAccount(0x1).address",
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		params := &protocol.RenameParams{
+			NewName: "counter",
+		}
+		params.TextDocument.URI = "file:///project/c.cdc"
+		params.Position = protocol.Position{Line: 0, Character: 30}
+
+		_, err = server.Rename(testConn{}, params)
+		require.Error(t, err)
+	})
+
+	t.Run("member of interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The members of the interface, of the conforming composite,
+		// and accesses through the interface and restricted types are renamed together
+
+		const code = `
+pub struct interface I {
+    pub fun get(): Int
+}
+
+pub struct S: I {
+    pub fun get(): Int {
+        return 1
+    }
+}
+
+pub struct T {
+    pub fun get(): Int {
+        return 2
+    }
+}
+
+pub fun main(): Int {
+    let i: {I} = S()
+    let s: S{I} = S()
+    return i.get() + s.get() + S().get() + T().get()
+}
+`
+
+		const uri protocol.DocumentUri = "file:///project/c.cdc"
+
+		server := newTestServer(t)
+
+		err := server.DidOpenTextDocument(
+			testConn{},
+			&protocol.DidOpenTextDocumentParams{
+				TextDocument: protocol.TextDocumentItem{
+					URI:  uri,
+					Text: code,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		expected := map[string][]protocol.TextEdit{
+			string(uri): textEdits(
+				testRange(3, 12, 3),
+				testRange(7, 12, 3),
+				testRange(21, 13, 3),
+				testRange(21, 23, 3),
+				testRange(21, 35, 3),
+			),
+		}
+
+		// Renaming the interface member and renaming the composite member are equivalent
+
+		for _, position := range []protocol.Position{
+			{Line: 2, Character: 13},
+			{Line: 6, Character: 13},
+		} {
+			params := &protocol.RenameParams{
+				NewName: "counter",
+			}
+			params.TextDocument.URI = uri
+			params.Position = position
+
+			edit, err := server.Rename(testConn{}, params)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, *edit.Changes)
+		}
+	})
+}
@@ -0,0 +1,831 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser2/lexer"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// semanticTokenType is the type of a semantic token.
+// The value is the index of the type in the legend
+//
+type semanticTokenType uint32
+
+const (
+	semanticTokenTypeNamespace semanticTokenType = iota
+	semanticTokenTypeType
+	semanticTokenTypeClass
+	semanticTokenTypeEnum
+	semanticTokenTypeInterface
+	semanticTokenTypeStruct
+	semanticTokenTypeTypeParameter
+	semanticTokenTypeParameter
+	semanticTokenTypeVariable
+	semanticTokenTypeProperty
+	semanticTokenTypeEnumMember
+	semanticTokenTypeEvent
+	semanticTokenTypeFunction
+	semanticTokenTypeMethod
+	semanticTokenTypeKeyword
+	semanticTokenTypeComment
+	semanticTokenTypeString
+	semanticTokenTypeNumber
+	semanticTokenTypeOperator
+)
+
+// semanticTokenTypeNames are the names of the semantic token types, indexed by type.
+//
+// Contracts are namespaces, and resources are classes
+//
+var semanticTokenTypeNames = []string{
+	semanticTokenTypeNamespace:     "namespace",
+	semanticTokenTypeType:          "type",
+	semanticTokenTypeClass:         "class",
+	semanticTokenTypeEnum:          "enum",
+	semanticTokenTypeInterface:     "interface",
+	semanticTokenTypeStruct:        "struct",
+	semanticTokenTypeTypeParameter: "typeParameter",
+	semanticTokenTypeParameter:     "parameter",
+	semanticTokenTypeVariable:      "variable",
+	semanticTokenTypeProperty:      "property",
+	semanticTokenTypeEnumMember:    "enumMember",
+	semanticTokenTypeEvent:         "event",
+	semanticTokenTypeFunction:      "function",
+	semanticTokenTypeMethod:        "method",
+	semanticTokenTypeKeyword:       "keyword",
+	semanticTokenTypeComment:       "comment",
+	semanticTokenTypeString:        "string",
+	semanticTokenTypeNumber:        "number",
+	semanticTokenTypeOperator:      "operator",
+}
+
+// semanticTokenModifiers is a set of semantic token modifiers.
+// Each bit is the index of the modifier in the legend
+//
+type semanticTokenModifiers uint32
+
+const (
+	semanticTokenModifierDeclaration semanticTokenModifiers = 1 << iota
+	semanticTokenModifierReadonly
+	semanticTokenModifierDefaultLibrary
+	semanticTokenModifierResource
+	semanticTokenModifierPublic
+	semanticTokenModifierPublicSettable
+	semanticTokenModifierPrivate
+	semanticTokenModifierContract
+	semanticTokenModifierAccount
+)
+
+// semanticTokenModifierNames are the names of the semantic token modifiers, in order of their bits.
+//
+// In addition to the standard modifiers, resource types and values are marked with `resource`,
+// and declarations and members are marked with their access level
+//
+var semanticTokenModifierNames = []string{
+	"declaration",
+	"readonly",
+	"defaultLibrary",
+	"resource",
+	"public",
+	"publicSettable",
+	"private",
+	"contract",
+	"account",
+}
+
+// semanticTokenAccessModifiers is the set of all access level modifiers
+//
+const semanticTokenAccessModifiers = semanticTokenModifierPublic |
+	semanticTokenModifierPublicSettable |
+	semanticTokenModifierPrivate |
+	semanticTokenModifierContract |
+	semanticTokenModifierAccount
+
+var semanticTokensLegend = protocol.SemanticTokensLegend{
+	TokenTypes:     semanticTokenTypeNames,
+	TokenModifiers: semanticTokenModifierNames,
+}
+
+func accessSemanticTokenModifiers(access ast.Access) semanticTokenModifiers {
+	switch access {
+	case ast.AccessPublic:
+		return semanticTokenModifierPublic
+	case ast.AccessPublicSettable:
+		return semanticTokenModifierPublicSettable
+	case ast.AccessPrivate:
+		return semanticTokenModifierPrivate
+	case ast.AccessContract:
+		return semanticTokenModifierContract
+	case ast.AccessAccount:
+		return semanticTokenModifierAccount
+	}
+	return 0
+}
+
+// semanticTokenKeywords are the identifiers which are keywords.
+//
+// Some keywords, e.g. `from` and `account`, are only keywords in certain contexts,
+// and may also be used as argument labels or member names
+//
+var semanticTokenKeywords = map[string]struct{}{
+	"if":          {},
+	"else":        {},
+	"while":       {},
+	"break":       {},
+	"continue":    {},
+	"return":      {},
+	"true":        {},
+	"false":       {},
+	"nil":         {},
+	"let":         {},
+	"var":         {},
+	"fun":         {},
+	"as":          {},
+	"create":      {},
+	"destroy":     {},
+	"for":         {},
+	"in":          {},
+	"emit":        {},
+	"auth":        {},
+	"priv":        {},
+	"pub":         {},
+	"access":      {},
+	"set":         {},
+	"all":         {},
+	"self":        {},
+	"init":        {},
+	"contract":    {},
+	"account":     {},
+	"import":      {},
+	"from":        {},
+	"pre":         {},
+	"post":        {},
+	"event":       {},
+	"struct":      {},
+	"resource":    {},
+	"interface":   {},
+	"prepare":     {},
+	"execute":     {},
+	"case":        {},
+	"switch":      {},
+	"default":     {},
+	"enum":        {},
+	"transaction": {},
+}
+
+// semanticTokenClass is the classification of a token
+//
+type semanticTokenClass struct {
+	tokenType semanticTokenType
+	modifiers semanticTokenModifiers
+}
+
+// semanticToken is a classified token on a single line.
+// The line and start character are zero-based
+//
+type semanticToken struct {
+	line      uint32
+	startChar uint32
+	length    uint32
+	class     semanticTokenClass
+}
+
+func (t semanticToken) before(line, char uint32) bool {
+	return t.line < line || (t.line == line && t.startChar < char)
+}
+
+// SemanticTokensFull is called when the client requests the semantic tokens of a whole document.
+//
+func (s *Server) SemanticTokensFull(
+	_ protocol.Conn,
+	params *protocol.SemanticTokensParams,
+) (
+	*protocol.SemanticTokens,
+	error,
+) {
+	return s.semanticTokens(params.TextDocument.URI, nil), nil
+}
+
+// SemanticTokensRange is called when the client requests the semantic tokens of a range of a document,
+// e.g. the visible part.
+//
+func (s *Server) SemanticTokensRange(
+	_ protocol.Conn,
+	params *protocol.SemanticTokensRangeParams,
+) (
+	*protocol.SemanticTokens,
+	error,
+) {
+	return s.semanticTokens(params.TextDocument.URI, &params.Range), nil
+}
+
+func (s *Server) semanticTokens(uri protocol.DocumentUri, tokenRange *protocol.Range) *protocol.SemanticTokens {
+	result := &protocol.SemanticTokens{
+		// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+		// The later is not a valid result
+		Data: []uint32{},
+	}
+
+	document, ok := s.documents[uri]
+	if !ok {
+		return result
+	}
+
+	// The checker is missing if the document could not be parsed,
+	// in which case only the lexical tokens are classified
+
+	var identifierClasses map[sema.Position]semanticTokenClass
+	checker := s.checkerForDocument(uri)
+	if checker != nil {
+		identifierClasses = semanticIdentifierClasses(checker)
+	}
+
+	tokens := lexicalSemanticTokens(document.Text, identifierClasses)
+
+	if tokenRange != nil {
+		startLine, startChar := uint32(tokenRange.Start.Line), uint32(tokenRange.Start.Character)
+		endLine, endChar := uint32(tokenRange.End.Line), uint32(tokenRange.End.Character)
+
+		filtered := tokens[:0]
+		for _, token := range tokens {
+			if token.before(startLine, startChar) || !token.before(endLine, endChar) {
+				continue
+			}
+			filtered = append(filtered, token)
+		}
+		tokens = filtered
+	}
+
+	result.Data = encodeSemanticTokens(tokens)
+
+	return result
+}
+
+// encodeSemanticTokens encodes the given tokens, which must be sorted by position.
+//
+// Each token is encoded as five integers: the line and start character relative to the previous token,
+// the length, the type, and the modifiers
+//
+func encodeSemanticTokens(tokens []semanticToken) []uint32 {
+	data := make([]uint32, 0, len(tokens)*5)
+
+	var previousLine, previousStartChar uint32
+
+	for _, token := range tokens {
+		deltaLine := token.line - previousLine
+		deltaStartChar := token.startChar
+		if deltaLine == 0 {
+			deltaStartChar -= previousStartChar
+		}
+
+		data = append(
+			data,
+			deltaLine,
+			deltaStartChar,
+			token.length,
+			uint32(token.class.tokenType),
+			uint32(token.class.modifiers),
+		)
+
+		previousLine = token.line
+		previousStartChar = token.startChar
+	}
+
+	return data
+}
+
+// lexicalSemanticTokens lexes the given code and returns the classified tokens, sorted by position.
+//
+// Identifiers are classified using the given classes, if any.
+// Otherwise, keywords are classified as such, and other identifiers are not classified
+//
+func lexicalSemanticTokens(code string, identifierClasses map[sema.Position]semanticTokenClass) []semanticToken {
+	tokenStream := lexer.Lex(code)
+
+	var lexerTokens []lexer.Token
+	for {
+		token := tokenStream.Next()
+		if token.Is(lexer.TokenEOF) {
+			break
+		}
+		if token.Is(lexer.TokenSpace) {
+			continue
+		}
+		lexerTokens = append(lexerTokens, token)
+	}
+
+	var tokens []semanticToken
+
+	add := func(startPos, endPos ast.Position, class semanticTokenClass) {
+		tokens = appendSemanticTokens(tokens, code, startPos, endPos, class)
+	}
+
+	// Block comments may be nested, and are lexed as start, content, and end tokens.
+	// The whole comment is a single token
+
+	var blockCommentDepth int
+	var blockCommentStartPos ast.Position
+
+	for i, token := range lexerTokens {
+
+		if blockCommentDepth > 0 {
+			switch token.Type {
+			case lexer.TokenBlockCommentStart:
+				blockCommentDepth++
+
+			case lexer.TokenBlockCommentEnd:
+				blockCommentDepth--
+				if blockCommentDepth == 0 {
+					add(
+						blockCommentStartPos,
+						token.EndPos,
+						semanticTokenClass{tokenType: semanticTokenTypeComment},
+					)
+				}
+			}
+
+			// The block comment is unterminated
+
+			if blockCommentDepth > 0 && i == len(lexerTokens)-1 {
+				add(
+					blockCommentStartPos,
+					token.EndPos,
+					semanticTokenClass{tokenType: semanticTokenTypeComment},
+				)
+			}
+
+			continue
+		}
+
+		switch token.Type {
+		case lexer.TokenBlockCommentStart:
+			blockCommentDepth = 1
+			blockCommentStartPos = token.StartPos
+
+		case lexer.TokenLineComment:
+			add(
+				token.StartPos,
+				token.EndPos,
+				semanticTokenClass{tokenType: semanticTokenTypeComment},
+			)
+
+		case lexer.TokenString:
+			add(
+				token.StartPos,
+				token.EndPos,
+				semanticTokenClass{tokenType: semanticTokenTypeString},
+			)
+
+		case lexer.TokenBinaryIntegerLiteral,
+			lexer.TokenOctalIntegerLiteral,
+			lexer.TokenDecimalIntegerLiteral,
+			lexer.TokenHexadecimalIntegerLiteral,
+			lexer.TokenUnknownBaseIntegerLiteral,
+			lexer.TokenFixedPointNumberLiteral:
+
+			add(
+				token.StartPos,
+				token.EndPos,
+				semanticTokenClass{tokenType: semanticTokenTypeNumber},
+			)
+
+		case lexer.TokenAt:
+			add(
+				token.StartPos,
+				token.EndPos,
+				semanticTokenClass{
+					tokenType: semanticTokenTypeOperator,
+					modifiers: semanticTokenModifierResource,
+				},
+			)
+
+		case lexer.TokenIdentifier:
+			position := sema.ASTToSemaPosition(token.StartPos)
+			if class, ok := identifierClasses[position]; ok {
+				add(token.StartPos, token.EndPos, class)
+			} else if isSemanticKeyword(lexerTokens, i) {
+				add(
+					token.StartPos,
+					token.EndPos,
+					semanticTokenClass{tokenType: semanticTokenTypeKeyword},
+				)
+			}
+		}
+	}
+
+	return tokens
+}
+
+// isSemanticKeyword returns true if the identifier token at the given index is a keyword.
+//
+// Identifiers which are accessed as members or are used as labels or names,
+// i.e. which are followed by a colon, are not keywords, except for the `default` case
+//
+func isSemanticKeyword(tokens []lexer.Token, index int) bool {
+	identifier, ok := tokens[index].Value.(string)
+	if !ok {
+		return false
+	}
+
+	if _, ok := semanticTokenKeywords[identifier]; !ok {
+		return false
+	}
+
+	if index > 0 {
+		previous := tokens[index-1]
+		if previous.Is(lexer.TokenDot) || previous.Is(lexer.TokenQuestionMarkDot) {
+			return false
+		}
+	}
+
+	if index < len(tokens)-1 && tokens[index+1].Is(lexer.TokenColon) {
+		return identifier == "default"
+	}
+
+	return true
+}
+
+// appendSemanticTokens appends the tokens for the given range of the code.
+//
+// Clients are not required to support tokens spanning multiple lines,
+// so a multi-line range, e.g. a block comment, is split into one token per line
+//
+func appendSemanticTokens(
+	tokens []semanticToken,
+	code string,
+	startPos, endPos ast.Position,
+	class semanticTokenClass,
+) []semanticToken {
+	if startPos.Offset < 0 || endPos.Offset >= len(code) || startPos.Offset > endPos.Offset {
+		return tokens
+	}
+
+	line := uint32(startPos.Line - 1)
+	startChar := uint32(startPos.Column)
+
+	for i, text := range strings.Split(code[startPos.Offset:endPos.Offset+1], "\n") {
+		if i > 0 {
+			line++
+			startChar = 0
+		}
+
+		length := utf8.RuneCountInString(strings.TrimSuffix(text, "\r"))
+		if length == 0 {
+			continue
+		}
+
+		tokens = append(tokens, semanticToken{
+			line:      line,
+			startChar: startChar,
+			length:    uint32(length),
+			class:     class,
+		})
+	}
+
+	return tokens
+}
+
+// semanticIdentifierClasses returns the classes of the identifiers in the program checked by the given checker,
+// indexed by the start position of the identifier.
+//
+// The classes are determined from the occurrences recorded by the checker,
+// the declarations of the program, and the members accessed in member expressions
+//
+func semanticIdentifierClasses(checker *sema.Checker) map[sema.Position]semanticTokenClass {
+	classes := map[sema.Position]semanticTokenClass{}
+
+	declarations := semanticDeclarationClasses(checker.Program)
+
+	// Occurrences of declarations, and references to them.
+	// Occurrences are only recorded if position info is enabled
+
+	var occurrences []sema.Occurrence
+	if checker.Occurrences != nil {
+		occurrences = checker.Occurrences.All()
+	}
+
+	for _, occurrence := range occurrences {
+		origin := occurrence.Origin
+		if origin == nil {
+			continue
+		}
+
+		tokenType, ok := declarationKindSemanticTokenType(origin.DeclarationKind, origin.Type)
+		if !ok {
+			continue
+		}
+
+		var modifiers semanticTokenModifiers
+
+		if origin.DeclarationKind == common.DeclarationKindConstant {
+			modifiers |= semanticTokenModifierReadonly
+		}
+
+		if isResourceDeclarationKind(origin.DeclarationKind) ||
+			(origin.Type != nil && origin.Type.IsResourceType()) {
+
+			modifiers |= semanticTokenModifierResource
+		}
+
+		// Built-in declarations have no position
+
+		if origin.StartPos == nil || origin.StartPos.Line < 1 {
+			modifiers |= semanticTokenModifierDefaultLibrary
+		} else {
+			declarationPosition := sema.ASTToSemaPosition(*origin.StartPos)
+
+			if declarationPosition == occurrence.StartPos {
+				modifiers |= semanticTokenModifierDeclaration
+			}
+
+			// References to declarations of the program have the same access level,
+			// and are constant if the declaration is
+
+			if declaration, ok := declarations[declarationPosition]; ok {
+				modifiers |= declaration.modifiers &
+					(semanticTokenAccessModifiers | semanticTokenModifierReadonly)
+			}
+		}
+
+		classes[occurrence.StartPos] = semanticTokenClass{
+			tokenType: tokenType,
+			modifiers: modifiers,
+		}
+	}
+
+	// Declarations have a more specific type than the occurrence, e.g. functions may be methods
+
+	for position, declaration := range declarations {
+		declaration.modifiers |= classes[position].modifiers
+		classes[position] = declaration
+	}
+
+	// Accesses of members
+
+	for expression, memberInfo := range checker.Elaboration.MemberExpressionMemberInfos {
+		member := memberInfo.Member
+		if member == nil {
+			continue
+		}
+
+		tokenType, ok := memberSemanticTokenType(member)
+		if !ok {
+			continue
+		}
+
+		modifiers := accessSemanticTokenModifiers(member.Access)
+
+		if member.VariableKind == ast.VariableKindConstant {
+			modifiers |= semanticTokenModifierReadonly
+		}
+
+		if member.TypeAnnotation != nil && member.TypeAnnotation.Type.IsResourceType() {
+			modifiers |= semanticTokenModifierResource
+		}
+
+		classes[sema.ASTToSemaPosition(expression.Identifier.Pos)] = semanticTokenClass{
+			tokenType: tokenType,
+			modifiers: modifiers,
+		}
+	}
+
+	return classes
+}
+
+// semanticDeclarationClasses returns the classes of the identifiers of the declarations in the given program,
+// indexed by the start position of the identifier
+//
+func semanticDeclarationClasses(program *ast.Program) map[sema.Position]semanticTokenClass {
+	classes := map[sema.Position]semanticTokenClass{}
+
+	declare := func(identifier ast.Identifier, tokenType semanticTokenType, modifiers semanticTokenModifiers) {
+		classes[sema.ASTToSemaPosition(identifier.Pos)] = semanticTokenClass{
+			tokenType: tokenType,
+			modifiers: modifiers | semanticTokenModifierDeclaration,
+		}
+	}
+
+	declareField := func(field *ast.FieldDeclaration) {
+		modifiers := accessSemanticTokenModifiers(field.Access)
+		if field.VariableKind == ast.VariableKindConstant {
+			modifiers |= semanticTokenModifierReadonly
+		}
+		declare(field.Identifier, semanticTokenTypeProperty, modifiers)
+	}
+
+	var declareMembers func(members *ast.Members)
+
+	declareComposite := func(declaration *ast.CompositeDeclaration) {
+		modifiers := accessSemanticTokenModifiers(declaration.Access)
+		if declaration.CompositeKind == common.CompositeKindResource {
+			modifiers |= semanticTokenModifierResource
+		}
+		declare(declaration.Identifier, compositeKindSemanticTokenType(declaration.CompositeKind), modifiers)
+		declareMembers(declaration.Members)
+	}
+
+	declareInterface := func(declaration *ast.InterfaceDeclaration) {
+		modifiers := accessSemanticTokenModifiers(declaration.Access)
+		if declaration.CompositeKind == common.CompositeKindResource {
+			modifiers |= semanticTokenModifierResource
+		}
+		declare(declaration.Identifier, semanticTokenTypeInterface, modifiers)
+		declareMembers(declaration.Members)
+	}
+
+	declareMembers = func(members *ast.Members) {
+		for _, field := range members.Fields() {
+			declareField(field)
+		}
+
+		for _, function := range members.Functions() {
+			declare(
+				function.Identifier,
+				semanticTokenTypeMethod,
+				accessSemanticTokenModifiers(function.Access),
+			)
+		}
+
+		for _, enumCase := range members.EnumCases() {
+			declare(
+				enumCase.Identifier,
+				semanticTokenTypeEnumMember,
+				accessSemanticTokenModifiers(enumCase.Access)|semanticTokenModifierReadonly,
+			)
+		}
+
+		for _, composite := range members.Composites() {
+			declareComposite(composite)
+		}
+
+		for _, interfaceDeclaration := range members.Interfaces() {
+			declareInterface(interfaceDeclaration)
+		}
+	}
+
+	if program == nil {
+		return classes
+	}
+
+	for _, composite := range program.CompositeDeclarations() {
+		declareComposite(composite)
+	}
+
+	for _, interfaceDeclaration := range program.InterfaceDeclarations() {
+		declareInterface(interfaceDeclaration)
+	}
+
+	for _, function := range program.FunctionDeclarations() {
+		declare(
+			function.Identifier,
+			semanticTokenTypeFunction,
+			accessSemanticTokenModifiers(function.Access),
+		)
+	}
+
+	for _, variable := range program.VariableDeclarations() {
+		modifiers := accessSemanticTokenModifiers(variable.Access)
+		if variable.IsConstant {
+			modifiers |= semanticTokenModifierReadonly
+		}
+		declare(variable.Identifier, semanticTokenTypeVariable, modifiers)
+	}
+
+	for _, transaction := range program.TransactionDeclarations() {
+		for _, field := range transaction.Fields {
+			declareField(field)
+		}
+	}
+
+	return classes
+}
+
+func compositeKindSemanticTokenType(kind common.CompositeKind) semanticTokenType {
+	switch kind {
+	case common.CompositeKindResource:
+		return semanticTokenTypeClass
+	case common.CompositeKindContract:
+		return semanticTokenTypeNamespace
+	case common.CompositeKindEvent:
+		return semanticTokenTypeEvent
+	case common.CompositeKindEnum:
+		return semanticTokenTypeEnum
+	default:
+		return semanticTokenTypeStruct
+	}
+}
+
+func isResourceDeclarationKind(kind common.DeclarationKind) bool {
+	switch kind {
+	case common.DeclarationKindResource,
+		common.DeclarationKindResourceInterface:
+
+		return true
+	}
+	return false
+}
+
+// declarationKindSemanticTokenType returns the token type for an identifier
+// which refers to a declaration of the given kind and type
+//
+func declarationKindSemanticTokenType(kind common.DeclarationKind, ty sema.Type) (semanticTokenType, bool) {
+	switch kind {
+	case common.DeclarationKindContract,
+		common.DeclarationKindImport:
+
+		return semanticTokenTypeNamespace, true
+
+	case common.DeclarationKindStructure:
+		return semanticTokenTypeStruct, true
+
+	case common.DeclarationKindResource:
+		return semanticTokenTypeClass, true
+
+	case common.DeclarationKindStructureInterface,
+		common.DeclarationKindResourceInterface,
+		common.DeclarationKindContractInterface:
+
+		return semanticTokenTypeInterface, true
+
+	case common.DeclarationKindEvent:
+		return semanticTokenTypeEvent, true
+
+	case common.DeclarationKindEnum:
+		return semanticTokenTypeEnum, true
+
+	case common.DeclarationKindEnumCase:
+		return semanticTokenTypeEnumMember, true
+
+	case common.DeclarationKindType:
+		return semanticTokenTypeType, true
+
+	case common.DeclarationKindTypeParameter:
+		return semanticTokenTypeTypeParameter, true
+
+	case common.DeclarationKindField:
+		return semanticTokenTypeProperty, true
+
+	case common.DeclarationKindParameter:
+		return semanticTokenTypeParameter, true
+
+	case common.DeclarationKindVariable,
+		common.DeclarationKindConstant:
+
+		return semanticTokenTypeVariable, true
+
+	case common.DeclarationKindFunction:
+		return semanticTokenTypeFunction, true
+
+	case common.DeclarationKindValue:
+		if _, ok := ty.(*sema.FunctionType); ok {
+			return semanticTokenTypeFunction, true
+		}
+		return semanticTokenTypeVariable, true
+
+	}
+
+	return 0, false
+}
+
+// memberSemanticTokenType returns the token type for an identifier which accesses the given member
+//
+func memberSemanticTokenType(member *sema.Member) (semanticTokenType, bool) {
+	switch member.DeclarationKind {
+	case common.DeclarationKindField:
+		return semanticTokenTypeProperty, true
+
+	case common.DeclarationKindFunction:
+		return semanticTokenTypeMethod, true
+	}
+
+	var ty sema.Type
+	if member.TypeAnnotation != nil {
+		ty = member.TypeAnnotation.Type
+	}
+
+	return declarationKindSemanticTokenType(member.DeclarationKind, ty)
+}
@@ -0,0 +1,248 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const testSemanticTokensURI protocol.DocumentUri = "file:///project/d.cdc"
+
+const testSemanticTokensCode = `
+pub contract C {
+    pub event Deposit(amount: UFix64)
+
+    pub resource Vault {
+        pub var balance: UFix64
+        access(contract) let id: UInt64
+
+        init(balance: UFix64) {
+            self.balance = balance
+            self.id = 1
+        }
+
+        pub fun deposit(from: @Vault) {
+            let amount = from.balance
+            self.balance = self.balance + amount
+            emit Deposit(amount: amount)
+            destroy from
+        }
+    }
+
+    /* nested /* block */
+       comment */
+    pub fun createVault(): @Vault {
+        return <-create Vault(balance: 1.5)
+    }
+
+    init() {}
+}
+`
+
+type decodedSemanticToken struct {
+	text      string
+	tokenType string
+	modifiers []string
+}
+
+// decodeSemanticTokens decodes the given semantic tokens data
+// into the text, type name, and modifier names of each token
+//
+func decodeSemanticTokens(t *testing.T, code string, data []uint32) []decodedSemanticToken {
+	require.Zero(t, len(data)%5)
+
+	lines := strings.Split(code, "\n")
+
+	var tokens []decodedSemanticToken
+	var line, startChar uint32
+
+	for i := 0; i < len(data); i += 5 {
+		deltaLine, deltaStartChar, length, tokenType, modifiers :=
+			data[i], data[i+1], data[i+2], data[i+3], data[i+4]
+
+		if deltaLine > 0 {
+			startChar = 0
+		}
+		line += deltaLine
+		startChar += deltaStartChar
+
+		runes := []rune(lines[line])
+		token := decodedSemanticToken{
+			text:      string(runes[startChar : startChar+length]),
+			tokenType: semanticTokenTypeNames[tokenType],
+		}
+
+		for bit, name := range semanticTokenModifierNames {
+			if modifiers&(1<<bit) != 0 {
+				token.modifiers = append(token.modifiers, name)
+			}
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+func TestServer_SemanticTokensFull(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("classified", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testSemanticTokensURI, testSemanticTokensCode)
+
+		result, err := server.SemanticTokensFull(
+			testConn{},
+			&protocol.SemanticTokensParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testSemanticTokensURI},
+			},
+		)
+		require.NoError(t, err)
+
+		tokens := decodeSemanticTokens(t, testSemanticTokensCode, result.Data)
+
+		for _, token := range []decodedSemanticToken{
+			{"pub", "keyword", nil},
+			{"contract", "keyword", nil},
+			{"C", "namespace", []string{"declaration", "public"}},
+			{"Deposit", "event", []string{"declaration", "public"}},
+			{"Deposit", "event", []string{"public"}},
+			{"Vault", "class", []string{"declaration", "resource", "public"}},
+			{"Vault", "class", []string{"resource", "public"}},
+			{"@", "operator", []string{"resource"}},
+			{"balance", "property", []string{"declaration", "public"}},
+			{"balance", "property", []string{"public"}},
+			{"balance", "parameter", []string{"declaration"}},
+			{"id", "property", []string{"declaration", "readonly", "contract"}},
+			{"id", "property", []string{"readonly", "contract"}},
+			{"UFix64", "type", []string{"defaultLibrary"}},
+			{"deposit", "method", []string{"declaration", "public"}},
+			{"createVault", "method", []string{"declaration", "public"}},
+			{"from", "parameter", []string{"declaration", "resource"}},
+			{"from", "parameter", []string{"resource"}},
+			{"amount", "variable", []string{"declaration", "readonly"}},
+			{"amount", "variable", []string{"readonly"}},
+			{"self", "keyword", nil},
+			{"1", "number", nil},
+			{"1.5", "number", nil},
+			{"/* nested /* block */", "comment", nil},
+			{"       comment */", "comment", nil},
+		} {
+			assert.Contains(t, tokens, token)
+		}
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+
+		t.Parallel()
+
+		code := "pub fun test() {\n    let x = 1 +\n}\n"
+
+		server := newTestServer(t)
+		openTestDocument(t, server, testSemanticTokensURI, code)
+
+		result, err := server.SemanticTokensFull(
+			testConn{},
+			&protocol.SemanticTokensParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testSemanticTokensURI},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]decodedSemanticToken{
+				{"pub", "keyword", nil},
+				{"fun", "keyword", nil},
+				{"let", "keyword", nil},
+				{"1", "number", nil},
+			},
+			decodeSemanticTokens(t, code, result.Data),
+		)
+	})
+
+	t.Run("unknown document", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newTestServer(t)
+
+		result, err := server.SemanticTokensFull(
+			testConn{},
+			&protocol.SemanticTokensParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: testSemanticTokensURI},
+			},
+		)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Data)
+		assert.Empty(t, result.Data)
+	})
+}
+
+func TestServer_SemanticTokensRange(t *testing.T) {
+
+	t.Parallel()
+
+	server := newTestServer(t)
+	openTestDocument(t, server, testSemanticTokensURI, testSemanticTokensCode)
+
+	// Only the tokens of the function `createVault`
+
+	result, err := server.SemanticTokensRange(
+		testConn{},
+		&protocol.SemanticTokensRangeParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: testSemanticTokensURI},
+			Range: protocol.Range{
+				Start: protocol.Position{Line: 23},
+				End:   protocol.Position{Line: 26},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// The first token is relative to the start of the document.
+	// The argument label is not classified
+
+	require.NotEmpty(t, result.Data)
+	assert.Equal(t, uint32(23), result.Data[0])
+
+	assert.Equal(t,
+		[]decodedSemanticToken{
+			{"pub", "keyword", nil},
+			{"fun", "keyword", nil},
+			{"createVault", "method", []string{"declaration", "public"}},
+			{"@", "operator", []string{"resource"}},
+			{"Vault", "class", []string{"resource", "public"}},
+			{"return", "keyword", nil},
+			{"create", "keyword", nil},
+			{"Vault", "class", []string{"resource", "public"}},
+			{"1.5", "number", nil},
+		},
+		decodeSemanticTokens(t, testSemanticTokensCode, result.Data),
+	)
+}
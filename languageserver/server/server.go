@@ -342,13 +342,22 @@ func (s *Server) Initialize(
 				TriggerCharacters: []string{"."},
 				ResolveProvider:   true,
 			},
-			DocumentHighlightProvider: true,
-			DocumentSymbolProvider:    true,
-			RenameProvider:            true,
+			DocumentHighlightProvider:       true,
+			DocumentSymbolProvider:          true,
+			DocumentFormattingProvider:      true,
+			DocumentRangeFormattingProvider: true,
+			InlayHintProvider:               true,
+			ReferencesProvider:              true,
+			RenameProvider:                  true,
 			SignatureHelpProvider: &protocol.SignatureHelpOptions{
 				TriggerCharacters: []string{"("},
 			},
 			CodeActionProvider: true,
+			SemanticTokensProvider: &protocol.SemanticTokensOptions{
+				Legend: semanticTokensLegend,
+				Full:   true,
+				Range:  true,
+			},
 		},
 	}
 
@@ -566,9 +575,17 @@ func (s *Server) Hover(
 
 	var markup strings.Builder
 
+	// The type of a type alias is its target type
+
+	title := "Type"
+	if occurrence.Origin.DeclarationKind == common.DeclarationKindTypeAlias {
+		title = "Type Alias"
+	}
+
 	_, _ = fmt.Fprintf(
 		&markup,
-		"**Type**\n\n```cadence\n%s\n```\n",
+		"**%s**\n\n```cadence\n%s\n```\n",
+		title,
 		documentType(occurrence.Origin.Type),
 	)
 
@@ -702,6 +719,14 @@ func (s *Server) SignatureHelp(
 			)
 		}
 
+		if parameter.DefaultArgument != nil {
+			signatureLabelPart = fmt.Sprintf(
+				"%s = %s",
+				signatureLabelPart,
+				parameter.DefaultArgument,
+			)
+		}
+
 		signatureLabelParts = append(signatureLabelParts, signatureLabelPart)
 	}
 
@@ -785,58 +810,6 @@ func (s *Server) DocumentHighlight(
 	return documentHighlights, nil
 }
 
-func (s *Server) Rename(
-	_ protocol.Conn,
-	params *protocol.RenameParams,
-) (
-	*protocol.WorkspaceEdit,
-	error,
-) {
-	uri := params.TextDocument.URI
-	checker := s.checkerForDocument(uri)
-	if checker == nil {
-		return nil, nil
-	}
-
-	position := conversion.ProtocolToSemaPosition(params.Position)
-	occurrences := checker.Occurrences.FindAll(position)
-	// If there are no occurrences,
-	// then try the preceding position
-	if len(occurrences) == 0 && position.Column > 0 {
-		previousPosition := position
-		previousPosition.Column -= 1
-		occurrences = checker.Occurrences.FindAll(previousPosition)
-	}
-
-	textEdits := make([]protocol.TextEdit, 0)
-
-	for _, occurrence := range occurrences {
-
-		origin := occurrence.Origin
-		if origin == nil || origin.StartPos == nil || origin.EndPos == nil {
-			continue
-		}
-
-		for _, occurrenceRange := range origin.Occurrences {
-			textEdits = append(textEdits,
-				protocol.TextEdit{
-					Range: conversion.ASTToProtocolRange(
-						occurrenceRange.StartPos,
-						occurrenceRange.EndPos,
-					),
-					NewText: params.NewName,
-				},
-			)
-		}
-	}
-
-	return &protocol.WorkspaceEdit{
-		Changes: &map[string][]protocol.TextEdit{
-			string(uri): textEdits,
-		},
-	}, nil
-}
-
 func (s *Server) CodeAction(
 	conn protocol.Conn,
 	params *protocol.CodeActionParams,
@@ -1431,6 +1404,9 @@ func convertDeclarationKindToCompletionItemType(kind common.DeclarationKind) pro
 		common.DeclarationKindParameter:
 		return protocol.ConstantCompletion
 
+	case common.DeclarationKindTypeAlias:
+		return protocol.TypeParameterCompletion
+
 	default:
 		return protocol.TextCompletion
 	}
@@ -1774,6 +1750,8 @@ func (s *Server) getDiagnostics(
 					}, nil
 
 				default:
+					resolvedLocation := importedLocation
+
 					if isPathLocation(importedLocation) {
 						// import may be a relative path and therefore should be normalized
 						// against the current location
@@ -1786,6 +1764,11 @@ func (s *Server) getDiagnostics(
 						}
 					}
 
+					err := s.checkImportHashes(checker, resolvedLocation, importedLocation)
+					if err != nil {
+						return nil, err
+					}
+
 					importedLocationID := importedLocation.ID()
 
 					importedChecker, ok := s.checkers[importedLocationID]
@@ -1804,6 +1787,14 @@ func (s *Server) getDiagnostics(
 						if err != nil {
 							return nil, err
 						}
+
+						// Record position info for imported programs,
+						// so references across programs can be found
+
+						err = sema.WithPositionInfoEnabled(true)(importedChecker)
+						if err != nil {
+							return nil, err
+						}
 						s.checkers[importedLocationID] = importedChecker
 						err = importedChecker.Check()
 						if err != nil {
@@ -1933,6 +1924,18 @@ func parse(conn protocol.Conn, code, location string) (*ast.Program, error) {
 }
 
 func (s *Server) resolveImport(location common.Location) (program *ast.Program, err error) {
+	code, ok, err := s.resolveImportCode(location)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return parser2.ParseProgram(code)
+}
+
+// resolveImportCode returns the code of the given import location.
+// The result is false if the location cannot be resolved
+//
+func (s *Server) resolveImportCode(location common.Location) (code string, ok bool, err error) {
 	// NOTE: important, *DON'T* return an error when a location type
 	// is not supported: the import location can simply not be resolved,
 	// no error occurred while resolving it.
@@ -1941,29 +1944,28 @@ func (s *Server) resolveImport(location common.Location) (program *ast.Program,
 	// and we simply return no code for it, so that the checker's
 	// import handler is called which resolves the location
 
-	var code string
 	switch loc := location.(type) {
 	case common.StringLocation:
 		if s.resolveStringImport == nil {
-			return nil, nil
+			return "", false, nil
 		}
 
 		code, err = s.resolveStringImport(loc)
 
 	case common.AddressLocation:
 		if s.resolveAddressImport == nil {
-			return nil, nil
+			return "", false, nil
 		}
 		code, err = s.resolveAddressImport(loc)
 
 	default:
-		return nil, nil
+		return "", false, nil
 	}
 	if err != nil {
-		return nil, err
+		return "", false, err
 	}
 
-	return parser2.ParseProgram(code)
+	return code, true, nil
 }
 
 func (s *Server) GetDocument(uri protocol.DocumentUri) (doc Document, ok bool) {
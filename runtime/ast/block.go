@@ -64,16 +64,10 @@ func StatementsDoc(statements []Statement) prettier.Doc {
 	var statementsDoc prettier.Concat
 
 	for _, statement := range statements {
-		// TODO: replace once Statement implements Doc
-		hasDoc, ok := statement.(interface{ Doc() prettier.Doc })
-		if !ok {
-			continue
-		}
-
 		statementsDoc = append(
 			statementsDoc,
 			prettier.HardLine{},
-			hasDoc.Doc(),
+			statement.Doc(),
 		)
 	}
 
@@ -116,6 +110,46 @@ func (b *FunctionBlock) Walk(walkChild func(Element)) {
 	// TODO: post-conditions
 }
 
+func (b *FunctionBlock) Doc() prettier.Doc {
+	if b.PreConditions.IsEmpty() &&
+		b.PostConditions.IsEmpty() {
+
+		return b.Block.Doc()
+	}
+
+	var bodyConcat prettier.Concat
+
+	if !b.PreConditions.IsEmpty() {
+		bodyConcat = append(
+			bodyConcat,
+			prettier.HardLine{},
+			b.PreConditions.Doc(ConditionKindPre),
+		)
+	}
+
+	if !b.PostConditions.IsEmpty() {
+		bodyConcat = append(
+			bodyConcat,
+			prettier.HardLine{},
+			b.PostConditions.Doc(ConditionKindPost),
+		)
+	}
+
+	bodyConcat = append(
+		bodyConcat,
+		StatementsDoc(b.Block.Statements),
+	)
+
+	return prettier.Concat{
+		blockStartDoc,
+		prettier.Indent{
+			Doc: bodyConcat,
+		},
+		prettier.HardLine{},
+		blockEndDoc,
+	}
+}
+
 func (b *FunctionBlock) MarshalJSON() ([]byte, error) {
 	type Alias FunctionBlock
 	return json.Marshal(&struct {
@@ -145,6 +179,28 @@ type Condition struct {
 	Message Expression
 }
 
+var conditionMessageSeparatorDoc prettier.Doc = prettier.Text(":")
+
+func (c *Condition) Doc() prettier.Doc {
+	doc := c.Test.Doc()
+	if c.Message == nil {
+		return doc
+	}
+
+	return prettier.Group{
+		Doc: prettier.Concat{
+			doc,
+			conditionMessageSeparatorDoc,
+			prettier.Indent{
+				Doc: prettier.Concat{
+					prettier.Line{},
+					c.Message.Doc(),
+				},
+			},
+		},
+	}
+}
+
 // Conditions
 
 type Conditions []*Condition
@@ -152,3 +208,26 @@ type Conditions []*Condition
 func (c *Conditions) IsEmpty() bool {
 	return c == nil || len(*c) == 0
 }
+
+func (c *Conditions) Doc(kind ConditionKind) prettier.Doc {
+	var doc prettier.Concat
+
+	for _, condition := range *c {
+		doc = append(
+			doc,
+			prettier.HardLine{},
+			condition.Doc(),
+		)
+	}
+
+	return prettier.Concat{
+		prettier.Text(kind.Keyword()),
+		prettier.Space,
+		blockStartDoc,
+		prettier.Indent{
+			Doc: doc,
+		},
+		prettier.HardLine{},
+		blockEndDoc,
+	}
+}
@@ -21,6 +21,8 @@ package ast
 import (
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -73,6 +75,65 @@ func (d *CompositeDeclaration) DeclarationDocString() string {
 	return d.DocString
 }
 
+var compositeConformanceSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (d *CompositeDeclaration) Doc() prettier.Doc {
+
+	doc := prettier.Concat{
+		DocStringDoc(d.DocString),
+		accessDoc(d.Access),
+		prettier.Text(d.CompositeKind.Keyword()),
+		prettier.Space,
+		prettier.Text(d.Identifier.Identifier),
+	}
+
+	// Events are declared with just the parameter list of the initializer
+
+	if d.CompositeKind == common.CompositeKindEvent {
+		var parameterList *ParameterList
+		initializers := d.Members.Initializers()
+		if len(initializers) > 0 {
+			parameterList = initializers[0].FunctionDeclaration.ParameterList
+		}
+
+		return append(
+			doc,
+			parameterList.Doc(),
+		)
+	}
+
+	if len(d.Conformances) > 0 {
+		conformanceDocs := make([]prettier.Doc, len(d.Conformances))
+		for i, conformance := range d.Conformances {
+			conformanceDocs[i] = conformance.Doc()
+		}
+
+		doc = append(
+			doc,
+			prettier.Group{
+				Doc: prettier.Concat{
+					typeSeparatorDoc,
+					prettier.Indent{
+						Doc: prettier.Join(
+							compositeConformanceSeparatorDoc,
+							conformanceDocs...,
+						),
+					},
+				},
+			},
+		)
+	}
+
+	return append(
+		doc,
+		prettier.Space,
+		d.Members.Doc(),
+	)
+}
+
 func (d *CompositeDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias CompositeDeclaration
 	return json.Marshal(&struct {
@@ -126,6 +187,32 @@ func (d *FieldDeclaration) DeclarationDocString() string {
 	return d.DocString
 }
 
+func (d *FieldDeclaration) Doc() prettier.Doc {
+
+	doc := prettier.Concat{
+		DocStringDoc(d.DocString),
+		accessDoc(d.Access),
+	}
+
+	keyword := d.VariableKind.Keyword()
+	if keyword != "" {
+		doc = append(
+			doc,
+			prettier.Text(keyword),
+			prettier.Space,
+		)
+	}
+
+	return append(
+		doc,
+		prettier.Text(d.Identifier.Identifier),
+		typeSeparatorDoc,
+		prettier.Group{
+			Doc: d.TypeAnnotation.Doc(),
+		},
+	)
+}
+
 func (d *FieldDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias FieldDeclaration
 	return json.Marshal(&struct {
@@ -184,6 +271,17 @@ func (d *EnumCaseDeclaration) DeclarationDocString() string {
 	return d.DocString
 }
 
+var enumCaseKeywordSpaceDoc prettier.Doc = prettier.Text("case ")
+
+func (d *EnumCaseDeclaration) Doc() prettier.Doc {
+	return prettier.Concat{
+		DocStringDoc(d.DocString),
+		accessDoc(d.Access),
+		enumCaseKeywordSpaceDoc,
+		prettier.Text(d.Identifier.Identifier),
+	}
+}
+
 func (d *EnumCaseDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias EnumCaseDeclaration
 	return json.Marshal(&struct {
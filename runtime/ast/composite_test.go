@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/turbolent/prettier"
 
 	"github.com/onflow/cadence/runtime/common"
 )
@@ -158,3 +159,115 @@ func TestCompositeDeclaration_MarshalJSON(t *testing.T) {
 		string(actual),
 	)
 }
+
+func TestFieldDeclaration_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &FieldDeclaration{
+		Access:       AccessPublic,
+		VariableKind: VariableKindConstant,
+		Identifier: Identifier{
+			Identifier: "xyz",
+		},
+		TypeAnnotation: &TypeAnnotation{
+			IsResource: true,
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "CD",
+				},
+			},
+		},
+		DocString: " test",
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Concat{
+				prettier.Text("/// test"),
+				prettier.HardLine{},
+			},
+			prettier.Concat{
+				prettier.Text("pub"),
+				prettier.Space,
+			},
+			prettier.Text("let"),
+			prettier.Space,
+			prettier.Text("xyz"),
+			prettier.Text(": "),
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.Text("@"),
+					prettier.Text("CD"),
+				},
+			},
+		},
+		decl.Doc(),
+	)
+}
+
+func TestCompositeDeclaration_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &CompositeDeclaration{
+		Access:        AccessPublic,
+		CompositeKind: common.CompositeKindResource,
+		Identifier: Identifier{
+			Identifier: "AB",
+		},
+		Conformances: []*NominalType{
+			{
+				Identifier: Identifier{
+					Identifier: "CD",
+				},
+			},
+		},
+		Members: NewMembers([]Declaration{
+			&EnumCaseDeclaration{
+				Identifier: Identifier{
+					Identifier: "x",
+				},
+			},
+		}),
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Concat(nil),
+			prettier.Concat{
+				prettier.Text("pub"),
+				prettier.Space,
+			},
+			prettier.Text("resource"),
+			prettier.Space,
+			prettier.Text("AB"),
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.Text(": "),
+					prettier.Indent{
+						Doc: prettier.Text("CD"),
+					},
+				},
+			},
+			prettier.Space,
+			prettier.Concat{
+				prettier.Text("{"),
+				prettier.Indent{
+					Doc: prettier.Concat{
+						prettier.HardLine{},
+						prettier.Concat{
+							prettier.Concat(nil),
+							prettier.Concat(nil),
+							prettier.Text("case "),
+							prettier.Text("x"),
+						},
+					},
+				},
+				prettier.HardLine{},
+				prettier.Text("}"),
+			},
+		},
+		decl.Doc(),
+	)
+}
@@ -49,6 +49,17 @@ func (k ConditionKind) Name() string {
 	panic(errors.NewUnreachableError())
 }
 
+func (k ConditionKind) Keyword() string {
+	switch k {
+	case ConditionKindPre:
+		return "pre"
+	case ConditionKindPost:
+		return "post"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
 func (k ConditionKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(k.String())
 }
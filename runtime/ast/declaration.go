@@ -18,7 +18,13 @@
 
 package ast
 
-import "github.com/onflow/cadence/runtime/common"
+import (
+	"strings"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/runtime/common"
+)
 
 type Declaration interface {
 	Element
@@ -28,4 +34,123 @@ type Declaration interface {
 	DeclarationAccess() Access
 	DeclarationMembers() *Members
 	DeclarationDocString() string
+	Doc() prettier.Doc
+}
+
+const docStringLinePrefix = "///"
+const docStringBlockStart = "/**"
+const docStringBlockEnd = "*/"
+
+// DocStringDoc returns the document for the given doc string, if any.
+//
+// Multi-line doc strings starting with a line break are printed as a block comment,
+// all other doc strings are printed as line comments.
+//
+func DocStringDoc(docString string) prettier.Concat {
+	if docString == "" {
+		return nil
+	}
+
+	lines := strings.Split(docString, "\n")
+
+	var doc prettier.Concat
+
+	if len(lines) > 1 && lines[0] == "" {
+		doc = append(doc, prettier.Text(docStringBlockStart))
+
+		lastIndex := len(lines) - 1
+		for i, line := range lines[1:] {
+			line = strings.TrimSpace(line)
+			if strings.HasPrefix(line, "*") {
+				line = " " + line
+			}
+			if i+1 == lastIndex {
+				if line == "" {
+					line = " " + docStringBlockEnd
+				} else {
+					line += " " + docStringBlockEnd
+				}
+			}
+			doc = append(
+				doc,
+				prettier.HardLine{},
+				prettier.Text(line),
+			)
+		}
+	} else {
+		for i, line := range lines {
+			if i > 0 {
+				doc = append(doc, prettier.HardLine{})
+			}
+			doc = append(
+				doc,
+				prettier.Text(docStringLinePrefix+strings.TrimRight(line, " \t")),
+			)
+		}
+	}
+
+	return append(doc, prettier.HardLine{})
+}
+
+// accessDoc returns the document for the given access modifier,
+// followed by a space, if any.
+//
+func accessDoc(access Access) prettier.Concat {
+	if access == AccessNotSpecified {
+		return nil
+	}
+	return prettier.Concat{
+		prettier.Text(access.Keyword()),
+		prettier.Space,
+	}
+}
+
+// DeclarationsDoc returns the document for the given declarations,
+// each on a separate line.
+//
+// Declarations are separated by an empty line,
+// unless they are consecutive fields, enum cases,
+// imports, pragmas, or type aliases.
+//
+func DeclarationsDoc(declarations []Declaration) prettier.Doc {
+	var doc prettier.Concat
+
+	for i, declaration := range declarations {
+		if i > 0 && !isCompactDeclarationPair(declarations[i-1], declaration) {
+			doc = append(doc, prettier.HardLine{})
+		}
+
+		doc = append(
+			doc,
+			prettier.HardLine{},
+			declaration.Doc(),
+		)
+	}
+
+	return doc
+}
+
+// isCompactDeclarationPair returns true if the given consecutive declarations
+// do not need to be separated by an empty line, e.g. consecutive fields
+//
+func isCompactDeclarationPair(previous, next Declaration) bool {
+	switch previous.(type) {
+	case *FieldDeclaration:
+		_, ok := next.(*FieldDeclaration)
+		return ok
+	case *EnumCaseDeclaration:
+		_, ok := next.(*EnumCaseDeclaration)
+		return ok
+	case *ImportDeclaration:
+		_, ok := next.(*ImportDeclaration)
+		return ok
+	case *PragmaDeclaration:
+		_, ok := next.(*PragmaDeclaration)
+		return ok
+	case *TypeAliasDeclaration:
+		_, ok := next.(*TypeAliasDeclaration)
+		return ok
+	}
+
+	return false
 }
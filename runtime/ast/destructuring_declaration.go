@@ -0,0 +1,176 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+
+	"github.com/turbolent/prettier"
+)
+
+// DestructuringDeclaration declares variables for the components of a value,
+// e.g. `let (a, b) = array` or `let (x: a, y: b) = s`.
+//
+// It is only valid as a statement, i.e. not as a top-level or member declaration.
+type DestructuringDeclaration struct {
+	IsConstant bool
+	Elements   []*DestructuringElement
+	Transfer   *Transfer
+	Value      Expression
+	StartPos   Position `json:"-"`
+}
+
+var _ Statement = &DestructuringDeclaration{}
+
+func (d *DestructuringDeclaration) StartPosition() Position {
+	return d.StartPos
+}
+
+func (d *DestructuringDeclaration) EndPosition() Position {
+	return d.Value.EndPosition()
+}
+
+func (*DestructuringDeclaration) isStatement() {}
+
+func (d *DestructuringDeclaration) Accept(visitor Visitor) Repr {
+	return visitor.VisitDestructuringDeclaration(d)
+}
+
+func (d *DestructuringDeclaration) Walk(walkChild func(Element)) {
+	walkChild(d.Value)
+}
+
+// IsLabeled returns true if the elements of the declaration are labeled,
+// i.e. the declaration destructures the fields of a composite value,
+// instead of the elements of an array
+func (d *DestructuringDeclaration) IsLabeled() bool {
+	return len(d.Elements) > 0 && d.Elements[0].Label != ""
+}
+
+const destructuringDeclarationElementsStartDoc = prettier.Text("(")
+const destructuringDeclarationElementsEndDoc = prettier.Text(")")
+const destructuringDeclarationElementSeparatorDoc = prettier.Text(",")
+
+func (d *DestructuringDeclaration) Doc() prettier.Doc {
+	keywordDoc := varKeywordDoc
+	if d.IsConstant {
+		keywordDoc = letKeywordDoc
+	}
+
+	elementsDoc := prettier.Concat{}
+
+	for i, element := range d.Elements {
+		if i > 0 {
+			elementsDoc = append(
+				elementsDoc,
+				destructuringDeclarationElementSeparatorDoc,
+				prettier.Line{},
+			)
+		}
+
+		elementsDoc = append(elementsDoc, element.Doc())
+	}
+
+	return prettier.Group{
+		Doc: prettier.Concat{
+			keywordDoc,
+			prettier.Space,
+			prettier.Group{
+				Doc: prettier.Concat{
+					destructuringDeclarationElementsStartDoc,
+					prettier.Indent{
+						Doc: prettier.Concat{
+							prettier.SoftLine{},
+							elementsDoc,
+						},
+					},
+					prettier.SoftLine{},
+					destructuringDeclarationElementsEndDoc,
+				},
+			},
+			prettier.Space,
+			d.Transfer.Doc(),
+			prettier.Space,
+			transferredValueDoc(d.Value),
+		},
+	}
+}
+
+func (d *DestructuringDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias DestructuringDeclaration
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "DestructuringDeclaration",
+		Range: NewRangeFromPositioned(d),
+		Alias: (*Alias)(d),
+	})
+}
+
+// DestructuringElement is an element of a destructuring declaration.
+//
+// If the element has a label, it binds the field with the label's name,
+// e.g. `x: a` binds the field `x` to the variable `a`.
+// Otherwise it binds the array element at the element's index.
+//
+// The identifier `_` ignores the component.
+type DestructuringElement struct {
+	Label         string    `json:",omitempty"`
+	LabelStartPos *Position `json:",omitempty"`
+	LabelEndPos   *Position `json:",omitempty"`
+	Identifier    Identifier
+}
+
+func (e *DestructuringElement) StartPosition() Position {
+	if e.LabelStartPos != nil {
+		return *e.LabelStartPos
+	}
+	return e.Identifier.StartPosition()
+}
+
+func (e *DestructuringElement) EndPosition() Position {
+	return e.Identifier.EndPosition()
+}
+
+func (e *DestructuringElement) Doc() prettier.Doc {
+	identifierDoc := prettier.Text(e.Identifier.Identifier)
+
+	if e.Label == "" {
+		return identifierDoc
+	}
+
+	return prettier.Concat{
+		prettier.Text(e.Label),
+		typeSeparatorDoc,
+		identifierDoc,
+	}
+}
+
+func (e *DestructuringElement) MarshalJSON() ([]byte, error) {
+	type Alias DestructuringElement
+	return json.Marshal(&struct {
+		Range
+		*Alias
+	}{
+		Range: NewRangeFromPositioned(e),
+		Alias: (*Alias)(e),
+	})
+}
@@ -0,0 +1,190 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turbolent/prettier"
+)
+
+func TestDestructuringDeclaration_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &DestructuringDeclaration{
+		IsConstant: true,
+		Elements: []*DestructuringElement{
+			{
+				Label:         "foo",
+				LabelStartPos: &Position{Offset: 1, Line: 2, Column: 3},
+				LabelEndPos:   &Position{Offset: 3, Line: 2, Column: 5},
+				Identifier: Identifier{
+					Identifier: "bar",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+		},
+		Transfer: &Transfer{
+			Operation: TransferOperationCopy,
+			Pos:       Position{Offset: 7, Line: 8, Column: 9},
+		},
+		Value: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "baz",
+				Pos:        Position{Offset: 10, Line: 11, Column: 12},
+			},
+		},
+		StartPos: Position{Offset: 13, Line: 14, Column: 15},
+	}
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "DestructuringDeclaration",
+            "IsConstant": true,
+            "Elements": [
+                {
+                    "Label": "foo",
+                    "LabelStartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "LabelEndPos": {"Offset": 3, "Line": 2, "Column": 5},
+                    "Identifier": {
+                        "Identifier": "bar",
+                        "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                        "EndPos": {"Offset": 6, "Line": 5, "Column": 8}
+                    },
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 6, "Line": 5, "Column": 8}
+                }
+            ],
+            "Transfer": {
+                "Type": "Transfer",
+                "Operation": "TransferOperationCopy",
+                "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                "EndPos": {"Offset": 7, "Line": 8, "Column": 9}
+            },
+            "Value": {
+                "Type": "IdentifierExpression",
+                "Identifier": {
+                    "Identifier": "baz",
+                    "StartPos": {"Offset": 10, "Line": 11, "Column": 12},
+                    "EndPos": {"Offset": 12, "Line": 11, "Column": 14}
+                },
+                "StartPos": {"Offset": 10, "Line": 11, "Column": 12},
+                "EndPos": {"Offset": 12, "Line": 11, "Column": 14}
+            },
+            "StartPos": {"Offset": 13, "Line": 14, "Column": 15},
+            "EndPos": {"Offset": 12, "Line": 11, "Column": 14}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestDestructuringDeclaration_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &DestructuringDeclaration{
+		IsConstant: false,
+		Elements: []*DestructuringElement{
+			{
+				Identifier: Identifier{
+					Identifier: "foo",
+				},
+			},
+			{
+				Identifier: Identifier{
+					Identifier: "bar",
+				},
+			},
+		},
+		Transfer: &Transfer{
+			Operation: TransferOperationMove,
+		},
+		Value: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "baz",
+			},
+		},
+	}
+
+	assert.Equal(t,
+		prettier.Group{
+			Doc: prettier.Concat{
+				prettier.Text("var"),
+				prettier.Text(" "),
+				prettier.Group{
+					Doc: prettier.Concat{
+						prettier.Text("("),
+						prettier.Indent{
+							Doc: prettier.Concat{
+								prettier.SoftLine{},
+								prettier.Concat{
+									prettier.Text("foo"),
+									prettier.Text(","),
+									prettier.Line{},
+									prettier.Text("bar"),
+								},
+							},
+						},
+						prettier.SoftLine{},
+						prettier.Text(")"),
+					},
+				},
+				prettier.Text(" "),
+				prettier.Text("<-"),
+				prettier.Text(" "),
+				prettier.Group{
+					Doc: prettier.Indent{
+						Doc: prettier.Text("baz"),
+					},
+				},
+			},
+		},
+		decl.Doc(),
+	)
+}
+
+func TestDestructuringElement_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	element := &DestructuringElement{
+		Label: "foo",
+		Identifier: Identifier{
+			Identifier: "bar",
+		},
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Text("foo"),
+			prettier.Text(": "),
+			prettier.Text("bar"),
+		},
+		element.Doc(),
+	)
+}
@@ -36,6 +36,7 @@ type Expression interface {
 	isExpression()
 	AcceptExp(ExpressionVisitor) Repr
 	Doc() prettier.Doc
+	precedence() precedence
 }
 
 // BoolExpression
@@ -51,6 +52,10 @@ func (*BoolExpression) isExpression() {}
 
 func (*BoolExpression) isIfStatementTest() {}
 
+func (*BoolExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 func (e *BoolExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -104,6 +109,10 @@ func (*NilExpression) isExpression() {}
 
 func (*NilExpression) isIfStatementTest() {}
 
+func (*NilExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 func (e *NilExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -160,6 +169,10 @@ func (*StringExpression) isExpression() {}
 
 func (*StringExpression) isIfStatementTest() {}
 
+func (*StringExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 func (e *StringExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -206,6 +219,13 @@ func (*IntegerExpression) isExpression() {}
 
 func (*IntegerExpression) isIfStatementTest() {}
 
+func (e *IntegerExpression) precedence() precedence {
+	if e.Value.Sign() < 0 {
+		return precedenceUnaryPrefix
+	}
+	return precedenceLiteral
+}
+
 func (e *IntegerExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -264,6 +284,13 @@ func (*FixedPointExpression) isExpression() {}
 
 func (*FixedPointExpression) isIfStatementTest() {}
 
+func (e *FixedPointExpression) precedence() precedence {
+	if e.Negative {
+		return precedenceUnaryPrefix
+	}
+	return precedenceLiteral
+}
+
 func (e *FixedPointExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -335,6 +362,10 @@ func (*ArrayExpression) isExpression() {}
 
 func (*ArrayExpression) isIfStatementTest() {}
 
+func (*ArrayExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 func (e *ArrayExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -404,6 +435,10 @@ func (*DictionaryExpression) isExpression() {}
 
 func (*DictionaryExpression) isIfStatementTest() {}
 
+func (*DictionaryExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 func (e *DictionaryExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -512,6 +547,10 @@ func (*IdentifierExpression) isExpression() {}
 
 func (*IdentifierExpression) isIfStatementTest() {}
 
+func (*IdentifierExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 func (e *IdentifierExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -586,6 +625,10 @@ func (*InvocationExpression) isExpression() {}
 
 func (*InvocationExpression) isIfStatementTest() {}
 
+func (*InvocationExpression) precedence() precedence {
+	return precedenceAccess
+}
+
 func (e *InvocationExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -621,8 +664,7 @@ func (e *InvocationExpression) String() string {
 func (e *InvocationExpression) Doc() prettier.Doc {
 
 	result := prettier.Concat{
-		// TODO: potentially parenthesize
-		e.InvokedExpression.Doc(),
+		parenthesizedExpressionDoc(e.InvokedExpression, precedenceUnaryPostfix),
 	}
 
 	if len(e.TypeArguments) > 0 {
@@ -713,6 +755,10 @@ func (*MemberExpression) isExpression() {}
 
 func (*MemberExpression) isIfStatementTest() {}
 
+func (*MemberExpression) precedence() precedence {
+	return precedenceAccess
+}
+
 func (*MemberExpression) isAccessExpression() {}
 
 func (e *MemberExpression) AccessedExpression() Expression {
@@ -753,8 +799,7 @@ func (e *MemberExpression) Doc() prettier.Doc {
 		separatorDoc = memberExpressionSeparatorDoc
 	}
 	return prettier.Concat{
-		// TODO: potentially parenthesize
-		e.Expression.Doc(),
+		parenthesizedExpressionDoc(e.Expression, precedenceUnaryPostfix),
 		prettier.Group{
 			Doc: prettier.Indent{
 				Doc: prettier.Concat{
@@ -806,6 +851,10 @@ func (*IndexExpression) isExpression() {}
 
 func (*IndexExpression) isIfStatementTest() {}
 
+func (*IndexExpression) precedence() precedence {
+	return precedenceAccess
+}
+
 func (*IndexExpression) isAccessExpression() {}
 
 func (e *IndexExpression) AccessedExpression() Expression {
@@ -834,8 +883,7 @@ func (e *IndexExpression) String() string {
 
 func (e *IndexExpression) Doc() prettier.Doc {
 	return prettier.Concat{
-		// TODO: potentially parenthesize
-		e.TargetExpression.Doc(),
+		parenthesizedExpressionDoc(e.TargetExpression, precedenceUnaryPostfix),
 		prettier.WrapBrackets(
 			e.IndexingExpression.Doc(),
 			prettier.SoftLine{},
@@ -868,6 +916,10 @@ func (*ConditionalExpression) isExpression() {}
 
 func (*ConditionalExpression) isIfStatementTest() {}
 
+func (*ConditionalExpression) precedence() precedence {
+	return precedenceTernary
+}
+
 func (e *ConditionalExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -900,13 +952,13 @@ var conditionalExpressionBranchSeparatorDoc prettier.Doc = prettier.Concat{
 }
 
 func (e *ConditionalExpression) Doc() prettier.Doc {
-	// TODO: potentially parenthesize
-	testDoc := e.Test.Doc()
+	// The conditional expression is right associative,
+	// so the test must bind tighter
+
+	testDoc := parenthesizedExpressionDoc(e.Test, precedenceTernary+1)
 
-	// TODO: potentially parenthesize
 	thenDoc := e.Then.Doc()
 
-	// TODO: potentially parenthesize
 	elseDoc := e.Else.Doc()
 
 	return prettier.Group{
@@ -963,6 +1015,10 @@ func (*UnaryExpression) isExpression() {}
 
 func (*UnaryExpression) isIfStatementTest() {}
 
+func (*UnaryExpression) precedence() precedence {
+	return precedenceUnaryPrefix
+}
+
 func (e *UnaryExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -986,8 +1042,7 @@ func (e *UnaryExpression) String() string {
 func (e *UnaryExpression) Doc() prettier.Doc {
 	return prettier.Concat{
 		prettier.Text(e.Operation.Symbol()),
-		// TODO: potentially parenthesize
-		e.Expression.Doc(),
+		parenthesizedExpressionDoc(e.Expression, precedenceUnaryPrefix),
 	}
 }
 
@@ -1026,6 +1081,10 @@ func (*BinaryExpression) isExpression() {}
 
 func (*BinaryExpression) isIfStatementTest() {}
 
+func (e *BinaryExpression) precedence() precedence {
+	return e.Operation.precedence()
+}
+
 func (e *BinaryExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1047,11 +1106,21 @@ func (e *BinaryExpression) String() string {
 }
 
 func (e *BinaryExpression) Doc() prettier.Doc {
-	// TODO: potentially parenthesize
-	leftDoc := e.Left.Doc()
+	// The operand on the associative side may have the same precedence,
+	// the other operand must bind tighter
+
+	operationPrecedence := e.Operation.precedence()
+	leftPrecedence := operationPrecedence
+	rightPrecedence := operationPrecedence
+	if e.Operation.isRightAssociative() {
+		leftPrecedence++
+	} else {
+		rightPrecedence++
+	}
 
-	// TODO: potentially parenthesize
-	rightDoc := e.Right.Doc()
+	leftDoc := parenthesizedExpressionDoc(e.Left, leftPrecedence)
+
+	rightDoc := parenthesizedExpressionDoc(e.Right, rightPrecedence)
 
 	return prettier.Group{
 		Doc: prettier.Concat{
@@ -1104,6 +1173,10 @@ func (*FunctionExpression) isExpression() {}
 
 func (*FunctionExpression) isIfStatementTest() {}
 
+func (*FunctionExpression) precedence() precedence {
+	return precedenceTernary
+}
+
 func (e *FunctionExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1124,17 +1197,13 @@ func (e *FunctionExpression) String() string {
 }
 
 var functionExpressionFunKeywordDoc prettier.Doc = prettier.Text("fun ")
-var functionExpressionParameterSeparatorDoc prettier.Doc = prettier.Concat{
-	prettier.Text(","),
-	prettier.Line{},
-}
 
 var typeSeparatorDoc prettier.Doc = prettier.Text(": ")
 var functionExpressionEmptyBlockDoc prettier.Doc = prettier.Text(" {}")
 
 func (e *FunctionExpression) Doc() prettier.Doc {
 
-	signatureDoc := e.parametersDoc()
+	signatureDoc := e.ParameterList.Doc()
 
 	if e.ReturnTypeAnnotation != nil &&
 		!IsEmptyType(e.ReturnTypeAnnotation.Type) {
@@ -1156,56 +1225,12 @@ func (e *FunctionExpression) Doc() prettier.Doc {
 	if e.FunctionBlock.IsEmpty() {
 		return append(doc, functionExpressionEmptyBlockDoc)
 	} else {
-		// TODO: pre-conditions
-		// TODO: post-conditions
-
-		blockDoc := e.FunctionBlock.Block.Doc()
-
 		return append(
 			doc,
 			prettier.Space,
-			blockDoc,
-		)
-	}
-}
-
-func (e *FunctionExpression) parametersDoc() prettier.Doc {
-
-	if e.ParameterList == nil ||
-		len(e.ParameterList.Parameters) == 0 {
-
-		return prettier.Text("()")
-	}
-
-	parameterDocs := make([]prettier.Doc, 0, len(e.ParameterList.Parameters))
-
-	for _, parameter := range e.ParameterList.Parameters {
-		var parameterDoc prettier.Concat
-
-		if parameter.Label != "" {
-			parameterDoc = append(parameterDoc,
-				prettier.Text(parameter.Label),
-				prettier.Space,
-			)
-		}
-
-		parameterDoc = append(
-			parameterDoc,
-			prettier.Text(parameter.Identifier.Identifier),
-			typeSeparatorDoc,
-			parameter.TypeAnnotation.Doc(),
+			e.FunctionBlock.Doc(),
 		)
-
-		parameterDocs = append(parameterDocs, parameterDoc)
 	}
-
-	return prettier.WrapParentheses(
-		prettier.Join(
-			functionExpressionParameterSeparatorDoc,
-			parameterDocs...,
-		),
-		prettier.SoftLine{},
-	)
 }
 
 func (e *FunctionExpression) StartPosition() Position {
@@ -1244,6 +1269,10 @@ func (*CastingExpression) isExpression() {}
 
 func (*CastingExpression) isIfStatementTest() {}
 
+func (*CastingExpression) precedence() precedence {
+	return precedenceCasting
+}
+
 func (e *CastingExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1264,8 +1293,7 @@ func (e *CastingExpression) String() string {
 }
 
 func (e *CastingExpression) Doc() prettier.Doc {
-	// TODO: potentially parenthesize
-	doc := e.Expression.Doc()
+	doc := parenthesizedExpressionDoc(e.Expression, precedenceCasting)
 
 	return prettier.Group{
 		Doc: prettier.Concat{
@@ -1314,6 +1342,10 @@ func (*CreateExpression) isExpression() {}
 
 func (*CreateExpression) isIfStatementTest() {}
 
+func (*CreateExpression) precedence() precedence {
+	return precedenceAccess
+}
+
 func (e *CreateExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1336,7 +1368,6 @@ func (e *CreateExpression) String() string {
 func (e *CreateExpression) Doc() prettier.Doc {
 	return prettier.Concat{
 		prettier.Text("create "),
-		// TODO: potentially parenthesize
 		e.InvocationExpression.Doc(),
 	}
 }
@@ -1375,6 +1406,10 @@ func (*DestroyExpression) isExpression() {}
 
 func (*DestroyExpression) isIfStatementTest() {}
 
+func (*DestroyExpression) precedence() precedence {
+	return precedenceTernary
+}
+
 func (e *DestroyExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1399,7 +1434,6 @@ const destroyExpressionKeywordDoc = prettier.Text("destroy ")
 func (e *DestroyExpression) Doc() prettier.Doc {
 	return prettier.Concat{
 		destroyExpressionKeywordDoc,
-		// TODO: potentially parenthesize
 		e.Expression.Doc(),
 	}
 }
@@ -1439,6 +1473,10 @@ func (*ReferenceExpression) isExpression() {}
 
 func (*ReferenceExpression) isIfStatementTest() {}
 
+func (*ReferenceExpression) precedence() precedence {
+	return precedenceTernary
+}
+
 func (e *ReferenceExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1464,8 +1502,10 @@ var referenceExpressionRefOperatorDoc prettier.Doc = prettier.Text("&")
 var referenceExpressionAsOperatorDoc prettier.Doc = prettier.Text("as")
 
 func (e *ReferenceExpression) Doc() prettier.Doc {
-	// TODO: potentially parenthesize
-	doc := e.Expression.Doc()
+	// The referenced expression is parsed as a casting expression,
+	// so it must bind at least as tight as a casting expression
+
+	doc := parenthesizedExpressionDoc(e.Expression, precedenceCasting)
 
 	return prettier.Group{
 		Doc: prettier.Concat{
@@ -1515,6 +1555,10 @@ func (*ForceExpression) isExpression() {}
 
 func (*ForceExpression) isIfStatementTest() {}
 
+func (*ForceExpression) precedence() precedence {
+	return precedenceUnaryPostfix
+}
+
 func (e *ForceExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1535,8 +1579,7 @@ const forceExpressionOperatorDoc = prettier.Text("!")
 
 func (e *ForceExpression) Doc() prettier.Doc {
 	return prettier.Concat{
-		// TODO: potentially parenthesize
-		e.Expression.Doc(),
+		parenthesizedExpressionDoc(e.Expression, precedenceUnaryPostfix),
 		forceExpressionOperatorDoc,
 	}
 }
@@ -1576,6 +1619,10 @@ func (*PathExpression) isExpression() {}
 
 func (*PathExpression) isIfStatementTest() {}
 
+func (*PathExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 func (e *PathExpression) Accept(visitor Visitor) Repr {
 	return e.AcceptExp(visitor)
 }
@@ -1039,6 +1039,141 @@ func TestBinaryExpression_Doc(t *testing.T) {
 	)
 }
 
+func TestBinaryExpression_Doc_Parentheses(t *testing.T) {
+
+	t.Parallel()
+
+	identifier := func(name string) Expression {
+		return &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: name,
+			},
+		}
+	}
+
+	operandDoc := func(name string) prettier.Doc {
+		return prettier.Group{
+			Doc: prettier.Text(name),
+		}
+	}
+
+	parenthesizedDoc := func(doc prettier.Doc) prettier.Doc {
+		return prettier.Group{
+			Doc: prettier.Concat{
+				prettier.Text("("),
+				prettier.Indent{
+					Doc: prettier.Concat{
+						prettier.SoftLine{},
+						doc,
+					},
+				},
+				prettier.SoftLine{},
+				prettier.Text(")"),
+			},
+		}
+	}
+
+	binaryDoc := func(left prettier.Doc, symbol string, right prettier.Doc) prettier.Doc {
+		return prettier.Group{
+			Doc: prettier.Concat{
+				left,
+				prettier.Line{},
+				prettier.Text(symbol),
+				prettier.Space,
+				right,
+			},
+		}
+	}
+
+	t.Run("lower precedence operand", func(t *testing.T) {
+
+		t.Parallel()
+
+		// (a + b) * c
+
+		expr := &BinaryExpression{
+			Operation: OperationMul,
+			Left: &BinaryExpression{
+				Operation: OperationPlus,
+				Left:      identifier("a"),
+				Right:     identifier("b"),
+			},
+			Right: identifier("c"),
+		}
+
+		assert.Equal(t,
+			binaryDoc(
+				prettier.Group{
+					Doc: parenthesizedDoc(
+						binaryDoc(operandDoc("a"), "+", operandDoc("b")),
+					),
+				},
+				"*",
+				operandDoc("c"),
+			),
+			expr.Doc(),
+		)
+	})
+
+	t.Run("left associative", func(t *testing.T) {
+
+		t.Parallel()
+
+		// a - (b - c)
+
+		expr := &BinaryExpression{
+			Operation: OperationMinus,
+			Left:      identifier("a"),
+			Right: &BinaryExpression{
+				Operation: OperationMinus,
+				Left:      identifier("b"),
+				Right:     identifier("c"),
+			},
+		}
+
+		assert.Equal(t,
+			binaryDoc(
+				operandDoc("a"),
+				"-",
+				prettier.Group{
+					Doc: parenthesizedDoc(
+						binaryDoc(operandDoc("b"), "-", operandDoc("c")),
+					),
+				},
+			),
+			expr.Doc(),
+		)
+	})
+
+	t.Run("right associative", func(t *testing.T) {
+
+		t.Parallel()
+
+		// a ?? (b ?? c)
+
+		expr := &BinaryExpression{
+			Operation: OperationNilCoalesce,
+			Left:      identifier("a"),
+			Right: &BinaryExpression{
+				Operation: OperationNilCoalesce,
+				Left:      identifier("b"),
+				Right:     identifier("c"),
+			},
+		}
+
+		assert.Equal(t,
+			binaryDoc(
+				operandDoc("a"),
+				"??",
+				prettier.Group{
+					Doc: binaryDoc(operandDoc("b"), "??", operandDoc("c")),
+				},
+			),
+			expr.Doc(),
+		)
+	})
+}
+
 func TestDestroyExpression_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
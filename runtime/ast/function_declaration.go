@@ -21,6 +21,8 @@ package ast
 import (
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -92,6 +94,52 @@ func (d *FunctionDeclaration) DeclarationDocString() string {
 	return d.DocString
 }
 
+var functionDeclarationFunKeywordSpaceDoc prettier.Doc = prettier.Text("fun ")
+
+func (d *FunctionDeclaration) Doc() prettier.Doc {
+	return prettier.Concat{
+		DocStringDoc(d.DocString),
+		accessDoc(d.Access),
+		functionDeclarationFunKeywordSpaceDoc,
+		prettier.Text(d.Identifier.Identifier),
+		d.signatureAndBlockDoc(),
+	}
+}
+
+// signatureAndBlockDoc returns the document for the parameter list,
+// the return type annotation (if any), and the function block (if any)
+//
+func (d *FunctionDeclaration) signatureAndBlockDoc() prettier.Doc {
+
+	signatureDoc := d.ParameterList.Doc()
+
+	if d.ReturnTypeAnnotation != nil &&
+		!IsEmptyType(d.ReturnTypeAnnotation.Type) {
+
+		signatureDoc = prettier.Concat{
+			signatureDoc,
+			typeSeparatorDoc,
+			d.ReturnTypeAnnotation.Doc(),
+		}
+	}
+
+	doc := prettier.Concat{
+		prettier.Group{
+			Doc: signatureDoc,
+		},
+	}
+
+	if d.FunctionBlock == nil {
+		return doc
+	}
+
+	return append(
+		doc,
+		prettier.Space,
+		d.FunctionBlock.Doc(),
+	)
+}
+
 func (d *FunctionDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias FunctionDeclaration
 	return json.Marshal(&struct {
@@ -151,6 +199,31 @@ func (d *SpecialFunctionDeclaration) DeclarationDocString() string {
 	return d.FunctionDeclaration.DeclarationDocString()
 }
 
+func (d *SpecialFunctionDeclaration) Doc() prettier.Doc {
+	functionDeclaration := d.FunctionDeclaration
+
+	doc := prettier.Concat{
+		DocStringDoc(functionDeclaration.DocString),
+		accessDoc(functionDeclaration.Access),
+		prettier.Text(functionDeclaration.Identifier.Identifier),
+	}
+
+	// The execute block of a transaction has no parameter list
+
+	if d.Kind == common.DeclarationKindExecute {
+		return append(
+			doc,
+			prettier.Space,
+			functionDeclaration.FunctionBlock.Doc(),
+		)
+	}
+
+	return append(
+		doc,
+		functionDeclaration.signatureAndBlockDoc(),
+	)
+}
+
 func (d *SpecialFunctionDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias SpecialFunctionDeclaration
 	return json.Marshal(&struct {
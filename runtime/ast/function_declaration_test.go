@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/turbolent/prettier"
 
 	"github.com/onflow/cadence/runtime/common"
 )
@@ -316,3 +317,83 @@ func TestSpecialFunctionDeclaration_MarshalJSON(t *testing.T) {
 		string(actual),
 	)
 }
+
+func TestFunctionDeclaration_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &FunctionDeclaration{
+		Access: AccessPublic,
+		Identifier: Identifier{
+			Identifier: "xyz",
+		},
+		ParameterList: &ParameterList{
+			Parameters: []*Parameter{
+				{
+					Label: "ok",
+					Identifier: Identifier{
+						Identifier: "foobar",
+					},
+					TypeAnnotation: &TypeAnnotation{
+						Type: &NominalType{
+							Identifier: Identifier{
+								Identifier: "AB",
+							},
+						},
+					},
+				},
+			},
+		},
+		ReturnTypeAnnotation: &TypeAnnotation{
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "CD",
+				},
+			},
+		},
+		DocString: " test",
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Concat{
+				prettier.Text("/// test"),
+				prettier.HardLine{},
+			},
+			prettier.Concat{
+				prettier.Text("pub"),
+				prettier.Space,
+			},
+			prettier.Text("fun "),
+			prettier.Text("xyz"),
+			prettier.Concat{
+				prettier.Group{
+					Doc: prettier.Concat{
+						prettier.Group{
+							Doc: prettier.Concat{
+								prettier.Text("("),
+								prettier.Indent{
+									Doc: prettier.Concat{
+										prettier.SoftLine{},
+										prettier.Concat{
+											prettier.Text("ok"),
+											prettier.Space,
+											prettier.Text("foobar"),
+											prettier.Text(": "),
+											prettier.Text("AB"),
+										},
+									},
+								},
+								prettier.SoftLine{},
+								prettier.Text(")"),
+							},
+						},
+						prettier.Text(": "),
+						prettier.Text("CD"),
+					},
+				},
+			},
+		},
+		decl.Doc(),
+	)
+}
@@ -19,8 +19,11 @@
 package ast
 
 import (
+	"encoding/hex"
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -30,6 +33,8 @@ type ImportDeclaration struct {
 	Identifiers []Identifier
 	Location    common.Location
 	LocationPos Position
+	// Hash is the optional expected hash of the imported code
+	Hash []byte `json:",omitempty"`
 	Range
 }
 
@@ -65,6 +70,67 @@ func (d *ImportDeclaration) DeclarationDocString() string {
 	return ""
 }
 
+var importDeclarationImportKeywordSpaceDoc prettier.Doc = prettier.Text("import ")
+var importDeclarationSpaceFromKeywordSpaceDoc prettier.Doc = prettier.Text(" from ")
+var importDeclarationIdentifierSeparatorDoc prettier.Doc = prettier.Text(", ")
+var importDeclarationHashStartDoc prettier.Doc = prettier.Text(" (hash: ")
+var importDeclarationHashEndDoc prettier.Doc = prettier.Text(")")
+
+func (d *ImportDeclaration) Doc() prettier.Doc {
+	doc := prettier.Concat{
+		importDeclarationImportKeywordSpaceDoc,
+	}
+
+	if len(d.Identifiers) > 0 {
+		identifierDocs := make([]prettier.Doc, len(d.Identifiers))
+		for i, identifier := range d.Identifiers {
+			identifierDocs[i] = prettier.Text(identifier.Identifier)
+		}
+
+		doc = append(
+			doc,
+			prettier.Join(importDeclarationIdentifierSeparatorDoc, identifierDocs...),
+			importDeclarationSpaceFromKeywordSpaceDoc,
+		)
+	}
+
+	doc = append(
+		doc,
+		importLocationDoc(d.Location),
+	)
+
+	if d.Hash != nil {
+		doc = append(
+			doc,
+			importDeclarationHashStartDoc,
+			prettier.Text(QuoteString(hex.EncodeToString(d.Hash))),
+			importDeclarationHashEndDoc,
+		)
+	}
+
+	return doc
+}
+
+func importLocationDoc(location common.Location) prettier.Doc {
+	switch location := location.(type) {
+	case common.StringLocation:
+		return prettier.Text(QuoteString(string(location)))
+
+	case common.AddressLocation:
+		address := location.Address.ShortHexWithPrefix()
+		if address == "0x" {
+			address = "0x0"
+		}
+		return prettier.Text(address)
+
+	case common.IdentifierLocation:
+		return prettier.Text(string(location))
+
+	default:
+		return prettier.Text(location.String())
+	}
+}
+
 func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias ImportDeclaration
 	return json.Marshal(&struct {
@@ -21,6 +21,8 @@ package ast
 import (
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -70,6 +72,21 @@ func (d *InterfaceDeclaration) DeclarationDocString() string {
 	return d.DocString
 }
 
+var interfaceKeywordSpaceDoc prettier.Doc = prettier.Text("interface ")
+
+func (d *InterfaceDeclaration) Doc() prettier.Doc {
+	return prettier.Concat{
+		DocStringDoc(d.DocString),
+		accessDoc(d.Access),
+		prettier.Text(d.CompositeKind.Keyword()),
+		prettier.Space,
+		interfaceKeywordSpaceDoc,
+		prettier.Text(d.Identifier.Identifier),
+		prettier.Space,
+		d.Members.Doc(),
+	}
+}
+
 func (d *InterfaceDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias InterfaceDeclaration
 	return json.Marshal(&struct {
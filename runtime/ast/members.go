@@ -21,6 +21,8 @@ package ast
 import (
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -65,6 +67,10 @@ func (m *Members) EnumCases() []*EnumCaseDeclaration {
 	return m.indices.EnumCases(m.declarations)
 }
 
+func (m *Members) TypeAliases() []*TypeAliasDeclaration {
+	return m.indices.TypeAliases(m.declarations)
+}
+
 func (m *Members) FieldsByIdentifier() map[string]*FieldDeclaration {
 	return m.indices.FieldsByIdentifier(m.declarations)
 }
@@ -110,6 +116,21 @@ func (m *Members) FieldPosition(name string, compositeKind common.CompositeKind)
 	}
 }
 
+func (m *Members) Doc() prettier.Doc {
+	if len(m.declarations) == 0 {
+		return blockEmptyDoc
+	}
+
+	return prettier.Concat{
+		blockStartDoc,
+		prettier.Indent{
+			Doc: DeclarationsDoc(m.declarations),
+		},
+		prettier.HardLine{},
+		blockEndDoc,
+	}
+}
+
 func (m *Members) MarshalJSON() ([]byte, error) {
 	type Alias Members
 	return json.Marshal(&struct {
@@ -115,6 +115,63 @@ func (s Operation) Symbol() string {
 	panic(errors.NewUnreachableError())
 }
 
+func (s Operation) precedence() precedence {
+	switch s {
+	case OperationOr:
+		return precedenceLogicalOr
+	case OperationAnd:
+		return precedenceLogicalAnd
+	case OperationEqual,
+		OperationNotEqual,
+		OperationLess,
+		OperationGreater,
+		OperationLessEqual,
+		OperationGreaterEqual:
+		return precedenceComparison
+	case OperationNilCoalesce:
+		return precedenceNilCoalescing
+	case OperationBitwiseOr:
+		return precedenceBitwiseOr
+	case OperationBitwiseXor:
+		return precedenceBitwiseXor
+	case OperationBitwiseAnd:
+		return precedenceBitwiseAnd
+	case OperationBitwiseLeftShift,
+		OperationBitwiseRightShift:
+		return precedenceBitwiseShift
+	case OperationPlus,
+		OperationMinus:
+		return precedenceAddition
+	case OperationMul,
+		OperationDiv,
+		OperationMod:
+		return precedenceMultiplication
+	case OperationCast,
+		OperationFailableCast,
+		OperationForceCast:
+		return precedenceCasting
+	case OperationNegate,
+		OperationMove:
+		return precedenceUnaryPrefix
+	}
+
+	return precedenceUnknown
+}
+
+// isRightAssociative returns true if the binary operation is right associative,
+// i.e. `a ?? b ?? c` is `a ?? (b ?? c)`
+//
+func (s Operation) isRightAssociative() bool {
+	switch s {
+	case OperationOr,
+		OperationAnd,
+		OperationNilCoalesce:
+		return true
+	}
+
+	return false
+}
+
 func (s Operation) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.String())
 }
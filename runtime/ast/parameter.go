@@ -22,6 +22,9 @@ type Parameter struct {
 	Label          string
 	Identifier     Identifier
 	TypeAnnotation *TypeAnnotation
+	// DefaultArgument is the optional constant expression
+	// used as the argument when a call omits it
+	DefaultArgument Expression `json:",omitempty"`
 	Range
 }
 
@@ -18,7 +18,11 @@
 
 package ast
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/turbolent/prettier"
+)
 
 type ParameterList struct {
 	once                    sync.Once
@@ -54,3 +58,54 @@ func (l *ParameterList) initialize() {
 	}
 	l._parametersByIdentifier = parametersByIdentifier
 }
+
+var parameterListEmptyDoc prettier.Doc = prettier.Text("()")
+var parameterListSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (l *ParameterList) Doc() prettier.Doc {
+
+	if l == nil || len(l.Parameters) == 0 {
+		return parameterListEmptyDoc
+	}
+
+	parameterDocs := make([]prettier.Doc, 0, len(l.Parameters))
+
+	for _, parameter := range l.Parameters {
+		var parameterDoc prettier.Concat
+
+		if parameter.Label != "" {
+			parameterDoc = append(parameterDoc,
+				prettier.Text(parameter.Label),
+				prettier.Space,
+			)
+		}
+
+		parameterDoc = append(
+			parameterDoc,
+			prettier.Text(parameter.Identifier.Identifier),
+			typeSeparatorDoc,
+			parameter.TypeAnnotation.Doc(),
+		)
+
+		if parameter.DefaultArgument != nil {
+			parameterDoc = append(
+				parameterDoc,
+				prettier.Text(" = "),
+				parameter.DefaultArgument.Doc(),
+			)
+		}
+
+		parameterDocs = append(parameterDocs, parameterDoc)
+	}
+
+	return prettier.WrapParentheses(
+		prettier.Join(
+			parameterListSeparatorDoc,
+			parameterDocs...,
+		),
+		prettier.SoftLine{},
+	)
+}
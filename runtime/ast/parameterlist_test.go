@@ -19,10 +19,13 @@
 package ast
 
 import (
+	"math/big"
+	"strings"
 	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/turbolent/prettier"
 )
 
 func TestParameterList_ParametersByIdentifier(t *testing.T) {
@@ -83,3 +86,43 @@ func TestParameterList_ParametersByIdentifier(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestParameterList_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	l := &ParameterList{
+		Parameters: []*Parameter{
+			{
+				Identifier: Identifier{Identifier: "a"},
+				TypeAnnotation: &TypeAnnotation{
+					Type: &NominalType{
+						Identifier: Identifier{Identifier: "Int"},
+					},
+				},
+			},
+			{
+				Label:      "c",
+				Identifier: Identifier{Identifier: "d"},
+				TypeAnnotation: &TypeAnnotation{
+					Type: &NominalType{
+						Identifier: Identifier{Identifier: "Int"},
+					},
+				},
+				DefaultArgument: &IntegerExpression{
+					PositiveLiteral: "42",
+					Value:           big.NewInt(42),
+					Base:            10,
+				},
+			},
+		},
+	}
+
+	var builder strings.Builder
+	prettier.Prettier(&builder, l.Doc(), 80, "    ")
+
+	require.Equal(t,
+		"(a: Int, c d: Int = 42)",
+		builder.String(),
+	)
+}
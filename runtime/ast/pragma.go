@@ -21,6 +21,8 @@ package ast
 import (
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -63,6 +65,15 @@ func (d *PragmaDeclaration) DeclarationDocString() string {
 	return ""
 }
 
+var pragmaDeclarationSymbolDoc prettier.Doc = prettier.Text("#")
+
+func (d *PragmaDeclaration) Doc() prettier.Doc {
+	return prettier.Concat{
+		pragmaDeclarationSymbolDoc,
+		d.Expression.Doc(),
+	}
+}
+
 func (d *PragmaDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias PragmaDeclaration
 	return json.Marshal(&struct {
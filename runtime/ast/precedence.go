@@ -0,0 +1,110 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"github.com/turbolent/prettier"
+)
+
+// precedence is the order of importance of expressions / operators.
+//
+// NOTE: The precedences must be kept in sync with the binding powers of the parser
+//
+type precedence int
+
+const (
+	precedenceUnknown precedence = iota
+	// precedenceTernary is the precedence of
+	// - ConditionalExpression. right associative!
+	// - expressions which extend as far to the right as possible:
+	//   FunctionExpression, ReferenceExpression, DestroyExpression
+	precedenceTernary
+	// precedenceLogicalOr is the precedence of
+	// - BinaryExpression, with OperationOr. right associative!
+	precedenceLogicalOr
+	// precedenceLogicalAnd is the precedence of
+	// - BinaryExpression, with OperationAnd. right associative!
+	precedenceLogicalAnd
+	// precedenceComparison is the precedence of
+	// - BinaryExpression, with OperationEqual, OperationNotEqual,
+	//   OperationLessEqual, OperationLess,
+	//   OperationGreater, or OperationGreaterEqual.
+	precedenceComparison
+	// precedenceNilCoalescing is the precedence of
+	// - BinaryExpression, with OperationNilCoalesce. right associative!
+	precedenceNilCoalescing
+	// precedenceBitwiseOr is the precedence of
+	// - BinaryExpression, with OperationBitwiseOr.
+	precedenceBitwiseOr
+	// precedenceBitwiseXor is the precedence of
+	// - BinaryExpression, with OperationBitwiseXor.
+	precedenceBitwiseXor
+	// precedenceBitwiseAnd is the precedence of
+	// - BinaryExpression, with OperationBitwiseAnd.
+	precedenceBitwiseAnd
+	// precedenceBitwiseShift is the precedence of
+	// - BinaryExpression, with OperationBitwiseLeftShift or OperationBitwiseRightShift.
+	precedenceBitwiseShift
+	// precedenceAddition is the precedence of
+	// - BinaryExpression, with OperationPlus or OperationMinus.
+	precedenceAddition
+	// precedenceMultiplication is the precedence of
+	// - BinaryExpression, with OperationMul, OperationMod, or OperationDiv.
+	precedenceMultiplication
+	// precedenceCasting is the precedence of
+	// - CastingExpression.
+	precedenceCasting
+	// precedenceUnaryPrefix is the precedence of
+	// - UnaryExpression
+	// - negative IntegerExpression and FixedPointExpression
+	precedenceUnaryPrefix
+	// precedenceUnaryPostfix is the precedence of
+	// - ForceExpression
+	precedenceUnaryPostfix
+	// precedenceAccess is the precedence of
+	// - InvocationExpression
+	// - IndexExpression
+	// - MemberExpression
+	// - CreateExpression
+	// Postfix operators are applied left to right,
+	// so the target of an access may be a ForceExpression without parentheses
+	precedenceAccess
+	// precedenceLiteral is the precedence of
+	// - BoolExpression
+	// - NilExpression
+	// - StringExpression
+	// - non-negative IntegerExpression and FixedPointExpression
+	// - ArrayExpression
+	// - DictionaryExpression
+	// - IdentifierExpression
+	// - PathExpression
+	precedenceLiteral
+)
+
+// parenthesizedExpressionDoc returns the document for the given expression,
+// parenthesized if the precedence of the expression is lower
+// than the given minimum precedence
+//
+func parenthesizedExpressionDoc(e Expression, minPrecedence precedence) prettier.Doc {
+	doc := e.Doc()
+	if e.precedence() >= minPrecedence {
+		return doc
+	}
+	return prettier.WrapParentheses(doc, prettier.SoftLine{})
+}
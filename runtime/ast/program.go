@@ -21,6 +21,8 @@ package ast
 import (
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -93,6 +95,10 @@ func (p *Program) VariableDeclarations() []*VariableDeclaration {
 	return p.indices.variableDeclarations(p.declarations)
 }
 
+func (p *Program) TypeAliasDeclarations() []*TypeAliasDeclaration {
+	return p.indices.typeAliasDeclarations(p.declarations)
+}
+
 // SoleContractDeclaration returns the sole contract declaration, if any,
 // and if there are no other actionable declarations.
 //
@@ -159,6 +165,24 @@ func (p *Program) SoleTransactionDeclaration() *TransactionDeclaration {
 	return transactionDeclarations[0]
 }
 
+func (p *Program) Doc() prettier.Doc {
+	var doc prettier.Concat
+
+	for i, declaration := range p.declarations {
+		if i > 0 && !isCompactDeclarationPair(p.declarations[i-1], declaration) {
+			doc = append(doc, prettier.HardLine{})
+		}
+
+		doc = append(
+			doc,
+			declaration.Doc(),
+			prettier.HardLine{},
+		)
+	}
+
+	return doc
+}
+
 func (p *Program) MarshalJSON() ([]byte, error) {
 	type Alias Program
 	return json.Marshal(&struct {
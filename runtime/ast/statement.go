@@ -27,6 +27,7 @@ import (
 type Statement interface {
 	Element
 	isStatement()
+	Doc() prettier.Doc
 }
 
 // ReturnStatement
@@ -60,7 +61,6 @@ func (s *ReturnStatement) Doc() prettier.Doc {
 
 	return prettier.Concat{
 		returnStatementKeywordSpaceDoc,
-		// TODO: potentially parenthesize
 		s.Expression.Doc(),
 	}
 }
@@ -151,6 +151,7 @@ func (s *ContinueStatement) MarshalJSON() ([]byte, error) {
 type IfStatementTest interface {
 	Element
 	isIfStatementTest()
+	Doc() prettier.Doc
 }
 
 // IfStatement
@@ -193,16 +194,9 @@ const ifStatementIfKeywordSpaceDoc = prettier.Text("if ")
 const ifStatementSpaceElseKeywordSpaceDoc = prettier.Text(" else ")
 
 func (s *IfStatement) Doc() prettier.Doc {
-	var testDoc prettier.Doc
-	// TODO: replace once IfStatementTest implements Doc
-	testWithDoc, ok := s.Test.(interface{ Doc() prettier.Doc })
-	if ok {
-		testDoc = testWithDoc.Doc()
-	}
-
 	doc := prettier.Concat{
 		ifStatementIfKeywordSpaceDoc,
-		testDoc,
+		s.Test.Doc(),
 		prettier.Space,
 		s.Then.Doc(),
 	}
@@ -406,7 +400,6 @@ const emitStatementKeywordSpaceDoc = prettier.Text("emit ")
 func (s *EmitStatement) Doc() prettier.Doc {
 	return prettier.Concat{
 		emitStatementKeywordSpaceDoc,
-		// TODO: potentially parenthesize
 		s.InvocationExpression.Doc(),
 	}
 }
@@ -460,11 +453,36 @@ func (s *AssignmentStatement) Doc() prettier.Doc {
 			prettier.Space,
 			s.Transfer.Doc(),
 			prettier.Space,
-			prettier.Group{
-				Doc: prettier.Indent{
-					Doc: s.Value.Doc(),
-				},
-			},
+			transferredValueDoc(s.Value),
+		},
+	}
+}
+
+// transferredValueDoc returns the document for the value
+// of an assignment or variable declaration.
+//
+// Continuation lines are indented,
+// unless the value is enclosed in brackets or braces,
+// e.g. an invocation or a function expression.
+//
+func transferredValueDoc(value Expression) prettier.Doc {
+	doc := value.Doc()
+
+	switch value.(type) {
+	case *InvocationExpression,
+		*CreateExpression,
+		*FunctionExpression,
+		*ArrayExpression,
+		*DictionaryExpression:
+
+		return prettier.Group{
+			Doc: doc,
+		}
+	}
+
+	return prettier.Group{
+		Doc: prettier.Indent{
+			Doc: doc,
 		},
 	}
 }
@@ -653,10 +671,18 @@ func (s *SwitchStatement) MarshalJSON() ([]byte, error) {
 
 type SwitchCase struct {
 	Expression Expression
+	Pattern    *SwitchCasePattern `json:",omitempty"`
 	Statements []Statement
 	Range
 }
 
+// IsDefault returns true if the case is the default case,
+// i.e. it has neither an expression nor a pattern
+//
+func (s *SwitchCase) IsDefault() bool {
+	return s.Expression == nil && s.Pattern == nil
+}
+
 func (s *SwitchCase) MarshalJSON() ([]byte, error) {
 	type Alias SwitchCase
 	return json.Marshal(&struct {
@@ -677,6 +703,15 @@ func (s *SwitchCase) Doc() prettier.Doc {
 		Doc: StatementsDoc(s.Statements),
 	}
 
+	if s.Pattern != nil {
+		return prettier.Concat{
+			switchCaseKeywordSpaceDoc,
+			s.Pattern.Doc(),
+			switchCaseColonSymbolDoc,
+			statementsDoc,
+		}
+	}
+
 	if s.Expression == nil {
 		return prettier.Concat{
 			switchCaseDefaultKeywordSpaceDoc,
@@ -691,3 +726,43 @@ func (s *SwitchCase) Doc() prettier.Doc {
 		statementsDoc,
 	}
 }
+
+// SwitchCasePattern is the pattern of a switch case.
+// If the tested value matches the pattern,
+// it is bound to a constant with the pattern's identifier.
+//
+// The pattern `let v as T` matches if the value has the type `T`.
+// The pattern `let v?` matches if the value is not `nil`,
+// and binds the unwrapped value.
+//
+type SwitchCasePattern struct {
+	Identifier     Identifier
+	TypeAnnotation *TypeAnnotation `json:",omitempty"`
+	IsOptional     bool
+	Range
+}
+
+const switchCasePatternLetKeywordSpaceDoc = prettier.Text("let ")
+const switchCasePatternAsKeywordSpaceDoc = prettier.Text(" as ")
+const switchCasePatternOptionalSymbolDoc = prettier.Text("?")
+
+func (p *SwitchCasePattern) Doc() prettier.Doc {
+	doc := prettier.Concat{
+		switchCasePatternLetKeywordSpaceDoc,
+		prettier.Text(p.Identifier.Identifier),
+	}
+
+	if p.IsOptional {
+		doc = append(doc, switchCasePatternOptionalSymbolDoc)
+	}
+
+	if p.TypeAnnotation != nil {
+		doc = append(
+			doc,
+			switchCasePatternAsKeywordSpaceDoc,
+			p.TypeAnnotation.Doc(),
+		)
+	}
+
+	return doc
+}
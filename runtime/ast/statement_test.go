@@ -1196,3 +1196,57 @@ func TestSwitchStatement_Doc(t *testing.T) {
 		stmt.Doc(),
 	)
 }
+
+func TestSwitchCasePattern_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("type", func(t *testing.T) {
+
+		t.Parallel()
+
+		pattern := &SwitchCasePattern{
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+			TypeAnnotation: &TypeAnnotation{
+				Type: &NominalType{
+					Identifier: Identifier{
+						Identifier: "Bar",
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			prettier.Concat{
+				prettier.Text("let "),
+				prettier.Text("foo"),
+				prettier.Text(" as "),
+				prettier.Text("Bar"),
+			},
+			pattern.Doc(),
+		)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		pattern := &SwitchCasePattern{
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+			IsOptional: true,
+		}
+
+		assert.Equal(t,
+			prettier.Concat{
+				prettier.Text("let "),
+				prettier.Text("foo"),
+				prettier.Text("?"),
+			},
+			pattern.Doc(),
+		)
+	})
+}
@@ -21,6 +21,8 @@ package ast
 import (
 	"encoding/json"
 
+	"github.com/turbolent/prettier"
+
 	"github.com/onflow/cadence/runtime/common"
 )
 
@@ -76,6 +78,82 @@ func (d *TransactionDeclaration) DeclarationDocString() string {
 	return ""
 }
 
+var transactionKeywordDoc prettier.Doc = prettier.Text("transaction")
+
+func (d *TransactionDeclaration) Doc() prettier.Doc {
+
+	doc := prettier.Concat{
+		DocStringDoc(d.DocString),
+		transactionKeywordDoc,
+	}
+
+	if d.ParameterList != nil && len(d.ParameterList.Parameters) > 0 {
+		doc = append(doc, d.ParameterList.Doc())
+	}
+
+	// Sections are separated by an empty line
+
+	var sectionDocs []prettier.Doc
+
+	if len(d.Fields) > 0 {
+		var fieldsDoc prettier.Concat
+		for i, field := range d.Fields {
+			if i > 0 {
+				fieldsDoc = append(fieldsDoc, prettier.HardLine{})
+			}
+			fieldsDoc = append(fieldsDoc, field.Doc())
+		}
+		sectionDocs = append(sectionDocs, fieldsDoc)
+	}
+
+	if d.Prepare != nil {
+		sectionDocs = append(sectionDocs, d.Prepare.Doc())
+	}
+
+	if !d.PreConditions.IsEmpty() {
+		sectionDocs = append(sectionDocs, d.PreConditions.Doc(ConditionKindPre))
+	}
+
+	if d.Execute != nil {
+		sectionDocs = append(sectionDocs, d.Execute.Doc())
+	}
+
+	if !d.PostConditions.IsEmpty() {
+		sectionDocs = append(sectionDocs, d.PostConditions.Doc(ConditionKindPost))
+	}
+
+	if len(sectionDocs) == 0 {
+		return append(
+			doc,
+			prettier.Space,
+			blockEmptyDoc,
+		)
+	}
+
+	var bodyDoc prettier.Concat
+	for i, sectionDoc := range sectionDocs {
+		if i > 0 {
+			bodyDoc = append(bodyDoc, prettier.HardLine{})
+		}
+		bodyDoc = append(
+			bodyDoc,
+			prettier.HardLine{},
+			sectionDoc,
+		)
+	}
+
+	return append(
+		doc,
+		prettier.Space,
+		blockStartDoc,
+		prettier.Indent{
+			Doc: bodyDoc,
+		},
+		prettier.HardLine{},
+		blockEndDoc,
+	)
+}
+
 func (d *TransactionDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias TransactionDeclaration
 	return json.Marshal(&struct {
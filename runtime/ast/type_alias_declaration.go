@@ -0,0 +1,103 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// TypeAliasDeclaration
+
+type TypeAliasDeclaration struct {
+	Access         Access
+	Identifier     Identifier
+	TypeAnnotation *TypeAnnotation
+	DocString      string
+	Range
+}
+
+func (*TypeAliasDeclaration) isDeclaration() {}
+
+func (d *TypeAliasDeclaration) Accept(visitor Visitor) Repr {
+	return visitor.VisitTypeAliasDeclaration(d)
+}
+
+func (*TypeAliasDeclaration) Walk(_ func(Element)) {
+	// TODO: walk type
+}
+
+func (d *TypeAliasDeclaration) DeclarationIdentifier() *Identifier {
+	return &d.Identifier
+}
+
+func (d *TypeAliasDeclaration) DeclarationKind() common.DeclarationKind {
+	return common.DeclarationKindTypeAlias
+}
+
+func (d *TypeAliasDeclaration) DeclarationAccess() Access {
+	return d.Access
+}
+
+func (d *TypeAliasDeclaration) DeclarationMembers() *Members {
+	return nil
+}
+
+func (d *TypeAliasDeclaration) DeclarationDocString() string {
+	return d.DocString
+}
+
+var typeAliasKeywordDoc prettier.Doc = prettier.Text("typealias")
+var typeAliasEqualDoc prettier.Doc = prettier.Text(" = ")
+
+func (d *TypeAliasDeclaration) Doc() prettier.Doc {
+	doc := prettier.Concat{
+		accessDoc(d.Access),
+		typeAliasKeywordDoc,
+		prettier.Space,
+		prettier.Text(d.Identifier.Identifier),
+		typeAliasEqualDoc,
+		prettier.Group{
+			Doc: d.TypeAnnotation.Doc(),
+		},
+	}
+
+	if d.DocString == "" {
+		return doc
+	}
+
+	return prettier.Concat{
+		DocStringDoc(d.DocString),
+		doc,
+	}
+}
+
+func (d *TypeAliasDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias TypeAliasDeclaration
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "TypeAliasDeclaration",
+		Alias: (*Alias)(d),
+	})
+}
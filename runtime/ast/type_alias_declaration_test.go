@@ -0,0 +1,132 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turbolent/prettier"
+)
+
+func TestTypeAliasDeclaration_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &TypeAliasDeclaration{
+		Access: AccessPublic,
+		Identifier: Identifier{
+			Identifier: "foo",
+			Pos:        Position{Offset: 1, Line: 2, Column: 3},
+		},
+		TypeAnnotation: &TypeAnnotation{
+			IsResource: true,
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "AB",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+			StartPos: Position{Offset: 7, Line: 8, Column: 9},
+		},
+		DocString: "test",
+		Range: Range{
+			StartPos: Position{Offset: 10, Line: 11, Column: 12},
+			EndPos:   Position{Offset: 13, Line: 14, Column: 15},
+		},
+	}
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "TypeAliasDeclaration",
+            "Access": "AccessPublic",
+            "Identifier": {
+                "Identifier": "foo",
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 3, "Line": 2, "Column": 5}
+            },
+            "TypeAnnotation": {
+                "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                "EndPos": {"Offset": 5, "Line": 5, "Column": 7},
+                "IsResource": true,
+                "AnnotatedType": {
+                    "Type": "NominalType",
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 5, "Line": 5, "Column": 7},
+                    "Identifier": {
+                        "Identifier": "AB",
+                        "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                        "EndPos": {"Offset": 5, "Line": 5, "Column": 7}
+                    }
+                }
+            },
+            "DocString": "test",
+            "StartPos": {"Offset": 10, "Line": 11, "Column": 12},
+            "EndPos": {"Offset": 13, "Line": 14, "Column": 15}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestTypeAliasDeclaration_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &TypeAliasDeclaration{
+		Access: AccessPublic,
+		Identifier: Identifier{
+			Identifier: "Token",
+		},
+		TypeAnnotation: &TypeAnnotation{
+			IsResource: true,
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "R",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Concat{
+				prettier.Text("pub"),
+				prettier.Space,
+			},
+			prettier.Text("typealias"),
+			prettier.Space,
+			prettier.Text("Token"),
+			prettier.Text(" = "),
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.Text("@"),
+					prettier.Text("R"),
+				},
+			},
+		},
+		decl.Doc(),
+	)
+}
@@ -101,31 +101,55 @@ func (d *VariableDeclaration) Doc() prettier.Doc {
 		keywordDoc = letKeywordDoc
 	}
 
-	// TODO: second transfer and value (if any)
+	identifierTypeDoc := prettier.Concat{
+		prettier.Text(d.Identifier.Identifier),
+	}
+
+	if d.TypeAnnotation != nil {
+		identifierTypeDoc = append(
+			identifierTypeDoc,
+			typeSeparatorDoc,
+			d.TypeAnnotation.Doc(),
+		)
+	}
 
-	// TODO: potentially parenthesize
-	valueDoc := d.Value.Doc()
+	valueDoc := prettier.Concat{
+		identifierTypeDoc,
+		prettier.Space,
+		d.Transfer.Doc(),
+		prettier.Space,
+		transferredValueDoc(d.Value),
+	}
+
+	if d.SecondTransfer != nil && d.SecondValue != nil {
+		valueDoc = append(
+			valueDoc,
+			prettier.Space,
+			d.SecondTransfer.Doc(),
+			prettier.Space,
+			transferredValueDoc(d.SecondValue),
+		)
+	}
 
-	return prettier.Group{
+	doc := prettier.Group{
 		Doc: prettier.Concat{
+			accessDoc(d.Access),
 			keywordDoc,
 			prettier.Space,
 			prettier.Group{
-				Doc: prettier.Concat{
-					prettier.Text(d.Identifier.Identifier),
-					prettier.Space,
-					// TODO: type annotation, if any
-					d.Transfer.Doc(),
-					prettier.Space,
-					prettier.Group{
-						Doc: prettier.Indent{
-							Doc: valueDoc,
-						},
-					},
-				},
+				Doc: valueDoc,
 			},
 		},
 	}
+
+	if d.DocString == "" {
+		return doc
+	}
+
+	return prettier.Concat{
+		DocStringDoc(d.DocString),
+		doc,
+	}
 }
 
 func (d *VariableDeclaration) MarshalJSON() ([]byte, error) {
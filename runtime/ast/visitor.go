@@ -55,6 +55,7 @@ type StatementVisitor interface {
 	VisitForStatement(*ForStatement) Repr
 	VisitEmitStatement(*EmitStatement) Repr
 	VisitVariableDeclaration(*VariableDeclaration) Repr
+	VisitDestructuringDeclaration(*DestructuringDeclaration) Repr
 	VisitAssignmentStatement(*AssignmentStatement) Repr
 	VisitSwapStatement(*SwapStatement) Repr
 	VisitExpressionStatement(*ExpressionStatement) Repr
@@ -98,4 +99,5 @@ type Visitor interface {
 	VisitPragmaDeclaration(*PragmaDeclaration) Repr
 	VisitImportDeclaration(*ImportDeclaration) Repr
 	VisitTransactionDeclaration(*TransactionDeclaration) Repr
+	VisitTypeAliasDeclaration(*TypeAliasDeclaration) Repr
 }
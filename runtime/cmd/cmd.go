@@ -90,7 +90,22 @@ func PrepareChecker(
 	memberAccountAccess map[common.LocationID]map[common.LocationID]struct{},
 	must func(error),
 ) (*sema.Checker, func(error)) {
-	checker, err := sema.NewChecker(
+	checker, err := NewChecker(program, location, codes, memberAccountAccess)
+	must(err)
+
+	return checker, must
+}
+
+// NewChecker returns a checker for the given program.
+// Imported files are parsed and checked when they are imported
+//
+func NewChecker(
+	program *ast.Program,
+	location common.Location,
+	codes map[common.LocationID]string,
+	memberAccountAccess map[common.LocationID]map[common.LocationID]struct{},
+) (*sema.Checker, error) {
+	return sema.NewChecker(
 		program,
 		location,
 		sema.WithPredeclaredValues(valueDeclarations.ToSemaValueDeclarations()),
@@ -111,9 +126,21 @@ func PrepareChecker(
 
 				importedChecker, ok := checkers[importedLocation.ID()]
 				if !ok {
-					importedProgram, _ := PrepareProgramFromFile(stringLocation, codes)
-					importedChecker, _ = PrepareChecker(importedProgram, importedLocation, codes, nil, must)
-					must(importedChecker.Check())
+					importedProgram, err := ParseProgramFile(stringLocation, codes)
+					if err != nil {
+						return nil, err
+					}
+
+					importedChecker, err = NewChecker(importedProgram, importedLocation, codes, nil)
+					if err != nil {
+						return nil, err
+					}
+
+					err = importedChecker.Check()
+					if err != nil {
+						return nil, err
+					}
+
 					checkers[importedLocation.ID()] = importedChecker
 				}
 
@@ -137,12 +164,27 @@ func PrepareChecker(
 			return ok
 		}),
 	)
-	must(err)
+}
 
-	return checker, must
+// ParseProgramFile parses the program in the file with the given location
+//
+func ParseProgramFile(location common.StringLocation, codes map[common.LocationID]string) (*ast.Program, error) {
+	codeBytes, err := ioutil.ReadFile(string(location))
+	if err != nil {
+		return nil, err
+	}
+
+	code := string(codeBytes)
+	codes[location.ID()] = code
+
+	return parser2.ParseProgram(code)
 }
 
-func PrepareInterpreter(filename string, debugger *interpreter.Debugger) (*interpreter.Interpreter, *sema.Checker, func(error)) {
+func PrepareInterpreter(
+	filename string,
+	debugger *interpreter.Debugger,
+	options ...interpreter.Option,
+) (*interpreter.Interpreter, *sema.Checker, func(error)) {
 
 	codes := map[common.LocationID]string{}
 
@@ -154,26 +196,64 @@ func PrepareInterpreter(filename string, debugger *interpreter.Debugger) (*inter
 
 	must(checker.Check())
 
+	inter, err := NewInterpreter(checker, debugger, options...)
+	must(err)
+
+	must(inter.Interpret())
+
+	return inter, checker, must
+}
+
+// NewInterpreter returns an interpreter for the program of the given checker,
+// which uses in-memory storage.
+// Imported files are interpreted when they are imported.
+// The given options are applied after the default options
+//
+func NewInterpreter(
+	checker *sema.Checker,
+	debugger *interpreter.Debugger,
+	options ...interpreter.Option,
+) (*interpreter.Interpreter, error) {
+
 	var uuid uint64
 
 	storage := interpreter.NewInMemoryStorage()
 
-	inter, err := interpreter.NewInterpreter(
-		interpreter.ProgramFromChecker(checker),
-		checker.Location,
+	defaultOptions := []interpreter.Option{
 		interpreter.WithStorage(storage),
 		interpreter.WithPredeclaredValues(valueDeclarations.ToInterpreterValueDeclarations()),
 		interpreter.WithUUIDHandler(func() (uint64, error) {
 			defer func() { uuid++ }()
 			return uuid, nil
 		}),
-		interpreter.WithDebugger(debugger),
-	)
-	must(err)
+		interpreter.WithImportLocationHandler(
+			func(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
+				importedChecker, ok := checkers[location.ID()]
+				if !ok {
+					panic(fmt.Errorf("cannot import `%s`: program was not checked", location))
+				}
 
-	must(inter.Interpret())
+				subInterpreter, err := inter.NewSubInterpreter(
+					interpreter.ProgramFromChecker(importedChecker),
+					location,
+				)
+				if err != nil {
+					panic(err)
+				}
 
-	return inter, checker, must
+				return interpreter.InterpreterImport{
+					Interpreter: subInterpreter,
+				}
+			},
+		),
+		interpreter.WithDebugger(debugger),
+	}
+
+	return interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		append(defaultOptions, options...)...,
+	)
 }
 
 func ExitWithError(message string) {
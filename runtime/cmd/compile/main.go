@@ -19,24 +19,29 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"os"
 
-	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/cmd"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/compiler"
-	"github.com/onflow/cadence/runtime/compiler/ir"
 	"github.com/onflow/cadence/runtime/compiler/wasm"
+	"github.com/onflow/cadence/vm"
 )
 
+var runFlag = flag.String("run", "", "execute the function with the given name and print the result")
+
 func main() {
-	args := os.Args
+	flag.Parse()
+
+	args := flag.Args()
 
-	if len(args) < 2 {
+	if len(args) < 1 {
 		cmd.ExitWithError("no input file")
 	}
 
-	path := args[1]
+	path := args[0]
 
 	location := common.StringLocation(path)
 
@@ -48,48 +53,48 @@ func main() {
 
 	must(checker.Check())
 
-	// Compile all functions
-
-	comp := compiler.NewCompiler(checker)
-
-	functionDeclarations := checker.Program.FunctionDeclarations()
-
-	funcs := make([]*ir.Func, len(functionDeclarations))
+	// Compile all functions and composites
 
-	for i, functionDeclaration := range functionDeclarations {
-		funcs[i] = functionDeclaration.Accept(comp).(*ir.Func)
+	funcs, err := compiler.Compile(checker)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
 	}
 
-	// Generate a WebAssembly module for the functions
+	// Generate a WebAssembly module for the functions.
+	// All functions are exported
 
 	module := compiler.GenerateWasm(funcs)
 
-	// Export all public functions
-
-	for i, functionDeclaration := range functionDeclarations {
-		if functionDeclaration.Access != ast.AccessPublic {
-			continue
-		}
-
-		module.Exports = append(module.Exports,
-			&wasm.Export{
-				Name: functionDeclaration.Identifier.Identifier,
-				Descriptor: wasm.FunctionExport{
-					FunctionIndex: uint32(i),
-				},
-			},
-		)
-	}
-
 	// Generate WASM binary
 
 	var buf wasm.Buffer
 	w := wasm.NewWASMWriter(&buf)
-	err := w.WriteModule(module)
+	err = w.WriteModule(module)
 	if err != nil {
 		panic(nil)
 	}
 
+	// Execute the given function, if any,
+	// instead of writing the binary
+
+	if *runFlag != "" {
+		machine, err := vm.NewVM(buf.Bytes(), location)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+
+		result, err := machine.Invoke(*runFlag)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+
+		if result != nil {
+			fmt.Println(result)
+		}
+
+		return
+	}
+
 	// Write WASM binary to stdout
 
 	_, err = os.Stdout.Write(buf.Bytes())
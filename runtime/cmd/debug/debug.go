@@ -0,0 +1,49 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+import (
+	"flag"
+	"os"
+
+	"github.com/onflow/cadence/runtime/cmd"
+)
+
+// Debug starts a debugger.
+//
+// Currently only the Debug Adapter Protocol is supported (-dap),
+// which is spoken over the standard input and output.
+// The program to debug is provided by the client in the launch request.
+//
+func Debug(args []string) {
+	flags := flag.NewFlagSet("debug", flag.ExitOnError)
+	dapFlag := flags.Bool("dap", false, "serve the Debug Adapter Protocol over the standard input and output")
+
+	// ExitOnError: errors are reported and the process exits
+	_ = flags.Parse(args)
+
+	if !*dapFlag {
+		cmd.ExitWithError("only the Debug Adapter Protocol is supported, use -dap")
+	}
+
+	err := NewServer(os.Stdin, os.Stdout).Run()
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+}
@@ -0,0 +1,220 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+)
+
+// The subset of the Debug Adapter Protocol which is supported by the server.
+// See https://microsoft.github.io/debug-adapter-protocol/specification
+
+const (
+	messageTypeRequest  = "request"
+	messageTypeResponse = "response"
+	messageTypeEvent    = "event"
+)
+
+const contentLengthHeader = "Content-Length"
+
+type request struct {
+	Seq       int             `json:"seq"`
+	Type      string          `json:"type"`
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+type response struct {
+	Seq        int         `json:"seq"`
+	Type       string      `json:"type"`
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+type event struct {
+	Seq   int         `json:"seq"`
+	Type  string      `json:"type"`
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+type capabilities struct {
+	SupportsConfigurationDoneRequest  bool `json:"supportsConfigurationDoneRequest"`
+	SupportsConditionalBreakpoints    bool `json:"supportsConditionalBreakpoints"`
+	SupportsHitConditionalBreakpoints bool `json:"supportsHitConditionalBreakpoints"`
+	SupportsEvaluateForHovers         bool `json:"supportsEvaluateForHovers"`
+}
+
+type launchArguments struct {
+	Program     string `json:"program"`
+	StopOnEntry bool   `json:"stopOnEntry"`
+}
+
+type source struct {
+	Name string `json:"name,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+type sourceBreakpoint struct {
+	Line         int    `json:"line"`
+	Condition    string `json:"condition,omitempty"`
+	HitCondition string `json:"hitCondition,omitempty"`
+}
+
+type setBreakpointsArguments struct {
+	Source      source             `json:"source"`
+	Breakpoints []sourceBreakpoint `json:"breakpoints"`
+}
+
+type breakpoint struct {
+	Verified bool   `json:"verified"`
+	Message  string `json:"message,omitempty"`
+	Line     int    `json:"line"`
+}
+
+type setBreakpointsResponseBody struct {
+	Breakpoints []breakpoint `json:"breakpoints"`
+}
+
+type thread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type threadsResponseBody struct {
+	Threads []thread `json:"threads"`
+}
+
+type stackFrame struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Source *source `json:"source,omitempty"`
+	Line   int     `json:"line"`
+	Column int     `json:"column"`
+}
+
+type stackTraceResponseBody struct {
+	StackFrames []stackFrame `json:"stackFrames"`
+	TotalFrames int          `json:"totalFrames"`
+}
+
+type scopesArguments struct {
+	FrameID int `json:"frameId"`
+}
+
+type scope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+type scopesResponseBody struct {
+	Scopes []scope `json:"scopes"`
+}
+
+type variablesArguments struct {
+	VariablesReference int `json:"variablesReference"`
+}
+
+type variable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+type variablesResponseBody struct {
+	Variables []variable `json:"variables"`
+}
+
+type evaluateArguments struct {
+	Expression string `json:"expression"`
+	FrameID    int    `json:"frameId"`
+}
+
+type evaluateResponseBody struct {
+	Result             string `json:"result"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+type continueResponseBody struct {
+	AllThreadsContinued bool `json:"allThreadsContinued"`
+}
+
+type stoppedEventBody struct {
+	Reason            string `json:"reason"`
+	ThreadID          int    `json:"threadId"`
+	AllThreadsStopped bool   `json:"allThreadsStopped"`
+}
+
+type outputEventBody struct {
+	Category string `json:"category"`
+	Output   string `json:"output"`
+}
+
+type exitedEventBody struct {
+	ExitCode int `json:"exitCode"`
+}
+
+// readMessage reads a message, which consists of a header part,
+// which must contain the length of the content, and the JSON content
+//
+func readMessage(reader *bufio.Reader) ([]byte, error) {
+	header, err := textproto.NewReader(reader).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	contentLength, err := strconv.Atoi(header.Get(contentLengthHeader))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s header: %w", contentLengthHeader, err)
+	}
+
+	content := make([]byte, contentLength)
+	_, err = io.ReadFull(reader, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func writeMessage(writer io.Writer, message interface{}) error {
+	content, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "%s: %d\r\n\r\n", contentLengthHeader, len(content))
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(content)
+	return err
+}
@@ -0,0 +1,786 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/pretty"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+// threadID is the ID of the only thread, the interpreter
+//
+const threadID = 1
+
+const entryPointFunctionName = "main"
+
+const (
+	stopReasonEntry      = "entry"
+	stopReasonStep       = "step"
+	stopReasonBreakpoint = "breakpoint"
+	stopReasonPause      = "pause"
+)
+
+// Server is a Debug Adapter Protocol server for the interpreter.
+//
+// It launches a program, a script with an optional `main` function,
+// and supports line breakpoints with conditions and hit counts, stepping, and inspecting the call stack and variables.
+//
+type Server struct {
+	reader *bufio.Reader
+
+	writeMutex sync.Mutex
+	writer     io.Writer
+	seq        int
+
+	debugger    *interpreter.Debugger
+	inter       *interpreter.Interpreter
+	codes       map[common.LocationID]string
+	stopOnEntry bool
+	executing   bool
+
+	// mutex protects the state which is shared with the execution
+	mutex      sync.Mutex
+	stopReason string
+	callStack  []interpreter.StackFrame
+	stopped    bool
+
+	// variables are the containers of variables, referenced by index + 1.
+	// The references are only valid while the execution is stopped
+	variables []func() []variable
+}
+
+func NewServer(reader io.Reader, writer io.Writer) *Server {
+	return &Server{
+		reader:   bufio.NewReader(reader),
+		writer:   writer,
+		debugger: interpreter.NewDebugger(),
+		codes:    map[common.LocationID]string{},
+	}
+}
+
+// Run handles requests until the client disconnects
+//
+func (s *Server) Run() error {
+	for {
+		content, err := readMessage(s.reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var req request
+		err = json.Unmarshal(content, &req)
+		if err != nil {
+			return err
+		}
+
+		if req.Type != messageTypeRequest {
+			continue
+		}
+
+		body, err := s.handle(req)
+		if err != nil {
+			s.respondError(req, err)
+		} else {
+			s.respond(req, body)
+		}
+
+		switch req.Command {
+		case "initialize":
+			if err == nil {
+				s.sendEvent("initialized", nil)
+			}
+
+		case "configurationDone":
+			if err == nil {
+				s.execute()
+			}
+
+		case "disconnect":
+			return nil
+		}
+	}
+}
+
+func (s *Server) handle(req request) (interface{}, error) {
+	switch req.Command {
+	case "initialize":
+		return capabilities{
+			SupportsConfigurationDoneRequest:  true,
+			SupportsConditionalBreakpoints:    true,
+			SupportsHitConditionalBreakpoints: true,
+			SupportsEvaluateForHovers:         true,
+		}, nil
+
+	case "launch":
+		var arguments launchArguments
+		err := json.Unmarshal(req.Arguments, &arguments)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.launch(arguments)
+
+	case "setBreakpoints":
+		var arguments setBreakpointsArguments
+		err := json.Unmarshal(req.Arguments, &arguments)
+		if err != nil {
+			return nil, err
+		}
+		return s.setBreakpoints(arguments), nil
+
+	case "configurationDone", "disconnect":
+		return nil, nil
+
+	case "threads":
+		return threadsResponseBody{
+			Threads: []thread{
+				{
+					ID:   threadID,
+					Name: entryPointFunctionName,
+				},
+			},
+		}, nil
+
+	case "stackTrace":
+		return s.stackTrace(), nil
+
+	case "scopes":
+		var arguments scopesArguments
+		err := json.Unmarshal(req.Arguments, &arguments)
+		if err != nil {
+			return nil, err
+		}
+		return s.scopes(arguments)
+
+	case "variables":
+		var arguments variablesArguments
+		err := json.Unmarshal(req.Arguments, &arguments)
+		if err != nil {
+			return nil, err
+		}
+		return s.childVariables(arguments)
+
+	case "evaluate":
+		var arguments evaluateArguments
+		err := json.Unmarshal(req.Arguments, &arguments)
+		if err != nil {
+			return nil, err
+		}
+		return s.evaluate(arguments)
+
+	case "continue":
+		return continueResponseBody{AllThreadsContinued: true}, s.continueExecution()
+
+	case "next":
+		return nil, s.step(interpreter.StepModeOver)
+
+	case "stepIn":
+		return nil, s.step(interpreter.StepModeInto)
+
+	case "stepOut":
+		return nil, s.step(interpreter.StepModeOut)
+
+	case "pause":
+		s.setStopReason(stopReasonPause)
+		s.debugger.RequestPause()
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported request: %s", req.Command)
+	}
+}
+
+// launch parses and checks the program, and prepares the interpreter.
+// The program is executed once the configuration is done
+//
+func (s *Server) launch(arguments launchArguments) error {
+	if arguments.Program == "" {
+		return fmt.Errorf("missing program")
+	}
+
+	location := locationForPath(arguments.Program)
+
+	program, err := cmd.ParseProgramFile(location, s.codes)
+	if err != nil {
+		return s.prettyError(err, location)
+	}
+
+	checker, err := cmd.NewChecker(program, location, s.codes, nil)
+	if err == nil {
+		err = checker.Check()
+	}
+	if err != nil {
+		return s.prettyError(err, location)
+	}
+
+	impls := stdlib.DefaultFlowBuiltinImpls()
+	impls.Log = func(invocation interpreter.Invocation) interpreter.Value {
+		s.sendOutput("stdout", invocation.Arguments[0].String()+"\n")
+		return interpreter.VoidValue{}
+	}
+
+	valueDeclarations := append(
+		stdlib.FlowBuiltInFunctions(impls),
+		stdlib.BuiltinFunctions...,
+	)
+
+	s.inter, err = cmd.NewInterpreter(
+		checker,
+		s.debugger,
+		interpreter.WithPredeclaredValues(valueDeclarations.ToInterpreterValueDeclarations()),
+	)
+	if err != nil {
+		return err
+	}
+
+	s.stopOnEntry = arguments.StopOnEntry
+
+	return nil
+}
+
+// execute interprets the program and invokes the `main` function, if any.
+// Events are sent when the execution stops, and when it finished
+//
+func (s *Server) execute() {
+	if s.inter == nil || s.executing {
+		return
+	}
+	s.executing = true
+
+	if s.stopOnEntry {
+		s.setStopReason(stopReasonEntry)
+		s.debugger.RequestPause()
+	}
+
+	finished := make(chan error, 1)
+
+	go func() {
+		err := s.inter.Interpret()
+		if err == nil && s.inter.Globals.Contains(entryPointFunctionName) {
+			_, err = s.inter.Invoke(entryPointFunctionName)
+		}
+		finished <- err
+	}()
+
+	go func() {
+		for {
+			select {
+			case stop := <-s.debugger.Stops():
+				s.onStop(stop)
+
+			case err := <-finished:
+				exitCode := 0
+				if err != nil {
+					exitCode = 1
+					s.sendOutput("stderr", s.prettyError(err, s.inter.Location).Error())
+				}
+
+				s.sendEvent("exited", exitedEventBody{ExitCode: exitCode})
+				s.sendEvent("terminated", nil)
+				return
+			}
+		}
+	}()
+}
+
+func (s *Server) onStop(stop interpreter.Stop) {
+	s.mutex.Lock()
+
+	reason := s.stopReason
+	if stop.Breakpoint != nil && reason != stopReasonPause {
+		reason = stopReasonBreakpoint
+	}
+	s.stopReason = ""
+
+	s.callStack = s.debugger.CallStack()
+	s.stopped = true
+
+	s.mutex.Unlock()
+
+	if reason == "" {
+		reason = stopReasonStep
+	}
+
+	s.sendEvent(
+		"stopped",
+		stoppedEventBody{
+			Reason:            reason,
+			ThreadID:          threadID,
+			AllThreadsStopped: true,
+		},
+	)
+}
+
+func (s *Server) setStopReason(reason string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stopReason = reason
+}
+
+// stoppedCallStack returns the call stack of the current stop,
+// or an error if the execution is not stopped
+//
+func (s *Server) stoppedCallStack() ([]interpreter.StackFrame, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.stopped {
+		return nil, fmt.Errorf("execution is not stopped")
+	}
+
+	return s.callStack, nil
+}
+
+// resume continues the execution, stepping according to the given mode, if any
+//
+func (s *Server) resume(reason string) error {
+	s.mutex.Lock()
+	if !s.stopped {
+		s.mutex.Unlock()
+		return fmt.Errorf("execution is not stopped")
+	}
+	s.stopped = false
+	s.callStack = nil
+	s.stopReason = reason
+	s.mutex.Unlock()
+
+	s.variables = nil
+
+	return nil
+}
+
+func (s *Server) continueExecution() error {
+	err := s.resume("")
+	if err != nil {
+		return err
+	}
+
+	s.debugger.Continue()
+	return nil
+}
+
+func (s *Server) step(mode interpreter.StepMode) error {
+	err := s.resume(stopReasonStep)
+	if err != nil {
+		return err
+	}
+
+	s.debugger.Step(mode)
+	return nil
+}
+
+func (s *Server) setBreakpoints(arguments setBreakpointsArguments) setBreakpointsResponseBody {
+	location := locationForPath(arguments.Source.Path)
+
+	s.debugger.ClearBreakpoints(location)
+
+	breakpoints := make([]breakpoint, 0, len(arguments.Breakpoints))
+
+	for _, sourceBreakpoint := range arguments.Breakpoints {
+
+		result := breakpoint{
+			Verified: true,
+			Line:     sourceBreakpoint.Line,
+		}
+
+		debuggerBreakpoint := &interpreter.Breakpoint{
+			Location: location,
+			Line:     sourceBreakpoint.Line,
+		}
+
+		if strings.TrimSpace(sourceBreakpoint.Condition) != "" {
+			condition, errs := parser2.ParseExpression(sourceBreakpoint.Condition)
+			if len(errs) > 0 {
+				result.Verified = false
+				result.Message = fmt.Sprintf("invalid condition: %s", errs[0])
+				breakpoints = append(breakpoints, result)
+				continue
+			}
+			debuggerBreakpoint.Condition = condition
+		}
+
+		if strings.TrimSpace(sourceBreakpoint.HitCondition) != "" {
+			hitCount, err := strconv.Atoi(strings.TrimSpace(sourceBreakpoint.HitCondition))
+			if err != nil || hitCount < 0 {
+				result.Verified = false
+				result.Message = "invalid hit count: expected a non-negative integer"
+				breakpoints = append(breakpoints, result)
+				continue
+			}
+			debuggerBreakpoint.HitCount = hitCount
+		}
+
+		s.debugger.AddBreakpoint(debuggerBreakpoint)
+
+		breakpoints = append(breakpoints, result)
+	}
+
+	return setBreakpointsResponseBody{
+		Breakpoints: breakpoints,
+	}
+}
+
+func (s *Server) stackTrace() stackTraceResponseBody {
+	callStack, _ := s.stoppedCallStack()
+
+	stackFrames := make([]stackFrame, 0, len(callStack))
+
+	for i, frame := range callStack {
+
+		name := frame.FunctionName
+		if name == "" {
+			name = entryPointFunctionName
+		}
+
+		result := stackFrame{
+			ID:   i + 1,
+			Name: name,
+		}
+
+		if frame.Location != nil {
+			result.Source = sourceForLocation(frame.Location)
+			result.Line = frame.Position.Line
+			result.Column = frame.Position.Column + 1
+		}
+
+		stackFrames = append(stackFrames, result)
+	}
+
+	return stackTraceResponseBody{
+		StackFrames: stackFrames,
+		TotalFrames: len(stackFrames),
+	}
+}
+
+func (s *Server) frame(frameID int) (interpreter.StackFrame, error) {
+	callStack, err := s.stoppedCallStack()
+	if err != nil {
+		return interpreter.StackFrame{}, err
+	}
+
+	index := frameID - 1
+	if index < 0 || index >= len(callStack) {
+		return interpreter.StackFrame{}, fmt.Errorf("unknown frame: %d", frameID)
+	}
+
+	return callStack[index], nil
+}
+
+func (s *Server) scopes(arguments scopesArguments) (scopesResponseBody, error) {
+	frame, err := s.frame(arguments.FrameID)
+	if err != nil {
+		return scopesResponseBody{}, err
+	}
+
+	var scopes []scope
+
+	if frame.Activation != nil {
+		activation := frame.Activation
+
+		scopes = append(scopes,
+			scope{
+				Name: "Locals",
+				VariablesReference: s.addVariables(func() []variable {
+					return s.namedVariables(activation.FunctionValues())
+				}),
+			},
+		)
+	}
+
+	if frame.Interpreter != nil {
+		globals := frame.Interpreter.Globals
+
+		scopes = append(scopes,
+			scope{
+				Name: "Globals",
+				VariablesReference: s.addVariables(func() []variable {
+					return s.namedVariables(globals)
+				}),
+			},
+		)
+	}
+
+	return scopesResponseBody{
+		Scopes: scopes,
+	}, nil
+}
+
+func (s *Server) childVariables(arguments variablesArguments) (variablesResponseBody, error) {
+	if _, err := s.stoppedCallStack(); err != nil {
+		return variablesResponseBody{}, err
+	}
+
+	index := arguments.VariablesReference - 1
+	if index < 0 || index >= len(s.variables) {
+		return variablesResponseBody{}, fmt.Errorf("unknown variables reference: %d", arguments.VariablesReference)
+	}
+
+	return variablesResponseBody{
+		Variables: s.variables[index](),
+	}, nil
+}
+
+func (s *Server) evaluate(arguments evaluateArguments) (evaluateResponseBody, error) {
+	frame, err := s.frame(arguments.FrameID)
+	if err != nil {
+		return evaluateResponseBody{}, err
+	}
+
+	expression, errs := parser2.ParseExpression(arguments.Expression)
+	if len(errs) > 0 {
+		return evaluateResponseBody{}, errs[0]
+	}
+
+	value, err := s.debugger.Evaluate(frame, expression)
+	if err != nil {
+		return evaluateResponseBody{}, s.prettyError(err, nil)
+	}
+
+	result := s.valueVariable("", value)
+
+	return evaluateResponseBody{
+		Result:             result.Value,
+		Type:               result.Type,
+		VariablesReference: result.VariablesReference,
+	}, nil
+}
+
+// addVariables adds a container of variables and returns the reference to it
+//
+func (s *Server) addVariables(variables func() []variable) int {
+	s.variables = append(s.variables, variables)
+	return len(s.variables)
+}
+
+func (s *Server) namedVariables(variables map[string]*interpreter.Variable) []variable {
+	names := make([]string, 0, len(variables))
+	for name := range variables { //nolint:maprangecheck
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]variable, 0, len(names))
+	for _, name := range names {
+		result = append(result,
+			s.valueVariable(name, variables[name].GetValue()),
+		)
+	}
+	return result
+}
+
+// valueVariable returns the variable for the given value.
+// Values which contain other values can be expanded
+//
+func (s *Server) valueVariable(name string, value interpreter.Value) variable {
+	result := variable{
+		Name:  name,
+		Value: value.String(),
+	}
+
+	if staticType := value.StaticType(); staticType != nil {
+		result.Type = staticType.String()
+	}
+
+	switch value := value.(type) {
+	case *interpreter.SomeValue:
+		inner := s.valueVariable(name, value.Value)
+		result.VariablesReference = inner.VariablesReference
+
+	case *interpreter.CompositeValue:
+		result.VariablesReference = s.addVariables(func() []variable {
+			fields := map[string]interpreter.Value{}
+			var names []string
+			value.ForEachField(func(fieldName string, fieldValue interpreter.Value) {
+				fields[fieldName] = fieldValue
+				names = append(names, fieldName)
+			})
+			sort.Strings(names)
+
+			children := make([]variable, 0, len(names))
+			for _, fieldName := range names {
+				children = append(children, s.valueVariable(fieldName, fields[fieldName]))
+			}
+			return children
+		})
+
+	case *interpreter.ArrayValue:
+		result.VariablesReference = s.addVariables(func() []variable {
+			children := make([]variable, 0, value.Count())
+			value.Iterate(func(element interpreter.Value) bool {
+				elementName := fmt.Sprintf("[%d]", len(children))
+				children = append(children, s.valueVariable(elementName, element))
+				return true
+			})
+			return children
+		})
+
+	case *interpreter.DictionaryValue:
+		result.VariablesReference = s.addVariables(func() []variable {
+			children := make([]variable, 0, value.Count())
+			value.Iterate(func(key, element interpreter.Value) bool {
+				elementName := fmt.Sprintf("[%s]", key)
+				children = append(children, s.valueVariable(elementName, element))
+				return true
+			})
+			return children
+		})
+
+	case *interpreter.SetValue:
+		result.VariablesReference = s.addVariables(func() []variable {
+			children := make([]variable, 0, value.Count())
+			value.Iterate(func(element interpreter.Value) bool {
+				elementName := fmt.Sprintf("[%d]", len(children))
+				children = append(children, s.valueVariable(elementName, element))
+				return true
+			})
+			return children
+		})
+	}
+
+	return result
+}
+
+func (s *Server) prettyError(err error, location common.Location) error {
+	var builder strings.Builder
+	printErr := pretty.NewErrorPrettyPrinter(&builder, false).
+		PrettyPrintError(err, location, s.codes)
+	if printErr != nil {
+		return err
+	}
+	return fmt.Errorf("%s", builder.String())
+}
+
+func (s *Server) respond(req request, body interface{}) {
+	s.send(&response{
+		Type:       messageTypeResponse,
+		RequestSeq: req.Seq,
+		Success:    true,
+		Command:    req.Command,
+		Body:       body,
+	})
+}
+
+func (s *Server) respondError(req request, err error) {
+	s.send(&response{
+		Type:       messageTypeResponse,
+		RequestSeq: req.Seq,
+		Success:    false,
+		Command:    req.Command,
+		Message:    err.Error(),
+	})
+}
+
+func (s *Server) sendEvent(name string, body interface{}) {
+	s.send(&event{
+		Type:  messageTypeEvent,
+		Event: name,
+		Body:  body,
+	})
+}
+
+func (s *Server) sendOutput(category string, output string) {
+	s.sendEvent(
+		"output",
+		outputEventBody{
+			Category: category,
+			Output:   output,
+		},
+	)
+}
+
+// send assigns the next sequence number to the given response or event, and writes it
+//
+func (s *Server) send(message interface{}) {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.seq++
+
+	switch message := message.(type) {
+	case *response:
+		message.Seq = s.seq
+	case *event:
+		message.Seq = s.seq
+	}
+
+	err := writeMessage(s.writer, message)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write message: %s\n", err)
+	}
+}
+
+// locationForPath returns the location for the file with the given path.
+// Paths inside of the working directory are relative,
+// so they match the locations of imports, which are relative to the working directory
+//
+func locationForPath(path string) common.StringLocation {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return common.StringLocation(path)
+	}
+
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		return common.StringLocation(absolutePath)
+	}
+
+	relativePath, err := filepath.Rel(workingDirectory, absolutePath)
+	if err != nil || strings.HasPrefix(relativePath, "..") {
+		return common.StringLocation(absolutePath)
+	}
+
+	return common.StringLocation(relativePath)
+}
+
+func sourceForLocation(location common.Location) *source {
+	stringLocation, ok := location.(common.StringLocation)
+	if !ok {
+		return &source{
+			Name: location.String(),
+		}
+	}
+
+	path, err := filepath.Abs(string(stringLocation))
+	if err != nil {
+		path = string(stringLocation)
+	}
+
+	return &source{
+		Name: filepath.Base(path),
+		Path: path,
+	}
+}
@@ -0,0 +1,299 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProgram = `
+pub fun add(_ a: Int, _ b: Int): Int {
+    let sum = a + b
+    return sum
+}
+
+pub fun main() {
+    let values = [1, 2]
+    let result = add(values[0], values[1])
+    log(result)
+}
+`
+
+type message struct {
+	Seq        int             `json:"seq"`
+	Type       string          `json:"type"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Command    string          `json:"command"`
+	Message    string          `json:"message"`
+	Event      string          `json:"event"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type testClient struct {
+	t      *testing.T
+	writer io.Writer
+	reader *bufio.Reader
+	seq    int
+	events []message
+}
+
+func newTestClient(t *testing.T) *testClient {
+	requestReader, requestWriter := io.Pipe()
+	responseReader, responseWriter := io.Pipe()
+
+	server := NewServer(requestReader, responseWriter)
+
+	go func() {
+		err := server.Run()
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	return &testClient{
+		t:      t,
+		writer: requestWriter,
+		reader: bufio.NewReader(responseReader),
+	}
+}
+
+func (c *testClient) read() message {
+	content, err := readMessage(c.reader)
+	require.NoError(c.t, err)
+
+	var result message
+	err = json.Unmarshal(content, &result)
+	require.NoError(c.t, err)
+
+	return result
+}
+
+// request sends a request and returns its response.
+// Events received before the response are queued
+//
+func (c *testClient) request(command string, arguments interface{}) message {
+	c.seq++
+
+	encodedArguments, err := json.Marshal(arguments)
+	require.NoError(c.t, err)
+
+	err = writeMessage(c.writer, request{
+		Seq:       c.seq,
+		Type:      messageTypeRequest,
+		Command:   command,
+		Arguments: encodedArguments,
+	})
+	require.NoError(c.t, err)
+
+	for {
+		result := c.read()
+		if result.Type == messageTypeEvent {
+			c.events = append(c.events, result)
+			continue
+		}
+
+		require.Equal(c.t, messageTypeResponse, result.Type)
+		require.Equal(c.t, c.seq, result.RequestSeq)
+		require.Equal(c.t, command, result.Command)
+		return result
+	}
+}
+
+// event returns the next event, skipping events with other names
+//
+func (c *testClient) event(name string) message {
+	for {
+		var result message
+		if len(c.events) > 0 {
+			result = c.events[0]
+			c.events = c.events[1:]
+		} else {
+			result = c.read()
+		}
+
+		if result.Type == messageTypeEvent && result.Event == name {
+			return result
+		}
+	}
+}
+
+func decodeBody(t *testing.T, result message, body interface{}) {
+	if result.Type == messageTypeResponse {
+		require.True(t, result.Success, result.Message)
+	}
+	err := json.Unmarshal(result.Body, body)
+	require.NoError(t, err)
+}
+
+func writeTestProgram(t *testing.T, code string) string {
+	path := filepath.Join(t.TempDir(), "test.cdc")
+	err := ioutil.WriteFile(path, []byte(code), 0644)
+	require.NoError(t, err)
+	return path
+}
+
+func TestServer(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeTestProgram(t, testProgram)
+
+	client := newTestClient(t)
+
+	var capabilities capabilities
+	decodeBody(t, client.request("initialize", nil), &capabilities)
+	assert.True(t, capabilities.SupportsConditionalBreakpoints)
+
+	client.event("initialized")
+
+	result := client.request("launch", launchArguments{Program: path})
+	require.True(t, result.Success, result.Message)
+
+	var breakpoints setBreakpointsResponseBody
+	decodeBody(t,
+		client.request("setBreakpoints", setBreakpointsArguments{
+			Source: source{Path: path},
+			Breakpoints: []sourceBreakpoint{
+				{Line: 4},
+				{Line: 9, Condition: "values["},
+				{Line: 10, HitCondition: "often"},
+			},
+		}),
+		&breakpoints,
+	)
+	require.Len(t, breakpoints.Breakpoints, 3)
+	assert.True(t, breakpoints.Breakpoints[0].Verified)
+	assert.False(t, breakpoints.Breakpoints[1].Verified)
+	assert.False(t, breakpoints.Breakpoints[2].Verified)
+
+	result = client.request("configurationDone", nil)
+	require.True(t, result.Success, result.Message)
+
+	var stopped stoppedEventBody
+	decodeBody(t, client.event("stopped"), &stopped)
+	assert.Equal(t, stopReasonBreakpoint, stopped.Reason)
+
+	var stackTrace stackTraceResponseBody
+	decodeBody(t, client.request("stackTrace", nil), &stackTrace)
+	require.Len(t, stackTrace.StackFrames, 2)
+
+	assert.Equal(t, "add", stackTrace.StackFrames[0].Name)
+	assert.Equal(t, 4, stackTrace.StackFrames[0].Line)
+	assert.Equal(t, path, stackTrace.StackFrames[0].Source.Path)
+
+	assert.Equal(t, "main", stackTrace.StackFrames[1].Name)
+	assert.Equal(t, 9, stackTrace.StackFrames[1].Line)
+
+	// Variables of the caller
+
+	var scopes scopesResponseBody
+	decodeBody(t,
+		client.request("scopes", scopesArguments{FrameID: 2}),
+		&scopes,
+	)
+	require.NotEmpty(t, scopes.Scopes)
+	assert.Equal(t, "Locals", scopes.Scopes[0].Name)
+
+	var variables variablesResponseBody
+	decodeBody(t,
+		client.request("variables", variablesArguments{
+			VariablesReference: scopes.Scopes[0].VariablesReference,
+		}),
+		&variables,
+	)
+	require.Len(t, variables.Variables, 1)
+	assert.Equal(t, "values", variables.Variables[0].Name)
+	assert.Equal(t, "[1, 2]", variables.Variables[0].Value)
+
+	decodeBody(t,
+		client.request("variables", variablesArguments{
+			VariablesReference: variables.Variables[0].VariablesReference,
+		}),
+		&variables,
+	)
+	assert.Equal(t,
+		[]variable{
+			{Name: "[0]", Value: "1", Type: "Int"},
+			{Name: "[1]", Value: "2", Type: "Int"},
+		},
+		variables.Variables,
+	)
+
+	// Expressions are evaluated in the frame
+
+	var evaluated evaluateResponseBody
+	decodeBody(t,
+		client.request("evaluate", evaluateArguments{
+			FrameID:    1,
+			Expression: "sum * 10",
+		}),
+		&evaluated,
+	)
+	assert.Equal(t, "30", evaluated.Result)
+
+	// Step out to the caller
+
+	result = client.request("stepOut", nil)
+	require.True(t, result.Success, result.Message)
+
+	decodeBody(t, client.event("stopped"), &stopped)
+	assert.Equal(t, stopReasonStep, stopped.Reason)
+
+	decodeBody(t, client.request("stackTrace", nil), &stackTrace)
+	require.Len(t, stackTrace.StackFrames, 1)
+	assert.Equal(t, 10, stackTrace.StackFrames[0].Line)
+
+	result = client.request("continue", nil)
+	require.True(t, result.Success, result.Message)
+
+	var output outputEventBody
+	decodeBody(t, client.event("output"), &output)
+	assert.Equal(t, outputEventBody{Category: "stdout", Output: "3\n"}, output)
+
+	var exited exitedEventBody
+	decodeBody(t, client.event("exited"), &exited)
+	assert.Equal(t, 0, exited.ExitCode)
+
+	client.event("terminated")
+
+	result = client.request("disconnect", nil)
+	require.True(t, result.Success, result.Message)
+}
+
+func TestServerLaunchInvalidProgram(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeTestProgram(t, `pub fun main() { let x: Int = "" }`)
+
+	client := newTestClient(t)
+
+	result := client.request("launch", launchArguments{Program: path})
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Message, "mismatched types")
+}
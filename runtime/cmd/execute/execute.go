@@ -19,26 +19,134 @@
 package execute
 
 import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/onflow/cadence/runtime"
 	"github.com/onflow/cadence/runtime/cmd"
 	"github.com/onflow/cadence/runtime/interpreter"
 )
 
+const (
+	profileFormatPprof     = "pprof"
+	profileFormatCollapsed = "collapsed"
+)
+
 // Execute parses the given filename and prints any syntax errors.
 // If there are no syntax errors, the program is interpreted.
 // If after the interpretation a global function `main` is defined, it will be called.
 // The program may call the function `log` to print a value.
+//
+// The execution can be profiled (-profile), and the profile is written to the given file,
+// either in the pprof format (default), or in the collapsed stack format, which can be rendered as a flame graph
+// (-profile-format). The collapsed stacks contain the values of one sample type (-profile-sample)
+//
 func Execute(args []string, debugger *interpreter.Debugger) {
+	flags := flag.NewFlagSet("execute", flag.ExitOnError)
+	profileFlag := flags.String("profile", "", "write a profile of the execution to the file")
+	profileFormatFlag := flags.String(
+		"profile-format",
+		profileFormatPprof,
+		fmt.Sprintf("format of the profile: %s or %s", profileFormatPprof, profileFormatCollapsed),
+	)
+	profileSampleFlag := flags.String(
+		"profile-sample",
+		runtime.ProfileSampleTypeComputation.String(),
+		fmt.Sprintf("sample type of the collapsed stacks: %s", strings.Join(profileSampleTypeNames(), ", ")),
+	)
+
+	// ExitOnError: errors are reported and the process exits
+	_ = flags.Parse(args)
+
+	args = flags.Args()
 
 	if len(args) < 1 {
 		cmd.ExitWithError("no input file")
 	}
 
-	inter, _, must := cmd.PrepareInterpreter(args[0], debugger)
+	var profiler *runtime.Profiler
+	var profileSampleType runtime.ProfileSampleType
+	var options []interpreter.Option
+
+	if *profileFlag != "" {
+		if *profileFormatFlag != profileFormatPprof &&
+			*profileFormatFlag != profileFormatCollapsed {
+
+			cmd.ExitWithError(fmt.Sprintf("invalid profile format: %s", *profileFormatFlag))
+		}
+
+		var ok bool
+		profileSampleType, ok = parseProfileSampleType(*profileSampleFlag)
+		if !ok {
+			cmd.ExitWithError(fmt.Sprintf("invalid profile sample type: %s", *profileSampleFlag))
+		}
+
+		profiler = runtime.NewProfiler()
+		options = profiler.InterpreterOptions()
+	}
+
+	inter, _, must := cmd.PrepareInterpreter(args[0], debugger, options...)
+
+	if inter.Globals.Contains("main") {
+		_, err := inter.Invoke("main")
+		must(err)
+	}
+
+	if profiler != nil {
+		err := writeProfileFile(*profileFlag, profiler, *profileFormatFlag, profileSampleType)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+	}
+}
+
+var profileSampleTypes = []runtime.ProfileSampleType{
+	runtime.ProfileSampleTypeStatements,
+	runtime.ProfileSampleTypeComputation,
+	runtime.ProfileSampleTypeWallTime,
+}
+
+func profileSampleTypeNames() []string {
+	names := make([]string, len(profileSampleTypes))
+	for i, sampleType := range profileSampleTypes {
+		names[i] = sampleType.String()
+	}
+	return names
+}
+
+func parseProfileSampleType(name string) (runtime.ProfileSampleType, bool) {
+	for _, sampleType := range profileSampleTypes {
+		if sampleType.String() == name {
+			return sampleType, true
+		}
+	}
+
+	return 0, false
+}
+
+func writeProfileFile(
+	path string,
+	profiler *runtime.Profiler,
+	format string,
+	sampleType runtime.ProfileSampleType,
+) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		closeErr := file.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
 
-	if !inter.Globals.Contains("main") {
-		return
+	if format == profileFormatCollapsed {
+		return profiler.WriteCollapsedStacks(file, sampleType)
 	}
 
-	_, err := inter.Invoke("main")
-	must(err)
+	return profiler.WritePprof(file)
 }
@@ -0,0 +1,132 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package formatter
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/format"
+)
+
+// Format formats the given files, or the standard input if no files are given.
+//
+// By default the formatted code is printed.
+// The flags allow writing the result back to the files (-w),
+// reporting files which are not formatted (-check),
+// and verifying that formatting is idempotent (-idempotent).
+//
+func Format(args []string) {
+	flags := flag.NewFlagSet("fmt", flag.ExitOnError)
+	writeFlag := flags.Bool("w", false, "write the result to the file instead of printing it")
+	checkFlag := flags.Bool("check", false, "report files which are not formatted and exit with a non-zero status")
+	idempotentFlag := flags.Bool("idempotent", false, "verify that formatting the formatted code does not change it")
+	widthFlag := flags.Int("width", format.DefaultLineWidth, "maximum line width")
+
+	// ExitOnError: errors are reported and the process exits
+	_ = flags.Parse(args)
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		if *writeFlag {
+			cmd.ExitWithError("cannot write the result without a file")
+		}
+		paths = []string{""}
+	}
+
+	options := []format.Option{
+		format.WithLineWidth(*widthFlag),
+	}
+
+	allSucceeded := true
+
+	for _, path := range paths {
+		code := read(path)
+		location := common.StringLocation(path)
+		codes := map[common.LocationID]string{}
+
+		formatted := formatCode(code, location, codes, options)
+
+		switch {
+		case *idempotentFlag:
+			reformatted := formatCode(formatted, location, codes, options)
+			if reformatted != formatted {
+				fmt.Fprintf(os.Stderr, "formatting is not idempotent: %s\n", path)
+				allSucceeded = false
+			}
+
+		case *checkFlag:
+			if formatted != code {
+				fmt.Println(path)
+				allSucceeded = false
+			}
+
+		case *writeFlag:
+			if formatted == code {
+				continue
+			}
+			err := ioutil.WriteFile(path, []byte(formatted), 0644)
+			if err != nil {
+				cmd.ExitWithError(err.Error())
+			}
+
+		default:
+			fmt.Print(formatted)
+		}
+	}
+
+	if !allSucceeded {
+		os.Exit(1)
+	}
+}
+
+func formatCode(
+	code string,
+	location common.Location,
+	codes map[common.LocationID]string,
+	options []format.Option,
+) string {
+	// Syntax errors are reported and the process exits
+	program, _ := cmd.PrepareProgram(code, location, codes)
+
+	options = append(
+		[]format.Option{format.WithComments(code)},
+		options...,
+	)
+
+	return format.Program(program, options...)
+}
+
+func read(path string) string {
+	var data []byte
+	var err error
+	if len(path) == 0 {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+	return string(data)
+}
@@ -22,11 +22,45 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/onflow/cadence/runtime/cmd/debug"
 	"github.com/onflow/cadence/runtime/cmd/execute"
+	"github.com/onflow/cadence/runtime/cmd/formatter"
+	"github.com/onflow/cadence/runtime/cmd/run"
+	"github.com/onflow/cadence/runtime/cmd/test"
 	"github.com/onflow/cadence/runtime/interpreter"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "fmt" {
+		formatter.Format(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "debug" {
+		debug.Debug(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "test" {
+		test.Test(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "run" {
+		run.Run(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "send" {
+		run.Send(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "deploy" {
+		run.Deploy(os.Args[2:])
+		return
+	}
+
 	if len(os.Args) > 1 {
 		// TODO: also make the REPL support the interactive debugger
 
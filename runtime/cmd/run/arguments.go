@@ -0,0 +1,119 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package run
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// stringsFlag is a flag which can be given multiple times
+//
+type stringsFlag []string
+
+var _ flag.Value = &stringsFlag{}
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ", ")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// parseFlags parses the given arguments, which may contain flags after positional arguments,
+// and returns the positional arguments
+//
+func parseFlags(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		err := flags.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// encodedArguments returns the JSON-Cadence encoded arguments
+// given in the argument file, if any, followed by the given arguments.
+//
+// The argument file must contain a JSON array of JSON-Cadence values.
+// All arguments are decoded to ensure they are valid
+//
+func encodedArguments(arguments []string, argumentFile string) ([][]byte, error) {
+	var result [][]byte
+
+	if argumentFile != "" {
+		data, err := ioutil.ReadFile(argumentFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var fileArguments []json.RawMessage
+		err = json.Unmarshal(data, &fileArguments)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument file %s: %w", argumentFile, err)
+		}
+
+		for _, argument := range fileArguments {
+			result = append(result, argument)
+		}
+	}
+
+	for _, argument := range arguments {
+		result = append(result, []byte(argument))
+	}
+
+	for i, argument := range result {
+		_, err := jsoncdc.Decode(argument)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d: %w", i+1, err)
+		}
+	}
+
+	return result, nil
+}
+
+func parseAddresses(addresses []string) ([]common.Address, error) {
+	result := make([]common.Address, len(addresses))
+	for i, address := range addresses {
+		var err error
+		result[i], err = common.HexToAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s: %w", address, err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,403 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package run
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/pretty"
+)
+
+type commandFlags struct {
+	flags        *flag.FlagSet
+	state        *string
+	arguments    stringsFlag
+	argumentFile *string
+}
+
+func newCommandFlags(name string) *commandFlags {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+
+	commandFlags := &commandFlags{
+		flags:        flags,
+		state:        flags.String("state", DefaultStateDirectory, "directory in which the state is stored"),
+		argumentFile: flags.String("arg-file", "", "file with a JSON array of JSON-Cadence encoded arguments"),
+	}
+	flags.Var(&commandFlags.arguments, "arg", "JSON-Cadence encoded argument (can be given multiple times)")
+
+	return commandFlags
+}
+
+// parse parses the given command-line arguments,
+// and returns the code of the file given as the only positional argument,
+// and the encoded arguments
+//
+func (f *commandFlags) parse(args []string) (code []byte, arguments [][]byte) {
+	// ExitOnError: errors are reported and the process exits
+	positional, _ := parseFlags(f.flags, args)
+
+	if len(positional) != 1 {
+		cmd.ExitWithError("expected exactly one file")
+	}
+
+	code, err := ioutil.ReadFile(positional[0])
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+
+	arguments, err = encodedArguments(f.arguments, *f.argumentFile)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+
+	return code, arguments
+}
+
+// Run executes the script in the given file against the stored state, and prints its result.
+//
+// The arguments of the script are JSON-Cadence encoded, and can be given individually (-arg),
+// and/or as a JSON array in a file (-arg-file).
+// The state is stored in the given directory (-state), and is not changed by the script
+//
+func Run(args []string) {
+	flags := newCommandFlags("run")
+	code, arguments := flags.parse(args)
+
+	err := runScript(*flags.state, code, arguments, os.Stdout)
+	if err != nil {
+		exitWithError(err)
+	}
+}
+
+// Send executes the transaction in the given file against the stored state,
+// with the given accounts as signers (-signer), and prints the emitted events.
+//
+// The arguments of the transaction are JSON-Cadence encoded, and can be given individually (-arg),
+// and/or as a JSON array in a file (-arg-file).
+// The state is stored in the given directory (-state), and is updated if the transaction succeeds
+//
+func Send(args []string) {
+	flags := newCommandFlags("send")
+
+	var signerFlags stringsFlag
+	flags.flags.Var(&signerFlags, "signer", "address of a signing account (can be given multiple times)")
+
+	code, arguments := flags.parse(args)
+
+	signers, err := parseAddresses(signerFlags)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+
+	err = sendTransaction(*flags.state, code, signers, arguments, os.Stdout)
+	if err != nil {
+		exitWithError(err)
+	}
+}
+
+// Deploy deploys the contract in the given file to an account (-signer, by default the service account).
+// If the account already has a contract with the same name, the contract is updated.
+//
+// The arguments of the contract's initializer are JSON-Cadence encoded, and can be given individually (-arg),
+// and/or as a JSON array in a file (-arg-file).
+// The state is stored in the given directory (-state), and is updated if the deployment succeeds
+//
+func Deploy(args []string) {
+	flags := newCommandFlags("deploy")
+
+	signerFlag := flags.flags.String("signer", ServiceAccountAddress.ShortHexWithPrefix(), "address of the account")
+
+	code, arguments := flags.parse(args)
+
+	signer, err := common.HexToAddress(*signerFlag)
+	if err != nil {
+		cmd.ExitWithError(fmt.Sprintf("invalid address %s: %s", *signerFlag, err))
+	}
+
+	err = deployContract(*flags.state, code, signer, arguments, os.Stdout)
+	if err != nil {
+		exitWithError(err)
+	}
+}
+
+func runScript(stateDirectory string, code []byte, arguments [][]byte, output io.Writer) error {
+	e, err := loadEmulator(stateDirectory)
+	if err != nil {
+		return err
+	}
+
+	result, err := e.ExecuteScript(code, arguments)
+	writeLogs(output, result.Logs)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := encodeValue(result.Value)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(output, "Result: %s\n", encoded)
+	return err
+}
+
+func sendTransaction(
+	stateDirectory string,
+	code []byte,
+	signers []common.Address,
+	arguments [][]byte,
+	output io.Writer,
+) error {
+	e, err := loadEmulator(stateDirectory)
+	if err != nil {
+		return err
+	}
+
+	result, err := e.ExecuteTransaction(code, signers, arguments)
+	writeLogs(output, result.Logs)
+	if err != nil {
+		return err
+	}
+
+	err = writeEvents(output, result.Events)
+	if err != nil {
+		return err
+	}
+
+	err = saveEmulator(stateDirectory, e)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(output, "Transaction executed in block %d\n", result.BlockHeight)
+	return err
+}
+
+func deployContract(
+	stateDirectory string,
+	code []byte,
+	address common.Address,
+	arguments [][]byte,
+	output io.Writer,
+) error {
+	name, parameterTypes, err := contractDeclaration(code)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) > len(parameterTypes) {
+		return fmt.Errorf(
+			"too many initializer arguments for contract %s: expected at most %d, got %d",
+			name,
+			len(parameterTypes),
+			len(arguments),
+		)
+	}
+
+	e, err := loadEmulator(stateDirectory)
+	if err != nil {
+		return err
+	}
+
+	var update bool
+	if account := e.Account(address); account != nil {
+		_, update = account.Contracts[name]
+	}
+
+	if update && len(arguments) > 0 {
+		return fmt.Errorf("contract %s is already deployed, and updates have no initializer arguments", name)
+	}
+
+	transactionArguments := [][]byte{
+		jsoncdc.MustEncode(cadence.String(name)),
+		jsoncdc.MustEncode(cadence.String(code)),
+	}
+	transactionArguments = append(transactionArguments, arguments...)
+
+	result, err := e.ExecuteTransaction(
+		[]byte(deploymentTransaction(parameterTypes[:len(arguments)], update)),
+		[]common.Address{address},
+		transactionArguments,
+	)
+	writeLogs(output, result.Logs)
+	if err != nil {
+		return err
+	}
+
+	err = writeEvents(output, result.Events)
+	if err != nil {
+		return err
+	}
+
+	err = saveEmulator(stateDirectory, e)
+	if err != nil {
+		return err
+	}
+
+	action := "deployed"
+	if update {
+		action = "updated"
+	}
+
+	_, err = fmt.Fprintf(
+		output,
+		"Contract %s %s to account %s in block %d\n",
+		name,
+		action,
+		address.ShortHexWithPrefix(),
+		result.BlockHeight,
+	)
+	return err
+}
+
+// contractDeclaration returns the name of the contract or contract interface declared in the given code,
+// and the types of the parameters of the contract's initializer
+//
+func contractDeclaration(code []byte) (name string, parameterTypes []string, err error) {
+	program, err := parser2.ParseProgram(string(code))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var count int
+
+	for _, declaration := range program.CompositeDeclarations() {
+		if declaration.CompositeKind != common.CompositeKindContract {
+			continue
+		}
+
+		count++
+		name = declaration.Identifier.Identifier
+
+		initializers := declaration.Members.Initializers()
+		if len(initializers) > 0 {
+			for _, parameter := range initializers[0].FunctionDeclaration.ParameterList.Parameters {
+				parameterTypes = append(parameterTypes, parameter.TypeAnnotation.String())
+			}
+		}
+	}
+
+	for _, declaration := range program.InterfaceDeclarations() {
+		if declaration.CompositeKind != common.CompositeKindContract {
+			continue
+		}
+
+		count++
+		name = declaration.Identifier.Identifier
+	}
+
+	if count != 1 {
+		return "", nil, errors.New("expected exactly one contract or contract interface declaration")
+	}
+
+	return name, parameterTypes, nil
+}
+
+// deploymentTransaction returns a transaction which deploys a contract,
+// or updates it, if update is true.
+//
+// The name and code of the contract are passed as the first two arguments,
+// followed by the initializer arguments, which have the given types
+//
+func deploymentTransaction(parameterTypes []string, update bool) string {
+	parameters := []string{"name: String", "code: String"}
+	contractArguments := []string{"name: name", "code: code.utf8"}
+
+	for i, parameterType := range parameterTypes {
+		name := fmt.Sprintf("arg%d", i)
+		parameters = append(parameters, fmt.Sprintf("%s: %s", name, parameterType))
+		contractArguments = append(contractArguments, name)
+	}
+
+	function := "add"
+	if update {
+		function = "update__experimental"
+	}
+
+	return fmt.Sprintf(
+		`
+          transaction(%s) {
+              prepare(signer: AuthAccount) {
+                  signer.contracts.%s(%s)
+              }
+          }
+        `,
+		strings.Join(parameters, ", "),
+		function,
+		strings.Join(contractArguments, ", "),
+	)
+}
+
+func encodeValue(value cadence.Value) ([]byte, error) {
+	encoded, err := jsoncdc.Encode(value)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(encoded), nil
+}
+
+func writeLogs(output io.Writer, logs []string) {
+	for _, message := range logs {
+		_, _ = fmt.Fprintf(output, "Log: %s\n", message)
+	}
+}
+
+func writeEvents(output io.Writer, events []cadence.Event) error {
+	for _, event := range events {
+		encoded, err := encodeValue(event)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(output, "Event: %s %s\n", event.EventType.ID(), encoded)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exitWithError reports the given error and exits.
+// Errors of programs are pretty-printed, including the location in the code
+//
+func exitWithError(err error) {
+	var runtimeError runtime.Error
+	if errors.As(err, &runtimeError) {
+		printErr := pretty.NewErrorPrettyPrinter(os.Stderr, true).
+			PrettyPrintError(runtimeError.Err, runtimeError.Location, runtimeError.Codes)
+		if printErr == nil {
+			os.Exit(1)
+		}
+	}
+
+	cmd.ExitWithError(err.Error())
+}
@@ -0,0 +1,218 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package run
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const counterContract = `
+  pub contract Counter {
+
+      pub event Incremented(value: UInt64)
+
+      pub var value: UInt64
+
+      init(start: UInt64) {
+          self.value = start
+      }
+
+      pub fun increment(by: UInt64) {
+          self.value = self.value + by
+          emit Incremented(value: self.value)
+      }
+  }
+`
+
+func TestDeploySendRun(t *testing.T) {
+
+	t.Parallel()
+
+	directory := t.TempDir()
+
+	// Deploy
+
+	var output bytes.Buffer
+	err := deployContract(
+		directory,
+		[]byte(counterContract),
+		ServiceAccountAddress,
+		[][]byte{[]byte(`{"type":"UInt64","value":"10"}`)},
+		&output,
+	)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Event: flow.AccountContractAdded ")
+	assert.Contains(t, output.String(), "Contract Counter deployed to account 0x1 in block 1\n")
+
+	// Send
+
+	output.Reset()
+	err = sendTransaction(
+		directory,
+		[]byte(`
+          import Counter from 0x1
+
+          transaction(by: UInt64) {
+              prepare(signer: AuthAccount) {
+                  log(signer.address)
+              }
+
+              execute {
+                  Counter.increment(by: by)
+              }
+          }
+        `),
+		[]common.Address{ServiceAccountAddress},
+		[][]byte{[]byte(`{"type":"UInt64","value":"5"}`)},
+		&output,
+	)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"Log: 0x0000000000000001\n"+
+			`Event: A.0000000000000001.Counter.Incremented {"type":"Event","value":{"id":"A.0000000000000001.Counter.Incremented","fields":[{"name":"value","value":{"type":"UInt64","value":"15"}}]}}`+"\n"+
+			"Transaction executed in block 2\n",
+		output.String(),
+	)
+
+	// Run
+
+	const script = `
+      import Counter from 0x1
+
+      pub fun main(x: UInt64): UInt64 {
+          log("running")
+          return Counter.value + x
+      }
+    `
+
+	output.Reset()
+	err = runScript(
+		directory,
+		[]byte(script),
+		[][]byte{[]byte(`{"type":"UInt64","value":"1"}`)},
+		&output,
+	)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"Log: \"running\"\n"+
+			`Result: {"type":"UInt64","value":"16"}`+"\n",
+		output.String(),
+	)
+
+	// Update
+
+	output.Reset()
+	err = deployContract(directory, []byte(counterContract), ServiceAccountAddress, nil, &output)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Contract Counter updated to account 0x1 in block 3\n")
+
+	// A failing transaction does not change the state
+
+	output.Reset()
+	err = sendTransaction(
+		directory,
+		[]byte(`
+          import Counter from 0x1
+
+          transaction {
+              execute {
+                  Counter.increment(by: 1)
+                  panic("failing")
+              }
+          }
+        `),
+		nil,
+		nil,
+		&output,
+	)
+	require.Error(t, err)
+
+	e, err := loadEmulator(directory)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), e.BlockHeight())
+}
+
+func TestDeployContractErrors(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("no contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := deployContract(t.TempDir(), []byte(`pub fun test() {}`), ServiceAccountAddress, nil, &bytes.Buffer{})
+		require.EqualError(t, err, "expected exactly one contract or contract interface declaration")
+	})
+
+	t.Run("too many arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := deployContract(
+			t.TempDir(),
+			[]byte(`pub contract C {}`),
+			ServiceAccountAddress,
+			[][]byte{[]byte(`{"type":"Int","value":"1"}`)},
+			&bytes.Buffer{},
+		)
+		require.EqualError(t, err, "too many initializer arguments for contract C: expected at most 0, got 1")
+	})
+}
+
+func TestEncodedArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("file and flags", func(t *testing.T) {
+
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "args.json")
+		err := ioutil.WriteFile(path, []byte(`[{"type":"UInt64","value":"1"}, {"type":"String","value":"a"}]`), 0644)
+		require.NoError(t, err)
+
+		arguments, err := encodedArguments([]string{`{"type":"Bool","value":true}`}, path)
+		require.NoError(t, err)
+		assert.Equal(t,
+			[][]byte{
+				[]byte(`{"type":"UInt64","value":"1"}`),
+				[]byte(`{"type":"String","value":"a"}`),
+				[]byte(`{"type":"Bool","value":true}`),
+			},
+			arguments,
+		)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := encodedArguments([]string{`{"type":"UInt64","value":"1"}`, `{"type":"UInt64"`}, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid argument 2")
+	})
+}
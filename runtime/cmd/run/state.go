@@ -0,0 +1,101 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package run
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/emulator"
+)
+
+// DefaultStateDirectory is the directory in which the state of the emulator is stored by default
+//
+const DefaultStateDirectory = ".cadence"
+
+const stateFileName = "emulator.state"
+
+// ServiceAccountAddress is the address of the account which is created for a new state
+//
+var ServiceAccountAddress = common.Address{0, 0, 0, 0, 0, 0, 0, 1}
+
+// loadEmulator returns an emulator with the state stored in the given directory.
+// If no state is stored yet, a new emulator with a service account is returned
+//
+func loadEmulator(directory string, options ...emulator.Option) (*emulator.Emulator, error) {
+	file, err := os.Open(filepath.Join(directory, stateFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return newEmulator(options...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return emulator.LoadEmulator(file, options...)
+}
+
+func newEmulator(options ...emulator.Option) (*emulator.Emulator, error) {
+	e := emulator.NewEmulator(options...)
+
+	_, err := e.CreateAccount(common.Address{})
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// saveEmulator stores the state of the given emulator in the given directory.
+// The state is first written to a temporary file, which then replaces the previous state,
+// so a failure does not corrupt the stored state
+//
+func saveEmulator(directory string, e *emulator.Emulator) (err error) {
+	err = os.MkdirAll(directory, 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(directory, stateFileName+".*")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	err = e.Save(file)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filepath.Join(directory, stateFileName))
+}
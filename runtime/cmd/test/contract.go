@@ -0,0 +1,587 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/emulator"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+// This file defines the `Test` contract, which is available in test files.
+
+const testContractName = "Test"
+
+const testContractDocString = `
+The Test contract provides assertions and access to the emulated environment of the test
+`
+
+// ExecutionError is reported when a transaction or script executed by a test fails
+//
+type ExecutionError struct {
+	Kind string
+	Err  error
+	interpreter.LocationRange
+}
+
+func (e ExecutionError) Error() string {
+	return fmt.Sprintf("%s failed: %s", e.Kind, e.Err.Error())
+}
+
+func (e ExecutionError) Unwrap() error {
+	return e.Err
+}
+
+const testAssertFunctionName = "assert"
+
+const testAssertFunctionDocString = `
+Fails the test if the given condition is false, and reports a message which explains how the condition is false.
+
+The message argument is optional.
+`
+
+const testExpectFunctionName = "expect"
+
+const testExpectFunctionDocString = `
+Fails the test if the given value is not equal to the expected value
+`
+
+var testExpectFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "value",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.AnyStructType),
+		},
+		{
+			Label:          "toEqual",
+			Identifier:     "expected",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.AnyStructType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+}
+
+const testExpectFailureFunctionName = "expectFailure"
+
+const testExpectFailureFunctionDocString = `
+Fails the test if the given function does not fail.
+
+If an error message substring is given, the test also fails if the error message of the function does not contain it.
+`
+
+var testExpectFailureFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "function",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.FunctionType{
+					ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.VoidType),
+				},
+			),
+		},
+		{
+			Identifier:     "errorMessageSubstring",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+	},
+	ReturnTypeAnnotation:  sema.NewTypeAnnotation(sema.VoidType),
+	RequiredArgumentCount: sema.RequiredArgumentCount(1),
+}
+
+const testCreateAccountFunctionName = "createAccount"
+
+const testCreateAccountFunctionDocString = `
+Creates a new account in the environment of the test and returns its address
+`
+
+var testCreateAccountFunctionType = &sema.FunctionType{
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.AddressType{}),
+}
+
+const testDeployContractFunctionName = "deployContract"
+
+const testDeployContractFunctionDocString = `
+Deploys the contract with the given name and code to the given account.
+
+The arguments are passed to the initializer of the contract, and are optional.
+`
+
+var testArgumentsType = &sema.VariableSizedType{
+	Type: sema.AnyStructType,
+}
+
+var testDeployContractFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Identifier:     "name",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+		{
+			Identifier:     "code",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+		{
+			Identifier:     "account",
+			TypeAnnotation: sema.NewTypeAnnotation(&sema.AddressType{}),
+		},
+		{
+			Identifier:     "arguments",
+			TypeAnnotation: sema.NewTypeAnnotation(testArgumentsType),
+		},
+	},
+	ReturnTypeAnnotation:  sema.NewTypeAnnotation(sema.VoidType),
+	RequiredArgumentCount: sema.RequiredArgumentCount(3),
+}
+
+const testExecuteTransactionFunctionName = "executeTransaction"
+
+const testExecuteTransactionFunctionDocString = `
+Executes the given transaction, signed by the given accounts, with the given arguments.
+
+The signers and the arguments are optional. The test fails if the transaction fails.
+`
+
+var testExecuteTransactionFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "code",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+		{
+			Identifier: "signers",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.VariableSizedType{
+					Type: &sema.AddressType{},
+				},
+			),
+		},
+		{
+			Identifier:     "arguments",
+			TypeAnnotation: sema.NewTypeAnnotation(testArgumentsType),
+		},
+	},
+	ReturnTypeAnnotation:  sema.NewTypeAnnotation(sema.VoidType),
+	RequiredArgumentCount: sema.RequiredArgumentCount(1),
+}
+
+const testExecuteScriptFunctionName = "executeScript"
+
+const testExecuteScriptFunctionDocString = `
+Executes the given script with the given arguments and returns its result.
+
+The arguments are optional. The test fails if the script fails.
+`
+
+var testExecuteScriptFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "code",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+		{
+			Identifier:     "arguments",
+			TypeAnnotation: sema.NewTypeAnnotation(testArgumentsType),
+		},
+	},
+	ReturnTypeAnnotation:  sema.NewTypeAnnotation(sema.AnyStructType),
+	RequiredArgumentCount: sema.RequiredArgumentCount(1),
+}
+
+const testReadFileFunctionName = "readFile"
+
+const testReadFileFunctionDocString = `
+Returns the contents of the file at the given path, which is relative to the test file
+`
+
+var testReadFileFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "path",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+}
+
+var testContractType = func() *sema.CompositeType {
+
+	ty := &sema.CompositeType{
+		Kind:       common.CompositeKindContract,
+		Identifier: testContractName,
+		Members:    sema.NewStringMemberOrderedMap(),
+	}
+
+	for _, function := range []struct {
+		name         string
+		functionType *sema.FunctionType
+		docString    string
+	}{
+		{testAssertFunctionName, stdlib.AssertFunction.Type, testAssertFunctionDocString},
+		{testExpectFunctionName, testExpectFunctionType, testExpectFunctionDocString},
+		{testExpectFailureFunctionName, testExpectFailureFunctionType, testExpectFailureFunctionDocString},
+		{testCreateAccountFunctionName, testCreateAccountFunctionType, testCreateAccountFunctionDocString},
+		{testDeployContractFunctionName, testDeployContractFunctionType, testDeployContractFunctionDocString},
+		{testExecuteTransactionFunctionName, testExecuteTransactionFunctionType, testExecuteTransactionFunctionDocString},
+		{testExecuteScriptFunctionName, testExecuteScriptFunctionType, testExecuteScriptFunctionDocString},
+		{testReadFileFunctionName, testReadFileFunctionType, testReadFileFunctionDocString},
+	} {
+		ty.Members.Set(
+			function.name,
+			sema.NewPublicFunctionMember(
+				ty,
+				function.name,
+				function.functionType,
+				function.docString,
+			),
+		)
+	}
+
+	return ty
+}()
+
+var testContractStaticType = interpreter.NewCompositeStaticType(nil, testContractName)
+
+var testContractFieldNames = []string{
+	testAssertFunctionName,
+	testExpectFunctionName,
+	testExpectFailureFunctionName,
+	testCreateAccountFunctionName,
+	testDeployContractFunctionName,
+	testExecuteTransactionFunctionName,
+	testExecuteScriptFunctionName,
+	testReadFileFunctionName,
+}
+
+// newTestContractValue returns the value of the `Test` contract,
+// whose functions operate on the given environment.
+// Files are read relative to the given directory
+//
+func newTestContractValue(env *emulator.Emulator, directory string) *interpreter.SimpleCompositeValue {
+	return interpreter.NewSimpleCompositeValue(
+		testContractType.ID(),
+		testContractStaticType,
+		nil,
+		testContractFieldNames,
+		map[string]interpreter.Value{
+			testAssertFunctionName:             stdlib.AssertFunction.Function,
+			testExpectFunctionName:             testExpectFunction,
+			testExpectFailureFunctionName:      testExpectFailureFunction,
+			testCreateAccountFunctionName:      newTestCreateAccountFunction(env),
+			testDeployContractFunctionName:     newTestDeployContractFunction(env),
+			testExecuteTransactionFunctionName: newTestExecuteTransactionFunction(env),
+			testExecuteScriptFunctionName:      newTestExecuteScriptFunction(env),
+			testReadFileFunctionName:           newTestReadFileFunction(directory),
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func newTestContractDeclaration(env *emulator.Emulator, directory string) stdlib.StandardLibraryValue {
+	return stdlib.StandardLibraryValue{
+		Name:      testContractName,
+		Type:      testContractType,
+		DocString: testContractDocString,
+		ValueFactory: func(_ *interpreter.Interpreter) interpreter.Value {
+			return newTestContractValue(env, directory)
+		},
+		Kind: common.DeclarationKindContract,
+	}
+}
+
+var testExpectFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		value := invocation.Arguments[0]
+		expected := invocation.Arguments[1]
+
+		equatableValue, ok := value.(interpreter.EquatableValue)
+		if !ok ||
+			!equatableValue.Equal(invocation.Interpreter, invocation.GetLocationRange, expected) {
+
+			panic(stdlib.AssertionError{
+				Message:       fmt.Sprintf("expected `%s`, got `%s`", expected, value),
+				LocationRange: invocation.GetLocationRange(),
+			})
+		}
+
+		return interpreter.VoidValue{}
+	},
+	testExpectFunctionType,
+)
+
+var testExpectFailureFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		function := invocation.Arguments[0].(interpreter.FunctionValue)
+
+		var errorMessageSubstring string
+		if len(invocation.Arguments) > 1 {
+			errorMessageSubstring = invocation.Arguments[1].(*interpreter.StringValue).Str
+		}
+
+		locationRange := invocation.GetLocationRange()
+
+		_, err := invocation.Interpreter.InvokeFunctionValue(
+			function,
+			nil,
+			nil,
+			nil,
+			locationRange,
+		)
+		if err == nil {
+			panic(stdlib.AssertionError{
+				Message:       "expected failure, but function succeeded",
+				LocationRange: locationRange,
+			})
+		}
+
+		if !strings.Contains(err.Error(), errorMessageSubstring) {
+			panic(stdlib.AssertionError{
+				Message: fmt.Sprintf(
+					"expected error message to contain %q, got %q",
+					errorMessageSubstring,
+					err.Error(),
+				),
+				LocationRange: locationRange,
+			})
+		}
+
+		return interpreter.VoidValue{}
+	},
+	testExpectFailureFunctionType,
+)
+
+func newTestCreateAccountFunction(env *emulator.Emulator) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			address, err := env.CreateAccount(common.Address{})
+			if err != nil {
+				panic(err)
+			}
+
+			return interpreter.NewAddressValue(address)
+		},
+		testCreateAccountFunctionType,
+	)
+}
+
+func newTestDeployContractFunction(env *emulator.Emulator) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			name := invocation.Arguments[0].(*interpreter.StringValue)
+			code := invocation.Arguments[1].(*interpreter.StringValue)
+			address := invocation.Arguments[2].(interpreter.AddressValue)
+
+			var arguments *interpreter.ArrayValue
+			if len(invocation.Arguments) > 3 {
+				arguments = invocation.Arguments[3].(*interpreter.ArrayValue)
+			}
+
+			// The contract is deployed by a transaction signed by the account.
+			// The code and initializer arguments are passed as transaction arguments
+
+			encodedArguments := []interpreter.Value{code}
+			parameters := []string{"code: String"}
+			contractArguments := []string{}
+
+			if arguments != nil {
+				arguments.Iterate(func(argument interpreter.Value) (resume bool) {
+					argumentType := inter.MustConvertStaticToSemaType(argument.StaticType())
+					parameterName := fmt.Sprintf("arg%d", len(contractArguments))
+
+					parameters = append(
+						parameters,
+						fmt.Sprintf("%s: %s", parameterName, argumentType.QualifiedString()),
+					)
+					contractArguments = append(contractArguments, parameterName)
+					encodedArguments = append(encodedArguments, argument)
+					return true
+				})
+			}
+
+			transaction := fmt.Sprintf(
+				`
+                  transaction(%s) {
+                      prepare(signer: AuthAccount) {
+                          signer.contracts.add(name: %s, code: code.utf8%s)
+                      }
+                  }
+                `,
+				strings.Join(parameters, ", "),
+				ast.QuoteString(name.Str),
+				joinContractArguments(contractArguments),
+			)
+
+			_, err := env.ExecuteTransaction(
+				[]byte(transaction),
+				[]common.Address{address.ToAddress()},
+				encodeArguments(inter, encodedArguments),
+			)
+			if err != nil {
+				panic(ExecutionError{
+					Kind:          "contract deployment",
+					Err:           err,
+					LocationRange: invocation.GetLocationRange(),
+				})
+			}
+
+			return interpreter.VoidValue{}
+		},
+		testDeployContractFunctionType,
+	)
+}
+
+func joinContractArguments(arguments []string) string {
+	if len(arguments) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(arguments, ", ")
+}
+
+func newTestExecuteTransactionFunction(env *emulator.Emulator) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			code := invocation.Arguments[0].(*interpreter.StringValue)
+
+			var signers []common.Address
+			if len(invocation.Arguments) > 1 {
+				invocation.Arguments[1].(*interpreter.ArrayValue).
+					Iterate(func(signer interpreter.Value) (resume bool) {
+						signers = append(signers, signer.(interpreter.AddressValue).ToAddress())
+						return true
+					})
+			}
+
+			var arguments [][]byte
+			if len(invocation.Arguments) > 2 {
+				arguments = encodeArrayArguments(inter, invocation.Arguments[2].(*interpreter.ArrayValue))
+			}
+
+			_, err := env.ExecuteTransaction([]byte(code.Str), signers, arguments)
+			if err != nil {
+				panic(ExecutionError{
+					Kind:          "transaction",
+					Err:           err,
+					LocationRange: invocation.GetLocationRange(),
+				})
+			}
+
+			return interpreter.VoidValue{}
+		},
+		testExecuteTransactionFunctionType,
+	)
+}
+
+func newTestExecuteScriptFunction(env *emulator.Emulator) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			code := invocation.Arguments[0].(*interpreter.StringValue)
+
+			var arguments [][]byte
+			if len(invocation.Arguments) > 1 {
+				arguments = encodeArrayArguments(inter, invocation.Arguments[1].(*interpreter.ArrayValue))
+			}
+
+			result, err := env.ExecuteScript([]byte(code.Str), arguments)
+			if err != nil {
+				panic(ExecutionError{
+					Kind:          "script",
+					Err:           err,
+					LocationRange: invocation.GetLocationRange(),
+				})
+			}
+
+			value, err := runtime.ImportValue(inter, result.Value, nil)
+			if err != nil {
+				panic(err)
+			}
+
+			return value
+		},
+		testExecuteScriptFunctionType,
+	)
+}
+
+func newTestReadFileFunction(directory string) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			path := invocation.Arguments[0].(*interpreter.StringValue).Str
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(directory, path)
+			}
+
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				panic(err)
+			}
+
+			return interpreter.NewStringValue(string(content))
+		},
+		testReadFileFunctionType,
+	)
+}
+
+// encodeArrayArguments encodes the elements of the given array as JSON-Cadence
+//
+func encodeArrayArguments(inter *interpreter.Interpreter, array *interpreter.ArrayValue) [][]byte {
+	var arguments []interpreter.Value
+	array.Iterate(func(argument interpreter.Value) (resume bool) {
+		arguments = append(arguments, argument)
+		return true
+	})
+	return encodeArguments(inter, arguments)
+}
+
+// encodeArguments encodes the given values as JSON-Cadence
+//
+func encodeArguments(inter *interpreter.Interpreter, values []interpreter.Value) [][]byte {
+	arguments := make([][]byte, len(values))
+
+	for i, value := range values {
+		exportedValue, err := runtime.ExportValue(value, inter)
+		if err != nil {
+			panic(err)
+		}
+
+		arguments[i], err = jsoncdc.Encode(exportedValue)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return arguments
+}
@@ -0,0 +1,235 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence/runtime/pretty"
+)
+
+// WriteTextReport writes a human-readable report of the given results,
+// similar to the output of `go test -v`.
+// The logs and errors of failed tests are included
+//
+func WriteTextReport(writer io.Writer, results []*FileResult, useColor bool) error {
+	var builder strings.Builder
+
+	for _, fileResult := range results {
+
+		if fileResult.Err != nil {
+			builder.WriteString(formatError(fileResult, fileResult.Err, useColor))
+			builder.WriteString("\n")
+		}
+
+		for _, result := range fileResult.Results {
+			status := "PASS"
+			if !result.Passed() {
+				status = "FAIL"
+			}
+
+			builder.WriteString(
+				fmt.Sprintf(
+					"--- %s: %s (%s)\n",
+					status,
+					result.Name,
+					formatDuration(result.Duration),
+				),
+			)
+
+			if result.Passed() {
+				continue
+			}
+
+			for _, message := range result.Logs {
+				builder.WriteString(indent(message))
+			}
+
+			builder.WriteString(indent(formatError(fileResult, result.Err, useColor)))
+		}
+
+		status := "ok  "
+		if !fileResult.Passed() {
+			status = "FAIL"
+		}
+
+		builder.WriteString(
+			fmt.Sprintf(
+				"%s\t%s\t%s\n",
+				status,
+				fileResult.Location,
+				formatDuration(fileResult.Duration),
+			),
+		)
+	}
+
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+// formatError returns the given error of a test of the given file, pretty-printed
+//
+func formatError(fileResult *FileResult, err error, useColor bool) string {
+	var builder strings.Builder
+	printErr := pretty.NewErrorPrettyPrinter(&builder, useColor).
+		PrettyPrintError(err, fileResult.Location, fileResult.Codes)
+	if printErr != nil {
+		return err.Error()
+	}
+	return builder.String()
+}
+
+func formatDuration(duration time.Duration) string {
+	return fmt.Sprintf("%.2fs", duration.Seconds())
+}
+
+// indent indents each line of the given text, and terminates it with a line break
+//
+func indent(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "    " + line
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// JUnit XML report, as understood by most CI systems
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitProblem struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",chardata"`
+}
+
+// WriteJUnitReport writes a JUnit XML report of the given results.
+//
+// Each test file is reported as a test suite.
+// A file which could not be checked is reported as a test case with an error
+//
+func WriteJUnitReport(writer io.Writer, results []*FileResult) error {
+	report := junitTestSuites{}
+
+	var totalDuration time.Duration
+
+	for _, fileResult := range results {
+		name := string(fileResult.Location)
+
+		suite := junitTestSuite{
+			Name: name,
+			Time: formatJUnitDuration(fileResult.Duration),
+		}
+
+		if fileResult.Err != nil {
+			suite.Errors++
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      name,
+				ClassName: name,
+				Time:      formatJUnitDuration(0),
+				Error:     newJUnitProblem(fileResult, fileResult.Err),
+			})
+		}
+
+		for _, result := range fileResult.Results {
+			testCase := junitTestCase{
+				Name:      result.Name,
+				ClassName: name,
+				Time:      formatJUnitDuration(result.Duration),
+			}
+
+			if len(result.Logs) > 0 {
+				testCase.SystemOut = strings.Join(result.Logs, "\n")
+			}
+
+			if !result.Passed() {
+				suite.Failures++
+				testCase.Failure = newJUnitProblem(fileResult, result.Err)
+			}
+
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+
+		suite.Tests = len(suite.TestCases)
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Suites = append(report.Suites, suite)
+
+		totalDuration += fileResult.Duration
+	}
+
+	report.Time = formatJUnitDuration(totalDuration)
+
+	_, err := io.WriteString(writer, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	err = encoder.Encode(report)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(writer, "\n")
+	return err
+}
+
+func newJUnitProblem(fileResult *FileResult, err error) *junitProblem {
+	return &junitProblem{
+		Message:  strings.SplitN(err.Error(), "\n", 2)[0],
+		Contents: formatError(fileResult, err, false),
+	}
+}
+
+func formatJUnitDuration(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
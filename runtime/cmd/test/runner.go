@@ -0,0 +1,295 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/emulator"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+const testFunctionPrefix = "test"
+
+// Result is the result of running a single test function
+//
+type Result struct {
+	Name     string
+	Duration time.Duration
+	Logs     []string
+	Err      error
+}
+
+func (r *Result) Passed() bool {
+	return r.Err == nil
+}
+
+// FileResult is the result of running the tests of a test file.
+//
+// If the file could not be read, parsed, or checked,
+// the error is reported and no tests are run
+//
+type FileResult struct {
+	Location common.StringLocation
+	Codes    map[common.LocationID]string
+	Duration time.Duration
+	Results  []*Result
+	Err      error
+}
+
+func (r *FileResult) Passed() bool {
+	if r.Err != nil {
+		return false
+	}
+
+	for _, result := range r.Results {
+		if !result.Passed() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Runner runs the test functions of test files
+//
+type Runner struct {
+	// Filter, if not nil, restricts the run to the test functions with a matching name
+	Filter *regexp.Regexp
+}
+
+// RunFile runs the test functions of the test file with the given path.
+//
+// Test functions are global functions without parameters whose names start with `test`.
+// They are run in declaration order, each one in a new environment
+//
+func (r Runner) RunFile(path string) *FileResult {
+	startTime := time.Now()
+
+	location := common.StringLocation(path)
+
+	result := &FileResult{
+		Location: location,
+		Codes:    map[common.LocationID]string{},
+	}
+
+	defer func() {
+		result.Duration = time.Since(startTime)
+	}()
+
+	checker, err := r.check(path, location, result.Codes)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	directory := filepath.Dir(path)
+
+	for _, name := range r.testFunctionNames(checker.Program) {
+		result.Results = append(
+			result.Results,
+			runTest(checker, name, directory),
+		)
+	}
+
+	return result
+}
+
+func (r Runner) check(
+	path string,
+	location common.StringLocation,
+	codes map[common.LocationID]string,
+) (*sema.Checker, error) {
+
+	codeBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	code := string(codeBytes)
+	codes[location.ID()] = code
+
+	program, err := parser2.ParseProgram(code)
+	if err != nil {
+		return nil, err
+	}
+
+	// The value declarations are only needed for their types,
+	// so no environment is needed
+
+	checker, err := sema.NewChecker(
+		program,
+		location,
+		sema.WithPredeclaredValues(valueDeclarations(nil, "", nil).ToSemaValueDeclarations()),
+		sema.WithPredeclaredTypes(stdlib.BuiltinTypes.ToTypeDeclarations()),
+		sema.WithImportHandler(
+			func(_ *sema.Checker, importedLocation common.Location, _ ast.Range) (sema.Import, error) {
+				return nil, fmt.Errorf(
+					"cannot import `%s`: imports are not supported in test files, use `%s.%s`",
+					importedLocation,
+					testContractName,
+					testDeployContractFunctionName,
+				)
+			},
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checker.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return checker, nil
+}
+
+// testFunctionNames returns the names of the test functions of the given program
+// which match the filter, in declaration order
+//
+func (r Runner) testFunctionNames(program *ast.Program) []string {
+	var names []string
+
+	for _, declaration := range program.FunctionDeclarations() {
+		name := declaration.Identifier.Identifier
+
+		if !strings.HasPrefix(name, testFunctionPrefix) ||
+			len(declaration.ParameterList.Parameters) > 0 {
+
+			continue
+		}
+
+		if r.Filter != nil && !r.Filter.MatchString(name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// runTest runs the test function with the given name in a new interpreter,
+// with a new in-memory storage and a new environment
+//
+func runTest(checker *sema.Checker, name string, directory string) *Result {
+	result := &Result{
+		Name: name,
+	}
+
+	startTime := time.Now()
+	defer func() {
+		result.Duration = time.Since(startTime)
+	}()
+
+	log := func(message string) {
+		result.Logs = append(result.Logs, message)
+	}
+
+	env := emulator.NewEmulator(emulator.WithLogHandler(log))
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithStorage(interpreter.NewInMemoryStorage()),
+		interpreter.WithPredeclaredValues(
+			valueDeclarations(env, directory, log).ToInterpreterValueDeclarations(),
+		),
+		interpreter.WithUUIDHandler(env.GenerateUUID),
+	)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	err = inter.Interpret()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = inter.Invoke(name)
+
+	return result
+}
+
+const logFunctionDocString = `
+Logs a string representation of the given value
+`
+
+// valueDeclarations returns the values available in test files:
+// The built-in functions and values, the logging function, and the `Test` contract
+//
+func valueDeclarations(env *emulator.Emulator, directory string, log func(string)) valueDeclarationList {
+	logFunction := stdlib.NewStandardLibraryFunction(
+		"log",
+		stdlib.LogFunctionType,
+		logFunctionDocString,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			log(invocation.Arguments[0].String())
+			return interpreter.VoidValue{}
+		},
+	)
+
+	var declarations valueDeclarationList
+
+	for _, function := range append(stdlib.StandardLibraryFunctions{logFunction}, stdlib.BuiltinFunctions...) {
+		declarations = append(declarations, function)
+	}
+
+	for _, value := range append(stdlib.BuiltinValues(), newTestContractDeclaration(env, directory)) {
+		declarations = append(declarations, value)
+	}
+
+	return declarations
+}
+
+// valueDeclarationList is a list of standard library functions and values
+//
+type valueDeclarationList []interface {
+	sema.ValueDeclaration
+	interpreter.ValueDeclaration
+}
+
+func (declarations valueDeclarationList) ToSemaValueDeclarations() []sema.ValueDeclaration {
+	result := make([]sema.ValueDeclaration, len(declarations))
+	for i, declaration := range declarations {
+		result[i] = declaration
+	}
+	return result
+}
+
+func (declarations valueDeclarationList) ToInterpreterValueDeclarations() []interpreter.ValueDeclaration {
+	result := make([]interpreter.ValueDeclaration, len(declarations))
+	for i, declaration := range declarations {
+		result[i] = declaration
+	}
+	return result
+}
@@ -0,0 +1,359 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func writeTestFile(t *testing.T, directory string, name string, code string) string {
+	path := filepath.Join(directory, name)
+	err := ioutil.WriteFile(path, []byte(code), 0644)
+	require.NoError(t, err)
+	return path
+}
+
+func runTestCode(t *testing.T, code string) *FileResult {
+	path := writeTestFile(t, t.TempDir(), "a_test.cdc", code)
+	return Runner{}.RunFile(path)
+}
+
+func resultNames(fileResult *FileResult) []string {
+	names := make([]string, len(fileResult.Results))
+	for i, result := range fileResult.Results {
+		names[i] = result.Name
+	}
+	return names
+}
+
+func TestRunner_RunFile(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("discovery", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := runTestCode(t, `
+          pub fun testB() {}
+
+          pub fun helper() {}
+
+          pub fun testWithParameter(x: Int) {}
+
+          pub fun testA() {}
+        `)
+
+		require.NoError(t, result.Err)
+		assert.Equal(t, []string{"testB", "testA"}, resultNames(result))
+		assert.True(t, result.Passed())
+	})
+
+	t.Run("filter", func(t *testing.T) {
+
+		t.Parallel()
+
+		path := writeTestFile(t, t.TempDir(), "a_test.cdc", `
+          pub fun testFoo() {}
+          pub fun testBar() {}
+        `)
+
+		result := Runner{Filter: regexp.MustCompile("Bar")}.RunFile(path)
+
+		require.NoError(t, result.Err)
+		assert.Equal(t, []string{"testBar"}, resultNames(result))
+	})
+
+	t.Run("assertions", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := runTestCode(t, `
+          pub fun testPass() {
+              Test.assert(true)
+              Test.expect([1, 2], toEqual: [1, 2])
+          }
+
+          pub fun testAssert() {
+              log("before")
+              Test.assert(false, message: "not true")
+          }
+
+          pub fun testExpect() {
+              Test.expect("a", toEqual: "b")
+          }
+        `)
+
+		require.NoError(t, result.Err)
+		require.Len(t, result.Results, 3)
+
+		assert.NoError(t, result.Results[0].Err)
+
+		var assertionErr stdlib.AssertionError
+
+		require.ErrorAs(t, result.Results[1].Err, &assertionErr)
+		assert.Equal(t, "not true", assertionErr.Message)
+		assert.Equal(t, []string{`"before"`}, result.Results[1].Logs)
+
+		require.ErrorAs(t, result.Results[2].Err, &assertionErr)
+		assert.Equal(t, "expected `\"b\"`, got `\"a\"`", assertionErr.Message)
+
+		assert.False(t, result.Passed())
+	})
+
+	t.Run("expect failure", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := runTestCode(t, `
+          pub fun testFails() {
+              Test.expectFailure(fun () { panic("boom") }, errorMessageSubstring: "boom")
+          }
+
+          pub fun testSucceeds() {
+              Test.expectFailure(fun () {})
+          }
+
+          pub fun testWrongMessage() {
+              Test.expectFailure(fun () { panic("boom") }, errorMessageSubstring: "bang")
+          }
+        `)
+
+		require.NoError(t, result.Err)
+		require.Len(t, result.Results, 3)
+
+		assert.NoError(t, result.Results[0].Err)
+
+		var assertionErr stdlib.AssertionError
+
+		require.ErrorAs(t, result.Results[1].Err, &assertionErr)
+		assert.Equal(t, "expected failure, but function succeeded", assertionErr.Message)
+
+		require.ErrorAs(t, result.Results[2].Err, &assertionErr)
+		assert.Contains(t, assertionErr.Message, `expected error message to contain "bang"`)
+	})
+
+	t.Run("contract, transaction, and script", func(t *testing.T) {
+
+		t.Parallel()
+
+		directory := t.TempDir()
+
+		writeTestFile(t, directory, "Counter.cdc", `
+          pub contract Counter {
+              pub var count: Int
+
+              init(count: Int) {
+                  self.count = count
+              }
+
+              pub fun increment() {
+                  self.count = self.count + 1
+              }
+          }
+        `)
+
+		path := writeTestFile(t, directory, "counter_test.cdc", `
+          pub fun testCounter() {
+              let account = Test.createAccount()
+              Test.expect(account, toEqual: Address(0x1))
+
+              Test.deployContract(
+                  name: "Counter",
+                  code: Test.readFile("Counter.cdc"),
+                  account: account,
+                  arguments: [40]
+              )
+
+              Test.executeTransaction(
+                  "import Counter from 0x1 transaction { prepare(signer: AuthAccount) { Counter.increment(); log(signer.address) } }",
+                  signers: [account]
+              )
+
+              let count = Test.executeScript(
+                  "import Counter from 0x1 pub fun main(x: Int): Int { return Counter.count + x }",
+                  arguments: [1]
+              )
+              Test.expect(count, toEqual: 42)
+          }
+
+          pub fun testIsolation() {
+              // The account and contract of the previous test do not exist
+              Test.expect(Test.createAccount(), toEqual: Address(0x1))
+              Test.expectFailure(fun () {
+                  Test.executeScript("import Counter from 0x1 pub fun main() {}")
+              })
+          }
+        `)
+
+		result := Runner{}.RunFile(path)
+
+		require.NoError(t, result.Err)
+		require.Len(t, result.Results, 2)
+
+		assert.NoError(t, result.Results[0].Err)
+		assert.Equal(t, []string{"0x0000000000000001"}, result.Results[0].Logs)
+
+		assert.NoError(t, result.Results[1].Err)
+	})
+
+	t.Run("failing transaction", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := runTestCode(t, `
+          pub fun testTransaction() {
+              Test.executeTransaction("transaction { execute { panic(\"nope\") } }")
+          }
+        `)
+
+		require.NoError(t, result.Err)
+		require.Len(t, result.Results, 1)
+
+		var executionErr ExecutionError
+		require.ErrorAs(t, result.Results[0].Err, &executionErr)
+		assert.Equal(t, "transaction", executionErr.Kind)
+		assert.Contains(t, executionErr.Error(), "panic: nope")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := runTestCode(t, `
+          pub fun testInvalid() {
+              Test.expect(1)
+          }
+        `)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, result.Err, &checkerErr)
+		assert.Empty(t, result.Results)
+		assert.False(t, result.Passed())
+	})
+
+	t.Run("import", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := runTestCode(t, `
+          import Counter from 0x1
+        `)
+
+		require.Error(t, result.Err)
+		assert.Contains(t, result.Err.Error(), "imports are not supported in test files")
+	})
+}
+
+func TestDiscoverTestFiles(t *testing.T) {
+
+	t.Parallel()
+
+	directory := t.TempDir()
+
+	nested := filepath.Join(directory, "nested")
+	require.NoError(t, os.Mkdir(nested, 0755))
+
+	a := writeTestFile(t, directory, "a_test.cdc", "")
+	b := writeTestFile(t, nested, "b_test.cdc", "")
+	writeTestFile(t, directory, "a.cdc", "")
+	c := writeTestFile(t, t.TempDir(), "c.cdc", "")
+
+	files, err := DiscoverTestFiles([]string{directory, c})
+	require.NoError(t, err)
+
+	expected := []string{a, b, c}
+	assert.ElementsMatch(t, expected, files)
+	assert.IsIncreasing(t, files)
+
+	_, err = DiscoverTestFiles([]string{filepath.Join(directory, "missing")})
+	require.Error(t, err)
+}
+
+func TestReports(t *testing.T) {
+
+	t.Parallel()
+
+	result := runTestCode(t, `
+      pub fun testPass() {}
+
+      pub fun testFail() {
+          log("hello")
+          Test.assert(false, message: "nope")
+      }
+    `)
+
+	t.Run("text", func(t *testing.T) {
+
+		t.Parallel()
+
+		var buffer bytes.Buffer
+		err := WriteTextReport(&buffer, []*FileResult{result}, false)
+		require.NoError(t, err)
+
+		output := buffer.String()
+		assert.Contains(t, output, "--- PASS: testPass")
+		assert.Contains(t, output, "--- FAIL: testFail")
+		assert.Contains(t, output, `    "hello"`)
+		assert.Contains(t, output, "    error: assertion failed: nope")
+		assert.Contains(t, output, "FAIL\t"+string(result.Location))
+	})
+
+	t.Run("JUnit", func(t *testing.T) {
+
+		t.Parallel()
+
+		var buffer bytes.Buffer
+		err := WriteJUnitReport(&buffer, []*FileResult{result})
+		require.NoError(t, err)
+
+		var report junitTestSuites
+		err = xml.Unmarshal(buffer.Bytes(), &report)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, report.Tests)
+		assert.Equal(t, 1, report.Failures)
+		assert.Equal(t, 0, report.Errors)
+
+		require.Len(t, report.Suites, 1)
+		suite := report.Suites[0]
+		assert.Equal(t, string(result.Location), suite.Name)
+
+		require.Len(t, suite.TestCases, 2)
+		assert.Nil(t, suite.TestCases[0].Failure)
+
+		failed := suite.TestCases[1]
+		assert.Equal(t, "testFail", failed.Name)
+		require.NotNil(t, failed.Failure)
+		assert.Equal(t, "assertion failed: nope", failed.Failure.Message)
+		assert.Equal(t, `"hello"`, failed.SystemOut)
+	})
+}
@@ -0,0 +1,153 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/cmd"
+)
+
+const testFileSuffix = "_test.cdc"
+
+// Test runs the tests in the test files at the given paths.
+//
+// Directories are searched recursively for test files, i.e. files ending in `_test.cdc`.
+// If no paths are given, the current directory is searched.
+//
+// The test functions to run can be restricted using a regular expression (-run),
+// and a JUnit XML report can be written in addition to the text report (-junit).
+// The process exits with a non-zero status if any test fails
+//
+func Test(args []string) {
+	flags := flag.NewFlagSet("test", flag.ExitOnError)
+	runFlag := flags.String("run", "", "only run the test functions matching the regular expression")
+	junitFlag := flags.String("junit", "", "write a JUnit XML report to the file")
+
+	// ExitOnError: errors are reported and the process exits
+	_ = flags.Parse(args)
+
+	runner := Runner{}
+
+	if *runFlag != "" {
+		filter, err := regexp.Compile(*runFlag)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+		runner.Filter = filter
+	}
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	files, err := DiscoverTestFiles(paths)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+
+	if len(files) == 0 {
+		cmd.ExitWithError("no test files found")
+	}
+
+	results := make([]*FileResult, len(files))
+	passed := true
+
+	for i, file := range files {
+		result := runner.RunFile(file)
+		results[i] = result
+		passed = passed && result.Passed()
+	}
+
+	err = WriteTextReport(os.Stdout, results, true)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+
+	if *junitFlag != "" {
+		err = writeJUnitReportFile(*junitFlag, results)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+	}
+
+	if !passed {
+		os.Exit(1)
+	}
+}
+
+func writeJUnitReportFile(path string, results []*FileResult) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		closeErr := file.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+
+	return WriteJUnitReport(file, results)
+}
+
+// DiscoverTestFiles returns the test files at the given paths, sorted.
+// Directories are searched recursively, files are included as-is
+//
+func DiscoverTestFiles(paths []string) ([]string, error) {
+	var files []string
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !info.IsDir() && strings.HasSuffix(path, testFileSuffix) {
+				files = append(files, path)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
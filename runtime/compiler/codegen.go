@@ -29,11 +29,29 @@ import (
 const RuntimeModuleName = "crt"
 
 type wasmCodeGen struct {
-	mod                        *wasm.ModuleBuilder
-	code                       *wasm.Code
-	runtimeFunctionIndexInt    uint32
-	runtimeFunctionIndexString uint32
-	runtimeFunctionIndexAdd    uint32
+	mod                              *wasm.ModuleBuilder
+	code                             *wasm.Code
+	runtimeFunctionCount             uint32
+	runtimeFunctionIndexInt          uint32
+	runtimeFunctionIndexString       uint32
+	runtimeFunctionIndexBool         uint32
+	runtimeFunctionIndexIsTrue       uint32
+	runtimeFunctionIndexAdd          uint32
+	runtimeFunctionIndexSubtract     uint32
+	runtimeFunctionIndexMultiply     uint32
+	runtimeFunctionIndexDivide       uint32
+	runtimeFunctionIndexMod          uint32
+	runtimeFunctionIndexEqual        uint32
+	runtimeFunctionIndexNotEqual     uint32
+	runtimeFunctionIndexLess         uint32
+	runtimeFunctionIndexLessEqual    uint32
+	runtimeFunctionIndexGreater      uint32
+	runtimeFunctionIndexGreaterEqual uint32
+	runtimeFunctionIndexNegate       uint32
+	runtimeFunctionIndexNot          uint32
+	runtimeFunctionIndexNewComposite uint32
+	runtimeFunctionIndexGetField     uint32
+	runtimeFunctionIndexSetField     uint32
 }
 
 func (codeGen *wasmCodeGen) VisitInt(i ir.Int) ir.Repr {
@@ -52,6 +70,18 @@ func (codeGen *wasmCodeGen) VisitString(s ir.String) ir.Repr {
 	return nil
 }
 
+func (codeGen *wasmCodeGen) VisitBool(b ir.Bool) ir.Repr {
+	var value int32
+	if b.Value {
+		value = 1
+	}
+	codeGen.emit(wasm.InstructionI32Const{Value: value})
+	codeGen.emit(wasm.InstructionCall{
+		FuncIndex: codeGen.runtimeFunctionIndexBool,
+	})
+	return nil
+}
+
 func (codeGen *wasmCodeGen) VisitSequence(sequence *ir.Sequence) ir.Repr {
 	for _, stmt := range sequence.Stmts {
 		stmt.Accept(codeGen)
@@ -59,29 +89,58 @@ func (codeGen *wasmCodeGen) VisitSequence(sequence *ir.Sequence) ir.Repr {
 	return nil
 }
 
-func (codeGen *wasmCodeGen) VisitBlock(_ *ir.Block) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitBlock(block *ir.Block) ir.Repr {
+	instructions := codeGen.generateStmts(block.Stmts)
+	codeGen.emit(wasm.InstructionBlock{
+		Block: wasm.Block{
+			Instructions1: instructions,
+		},
+	})
+	return nil
 }
 
-func (codeGen *wasmCodeGen) VisitLoop(_ *ir.Loop) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitLoop(loop *ir.Loop) ir.Repr {
+	instructions := codeGen.generateStmts(loop.Stmts)
+	codeGen.emit(wasm.InstructionLoop{
+		Block: wasm.Block{
+			Instructions1: instructions,
+		},
+	})
+	return nil
 }
 
-func (codeGen *wasmCodeGen) VisitIf(_ *ir.If) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitIf(stmt *ir.If) ir.Repr {
+	codeGen.emitTest(stmt.Test)
+
+	thenInstructions := codeGen.generate(stmt.Then)
+
+	var elseInstructions []wasm.Instruction
+	if stmt.Else != nil {
+		elseInstructions = codeGen.generate(stmt.Else)
+	}
+
+	codeGen.emit(wasm.InstructionIf{
+		Block: wasm.Block{
+			Instructions1: thenInstructions,
+			Instructions2: elseInstructions,
+		},
+	})
+	return nil
 }
 
-func (codeGen *wasmCodeGen) VisitBranch(_ *ir.Branch) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitBranch(branch *ir.Branch) ir.Repr {
+	codeGen.emit(wasm.InstructionBr{
+		LabelIndex: branch.Index,
+	})
+	return nil
 }
 
-func (codeGen *wasmCodeGen) VisitBranchIf(_ *ir.BranchIf) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitBranchIf(branchIf *ir.BranchIf) ir.Repr {
+	codeGen.emitTest(branchIf.Exp)
+	codeGen.emit(wasm.InstructionBrIf{
+		LabelIndex: branchIf.Index,
+	})
+	return nil
 }
 
 func (codeGen *wasmCodeGen) VisitStoreLocal(storeLocal *ir.StoreLocal) ir.Repr {
@@ -92,13 +151,31 @@ func (codeGen *wasmCodeGen) VisitStoreLocal(storeLocal *ir.StoreLocal) ir.Repr {
 	return nil
 }
 
-func (codeGen *wasmCodeGen) VisitDrop(_ *ir.Drop) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitDrop(drop *ir.Drop) ir.Repr {
+	drop.Exp.Accept(codeGen)
+	codeGen.emit(wasm.InstructionDrop{})
+	return nil
+}
+
+func (codeGen *wasmCodeGen) VisitExprStmt(stmt *ir.ExprStmt) ir.Repr {
+	stmt.Exp.Accept(codeGen)
+	return nil
+}
+
+func (codeGen *wasmCodeGen) VisitSetField(setField *ir.SetField) ir.Repr {
+	setField.Exp.Accept(codeGen)
+	codeGen.emitConstant([]byte(setField.Name))
+	setField.Value.Accept(codeGen)
+	codeGen.emit(wasm.InstructionCall{
+		FuncIndex: codeGen.runtimeFunctionIndexSetField,
+	})
+	return nil
 }
 
 func (codeGen *wasmCodeGen) VisitReturn(r *ir.Return) ir.Repr {
-	r.Exp.Accept(codeGen)
+	if r.Exp != nil {
+		r.Exp.Accept(codeGen)
+	}
 	codeGen.emit(wasm.InstructionReturn{})
 	return nil
 }
@@ -121,34 +198,119 @@ func (codeGen *wasmCodeGen) VisitMoveLocal(_ *ir.MoveLocal) ir.Repr {
 	panic(errors.NewUnreachableError())
 }
 
-func (codeGen *wasmCodeGen) VisitUnOpExpr(_ *ir.UnOpExpr) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitUnOpExpr(expr *ir.UnOpExpr) ir.Repr {
+	expr.Expr.Accept(codeGen)
+
+	var funcIndex uint32
+	switch expr.Op {
+	case ir.UnOpNegate:
+		funcIndex = codeGen.runtimeFunctionIndexNegate
+	case ir.UnOpNot:
+		funcIndex = codeGen.runtimeFunctionIndexNot
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	codeGen.emit(wasm.InstructionCall{
+		FuncIndex: funcIndex,
+	})
+	return nil
 }
 
 func (codeGen *wasmCodeGen) VisitBinOpExpr(expr *ir.BinOpExpr) ir.Repr {
 	expr.Left.Accept(codeGen)
 	expr.Right.Accept(codeGen)
-	// TODO: add remaining operations, take types into account
+
+	// TODO: take types into account
+
+	var funcIndex uint32
 	switch expr.Op {
 	case ir.BinOpPlus:
-		codeGen.emit(wasm.InstructionCall{
-			FuncIndex: codeGen.runtimeFunctionIndexAdd,
-		})
-		return nil
+		funcIndex = codeGen.runtimeFunctionIndexAdd
+	case ir.BinOpMinus:
+		funcIndex = codeGen.runtimeFunctionIndexSubtract
+	case ir.BinOpMul:
+		funcIndex = codeGen.runtimeFunctionIndexMultiply
+	case ir.BinOpDiv:
+		funcIndex = codeGen.runtimeFunctionIndexDivide
+	case ir.BinOpMod:
+		funcIndex = codeGen.runtimeFunctionIndexMod
+	case ir.BinOpEqual:
+		funcIndex = codeGen.runtimeFunctionIndexEqual
+	case ir.BinOpNotEqual:
+		funcIndex = codeGen.runtimeFunctionIndexNotEqual
+	case ir.BinOpLess:
+		funcIndex = codeGen.runtimeFunctionIndexLess
+	case ir.BinOpLessEqual:
+		funcIndex = codeGen.runtimeFunctionIndexLessEqual
+	case ir.BinOpGreater:
+		funcIndex = codeGen.runtimeFunctionIndexGreater
+	case ir.BinOpGreaterEqual:
+		funcIndex = codeGen.runtimeFunctionIndexGreaterEqual
+	default:
+		panic(errors.NewUnreachableError())
 	}
-	panic(errors.NewUnreachableError())
+
+	codeGen.emit(wasm.InstructionCall{
+		FuncIndex: funcIndex,
+	})
+	return nil
 }
 
-func (codeGen *wasmCodeGen) VisitCall(_ *ir.Call) ir.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (codeGen *wasmCodeGen) VisitCall(call *ir.Call) ir.Repr {
+	for _, argument := range call.Arguments {
+		argument.Accept(codeGen)
+	}
+	// function indices include the imported runtime functions
+	codeGen.emit(wasm.InstructionCall{
+		FuncIndex: codeGen.runtimeFunctionCount + call.FunctionIndex,
+	})
+	return nil
+}
+
+func (codeGen *wasmCodeGen) VisitIfExpr(expr *ir.IfExpr) ir.Repr {
+	codeGen.emitTest(expr.Test)
+
+	thenInstructions := codeGen.generate(expr.Then)
+	elseInstructions := codeGen.generate(expr.Else)
+
+	codeGen.emit(wasm.InstructionIf{
+		Block: wasm.Block{
+			BlockType:     wasm.ValueTypeExternRef,
+			Instructions1: thenInstructions,
+			Instructions2: elseInstructions,
+		},
+	})
+	return nil
+}
+
+func (codeGen *wasmCodeGen) VisitNewComposite(expr *ir.NewComposite) ir.Repr {
+	codeGen.emitConstantCall(
+		codeGen.runtimeFunctionIndexNewComposite,
+		[]byte(expr.QualifiedIdentifier),
+	)
+	return nil
+}
+
+func (codeGen *wasmCodeGen) VisitGetField(expr *ir.GetField) ir.Repr {
+	expr.Exp.Accept(codeGen)
+	codeGen.emitConstantCall(
+		codeGen.runtimeFunctionIndexGetField,
+		[]byte(expr.Name),
+	)
+	return nil
 }
 
 func (codeGen *wasmCodeGen) VisitFunc(f *ir.Func) ir.Repr {
 	codeGen.code = &wasm.Code{}
 	codeGen.code.Locals = generateWasmLocalTypes(f.Locals)
 	f.Statement.Accept(codeGen)
+	if len(f.Type.Results) > 0 {
+		// all paths of the function return explicitly,
+		// but the validation of the function requires
+		// a result at the end of the function body
+		codeGen.emit(wasm.InstructionUnreachable{})
+	}
 	functionType := generateWasmFunctionType(f.Type)
 	funcIndex := codeGen.mod.AddFunction(f.Name, functionType, codeGen.code)
 	// TODO: make export dependent on visibility modifier
@@ -165,6 +327,32 @@ func (codeGen *wasmCodeGen) emit(inst wasm.Instruction) {
 	codeGen.code.Instructions = append(codeGen.code.Instructions, inst)
 }
 
+// generate generates the instructions for the given IR node,
+// without emitting them into the current code
+//
+func (codeGen *wasmCodeGen) generate(node interface{ Accept(ir.Visitor) ir.Repr }) []wasm.Instruction {
+	instructions := codeGen.code.Instructions
+	codeGen.code.Instructions = nil
+	node.Accept(codeGen)
+	generated := codeGen.code.Instructions
+	codeGen.code.Instructions = instructions
+	return generated
+}
+
+func (codeGen *wasmCodeGen) generateStmts(stmts []ir.Stmt) []wasm.Instruction {
+	return codeGen.generate(&ir.Sequence{Stmts: stmts})
+}
+
+// emitTest emits the instructions for the given boolean expression,
+// and converts the result into an i32, as required by conditional instructions
+//
+func (codeGen *wasmCodeGen) emitTest(test ir.Expr) {
+	test.Accept(codeGen)
+	codeGen.emit(wasm.InstructionCall{
+		FuncIndex: codeGen.runtimeFunctionIndexIsTrue,
+	})
+}
+
 func (codeGen *wasmCodeGen) addConstant(value []byte) uint32 {
 	offset := codeGen.mod.RequireMemory(uint32(len(value)))
 	// TODO: optimize:
@@ -174,13 +362,18 @@ func (codeGen *wasmCodeGen) addConstant(value []byte) uint32 {
 	return offset
 }
 
-func (codeGen *wasmCodeGen) emitConstantCall(funcIndex uint32, value []byte) {
+// emitConstant emits the memory offset and the length of the given constant
+//
+func (codeGen *wasmCodeGen) emitConstant(value []byte) {
 	memoryOffset := codeGen.addConstant(value)
 	codeGen.emit(wasm.InstructionI32Const{Value: int32(memoryOffset)})
 
 	length := int32(len(value))
 	codeGen.emit(wasm.InstructionI32Const{Value: length})
+}
 
+func (codeGen *wasmCodeGen) emitConstantCall(funcIndex uint32, value []byte) {
+	codeGen.emitConstant(value)
 	codeGen.emit(wasm.InstructionCall{FuncIndex: funcIndex})
 }
 
@@ -196,7 +389,35 @@ var constantFunctionType = &wasm.FunctionType{
 	},
 }
 
-var addFunctionType = &wasm.FunctionType{
+var boolFunctionType = &wasm.FunctionType{
+	Params: []wasm.ValueType{
+		// 0 (false) or 1 (true)
+		wasm.ValueTypeI32,
+	},
+	Results: []wasm.ValueType{
+		wasm.ValueTypeExternRef,
+	},
+}
+
+var isTrueFunctionType = &wasm.FunctionType{
+	Params: []wasm.ValueType{
+		wasm.ValueTypeExternRef,
+	},
+	Results: []wasm.ValueType{
+		wasm.ValueTypeI32,
+	},
+}
+
+var unaryFunctionType = &wasm.FunctionType{
+	Params: []wasm.ValueType{
+		wasm.ValueTypeExternRef,
+	},
+	Results: []wasm.ValueType{
+		wasm.ValueTypeExternRef,
+	},
+}
+
+var binaryFunctionType = &wasm.FunctionType{
 	Params: []wasm.ValueType{
 		wasm.ValueTypeExternRef,
 		wasm.ValueTypeExternRef,
@@ -206,11 +427,55 @@ var addFunctionType = &wasm.FunctionType{
 	},
 }
 
+var getFieldFunctionType = &wasm.FunctionType{
+	Params: []wasm.ValueType{
+		// composite
+		wasm.ValueTypeExternRef,
+		// memory offset of name
+		wasm.ValueTypeI32,
+		// length of name
+		wasm.ValueTypeI32,
+	},
+	Results: []wasm.ValueType{
+		wasm.ValueTypeExternRef,
+	},
+}
+
+var setFieldFunctionType = &wasm.FunctionType{
+	Params: []wasm.ValueType{
+		// composite
+		wasm.ValueTypeExternRef,
+		// memory offset of name
+		wasm.ValueTypeI32,
+		// length of name
+		wasm.ValueTypeI32,
+		// value
+		wasm.ValueTypeExternRef,
+	},
+}
+
 func (codeGen *wasmCodeGen) addRuntimeImports() {
 	// NOTE: ensure to update the imports in the vm
 	codeGen.runtimeFunctionIndexInt = codeGen.addRuntimeImport("Int", constantFunctionType)
 	codeGen.runtimeFunctionIndexString = codeGen.addRuntimeImport("String", constantFunctionType)
-	codeGen.runtimeFunctionIndexAdd = codeGen.addRuntimeImport("add", addFunctionType)
+	codeGen.runtimeFunctionIndexAdd = codeGen.addRuntimeImport("add", binaryFunctionType)
+	codeGen.runtimeFunctionIndexBool = codeGen.addRuntimeImport("Bool", boolFunctionType)
+	codeGen.runtimeFunctionIndexIsTrue = codeGen.addRuntimeImport("isTrue", isTrueFunctionType)
+	codeGen.runtimeFunctionIndexSubtract = codeGen.addRuntimeImport("subtract", binaryFunctionType)
+	codeGen.runtimeFunctionIndexMultiply = codeGen.addRuntimeImport("multiply", binaryFunctionType)
+	codeGen.runtimeFunctionIndexDivide = codeGen.addRuntimeImport("divide", binaryFunctionType)
+	codeGen.runtimeFunctionIndexMod = codeGen.addRuntimeImport("mod", binaryFunctionType)
+	codeGen.runtimeFunctionIndexEqual = codeGen.addRuntimeImport("equal", binaryFunctionType)
+	codeGen.runtimeFunctionIndexNotEqual = codeGen.addRuntimeImport("notEqual", binaryFunctionType)
+	codeGen.runtimeFunctionIndexLess = codeGen.addRuntimeImport("less", binaryFunctionType)
+	codeGen.runtimeFunctionIndexLessEqual = codeGen.addRuntimeImport("lessEqual", binaryFunctionType)
+	codeGen.runtimeFunctionIndexGreater = codeGen.addRuntimeImport("greater", binaryFunctionType)
+	codeGen.runtimeFunctionIndexGreaterEqual = codeGen.addRuntimeImport("greaterEqual", binaryFunctionType)
+	codeGen.runtimeFunctionIndexNegate = codeGen.addRuntimeImport("negate", unaryFunctionType)
+	codeGen.runtimeFunctionIndexNot = codeGen.addRuntimeImport("not", unaryFunctionType)
+	codeGen.runtimeFunctionIndexNewComposite = codeGen.addRuntimeImport("newComposite", constantFunctionType)
+	codeGen.runtimeFunctionIndexGetField = codeGen.addRuntimeImport("getField", getFieldFunctionType)
+	codeGen.runtimeFunctionIndexSetField = codeGen.addRuntimeImport("setField", setFieldFunctionType)
 }
 
 func (codeGen *wasmCodeGen) addRuntimeImport(name string, funcType *wasm.FunctionType) uint32 {
@@ -218,6 +483,7 @@ func (codeGen *wasmCodeGen) addRuntimeImport(name string, funcType *wasm.Functio
 	if err != nil {
 		panic(fmt.Errorf("failed to add runtime import of function %s: %w", name, err))
 	}
+	codeGen.runtimeFunctionCount++
 	return funcIndex
 }
 
@@ -249,7 +515,9 @@ func generateWasmValType(valType ir.ValType) wasm.ValueType {
 	// TODO: add remaining types
 	switch valType {
 	case ir.ValTypeInt,
-		ir.ValTypeString:
+		ir.ValTypeString,
+		ir.ValTypeBool,
+		ir.ValTypeComposite:
 
 		return wasm.ValueTypeExternRef
 	}
@@ -68,131 +68,255 @@ func TestWasmCodeGenSimple(t *testing.T) {
 		},
 	})
 
+	// function type of inc
+
 	require.Equal(t,
-		&wasm.Module{
-			Types: []*wasm.FunctionType{
-				// function type of crt.Int
-				{
-					Params: []wasm.ValueType{
-						wasm.ValueTypeI32,
-						wasm.ValueTypeI32,
-					},
-					Results: []wasm.ValueType{
-						wasm.ValueTypeExternRef,
-					},
-				},
-				// function type of crt.String
-				{
-					Params: []wasm.ValueType{
-						wasm.ValueTypeI32,
-						wasm.ValueTypeI32,
-					},
-					Results: []wasm.ValueType{
-						wasm.ValueTypeExternRef,
-					},
-				},
-				// function type of add
-				{
-					Params: []wasm.ValueType{
-						wasm.ValueTypeExternRef,
-						wasm.ValueTypeExternRef,
-					},
-					Results: []wasm.ValueType{
+		&wasm.FunctionType{
+			Params: []wasm.ValueType{
+				wasm.ValueTypeExternRef,
+			},
+			Results: []wasm.ValueType{
+				wasm.ValueTypeExternRef,
+			},
+		},
+		mod.Types[20],
+	)
+
+	require.Equal(t,
+		[]*wasm.Function{
+			{
+				Name:      "inc",
+				TypeIndex: 20,
+				Code: &wasm.Code{
+					Locals: []wasm.ValueType{
 						wasm.ValueTypeExternRef,
-					},
-				},
-				// function type of inc
-				{
-					Params: []wasm.ValueType{
 						wasm.ValueTypeExternRef,
 					},
-					Results: []wasm.ValueType{
-						wasm.ValueTypeExternRef,
+					Instructions: []wasm.Instruction{
+						wasm.InstructionI32Const{Value: 0},
+						wasm.InstructionI32Const{Value: 2},
+						wasm.InstructionCall{FuncIndex: 0},
+						wasm.InstructionLocalSet{LocalIndex: 1},
+						wasm.InstructionLocalGet{LocalIndex: 0},
+						wasm.InstructionLocalGet{LocalIndex: 1},
+						wasm.InstructionCall{FuncIndex: 2},
+						wasm.InstructionReturn{},
+						wasm.InstructionUnreachable{},
 					},
 				},
 			},
-			Imports: []*wasm.Import{
-				{
-					Module:    RuntimeModuleName,
-					Name:      "Int",
-					TypeIndex: 0,
-				},
-				{
-					Module:    RuntimeModuleName,
-					Name:      "String",
-					TypeIndex: 1,
+		},
+		mod.Functions,
+	)
+
+	require.Equal(t,
+		[]*wasm.Memory{
+			{
+				Min: 1,
+				Max: nil,
+			},
+		},
+		mod.Memories,
+	)
+
+	require.Equal(t,
+		[]*wasm.Data{
+			// load [0x1, 0x1] at offset 0
+			{
+				MemoryIndex: 0,
+				Offset: []wasm.Instruction{
+					wasm.InstructionI32Const{Value: 0},
 				},
-				{
-					Module:    RuntimeModuleName,
-					Name:      "add",
-					TypeIndex: 2,
+				Init: []byte{
+					// positive flag
+					0x1,
+					// integer 1
+					0x1,
 				},
 			},
-			Functions: []*wasm.Function{
-				{
-					Name:      "inc",
-					TypeIndex: 3,
-					Code: &wasm.Code{
-						Locals: []wasm.ValueType{
-							wasm.ValueTypeExternRef,
-							wasm.ValueTypeExternRef,
-						},
-						Instructions: []wasm.Instruction{
-							wasm.InstructionI32Const{Value: 0},
-							wasm.InstructionI32Const{Value: 2},
-							wasm.InstructionCall{FuncIndex: 0},
-							wasm.InstructionLocalSet{LocalIndex: 1},
-							wasm.InstructionLocalGet{LocalIndex: 0},
-							wasm.InstructionLocalGet{LocalIndex: 1},
-							wasm.InstructionCall{FuncIndex: 2},
-							wasm.InstructionReturn{},
-						},
-					},
+		},
+		mod.Data,
+	)
+
+	require.Equal(t,
+		[]*wasm.Export{
+			{
+				Name: "inc",
+				Descriptor: wasm.FunctionExport{
+					FunctionIndex: 20,
 				},
 			},
-			Memories: []*wasm.Memory{
-				{
-					Min: 1,
-					Max: nil,
+			{
+				Name: "mem",
+				Descriptor: wasm.MemoryExport{
+					MemoryIndex: 0,
 				},
 			},
-			Data: []*wasm.Data{
-				// load [0x1, 0x1] at offset 0
-				{
-					MemoryIndex: 0,
-					Offset: []wasm.Instruction{
-						wasm.InstructionI32Const{Value: 0},
-					},
-					Init: []byte{
-						// positive flag
-						0x1,
-						// integer 1
-						0x1,
-					},
+		},
+		mod.Exports,
+	)
+
+	var buf wasm.Buffer
+	w := wasm.NewWASMWriter(&buf)
+	err := w.WriteModule(mod)
+	require.NoError(t, err)
+
+	_ = wasm.WASM2WAT(buf.Bytes())
+}
+
+func TestWasmCodeGenRuntimeImports(t *testing.T) {
+
+	mod := GenerateWasm(nil)
+
+	// NOTE: the imports are also declared by the VM
+
+	names := make([]string, len(mod.Imports))
+	for i, imp := range mod.Imports {
+		require.Equal(t, RuntimeModuleName, imp.Module)
+		require.Equal(t, uint32(i), imp.TypeIndex)
+		names[i] = imp.Name
+	}
+
+	require.Equal(t,
+		[]string{
+			"Int",
+			"String",
+			"add",
+			"Bool",
+			"isTrue",
+			"subtract",
+			"multiply",
+			"divide",
+			"mod",
+			"equal",
+			"notEqual",
+			"less",
+			"lessEqual",
+			"greater",
+			"greaterEqual",
+			"negate",
+			"not",
+			"newComposite",
+			"getField",
+			"setField",
+		},
+		names,
+	)
+
+	require.Equal(t,
+		&wasm.FunctionType{
+			Params: []wasm.ValueType{
+				wasm.ValueTypeI32,
+			},
+			Results: []wasm.ValueType{
+				wasm.ValueTypeExternRef,
+			},
+		},
+		mod.Types[3],
+	)
+
+	require.Equal(t,
+		&wasm.FunctionType{
+			Params: []wasm.ValueType{
+				wasm.ValueTypeExternRef,
+			},
+			Results: []wasm.ValueType{
+				wasm.ValueTypeI32,
+			},
+		},
+		mod.Types[4],
+	)
+}
+
+func TestWasmCodeGenControlFlow(t *testing.T) {
+
+	// A loop which counts down the parameter,
+	// and calls the function itself
+
+	mod := GenerateWasm([]*ir.Func{
+		{
+			Name: "countDown",
+			Type: ir.FuncType{
+				Params: []ir.ValType{
+					ir.ValTypeInt,
 				},
 			},
-			Exports: []*wasm.Export{
-				{
-					Name: "inc",
-					Descriptor: wasm.FunctionExport{
-						FunctionIndex: 3,
+			Statement: &ir.Block{
+				Stmts: []ir.Stmt{
+					&ir.Loop{
+						Stmts: []ir.Stmt{
+							&ir.BranchIf{
+								Exp: &ir.UnOpExpr{
+									Op: ir.UnOpNot,
+									Expr: &ir.Const{
+										Constant: ir.Bool{Value: true},
+									},
+								},
+								Index: 1,
+							},
+							&ir.If{
+								Test: &ir.CopyLocal{LocalIndex: 0},
+								Then: &ir.ExprStmt{
+									Exp: &ir.Call{
+										FunctionIndex: 0,
+										Arguments: []ir.Expr{
+											&ir.CopyLocal{LocalIndex: 0},
+										},
+									},
+								},
+								Else: &ir.Return{},
+							},
+							&ir.Branch{Index: 0},
+						},
 					},
 				},
-				{
-					Name: "mem",
-					Descriptor: wasm.MemoryExport{
-						MemoryIndex: 0,
+			},
+		},
+	})
+
+	require.Len(t, mod.Functions, 1)
+
+	require.Equal(t,
+		[]wasm.Instruction{
+			wasm.InstructionBlock{
+				Block: wasm.Block{
+					Instructions1: []wasm.Instruction{
+						wasm.InstructionLoop{
+							Block: wasm.Block{
+								Instructions1: []wasm.Instruction{
+									// the negated test
+									wasm.InstructionI32Const{Value: 1},
+									wasm.InstructionCall{FuncIndex: 3},
+									wasm.InstructionCall{FuncIndex: 16},
+									wasm.InstructionCall{FuncIndex: 4},
+									wasm.InstructionBrIf{LabelIndex: 1},
+									// the if statement
+									wasm.InstructionLocalGet{LocalIndex: 0},
+									wasm.InstructionCall{FuncIndex: 4},
+									wasm.InstructionIf{
+										Block: wasm.Block{
+											Instructions1: []wasm.Instruction{
+												wasm.InstructionLocalGet{LocalIndex: 0},
+												wasm.InstructionCall{FuncIndex: 20},
+											},
+											Instructions2: []wasm.Instruction{
+												wasm.InstructionReturn{},
+											},
+										},
+									},
+									wasm.InstructionBr{LabelIndex: 0},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
-		mod,
+		mod.Functions[0].Code.Instructions,
 	)
 
 	var buf wasm.Buffer
 	w := wasm.NewWASMWriter(&buf)
 	err := w.WriteModule(mod)
 	require.NoError(t, err)
-
-	_ = wasm.WASM2WAT(buf.Bytes())
 }
@@ -19,25 +19,75 @@
 package compiler
 
 import (
+	"fmt"
+
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/compiler/ir"
 	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
 type Compiler struct {
-	Checker     *sema.Checker
-	activations *LocalActivations
-	locals      []*Local
+	Checker         *sema.Checker
+	activations     *LocalActivations
+	locals          []*Local
+	functionIndices map[string]uint32
+	functionCount   uint32
+	// constructorSelf is the local of the constructed composite,
+	// if the current function is a constructor
+	constructorSelf *Local
+	// labelDepth is the number of labels (blocks, loops, and ifs)
+	// enclosing the current statement
+	labelDepth int
+	loops      []loop
+}
+
+// loop records the label depths of the targets
+// of break and continue statements in a loop
+//
+type loop struct {
+	breakDepth    int
+	continueDepth int
 }
 
+type functionKind uint
+
+const (
+	functionKindFunction functionKind = iota
+	functionKindMethod
+	functionKindConstructor
+)
+
 func NewCompiler(checker *sema.Checker) *Compiler {
 	return &Compiler{
-		Checker:     checker,
-		activations: &LocalActivations{},
+		Checker:         checker,
+		activations:     &LocalActivations{},
+		functionIndices: map[string]uint32{},
 	}
 }
 
+// Compile compiles the functions and composites of the checked program.
+//
+// If the program uses a construct which is not supported yet,
+// an UnsupportedError is returned
+//
+func Compile(checker *sema.Checker) (funcs []*ir.Func, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			unsupportedErr, ok := r.(*UnsupportedError)
+			if !ok {
+				panic(r)
+			}
+			err = unsupportedErr
+		}
+	}()
+
+	compiler := NewCompiler(checker)
+	funcs = checker.Program.Accept(compiler).([]*ir.Func)
+	return funcs, nil
+}
+
 // declareLocal declares a local
 func (compiler *Compiler) declareLocal(identifier string, valType ir.ValType) *Local {
 	// NOTE: semantic analysis already checked possible invalid redeclaration
@@ -56,7 +106,39 @@ func (compiler *Compiler) setLocal(name string, variable *Local) {
 	compiler.activations.Set(name, variable)
 }
 
+// declareFunction assigns the next function index to the function with the given name
+//
+func (compiler *Compiler) declareFunction(name string) {
+	compiler.functionIndices[name] = compiler.functionCount
+	compiler.functionCount++
+}
+
+// findFunction returns the function index of the function with the given name.
+// Functions which were not declared by the compiled program, e.g. built-in functions,
+// are not supported yet
+//
+func (compiler *Compiler) findFunction(name string, hasPosition ast.HasPosition) uint32 {
+	index, ok := compiler.functionIndices[name]
+	if !ok {
+		panic(newUnsupportedError("built-in functions", hasPosition))
+	}
+	return index
+}
+
 func (compiler *Compiler) VisitReturnStatement(statement *ast.ReturnStatement) ast.Repr {
+	if statement.Expression == nil {
+		// Constructors return the constructed composite
+		if compiler.constructorSelf != nil {
+			return &ir.Return{
+				Exp: &ir.CopyLocal{
+					LocalIndex: compiler.constructorSelf.Index,
+				},
+			}
+		}
+
+		return &ir.Return{}
+	}
+
 	exp := statement.Expression.Accept(compiler).(ir.Expr)
 	return &ir.Return{
 		Exp: exp,
@@ -64,38 +146,223 @@ func (compiler *Compiler) VisitReturnStatement(statement *ast.ReturnStatement) a
 }
 
 func (compiler *Compiler) VisitBreakStatement(_ *ast.BreakStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+	loop := compiler.loops[len(compiler.loops)-1]
+	return &ir.Branch{
+		Index: uint32(compiler.labelDepth - loop.breakDepth),
+	}
 }
 
 func (compiler *Compiler) VisitContinueStatement(_ *ast.ContinueStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+	loop := compiler.loops[len(compiler.loops)-1]
+	return &ir.Branch{
+		Index: uint32(compiler.labelDepth - loop.continueDepth),
+	}
 }
 
-func (compiler *Compiler) VisitIfStatement(_ *ast.IfStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
+
+	testExpression, ok := statement.Test.(ast.Expression)
+	if !ok {
+		panic(newUnsupportedError("optional binding", statement))
+	}
+
+	test := testExpression.Accept(compiler).(ir.Expr)
+
+	// The branches are nested in the label of the if
+
+	compiler.labelDepth++
+	defer func() {
+		compiler.labelDepth--
+	}()
+
+	then := statement.Then.Accept(compiler).(ir.Stmt)
+
+	var els ir.Stmt
+	if statement.Else != nil {
+		els = statement.Else.Accept(compiler).(ir.Stmt)
+	}
+
+	return &ir.If{
+		Test: test,
+		Then: then,
+		Else: els,
+	}
 }
 
-func (compiler *Compiler) VisitWhileStatement(_ *ast.WhileStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitWhileStatement(statement *ast.WhileStatement) ast.Repr {
+
+	// A while loop is compiled to a loop nested in a block:
+	// Breaking out of the block ends the loop,
+	// and branching to the loop starts the next iteration.
+
+	compiler.labelDepth += 2
+	compiler.loops = append(compiler.loops, loop{
+		breakDepth:    compiler.labelDepth - 1,
+		continueDepth: compiler.labelDepth,
+	})
+	defer func() {
+		compiler.loops = compiler.loops[:len(compiler.loops)-1]
+		compiler.labelDepth -= 2
+	}()
+
+	test := statement.Test.Accept(compiler).(ir.Expr)
+	body := statement.Block.Accept(compiler).(ir.Stmt)
+
+	return &ir.Block{
+		Stmts: []ir.Stmt{
+			&ir.Loop{
+				Stmts: []ir.Stmt{
+					// break out of the block if the test is false
+					&ir.BranchIf{
+						Exp: &ir.UnOpExpr{
+							Op:   ir.UnOpNot,
+							Expr: test,
+						},
+						Index: 1,
+					},
+					body,
+					// continue with the next iteration
+					&ir.Branch{
+						Index: 0,
+					},
+				},
+			},
+		},
+	}
 }
 
-func (compiler *Compiler) VisitForStatement(_ *ast.ForStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitForStatement(statement *ast.ForStatement) ast.Repr {
+	// Arrays are not supported yet, so there is nothing to iterate over
+	panic(newUnsupportedError("for-in loops", statement))
 }
 
-func (compiler *Compiler) VisitEmitStatement(_ *ast.EmitStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitEmitStatement(statement *ast.EmitStatement) ast.Repr {
+	panic(newUnsupportedError("events", statement))
 }
 
-func (compiler *Compiler) VisitSwitchStatement(_ *ast.SwitchStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitSwitchStatement(statement *ast.SwitchStatement) ast.Repr {
+
+	// A switch statement is compiled to a block,
+	// which contains an if statement for each case.
+	// The if statement compares the tested value, which is stored in a local,
+	// with the case's expression, and if equal, executes the case's statements
+	// and breaks out of the block.
+	// The statements of the default case, if any, are at the end of the block.
+	//
+	// A break statement in a case breaks out of the block,
+	// a continue statement continues the enclosing loop, if any
+
+	testType := compiler.Checker.Elaboration.SwitchStatementTestTypes[statement]
+	testValType := compileValueType(testType, statement.Expression)
+
+	// NOTE: The local is not declared in an activation,
+	// as it is only referred to by the compiled statements
+	index := uint32(len(compiler.locals))
+	testLocal := NewLocal(index, testValType)
+	compiler.locals = append(compiler.locals, testLocal)
+
+	test := statement.Expression.Accept(compiler).(ir.Expr)
+
+	compiler.labelDepth++
+
+	continueDepth := -1
+	if len(compiler.loops) > 0 {
+		continueDepth = compiler.loops[len(compiler.loops)-1].continueDepth
+	}
+
+	compiler.loops = append(compiler.loops, loop{
+		breakDepth:    compiler.labelDepth,
+		continueDepth: continueDepth,
+	})
+
+	defer func() {
+		compiler.loops = compiler.loops[:len(compiler.loops)-1]
+		compiler.labelDepth--
+	}()
+
+	stmts := []ir.Stmt{
+		&ir.StoreLocal{
+			LocalIndex: testLocal.Index,
+			Exp:        test,
+		},
+	}
+
+	for _, switchCase := range statement.Cases {
+
+		if switchCase.Pattern != nil {
+			panic(newUnsupportedError("switch case patterns", switchCase))
+		}
+
+		// The default case has no expression
+
+		if switchCase.Expression == nil {
+			stmts = append(stmts, compiler.compileSwitchCaseStatements(switchCase))
+			continue
+		}
+
+		caseExp := switchCase.Expression.Accept(compiler).(ir.Expr)
+
+		// The statements of the case are nested in the label of the if
+
+		compiler.labelDepth++
+		caseStmt := compiler.compileSwitchCaseStatements(switchCase)
+		compiler.labelDepth--
+
+		stmts = append(stmts,
+			&ir.If{
+				Test: &ir.BinOpExpr{
+					Op: ir.BinOpEqual,
+					Left: &ir.CopyLocal{
+						LocalIndex: testLocal.Index,
+					},
+					Right: caseExp,
+				},
+				Then: &ir.Sequence{
+					Stmts: []ir.Stmt{
+						caseStmt,
+						// break out of the switch's block,
+						// which encloses the if
+						&ir.Branch{
+							Index: 1,
+						},
+					},
+				},
+			},
+		)
+	}
+
+	return &ir.Block{
+		Stmts: stmts,
+	}
+}
+
+// compileSwitchCaseStatements compiles the statements of the given switch case.
+// Like a block, the case has its own scope
+//
+func (compiler *Compiler) compileSwitchCaseStatements(switchCase *ast.SwitchCase) ir.Stmt {
+	compiler.activations.PushNewWithCurrent()
+	defer compiler.activations.Pop()
+
+	return &ir.Sequence{
+		Stmts: compiler.compileStatements(switchCase.Statements),
+	}
+}
+
+// compileStatements compiles the statements of a block or switch case
+//
+func (compiler *Compiler) compileStatements(statements []ast.Statement) []ir.Stmt {
+	stmts := make([]ir.Stmt, len(statements))
+	for i, statement := range statements {
+		if _, ok := statement.(*ast.FunctionDeclaration); ok {
+			panic(newUnsupportedError("nested functions", statement))
+		}
+		stmts[i] = statement.Accept(compiler).(ir.Stmt)
+	}
+	return stmts
+}
+
+func (compiler *Compiler) VisitDestructuringDeclaration(declaration *ast.DestructuringDeclaration) ast.Repr {
+	panic(newUnsupportedError("destructuring declarations", declaration))
 }
 
 func (compiler *Compiler) VisitVariableDeclaration(declaration *ast.VariableDeclaration) ast.Repr {
@@ -106,7 +373,7 @@ func (compiler *Compiler) VisitVariableDeclaration(declaration *ast.VariableDecl
 
 	identifier := declaration.Identifier.Identifier
 	targetType := compiler.Checker.Elaboration.VariableDeclarationTargetTypes[declaration]
-	valType := compileValueType(targetType)
+	valType := compileValueType(targetType, declaration)
 	local := compiler.declareLocal(identifier, valType)
 	exp := declaration.Value.Accept(compiler).(ir.Expr)
 
@@ -116,29 +383,72 @@ func (compiler *Compiler) VisitVariableDeclaration(declaration *ast.VariableDecl
 	}
 }
 
-func (compiler *Compiler) VisitAssignmentStatement(_ *ast.AssignmentStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitAssignmentStatement(statement *ast.AssignmentStatement) ast.Repr {
+
+	// TODO: copy and convert
+
+	value := statement.Value.Accept(compiler).(ir.Expr)
+
+	switch target := statement.Target.(type) {
+	case *ast.IdentifierExpression:
+		local := compiler.findLocal(target.Identifier.Identifier)
+		if local == nil {
+			panic(newUnsupportedError("global variables", target))
+		}
+		return &ir.StoreLocal{
+			LocalIndex: local.Index,
+			Exp:        value,
+		}
+
+	case *ast.MemberExpression:
+		if target.Optional {
+			panic(newUnsupportedError("optional chaining", target))
+		}
+		exp := target.Expression.Accept(compiler).(ir.Expr)
+		return &ir.SetField{
+			Exp:   exp,
+			Name:  target.Identifier.Identifier,
+			Value: value,
+		}
+	}
+
+	panic(newUnsupportedError("index expressions", statement.Target))
 }
 
-func (compiler *Compiler) VisitSwapStatement(_ *ast.SwapStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitSwapStatement(statement *ast.SwapStatement) ast.Repr {
+	panic(newUnsupportedError("swap statements", statement))
 }
 
-func (compiler *Compiler) VisitExpressionStatement(_ *ast.ExpressionStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitExpressionStatement(statement *ast.ExpressionStatement) ast.Repr {
+	exp := statement.Expression.Accept(compiler).(ir.Expr)
+
+	// Invocations of functions without a return type have no result,
+	// all other expressions have a result, which must be dropped
+
+	if invocation, ok := statement.Expression.(*ast.InvocationExpression); ok {
+		returnType := compiler.Checker.Elaboration.InvocationExpressionReturnTypes[invocation]
+		if returnType == sema.VoidType {
+			return &ir.ExprStmt{
+				Exp: exp,
+			}
+		}
+	}
+
+	return &ir.Drop{
+		Exp: exp,
+	}
 }
 
-func (compiler *Compiler) VisitBoolExpression(_ *ast.BoolExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitBoolExpression(expression *ast.BoolExpression) ast.Repr {
+	return &ir.Const{
+		Constant: ir.Bool{
+			Value: expression.Value,
+		},
+	}
 }
 
-func (compiler *Compiler) VisitNilExpression(_ *ast.NilExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitNilExpression(expression *ast.NilExpression) ast.Repr {
+	panic(newUnsupportedError("nil", expression))
 }
 
 func (compiler *Compiler) VisitIntegerExpression(expression *ast.IntegerExpression) ast.Repr {
@@ -161,60 +471,168 @@ func (compiler *Compiler) VisitIntegerExpression(expression *ast.IntegerExpressi
 	}
 }
 
-func (compiler *Compiler) VisitFixedPointExpression(_ *ast.FixedPointExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitFixedPointExpression(expression *ast.FixedPointExpression) ast.Repr {
+	panic(newUnsupportedError("fixed-point numbers", expression))
 }
 
-func (compiler *Compiler) VisitArrayExpression(_ *ast.ArrayExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitArrayExpression(expression *ast.ArrayExpression) ast.Repr {
+	panic(newUnsupportedError("arrays", expression))
 }
 
-func (compiler *Compiler) VisitDictionaryExpression(_ *ast.DictionaryExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitDictionaryExpression(expression *ast.DictionaryExpression) ast.Repr {
+	panic(newUnsupportedError("dictionaries", expression))
 }
 
 func (compiler *Compiler) VisitIdentifierExpression(expression *ast.IdentifierExpression) ast.Repr {
-	// TODO
 	local := compiler.findLocal(expression.Identifier.Identifier)
+	if local == nil {
+		panic(newUnsupportedError("global variables and function values", expression))
+	}
 	// TODO: moves
 	return &ir.CopyLocal{
 		LocalIndex: local.Index,
 	}
 }
 
-func (compiler *Compiler) VisitInvocationExpression(_ *ast.InvocationExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitInvocationExpression(expression *ast.InvocationExpression) ast.Repr {
+
+	var functionIndex uint32
+	var arguments []ir.Expr
+
+	switch invokedExpression := expression.InvokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		// Global function or constructor
+		functionIndex = compiler.findFunction(
+			invokedExpression.Identifier.Identifier,
+			invokedExpression,
+		)
+
+	case *ast.MemberExpression:
+		// Composite function: the composite is passed as the first argument
+		memberInfo := compiler.Checker.Elaboration.MemberExpressionMemberInfos[invokedExpression]
+		if invokedExpression.Optional {
+			panic(newUnsupportedError("optional chaining", invokedExpression))
+		}
+		compositeType, ok := memberInfo.AccessedType.(*sema.CompositeType)
+		if !ok {
+			panic(newUnsupportedError(
+				fmt.Sprintf("members of type `%s`", memberInfo.AccessedType.QualifiedString()),
+				invokedExpression,
+			))
+		}
+
+		functionIndex = compiler.findFunction(
+			compositeFunctionName(
+				compositeType.QualifiedIdentifier(),
+				invokedExpression.Identifier.Identifier,
+			),
+			invokedExpression,
+		)
+
+		self := invokedExpression.Expression.Accept(compiler).(ir.Expr)
+		arguments = append(arguments, self)
+
+	default:
+		panic(newUnsupportedError("function values", expression.InvokedExpression))
+	}
+
+	for _, argument := range expression.Arguments {
+		arguments = append(arguments,
+			argument.Expression.Accept(compiler).(ir.Expr),
+		)
+	}
+
+	return &ir.Call{
+		FunctionIndex: functionIndex,
+		Arguments:     arguments,
+	}
 }
 
-func (compiler *Compiler) VisitMemberExpression(_ *ast.MemberExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitMemberExpression(expression *ast.MemberExpression) ast.Repr {
+	if expression.Optional {
+		panic(newUnsupportedError("optional chaining", expression))
+	}
+
+	memberInfo := compiler.Checker.Elaboration.MemberExpressionMemberInfos[expression]
+	if _, ok := memberInfo.AccessedType.(*sema.CompositeType); !ok {
+		panic(newUnsupportedError(
+			fmt.Sprintf("members of type `%s`", memberInfo.AccessedType.QualifiedString()),
+			expression,
+		))
+	}
+
+	exp := expression.Expression.Accept(compiler).(ir.Expr)
+
+	return &ir.GetField{
+		Exp:  exp,
+		Name: expression.Identifier.Identifier,
+	}
 }
 
-func (compiler *Compiler) VisitIndexExpression(_ *ast.IndexExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitIndexExpression(expression *ast.IndexExpression) ast.Repr {
+	panic(newUnsupportedError("index expressions", expression))
 }
 
-func (compiler *Compiler) VisitConditionalExpression(_ *ast.ConditionalExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitConditionalExpression(expression *ast.ConditionalExpression) ast.Repr {
+	return &ir.IfExpr{
+		Test: expression.Test.Accept(compiler).(ir.Expr),
+		Then: expression.Then.Accept(compiler).(ir.Expr),
+		Else: expression.Else.Accept(compiler).(ir.Expr),
+	}
 }
 
-func (compiler *Compiler) VisitUnaryExpression(_ *ast.UnaryExpression) ast.Repr {
-	// TODO
+func (compiler *Compiler) VisitUnaryExpression(expression *ast.UnaryExpression) ast.Repr {
+	exp := expression.Expression.Accept(compiler).(ir.Expr)
+
+	switch expression.Operation {
+	case ast.OperationMinus:
+		return &ir.UnOpExpr{
+			Op:   ir.UnOpNegate,
+			Expr: exp,
+		}
+
+	case ast.OperationNegate:
+		return &ir.UnOpExpr{
+			Op:   ir.UnOpNot,
+			Expr: exp,
+		}
+
+	case ast.OperationMove:
+		return exp
+	}
+
 	panic(errors.NewUnreachableError())
 }
 
 func (compiler *Compiler) VisitBinaryExpression(expression *ast.BinaryExpression) ast.Repr {
-	op := compileBinaryOperation(expression.Operation)
 	left := expression.Left.Accept(compiler).(ir.Expr)
 	right := expression.Right.Accept(compiler).(ir.Expr)
 
+	// The logical operators short-circuit:
+	// The right-hand side is only evaluated if needed
+
+	switch expression.Operation {
+	case ast.OperationAnd:
+		return &ir.IfExpr{
+			Test: left,
+			Then: right,
+			Else: &ir.Const{
+				Constant: ir.Bool{Value: false},
+			},
+		}
+
+	case ast.OperationOr:
+		return &ir.IfExpr{
+			Test: left,
+			Then: &ir.Const{
+				Constant: ir.Bool{Value: true},
+			},
+			Else: right,
+		}
+	}
+
+	op := compileBinaryOperation(expression)
+
 	return &ir.BinOpExpr{
 		Op:    op,
 		Left:  left,
@@ -222,9 +640,8 @@ func (compiler *Compiler) VisitBinaryExpression(expression *ast.BinaryExpression
 	}
 }
 
-func (compiler *Compiler) VisitFunctionExpression(_ *ast.FunctionExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitFunctionExpression(expression *ast.FunctionExpression) ast.Repr {
+	panic(newUnsupportedError("function expressions", expression))
 }
 
 func (compiler *Compiler) VisitStringExpression(e *ast.StringExpression) ast.Repr {
@@ -235,77 +652,173 @@ func (compiler *Compiler) VisitStringExpression(e *ast.StringExpression) ast.Rep
 	}
 }
 
-func (compiler *Compiler) VisitCastingExpression(_ *ast.CastingExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitCastingExpression(expression *ast.CastingExpression) ast.Repr {
+	panic(newUnsupportedError("casting expressions", expression))
 }
 
-func (compiler *Compiler) VisitCreateExpression(_ *ast.CreateExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitCreateExpression(expression *ast.CreateExpression) ast.Repr {
+	panic(newUnsupportedError("resources", expression))
 }
 
-func (compiler *Compiler) VisitDestroyExpression(_ *ast.DestroyExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitDestroyExpression(expression *ast.DestroyExpression) ast.Repr {
+	panic(newUnsupportedError("resources", expression))
 }
 
-func (compiler *Compiler) VisitReferenceExpression(_ *ast.ReferenceExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitReferenceExpression(expression *ast.ReferenceExpression) ast.Repr {
+	panic(newUnsupportedError("references", expression))
 }
 
-func (compiler *Compiler) VisitForceExpression(_ *ast.ForceExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitForceExpression(expression *ast.ForceExpression) ast.Repr {
+	panic(newUnsupportedError("optionals", expression))
 }
 
-func (compiler *Compiler) VisitPathExpression(_ *ast.PathExpression) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitPathExpression(expression *ast.PathExpression) ast.Repr {
+	panic(newUnsupportedError("paths", expression))
 }
 
-func (compiler *Compiler) VisitProgram(_ *ast.Program) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+// VisitProgram compiles the functions and composites of the program,
+// and returns the compiled functions.
+//
+// The function index of an ir.Call refers to this list of functions.
+//
+func (compiler *Compiler) VisitProgram(program *ast.Program) ast.Repr {
+
+	declarations := program.Declarations()
+
+	// Assign an index to each function before compiling any function,
+	// so that functions can invoke functions which are declared later
+
+	for _, declaration := range declarations {
+		switch declaration := declaration.(type) {
+		case *ast.FunctionDeclaration:
+			compiler.declareFunction(declaration.Identifier.Identifier)
+
+		case *ast.CompositeDeclaration:
+			compiler.declareCompositeFunctions(declaration)
+
+		case *ast.TypeAliasDeclaration:
+			// Type aliases are fully resolved during checking,
+			// there is nothing to compile
+
+		default:
+			panic(newUnsupportedError(
+				fmt.Sprintf("global %s declarations", declaration.DeclarationKind().Name()),
+				declaration,
+			))
+		}
+	}
+
+	var funcs []*ir.Func
+
+	for _, declaration := range declarations {
+		switch declaration := declaration.(type) {
+		case *ast.FunctionDeclaration:
+			funcs = append(funcs, declaration.Accept(compiler).(*ir.Func))
+
+		case *ast.CompositeDeclaration:
+			funcs = append(funcs, declaration.Accept(compiler).([]*ir.Func)...)
+		}
+	}
+
+	return funcs
 }
 
 func (compiler *Compiler) VisitFunctionDeclaration(declaration *ast.FunctionDeclaration) ast.Repr {
 
-	// TODO: declare function in current scope, use current scope in function
-	// TODO: conditions
+	functionType := compiler.Checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
+
+	return compiler.compileFunction(
+		// TODO: fully qualify
+		declaration.Identifier.Identifier,
+		functionKindFunction,
+		declaration.ParameterList.Parameters,
+		compileFunctionType(functionType, declaration),
+		declaration.FunctionBlock,
+	)
+}
+
+// compileFunction compiles a function, a composite function, or a constructor.
+//
+// The parameters of a composite function are preceded by the composite (self).
+// The constructor declares the constructed composite (self) as a local,
+// and returns it.
+//
+func (compiler *Compiler) compileFunction(
+	name string,
+	kind functionKind,
+	parameters []*ast.Parameter,
+	functionType ir.FuncType,
+	functionBlock *ast.FunctionBlock,
+) *ir.Func {
 
 	compiler.locals = nil
+	compiler.constructorSelf = nil
 
-	block := declaration.FunctionBlock.Block
+	compiler.activations.PushNewWithCurrent()
+	defer compiler.activations.Pop()
 
 	// Declare a local for each parameter
 
-	functionType := compiler.Checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
-
-	parameters := declaration.ParameterList.Parameters
+	if kind == functionKindMethod {
+		compiler.declareLocal(sema.SelfIdentifier, ir.ValTypeComposite)
+	}
 
-	for i, parameter := range parameters {
-		parameterType := functionType.Parameters[i].TypeAnnotation.Type
-		valType := compileValueType(parameterType)
+	for _, parameter := range parameters {
+		index := len(compiler.locals)
+		valType := functionType.Params[index]
 		name := parameter.Identifier.Identifier
 		compiler.declareLocal(name, valType)
 	}
 
+	parameterCount := len(compiler.locals)
+
 	// Compile the function block
 
-	stmt := block.Accept(compiler).(ir.Stmt)
+	var stmt ir.Stmt
+
+	if kind == functionKindConstructor {
+		self := compiler.declareLocal(sema.SelfIdentifier, ir.ValTypeComposite)
+		compiler.constructorSelf = self
+
+		stmts := []ir.Stmt{
+			&ir.StoreLocal{
+				LocalIndex: self.Index,
+				Exp: &ir.NewComposite{
+					QualifiedIdentifier: name,
+				},
+			},
+		}
+
+		if functionBlock != nil {
+			stmts = append(stmts,
+				functionBlock.Accept(compiler).(ir.Stmt),
+			)
+		}
+
+		stmts = append(stmts,
+			&ir.Return{
+				Exp: &ir.CopyLocal{
+					LocalIndex: self.Index,
+				},
+			},
+		)
+
+		stmt = &ir.Sequence{
+			Stmts: stmts,
+		}
+
+		compiler.constructorSelf = nil
+	} else {
+		stmt = functionBlock.Accept(compiler).(ir.Stmt)
+	}
 
 	// Important: compile locals after compiling function block,
 	// and don't include parameters in locals
-	locals := compileLocals(compiler.locals[len(parameters):])
-
-	compiledFunctionType := compileFunctionType(functionType)
+	locals := compileLocals(compiler.locals[parameterCount:])
 
 	return &ir.Func{
-		// TODO: fully qualify
-		Name:      declaration.Identifier.Identifier,
-		Type:      compiledFunctionType,
+		Name:      name,
+		Type:      functionType,
 		Locals:    locals,
 		Statement: stmt,
 	}
@@ -320,10 +833,7 @@ func (compiler *Compiler) VisitBlock(block *ast.Block) ast.Repr {
 
 	// Compile each statement in the block
 
-	stmts := make([]ir.Stmt, len(block.Statements))
-	for i, statement := range block.Statements {
-		stmts[i] = statement.Accept(compiler).(ir.Stmt)
-	}
+	stmts := compiler.compileStatements(block.Statements)
 
 	// NOTE: just return an IR statement sequence,
 	// there is no need for an IR block
@@ -332,86 +842,219 @@ func (compiler *Compiler) VisitBlock(block *ast.Block) ast.Repr {
 	}
 }
 
-func (compiler *Compiler) VisitFunctionBlock(_ *ast.FunctionBlock) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitFunctionBlock(functionBlock *ast.FunctionBlock) ast.Repr {
+	if functionBlock.PreConditions != nil || functionBlock.PostConditions != nil {
+		panic(newUnsupportedError("function conditions", functionBlock))
+	}
+
+	return functionBlock.Block.Accept(compiler)
 }
 
-func (compiler *Compiler) VisitCompositeDeclaration(_ *ast.CompositeDeclaration) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+// declareCompositeFunctions assigns function indices to the constructor
+// and the functions of the given composite declaration
+//
+func (compiler *Compiler) declareCompositeFunctions(declaration *ast.CompositeDeclaration) {
+
+	if declaration.CompositeKind != common.CompositeKindStructure {
+		panic(newUnsupportedError(
+			fmt.Sprintf("%s declarations", declaration.CompositeKind.Name()),
+			declaration,
+		))
+	}
+
+	if len(declaration.Members.Composites()) > 0 ||
+		len(declaration.Members.Interfaces()) > 0 {
+
+		panic(newUnsupportedError("nested declarations", declaration))
+	}
+
+	name := declaration.Identifier.Identifier
+
+	compiler.declareFunction(name)
+
+	for _, function := range declaration.Members.Functions() {
+		compiler.declareFunction(
+			compositeFunctionName(name, function.Identifier.Identifier),
+		)
+	}
 }
 
-func (compiler *Compiler) VisitInterfaceDeclaration(_ *ast.InterfaceDeclaration) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+// VisitCompositeDeclaration compiles the constructor and the functions
+// of the composite, and returns the compiled functions
+//
+func (compiler *Compiler) VisitCompositeDeclaration(declaration *ast.CompositeDeclaration) ast.Repr {
+
+	compositeType := compiler.Checker.Elaboration.CompositeDeclarationTypes[declaration]
+	name := compositeType.QualifiedIdentifier()
+
+	// Compile the constructor
+
+	var parameters []*ast.Parameter
+	var initializerBlock *ast.FunctionBlock
+
+	initializers := declaration.Members.Initializers()
+	if len(initializers) > 0 {
+		initializer := initializers[0].FunctionDeclaration
+		parameters = initializer.ParameterList.Parameters
+		initializerBlock = initializer.FunctionBlock
+	}
+
+	parameterTypes := make([]ir.ValType, len(compositeType.ConstructorParameters))
+	for i, parameter := range compositeType.ConstructorParameters {
+		parameterTypes[i] = compileValueType(parameter.TypeAnnotation.Type, declaration)
+	}
+
+	funcs := []*ir.Func{
+		compiler.compileFunction(
+			name,
+			functionKindConstructor,
+			parameters,
+			ir.FuncType{
+				Params:  parameterTypes,
+				Results: []ir.ValType{ir.ValTypeComposite},
+			},
+			initializerBlock,
+		),
+	}
+
+	// Compile the functions
+
+	for _, function := range declaration.Members.Functions() {
+
+		functionType := compileFunctionType(
+			compiler.Checker.Elaboration.FunctionDeclarationFunctionTypes[function],
+			function,
+		)
+
+		// The composite is passed as the first argument
+		functionType.Params = append(
+			[]ir.ValType{ir.ValTypeComposite},
+			functionType.Params...,
+		)
+
+		funcs = append(funcs,
+			compiler.compileFunction(
+				compositeFunctionName(name, function.Identifier.Identifier),
+				functionKindMethod,
+				function.ParameterList.Parameters,
+				functionType,
+				function.FunctionBlock,
+			),
+		)
+	}
+
+	return funcs
+}
+
+func (compiler *Compiler) VisitInterfaceDeclaration(declaration *ast.InterfaceDeclaration) ast.Repr {
+	panic(newUnsupportedError("interfaces", declaration))
 }
 
 func (compiler *Compiler) VisitFieldDeclaration(_ *ast.FieldDeclaration) ast.Repr {
-	// TODO
+	// Fields are not compiled separately,
+	// they are set by the constructor and accessed by name
 	panic(errors.NewUnreachableError())
 }
 
 func (compiler *Compiler) VisitCondition(_ *ast.Condition) ast.Repr {
-	// TODO
+	// Function blocks with conditions are rejected in VisitFunctionBlock
 	panic(errors.NewUnreachableError())
 }
 
-func (compiler *Compiler) VisitPragmaDeclaration(_ *ast.PragmaDeclaration) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitPragmaDeclaration(declaration *ast.PragmaDeclaration) ast.Repr {
+	panic(newUnsupportedError("pragmas", declaration))
 }
 
-func (compiler *Compiler) VisitImportDeclaration(_ *ast.ImportDeclaration) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	return nil
 }
 
-func (compiler *Compiler) VisitTransactionDeclaration(_ *ast.TransactionDeclaration) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitImportDeclaration(declaration *ast.ImportDeclaration) ast.Repr {
+	panic(newUnsupportedError("imports", declaration))
+}
+
+func (compiler *Compiler) VisitTransactionDeclaration(declaration *ast.TransactionDeclaration) ast.Repr {
+	panic(newUnsupportedError("transactions", declaration))
 }
 
 func (compiler *Compiler) VisitEnumCaseDeclaration(_ *ast.EnumCaseDeclaration) ast.Repr {
-	// TODO
+	// Enum declarations are rejected in declareCompositeFunctions
 	panic(errors.NewUnreachableError())
 }
 
-func compileBinaryOperation(operation ast.Operation) ir.BinOp {
-	// TODO: add remaining operations
-	switch operation {
+func compileBinaryOperation(expression *ast.BinaryExpression) ir.BinOp {
+	switch expression.Operation {
 	case ast.OperationPlus:
 		return ir.BinOpPlus
+	case ast.OperationMinus:
+		return ir.BinOpMinus
+	case ast.OperationMul:
+		return ir.BinOpMul
+	case ast.OperationDiv:
+		return ir.BinOpDiv
+	case ast.OperationMod:
+		return ir.BinOpMod
+	case ast.OperationEqual:
+		return ir.BinOpEqual
+	case ast.OperationNotEqual:
+		return ir.BinOpNotEqual
+	case ast.OperationLess:
+		return ir.BinOpLess
+	case ast.OperationLessEqual:
+		return ir.BinOpLessEqual
+	case ast.OperationGreater:
+		return ir.BinOpGreater
+	case ast.OperationGreaterEqual:
+		return ir.BinOpGreaterEqual
 	}
 
-	panic(errors.NewUnreachableError())
+	panic(newUnsupportedError(
+		fmt.Sprintf("operator `%s`", expression.Operation.Symbol()),
+		expression,
+	))
 }
 
-func compileValueType(ty sema.Type) ir.ValType {
-	// TODO: add remaining types
-
+func compileValueType(ty sema.Type, hasPosition ast.HasPosition) ir.ValType {
 	switch ty {
 	case sema.StringType:
 		return ir.ValTypeString
 	case sema.IntType:
 		return ir.ValTypeInt
+	case sema.BoolType:
+		return ir.ValTypeBool
 	}
 
-	panic(errors.NewUnreachableError())
+	if compositeType, ok := ty.(*sema.CompositeType); ok &&
+		compositeType.Kind == common.CompositeKindStructure {
+
+		return ir.ValTypeComposite
+	}
+
+	panic(newUnsupportedError(
+		fmt.Sprintf("values of type `%s`", ty.QualifiedString()),
+		hasPosition,
+	))
+}
+
+// compositeFunctionName returns the name of the compiled function
+// for the function with the given name of the given composite
+//
+func compositeFunctionName(compositeName string, functionName string) string {
+	return compositeName + "." + functionName
 }
 
-func compileFunctionType(functionType *sema.FunctionType) ir.FuncType {
+func compileFunctionType(functionType *sema.FunctionType, hasPosition ast.HasPosition) ir.FuncType {
 	// compile parameter types
 	paramTypes := make([]ir.ValType, len(functionType.Parameters))
 	for i, parameter := range functionType.Parameters {
-		paramTypes[i] = compileValueType(parameter.TypeAnnotation.Type)
+		paramTypes[i] = compileValueType(parameter.TypeAnnotation.Type, hasPosition)
 	}
 
 	// compile return / result type
 	var resultTypes []ir.ValType
 	if functionType.ReturnTypeAnnotation.Type != sema.VoidType {
 		resultTypes = []ir.ValType{
-			compileValueType(functionType.ReturnTypeAnnotation.Type),
+			compileValueType(functionType.ReturnTypeAnnotation.Type, hasPosition),
 		}
 	}
 	return ir.FuncType{
@@ -81,3 +81,334 @@ func TestCompilerSimple(t *testing.T) {
 		res,
 	)
 }
+
+func TestCompilerWhile(t *testing.T) {
+
+	checker, err := checker.ParseAndCheck(t, `
+      fun count(n: Int): Int {
+          var i = 0
+          while i < n {
+              if i == 5 {
+                  break
+              }
+              i = i + 1
+              continue
+          }
+          return i
+      }
+    `)
+
+	require.NoError(t, err)
+
+	compiler := NewCompiler(checker)
+
+	res := compiler.VisitProgram(checker.Program)
+
+	require.Equal(t,
+		[]*ir.Func{
+			{
+				Name: "count",
+				Type: ir.FuncType{
+					Params: []ir.ValType{
+						ir.ValTypeInt,
+					},
+					Results: []ir.ValType{
+						ir.ValTypeInt,
+					},
+				},
+				Locals: []ir.Local{
+					{Type: ir.ValTypeInt},
+				},
+				Statement: &ir.Sequence{
+					Stmts: []ir.Stmt{
+						&ir.StoreLocal{
+							LocalIndex: 1,
+							Exp: &ir.Const{
+								Constant: ir.Int{Value: []byte{1}},
+							},
+						},
+						&ir.Block{
+							Stmts: []ir.Stmt{
+								&ir.Loop{
+									Stmts: []ir.Stmt{
+										&ir.BranchIf{
+											Exp: &ir.UnOpExpr{
+												Op: ir.UnOpNot,
+												Expr: &ir.BinOpExpr{
+													Op:    ir.BinOpLess,
+													Left:  &ir.CopyLocal{LocalIndex: 1},
+													Right: &ir.CopyLocal{LocalIndex: 0},
+												},
+											},
+											Index: 1,
+										},
+										&ir.Sequence{
+											Stmts: []ir.Stmt{
+												&ir.If{
+													Test: &ir.BinOpExpr{
+														Op:   ir.BinOpEqual,
+														Left: &ir.CopyLocal{LocalIndex: 1},
+														Right: &ir.Const{
+															Constant: ir.Int{Value: []byte{1, 5}},
+														},
+													},
+													Then: &ir.Sequence{
+														Stmts: []ir.Stmt{
+															// break out of the if, the loop, and the block
+															&ir.Branch{Index: 2},
+														},
+													},
+												},
+												&ir.StoreLocal{
+													LocalIndex: 1,
+													Exp: &ir.BinOpExpr{
+														Op:   ir.BinOpPlus,
+														Left: &ir.CopyLocal{LocalIndex: 1},
+														Right: &ir.Const{
+															Constant: ir.Int{Value: []byte{1, 1}},
+														},
+													},
+												},
+												&ir.Branch{Index: 0},
+											},
+										},
+										&ir.Branch{Index: 0},
+									},
+								},
+							},
+						},
+						&ir.Return{
+							Exp: &ir.CopyLocal{LocalIndex: 1},
+						},
+					},
+				},
+			},
+		},
+		res,
+	)
+}
+
+func TestCompilerComposite(t *testing.T) {
+
+	checker, err := checker.ParseAndCheck(t, `
+      struct Counter {
+          var count: Int
+
+          init() {
+              self.count = 0
+          }
+
+          fun increment() {
+              self.count = self.count + 1
+          }
+      }
+
+      fun test(): Int {
+          let counter = Counter()
+          counter.increment()
+          return counter.count
+      }
+    `)
+
+	require.NoError(t, err)
+
+	compiler := NewCompiler(checker)
+
+	res := compiler.VisitProgram(checker.Program)
+
+	require.Equal(t,
+		[]*ir.Func{
+			{
+				Name: "Counter",
+				Type: ir.FuncType{
+					Params: []ir.ValType{},
+					Results: []ir.ValType{
+						ir.ValTypeComposite,
+					},
+				},
+				Locals: []ir.Local{
+					{Type: ir.ValTypeComposite},
+				},
+				Statement: &ir.Sequence{
+					Stmts: []ir.Stmt{
+						&ir.StoreLocal{
+							LocalIndex: 0,
+							Exp: &ir.NewComposite{
+								QualifiedIdentifier: "Counter",
+							},
+						},
+						&ir.Sequence{
+							Stmts: []ir.Stmt{
+								&ir.SetField{
+									Exp:  &ir.CopyLocal{LocalIndex: 0},
+									Name: "count",
+									Value: &ir.Const{
+										Constant: ir.Int{Value: []byte{1}},
+									},
+								},
+							},
+						},
+						&ir.Return{
+							Exp: &ir.CopyLocal{LocalIndex: 0},
+						},
+					},
+				},
+			},
+			{
+				Name: "Counter.increment",
+				Type: ir.FuncType{
+					Params: []ir.ValType{
+						ir.ValTypeComposite,
+					},
+				},
+				Locals: []ir.Local{},
+				Statement: &ir.Sequence{
+					Stmts: []ir.Stmt{
+						&ir.SetField{
+							Exp:  &ir.CopyLocal{LocalIndex: 0},
+							Name: "count",
+							Value: &ir.BinOpExpr{
+								Op: ir.BinOpPlus,
+								Left: &ir.GetField{
+									Exp:  &ir.CopyLocal{LocalIndex: 0},
+									Name: "count",
+								},
+								Right: &ir.Const{
+									Constant: ir.Int{Value: []byte{1, 1}},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "test",
+				Type: ir.FuncType{
+					Params: []ir.ValType{},
+					Results: []ir.ValType{
+						ir.ValTypeInt,
+					},
+				},
+				Locals: []ir.Local{
+					{Type: ir.ValTypeComposite},
+				},
+				Statement: &ir.Sequence{
+					Stmts: []ir.Stmt{
+						&ir.StoreLocal{
+							LocalIndex: 0,
+							Exp: &ir.Call{
+								FunctionIndex: 0,
+							},
+						},
+						&ir.ExprStmt{
+							Exp: &ir.Call{
+								FunctionIndex: 1,
+								Arguments: []ir.Expr{
+									&ir.CopyLocal{LocalIndex: 0},
+								},
+							},
+						},
+						&ir.Return{
+							Exp: &ir.GetField{
+								Exp:  &ir.CopyLocal{LocalIndex: 0},
+								Name: "count",
+							},
+						},
+					},
+				},
+			},
+		},
+		res,
+	)
+}
+
+func TestCompileUnsupported(t *testing.T) {
+
+	t.Parallel()
+
+	tests := map[string]string{
+		"resource": `
+          resource R {}
+        `,
+		"contract": `
+          contract C {}
+        `,
+		"global variable": `
+          let x = 1
+        `,
+		"interface": `
+          struct interface I {}
+        `,
+		"for-in loop": `
+          fun test() {
+              for x in [1, 2] {}
+          }
+        `,
+		"emit": `
+          event E()
+
+          fun test() {
+              emit E()
+          }
+        `,
+		"swap": `
+          fun test() {
+              var x = 1
+              var y = 2
+              x <-> y
+          }
+        `,
+		"switch case pattern": `
+          fun test(x: Int): Int {
+              switch x {
+              case let n as Int:
+                  return n
+              }
+              return 0
+          }
+        `,
+		"nested function": `
+          fun test() {
+              fun inner() {}
+          }
+        `,
+		"function conditions": `
+          fun test(x: Int) {
+              pre { x > 0 }
+          }
+        `,
+		"built-in member": `
+          fun test(): Int {
+              let s = "test"
+              return s.length
+          }
+        `,
+		"built-in function": `
+          fun test(): String {
+              let s = "test"
+              return s.concat("test")
+          }
+        `,
+		"optional": `
+          fun test(x: Int?) {}
+        `,
+	}
+
+	for name, code := range tests {
+
+		code := code
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := checker.ParseAndCheck(t, code)
+			require.NoError(t, err)
+
+			_, err = Compile(checker)
+			require.Error(t, err)
+
+			require.IsType(t, &UnsupportedError{}, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// UnsupportedError is returned when the program uses a construct
+// which the compiler does not support yet
+//
+type UnsupportedError struct {
+	Construct string
+	ast.Range
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("compiling %s is not supported yet", e.Construct)
+}
+
+// newUnsupportedError returns an error for the given construct which is not supported yet.
+// The compiler panics with the error, and it is returned by Compile
+//
+func newUnsupportedError(construct string, hasPosition ast.HasPosition) *UnsupportedError {
+	return &UnsupportedError{
+		Construct: construct,
+		Range:     ast.NewRangeFromPositioned(hasPosition),
+	}
+}
@@ -25,4 +25,14 @@ type BinOp uint
 const (
 	BinOpUnknown BinOp = iota
 	BinOpPlus
+	BinOpMinus
+	BinOpMul
+	BinOpDiv
+	BinOpMod
+	BinOpEqual
+	BinOpNotEqual
+	BinOpLess
+	BinOpLessEqual
+	BinOpGreater
+	BinOpGreaterEqual
 )
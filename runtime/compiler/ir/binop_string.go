@@ -10,11 +10,21 @@ func _() {
 	var x [1]struct{}
 	_ = x[BinOpUnknown-0]
 	_ = x[BinOpPlus-1]
+	_ = x[BinOpMinus-2]
+	_ = x[BinOpMul-3]
+	_ = x[BinOpDiv-4]
+	_ = x[BinOpMod-5]
+	_ = x[BinOpEqual-6]
+	_ = x[BinOpNotEqual-7]
+	_ = x[BinOpLess-8]
+	_ = x[BinOpLessEqual-9]
+	_ = x[BinOpGreater-10]
+	_ = x[BinOpGreaterEqual-11]
 }
 
-const _BinOp_name = "BinOpUnknownBinOpPlus"
+const _BinOp_name = "BinOpUnknownBinOpPlusBinOpMinusBinOpMulBinOpDivBinOpModBinOpEqualBinOpNotEqualBinOpLessBinOpLessEqualBinOpGreaterBinOpGreaterEqual"
 
-var _BinOp_index = [...]uint8{0, 12, 21}
+var _BinOp_index = [...]uint8{0, 12, 21, 31, 39, 47, 55, 65, 78, 87, 101, 113, 130}
 
 func (i BinOp) String() string {
 	if i >= BinOp(len(_BinOp_index)-1) {
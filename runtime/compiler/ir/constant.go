@@ -42,3 +42,13 @@ func (String) isConstant() {}
 func (c String) Accept(v Visitor) Repr {
 	return v.VisitString(c)
 }
+
+type Bool struct {
+	Value bool
+}
+
+func (Bool) isConstant() {}
+
+func (c Bool) Accept(v Visitor) Repr {
+	return v.VisitBool(c)
+}
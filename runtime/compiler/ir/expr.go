@@ -76,6 +76,10 @@ func (e *BinOpExpr) Accept(v Visitor) Repr {
 	return v.VisitBinOpExpr(e)
 }
 
+// Call invokes a function.
+// FunctionIndex is the index of the function in the compiled program,
+// i.e. it does not include imported functions
+//
 type Call struct {
 	FunctionIndex uint32
 	Arguments     []Expr
@@ -86,3 +90,39 @@ func (*Call) isExpr() {}
 func (e *Call) Accept(v Visitor) Repr {
 	return v.VisitCall(e)
 }
+
+// IfExpr evaluates the Then expression if the test is true,
+// and the Else expression otherwise
+//
+type IfExpr struct {
+	Test Expr
+	Then Expr
+	Else Expr
+}
+
+func (*IfExpr) isExpr() {}
+
+func (e *IfExpr) Accept(v Visitor) Repr {
+	return v.VisitIfExpr(e)
+}
+
+type NewComposite struct {
+	QualifiedIdentifier string
+}
+
+func (*NewComposite) isExpr() {}
+
+func (e *NewComposite) Accept(v Visitor) Repr {
+	return v.VisitNewComposite(e)
+}
+
+type GetField struct {
+	Exp  Expr
+	Name string
+}
+
+func (*GetField) isExpr() {}
+
+func (e *GetField) Accept(v Visitor) Repr {
+	return v.VisitGetField(e)
+}
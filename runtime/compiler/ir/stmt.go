@@ -107,6 +107,34 @@ func (s *Drop) Accept(v Visitor) Repr {
 	return v.VisitDrop(s)
 }
 
+// ExprStmt evaluates an expression which has no result,
+// e.g. the invocation of a function without a return type
+//
+type ExprStmt struct {
+	Exp Expr
+}
+
+func (*ExprStmt) isStmt() {}
+
+func (s *ExprStmt) Accept(v Visitor) Repr {
+	return v.VisitExprStmt(s)
+}
+
+type SetField struct {
+	Exp   Expr
+	Name  string
+	Value Expr
+}
+
+func (*SetField) isStmt() {}
+
+func (s *SetField) Accept(v Visitor) Repr {
+	return v.VisitSetField(s)
+}
+
+// Return returns from the function.
+// Exp is nil if the function has no result
+//
 type Return struct {
 	Exp Expr
 }
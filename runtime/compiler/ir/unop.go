@@ -24,4 +24,6 @@ type UnOp uint
 
 const (
 	UnOpUnknown UnOp = iota
+	UnOpNegate
+	UnOpNot
 )
@@ -9,11 +9,13 @@ func _() {
 	// Re-run the stringer command to generate them again.
 	var x [1]struct{}
 	_ = x[UnOpUnknown-0]
+	_ = x[UnOpNegate-1]
+	_ = x[UnOpNot-2]
 }
 
-const _UnOp_name = "UnOpUnknown"
+const _UnOp_name = "UnOpUnknownUnOpNegateUnOpNot"
 
-var _UnOp_index = [...]uint8{0, 11}
+var _UnOp_index = [...]uint8{0, 11, 21, 28}
 
 func (i UnOp) String() string {
 	if i >= UnOp(len(_UnOp_index)-1) {
@@ -26,4 +26,6 @@ const (
 	ValTypeUnknown ValType = iota
 	ValTypeInt
 	ValTypeString
+	ValTypeBool
+	ValTypeComposite
 )
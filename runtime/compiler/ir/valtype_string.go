@@ -11,11 +11,13 @@ func _() {
 	_ = x[ValTypeUnknown-0]
 	_ = x[ValTypeInt-1]
 	_ = x[ValTypeString-2]
+	_ = x[ValTypeBool-3]
+	_ = x[ValTypeComposite-4]
 }
 
-const _ValType_name = "ValTypeUnknownValTypeIntValTypeString"
+const _ValType_name = "ValTypeUnknownValTypeIntValTypeStringValTypeBoolValTypeComposite"
 
-var _ValType_index = [...]uint8{0, 14, 24, 37}
+var _ValType_index = [...]uint8{0, 14, 24, 37, 48, 64}
 
 func (i ValType) String() string {
 	if i >= ValType(len(_ValType_index)-1) {
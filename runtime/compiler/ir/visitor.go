@@ -23,6 +23,7 @@ type Repr interface{}
 type ConstVisitor interface {
 	VisitInt(Int) Repr
 	VisitString(String) Repr
+	VisitBool(Bool) Repr
 }
 
 type StmtVisitor interface {
@@ -34,6 +35,8 @@ type StmtVisitor interface {
 	VisitBranchIf(*BranchIf) Repr
 	VisitStoreLocal(*StoreLocal) Repr
 	VisitDrop(*Drop) Repr
+	VisitExprStmt(*ExprStmt) Repr
+	VisitSetField(*SetField) Repr
 	VisitReturn(*Return) Repr
 }
 
@@ -44,6 +47,9 @@ type ExprVisitor interface {
 	VisitUnOpExpr(*UnOpExpr) Repr
 	VisitBinOpExpr(*BinOpExpr) Repr
 	VisitCall(*Call) Repr
+	VisitIfExpr(*IfExpr) Repr
+	VisitNewComposite(*NewComposite) Repr
+	VisitGetField(*GetField) Repr
 }
 
 type Visitor interface {
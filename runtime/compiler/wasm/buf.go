@@ -31,6 +31,14 @@ type Buffer struct {
 	offset offset
 }
 
+// NewBuffer returns a buffer for reading the given data
+//
+func NewBuffer(data []byte) *Buffer {
+	return &Buffer{
+		data: data,
+	}
+}
+
 func (buf *Buffer) WriteByte(b byte) error {
 	if buf.offset < offset(len(buf.data)) {
 		buf.data[buf.offset] = b
@@ -177,6 +177,15 @@ func TestWASMReader_readValType(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, ValueTypeI64, valType)
 	})
+
+	t.Run("externref", func(t *testing.T) {
+
+		t.Parallel()
+
+		valType, err := read([]byte{byte(ValueTypeExternRef)})
+		require.NoError(t, err)
+		assert.Equal(t, ValueTypeExternRef, valType)
+	})
 }
 
 func TestWASMReader_readTypeSection(t *testing.T) {
@@ -25,13 +25,15 @@ import (
 )
 
 type ContractUpdateValidator struct {
-	location     Location
-	contractName string
-	oldProgram   *ast.Program
-	newProgram   *ast.Program
-	rootDecl     ast.Declaration
-	currentDecl  ast.Declaration
-	errors       []error
+	location       Location
+	contractName   string
+	oldProgram     *ast.Program
+	newProgram     *ast.Program
+	rootDecl       ast.Declaration
+	currentDecl    ast.Declaration
+	oldRootDecl    ast.Declaration
+	currentOldDecl ast.Declaration
+	errors         []error
 }
 
 // ContractUpdateValidator should implement ast.TypeEqualityChecker
@@ -67,6 +69,7 @@ func (validator *ContractUpdateValidator) Validate() error {
 	}
 
 	validator.rootDecl = newRootDecl
+	validator.oldRootDecl = oldRootDecl
 	validator.checkDeclarationUpdatability(oldRootDecl, newRootDecl)
 
 	if validator.hasErrors() {
@@ -129,9 +132,12 @@ func (validator *ContractUpdateValidator) checkDeclarationUpdatability(
 	}
 
 	parentDecl := validator.currentDecl
+	parentOldDecl := validator.currentOldDecl
 	validator.currentDecl = newDeclaration
+	validator.currentOldDecl = oldDeclaration
 	defer func() {
 		validator.currentDecl = parentDecl
+		validator.currentOldDecl = parentOldDecl
 	}()
 
 	validator.checkFields(oldDeclaration, newDeclaration)
@@ -293,6 +299,14 @@ func (validator *ContractUpdateValidator) checkEnumCases(oldDeclaration ast.Decl
 }
 
 func (validator *ContractUpdateValidator) CheckNominalTypeEquality(expected *ast.NominalType, found ast.Type) error {
+	// A type alias and its target type are equal
+
+	if expectedTarget := validator.expandOldTypeAlias(expected); expectedTarget != ast.Type(expected) {
+		return expectedTarget.CheckEqual(found, validator)
+	}
+
+	found = validator.expandNewTypeAlias(found)
+
 	foundNominalType, ok := found.(*ast.NominalType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -308,6 +322,8 @@ func (validator *ContractUpdateValidator) CheckNominalTypeEquality(expected *ast
 }
 
 func (validator *ContractUpdateValidator) CheckOptionalTypeEquality(expected *ast.OptionalType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	foundOptionalType, ok := found.(*ast.OptionalType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -317,6 +333,8 @@ func (validator *ContractUpdateValidator) CheckOptionalTypeEquality(expected *as
 }
 
 func (validator *ContractUpdateValidator) CheckVariableSizedTypeEquality(expected *ast.VariableSizedType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	foundVarSizedType, ok := found.(*ast.VariableSizedType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -326,6 +344,8 @@ func (validator *ContractUpdateValidator) CheckVariableSizedTypeEquality(expecte
 }
 
 func (validator *ContractUpdateValidator) CheckConstantSizedTypeEquality(expected *ast.ConstantSizedType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	foundConstSizedType, ok := found.(*ast.ConstantSizedType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -342,6 +362,8 @@ func (validator *ContractUpdateValidator) CheckConstantSizedTypeEquality(expecte
 }
 
 func (validator *ContractUpdateValidator) CheckDictionaryTypeEquality(expected *ast.DictionaryType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	foundDictionaryType, ok := found.(*ast.DictionaryType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -356,6 +378,8 @@ func (validator *ContractUpdateValidator) CheckDictionaryTypeEquality(expected *
 }
 
 func (validator *ContractUpdateValidator) CheckRestrictedTypeEquality(expected *ast.RestrictedType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	foundRestrictedType, ok := found.(*ast.RestrictedType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -395,6 +419,8 @@ func (validator *ContractUpdateValidator) CheckRestrictedTypeEquality(expected *
 }
 
 func (validator *ContractUpdateValidator) CheckInstantiationTypeEquality(expected *ast.InstantiationType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	foundInstType, ok := found.(*ast.InstantiationType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -417,6 +443,8 @@ func (validator *ContractUpdateValidator) CheckInstantiationTypeEquality(expecte
 }
 
 func (validator *ContractUpdateValidator) CheckFunctionTypeEquality(expected *ast.FunctionType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	foundFuncType, ok := found.(*ast.FunctionType)
 	if !ok || len(expected.ParameterTypeAnnotations) != len(foundFuncType.ParameterTypeAnnotations) {
 		return getTypeMismatchError(expected, found)
@@ -434,6 +462,8 @@ func (validator *ContractUpdateValidator) CheckFunctionTypeEquality(expected *as
 }
 
 func (validator *ContractUpdateValidator) CheckReferenceTypeEquality(expected *ast.ReferenceType, found ast.Type) error {
+	found = validator.expandNewTypeAlias(found)
+
 	refType, ok := found.(*ast.ReferenceType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -442,6 +472,125 @@ func (validator *ContractUpdateValidator) CheckReferenceTypeEquality(expected *a
 	return expected.Type.CheckEqual(refType.Type, validator)
 }
 
+// expandOldTypeAlias returns the target type of the type alias the given type refers to
+// in the old program, or the given type if it does not refer to a type alias
+//
+func (validator *ContractUpdateValidator) expandOldTypeAlias(ty ast.Type) ast.Type {
+	return expandTypeAlias(ty, validator.oldRootDecl, validator.currentOldDecl)
+}
+
+// expandNewTypeAlias returns the target type of the type alias the given type refers to
+// in the new program, or the given type if it does not refer to a type alias
+//
+func (validator *ContractUpdateValidator) expandNewTypeAlias(ty ast.Type) ast.Type {
+	return expandTypeAlias(ty, validator.rootDecl, validator.currentDecl)
+}
+
+// expandTypeAlias returns the target type of the type alias the given type refers to,
+// or the given type if it does not refer to a type alias.
+// Aliases of aliases are expanded until the target is not an alias.
+//
+// A simple name may refer to an alias declared in the current declaration or the root declaration,
+// a qualified name may refer to an alias declared in the root declaration or a nested declaration,
+// e.g. `Token`, `C.Token`, `R.Token`, or `C.R.Token` in the contract `C`.
+//
+// NOTE: The program was checked before, so aliases cannot be cyclic
+//
+func expandTypeAlias(ty ast.Type, rootDecl ast.Declaration, currentDecl ast.Declaration) ast.Type {
+	for {
+		nominalType, ok := ty.(*ast.NominalType)
+		if !ok {
+			return ty
+		}
+
+		typeAlias := findTypeAlias(nominalType, rootDecl, currentDecl)
+		if typeAlias == nil {
+			return ty
+		}
+
+		ty = typeAlias.TypeAnnotation.Type
+	}
+}
+
+func findTypeAlias(
+	nominalType *ast.NominalType,
+	rootDecl ast.Declaration,
+	currentDecl ast.Declaration,
+) *ast.TypeAliasDeclaration {
+
+	if rootDecl == nil {
+		return nil
+	}
+
+	// Determine the identifiers relative to the root declaration,
+	// i.e. skip the root declaration's name if the type is qualified
+
+	identifiers := append(
+		[]ast.Identifier{nominalType.Identifier},
+		nominalType.NestedIdentifiers...,
+	)
+
+	if nominalType.IsQualifiedName() &&
+		nominalType.Identifier.Identifier == rootDecl.DeclarationIdentifier().Identifier {
+
+		identifiers = identifiers[1:]
+	}
+
+	switch len(identifiers) {
+	case 1:
+		name := identifiers[0].Identifier
+
+		if currentDecl != nil {
+			typeAlias := findMemberTypeAlias(currentDecl, name)
+			if typeAlias != nil {
+				return typeAlias
+			}
+		}
+
+		return findMemberTypeAlias(rootDecl, name)
+
+	case 2:
+		nestedDecl := findNestedCompositeOrInterfaceDecl(rootDecl, identifiers[0].Identifier)
+		if nestedDecl == nil {
+			return nil
+		}
+
+		return findMemberTypeAlias(nestedDecl, identifiers[1].Identifier)
+
+	default:
+		return nil
+	}
+}
+
+func findMemberTypeAlias(declaration ast.Declaration, name string) *ast.TypeAliasDeclaration {
+	members := declaration.DeclarationMembers()
+	if members == nil {
+		return nil
+	}
+
+	for _, typeAlias := range members.TypeAliases() {
+		if typeAlias.Identifier.Identifier == name {
+			return typeAlias
+		}
+	}
+
+	return nil
+}
+
+func findNestedCompositeOrInterfaceDecl(declaration ast.Declaration, name string) ast.Declaration {
+	members := declaration.DeclarationMembers()
+
+	if compositeDecl, ok := members.CompositesByIdentifier()[name]; ok {
+		return compositeDecl
+	}
+
+	if interfaceDecl, ok := members.InterfacesByIdentifier()[name]; ok {
+		return interfaceDecl
+	}
+
+	return nil
+}
+
 func (validator *ContractUpdateValidator) checkNameEquality(expectedType *ast.NominalType, foundType *ast.NominalType) bool {
 	isExpectedQualifiedName := expectedType.IsQualifiedName()
 	isFoundQualifiedName := foundType.IsQualifiedName()
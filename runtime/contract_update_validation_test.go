@@ -1714,6 +1714,290 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 
 		assert.NoError(t, err)
 	})
+
+	t.Run("introduce type alias", func(t *testing.T) {
+
+		const oldCode = `
+			pub contract Test37 {
+				pub var a: {TestInterface}
+				pub var b: [Int]
+
+				init() {
+					self.a = TestStruct()
+					self.b = []
+				}
+
+				pub struct TestStruct: TestInterface {
+					pub var c: {Test37.TestInterface}
+
+					init() {
+						self.c = Test37.TestStruct2()
+					}
+				}
+
+				pub struct TestStruct2: TestInterface {}
+
+				pub struct interface TestInterface {}
+			}`
+
+		const newCode = `
+			pub contract Test37 {
+				pub typealias Token = {TestInterface}
+				pub typealias Count = Int
+				pub typealias Counts = [Count]
+
+				pub var a: Token
+				pub var b: Counts
+
+				init() {
+					self.a = TestStruct()
+					self.b = []
+				}
+
+				pub struct TestStruct: TestInterface {
+					pub var c: Test37.Token
+
+					init() {
+						self.c = Test37.TestStruct2()
+					}
+				}
+
+				pub struct TestStruct2: TestInterface {}
+
+				pub struct interface TestInterface {}
+			}`
+
+		err := deployAndUpdate(t, "Test37", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("remove type alias", func(t *testing.T) {
+
+		const oldCode = `
+			pub contract Test38 {
+				pub var a: TestStruct.Count
+
+				init() {
+					self.a = 1
+				}
+
+				pub struct TestStruct {
+					pub typealias Count = Int
+				}
+			}`
+
+		const newCode = `
+			pub contract Test38 {
+				pub var a: Int
+
+				init() {
+					self.a = 1
+				}
+
+				pub struct TestStruct {}
+			}`
+
+		err := deployAndUpdate(t, "Test38", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("change type alias target", func(t *testing.T) {
+
+		const oldCode = `
+			pub contract Test39 {
+				pub typealias Count = Int
+
+				pub var a: Count
+
+				init() {
+					self.a = 1
+				}
+			}`
+
+		const newCode = `
+			pub contract Test39 {
+				pub typealias Count = String
+
+				pub var a: Count
+
+				init() {
+					self.a = "1"
+				}
+			}`
+
+		err := deployAndUpdate(t, "Test39", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getErrorCause(t, err, "Test39")
+		assertFieldTypeMismatchError(t, cause, "Test39", "a", "Int", "String")
+	})
+
+	t.Run("add overloads", func(t *testing.T) {
+		const oldCode = `
+			pub contract Test40 {
+				pub struct TestStruct {
+					pub let a: Int
+
+					init(a: Int) {
+						self.a = a
+					}
+
+					pub fun get(index: Int): Int {
+						return index
+					}
+				}
+
+				pub fun make(a: Int): TestStruct {
+					return TestStruct(a: a)
+				}
+			}`
+
+		const newCode = `
+			pub contract Test40 {
+				pub struct TestStruct {
+					pub let a: Int
+
+					init(a: Int) {
+						self.a = a
+					}
+
+					init(a: Int, b: Int) {
+						self.a = a + b
+					}
+
+					pub fun get(index: Int): Int {
+						return index
+					}
+
+					pub fun get(index: Int, offset: Int): Int {
+						return index + offset
+					}
+				}
+
+				pub fun make(a: Int): TestStruct {
+					return TestStruct(a: a)
+				}
+
+				pub fun make(a: Int, b: Int): TestStruct {
+					return TestStruct(a: a, b: b)
+				}
+			}`
+
+		err := deployAndUpdate(t, "Test40", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("reorder overloads", func(t *testing.T) {
+		const oldCode = `
+			pub contract Test42 {
+				pub struct interface I {
+					pub fun get(a: Int): String
+					pub fun get(b: Int): String
+				}
+
+				pub struct TestStruct: I {
+					pub fun get(a: Int): String {
+						return "a"
+					}
+
+					pub fun get(b: Int): String {
+						return "b"
+					}
+				}
+
+				pub let s: {I}
+
+				init() {
+					self.s = TestStruct()
+				}
+			}`
+
+		const newCode = `
+			pub contract Test42 {
+				pub struct interface I {
+					pub fun get(b: Int): String
+					pub fun get(a: Int): String
+				}
+
+				pub struct TestStruct: I {
+					pub fun get(b: Int): String {
+						return "b"
+					}
+
+					pub fun get(a: Int): String {
+						return "a"
+					}
+				}
+
+				pub let s: {I}
+
+				init() {
+					self.s = TestStruct()
+				}
+			}`
+
+		err := deployAndUpdate(t, "Test42", oldCode, newCode)
+		require.NoError(t, err)
+
+		// The stored value dispatches to the overloads by their argument labels
+
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+					import Test42 from 0x42
+
+					pub fun main(): String {
+						return Test42.s.get(a: 1).concat(Test42.s.get(b: 1))
+					}
+				`),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.String("ab"), value)
+	})
+
+	t.Run("change overloaded field type", func(t *testing.T) {
+		const oldCode = `
+			pub contract Test41 {
+				pub struct TestStruct {
+					pub let a: Int
+
+					init() {
+						self.a = 1
+					}
+
+					init(a: Int) {
+						self.a = a
+					}
+				}
+			}`
+
+		const newCode = `
+			pub contract Test41 {
+				pub struct TestStruct {
+					pub let a: String
+
+					init() {
+						self.a = "1"
+					}
+
+					init(a: String) {
+						self.a = a
+					}
+				}
+			}`
+
+		err := deployAndUpdate(t, "Test41", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getErrorCause(t, err, "Test41")
+		assertFieldTypeMismatchError(t, cause, "TestStruct", "a", "Int", "String")
+	})
 }
 
 func assertDeclTypeChangeError(
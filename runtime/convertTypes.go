@@ -53,6 +53,8 @@ func ExportType(t sema.Type, results map[sema.TypeID]cadence.Type) cadence.Type
 			return exportInterfaceType(t, results)
 		case *sema.DictionaryType:
 			return exportDictionaryType(t, results)
+		case *sema.SetType:
+			return exportSetType(t, results)
 		case *sema.FunctionType:
 			return exportFunctionType(t, results)
 		case *sema.AddressType:
@@ -114,10 +116,18 @@ func ExportType(t sema.Type, results map[sema.TypeID]cadence.Type) cadence.Type
 			return cadence.Word32Type{}
 		case sema.Word64Type:
 			return cadence.Word64Type{}
+		case sema.Word128Type:
+			return cadence.Word128Type{}
+		case sema.Word256Type:
+			return cadence.Word256Type{}
 		case sema.Fix64Type:
 			return cadence.Fix64Type{}
 		case sema.UFix64Type:
 			return cadence.UFix64Type{}
+		case sema.Fix128Type:
+			return cadence.Fix128Type{}
+		case sema.UFix128Type:
+			return cadence.UFix128Type{}
 		case sema.PathType:
 			return cadence.PathType{}
 		case sema.StoragePathType:
@@ -350,6 +360,14 @@ func exportDictionaryType(t *sema.DictionaryType, results map[sema.TypeID]cadenc
 	}
 }
 
+func exportSetType(t *sema.SetType, results map[sema.TypeID]cadence.Type) cadence.Type {
+	convertedElementType := ExportType(t.ElementType, results)
+
+	return cadence.SetType{
+		ElementType: convertedElementType,
+	}
+}
+
 func exportFunctionType(t *sema.FunctionType, results map[sema.TypeID]cadence.Type) cadence.Type {
 
 	convertedParameters := make([]cadence.Parameter, len(t.Parameters))
@@ -497,10 +515,18 @@ func ImportType(t cadence.Type) interpreter.StaticType {
 		return interpreter.PrimitiveStaticTypeWord32
 	case cadence.Word64Type:
 		return interpreter.PrimitiveStaticTypeWord64
+	case cadence.Word128Type:
+		return interpreter.PrimitiveStaticTypeWord128
+	case cadence.Word256Type:
+		return interpreter.PrimitiveStaticTypeWord256
 	case cadence.Fix64Type:
 		return interpreter.PrimitiveStaticTypeFix64
 	case cadence.UFix64Type:
 		return interpreter.PrimitiveStaticTypeUFix64
+	case cadence.Fix128Type:
+		return interpreter.PrimitiveStaticTypeFix128
+	case cadence.UFix128Type:
+		return interpreter.PrimitiveStaticTypeUFix128
 	case cadence.VariableSizedArrayType:
 		return interpreter.VariableSizedStaticType{
 			Type: ImportType(t.ElementType),
@@ -515,6 +541,10 @@ func ImportType(t cadence.Type) interpreter.StaticType {
 			KeyType:   ImportType(t.KeyType),
 			ValueType: ImportType(t.ElementType),
 		}
+	case cadence.SetType:
+		return interpreter.SetStaticType{
+			ElementType: ImportType(t.ElementType),
+		}
 	case *cadence.StructType,
 		*cadence.ResourceType,
 		*cadence.EventType,
@@ -106,16 +106,26 @@ func exportValueWithInterpreter(
 		return cadence.NewWord32(uint32(v)), nil
 	case interpreter.Word64Value:
 		return cadence.NewWord64(uint64(v)), nil
+	case interpreter.Word128Value:
+		return cadence.NewWord128FromBig(v.ToBigInt())
+	case interpreter.Word256Value:
+		return cadence.NewWord256FromBig(v.ToBigInt())
 	case interpreter.Fix64Value:
 		return cadence.Fix64(v), nil
 	case interpreter.UFix64Value:
 		return cadence.UFix64(v), nil
+	case interpreter.Fix128Value:
+		return cadence.NewFix128FromBig(v.BigInt)
+	case interpreter.UFix128Value:
+		return cadence.NewUFix128FromBig(v.BigInt)
 	case *interpreter.CompositeValue:
 		return exportCompositeValue(v, inter, seenReferences)
 	case *interpreter.SimpleCompositeValue:
 		return exportSimpleCompositeValue(v, inter, seenReferences)
 	case *interpreter.DictionaryValue:
 		return exportDictionaryValue(v, inter, seenReferences)
+	case *interpreter.SetValue:
+		return exportSetValue(v, inter, seenReferences)
 	case interpreter.AddressValue:
 		return cadence.NewAddress(v), nil
 	case interpreter.LinkValue:
@@ -384,6 +394,36 @@ func exportDictionaryValue(
 	return cadence.NewDictionary(pairs), nil
 }
 
+func exportSetValue(
+	v *interpreter.SetValue,
+	inter *interpreter.Interpreter,
+	seenReferences seenReferences,
+) (
+	cadence.Set,
+	error,
+) {
+	values := make([]cadence.Value, 0, v.Count())
+
+	var err error
+	v.Iterate(func(element interpreter.Value) (resume bool) {
+		var exportedElement cadence.Value
+		exportedElement, err = exportValueWithInterpreter(element, inter, seenReferences)
+		if err != nil {
+			return false
+		}
+		values = append(
+			values,
+			exportedElement,
+		)
+		return true
+	})
+	if err != nil {
+		return cadence.Set{}, err
+	}
+
+	return cadence.NewSet(values), nil
+}
+
 func exportLinkValue(v interpreter.LinkValue, inter *interpreter.Interpreter) cadence.Link {
 	path := exportPathValue(v.TargetPath)
 	ty := string(inter.MustConvertStaticToSemaType(v.Type).ID())
@@ -436,6 +476,13 @@ func exportEvent(event exportableEvent, seenReferences seenReferences) (cadence.
 	return cadence.NewEvent(fields).WithType(eventType), nil
 }
 
+// ImportValue converts a Cadence value to a runtime value.
+// If the expected type is nil, the type of the value is inferred
+//
+func ImportValue(inter *interpreter.Interpreter, value cadence.Value, expectedType sema.Type) (interpreter.Value, error) {
+	return importValue(inter, value, expectedType)
+}
+
 // importValue converts a Cadence value to a runtime value.
 func importValue(inter *interpreter.Interpreter, value cadence.Value, expectedType sema.Type) (interpreter.Value, error) {
 	switch v := value.(type) {
@@ -487,16 +534,26 @@ func importValue(inter *interpreter.Interpreter, value cadence.Value, expectedTy
 		return interpreter.Word32Value(v), nil
 	case cadence.Word64:
 		return interpreter.Word64Value(v), nil
+	case cadence.Word128:
+		return interpreter.NewWord128ValueFromBigInt(v.Value), nil
+	case cadence.Word256:
+		return interpreter.NewWord256ValueFromBigInt(v.Value), nil
 	case cadence.Fix64:
 		return interpreter.Fix64Value(v), nil
 	case cadence.UFix64:
 		return interpreter.UFix64Value(v), nil
+	case cadence.Fix128:
+		return interpreter.NewFix128ValueFromBigInt(v.Value), nil
+	case cadence.UFix128:
+		return interpreter.NewUFix128ValueFromBigInt(v.Value), nil
 	case cadence.Path:
 		return importPathValue(v), nil
 	case cadence.Array:
 		return importArrayValue(inter, v, expectedType)
 	case cadence.Dictionary:
 		return importDictionaryValue(inter, v, expectedType)
+	case cadence.Set:
+		return importSetValue(inter, v, expectedType)
 	case cadence.Struct:
 		return importCompositeValue(
 			inter,
@@ -768,6 +825,64 @@ func importDictionaryValue(
 	), nil
 }
 
+func importSetValue(
+	inter *interpreter.Interpreter,
+	v cadence.Set,
+	expectedType sema.Type,
+) (
+	*interpreter.SetValue,
+	error,
+) {
+	values := make([]interpreter.Value, len(v.Values))
+
+	var elementType sema.Type
+	setType, ok := expectedType.(*sema.SetType)
+	if ok {
+		elementType = setType.ElementType
+	}
+
+	for i, element := range v.Values {
+		value, err := importValue(inter, element, elementType)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	var setStaticType interpreter.SetStaticType
+	if elementType != nil {
+		setStaticType = interpreter.ConvertSemaSetTypeToStaticSetType(setType)
+	} else {
+		types := make([]sema.Type, len(values))
+
+		for i, value := range values {
+			typ, err := inter.ConvertStaticToSemaType(value.StaticType())
+			if err != nil {
+				return nil, err
+			}
+			types[i] = typ
+		}
+
+		elementSuperType := sema.LeastCommonSuperType(types...)
+
+		if !sema.IsValidDictionaryKeyType(elementSuperType) {
+			return nil, fmt.Errorf(
+				"cannot import set: elements do not belong to the same type",
+			)
+		}
+
+		setStaticType = interpreter.SetStaticType{
+			ElementType: interpreter.ConvertSemaToStaticType(elementSuperType),
+		}
+	}
+
+	return interpreter.NewSetValue(
+		inter,
+		setStaticType,
+		values...,
+	), nil
+}
+
 func importCompositeValue(
 	inter *interpreter.Interpreter,
 	kind common.CompositeKind,
@@ -21,6 +21,7 @@ package runtime
 import (
 	_ "embed"
 	"fmt"
+	"math/big"
 	"testing"
 	"unicode/utf8"
 
@@ -321,6 +322,16 @@ func TestExportValue(t *testing.T) {
 			value:    interpreter.Word64Value(42),
 			expected: cadence.NewWord64(42),
 		},
+		{
+			label:    "Word128",
+			value:    interpreter.NewWord128ValueFromUint64(42),
+			expected: cadence.NewWord128(42),
+		},
+		{
+			label:    "Word256",
+			value:    interpreter.NewWord256ValueFromUint64(42),
+			expected: cadence.NewWord256(42),
+		},
 		{
 			label:    "Fix64",
 			value:    interpreter.Fix64Value(-123000000),
@@ -331,6 +342,16 @@ func TestExportValue(t *testing.T) {
 			value:    interpreter.UFix64Value(123000000),
 			expected: cadence.UFix64(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    interpreter.NewFix128ValueFromBigInt(big.NewInt(-123)),
+			expected: cadence.Fix128{Value: big.NewInt(-123)},
+		},
+		{
+			label:    "UFix128",
+			value:    interpreter.NewUFix128ValueFromBigInt(big.NewInt(123)),
+			expected: cadence.UFix128{Value: big.NewInt(123)},
+		},
 		{
 			label: "Path",
 			value: interpreter.PathValue{
@@ -698,6 +719,16 @@ func TestImportValue(t *testing.T) {
 			value:    cadence.NewWord64(42),
 			expected: interpreter.Word64Value(42),
 		},
+		{
+			label:    "Word128",
+			value:    cadence.NewWord128(42),
+			expected: interpreter.NewWord128ValueFromUint64(42),
+		},
+		{
+			label:    "Word256",
+			value:    cadence.NewWord256(42),
+			expected: interpreter.NewWord256ValueFromUint64(42),
+		},
 		{
 			label:    "Fix64",
 			value:    cadence.Fix64(-123000000),
@@ -708,6 +739,16 @@ func TestImportValue(t *testing.T) {
 			value:    cadence.UFix64(123000000),
 			expected: interpreter.UFix64Value(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    cadence.Fix128{Value: big.NewInt(-123)},
+			expected: interpreter.NewFix128ValueFromBigInt(big.NewInt(-123)),
+		},
+		{
+			label:    "UFix128",
+			value:    cadence.UFix128{Value: big.NewInt(123)},
+			expected: interpreter.NewUFix128ValueFromBigInt(big.NewInt(123)),
+		},
 		{
 			label: "Path",
 			value: cadence.Path{
@@ -940,6 +981,16 @@ func TestImportRuntimeType(t *testing.T) {
 			actual:   cadence.Word64Type{},
 			expected: interpreter.PrimitiveStaticTypeWord64,
 		},
+		{
+			label:    "Word128",
+			actual:   cadence.Word128Type{},
+			expected: interpreter.PrimitiveStaticTypeWord128,
+		},
+		{
+			label:    "Word256",
+			actual:   cadence.Word256Type{},
+			expected: interpreter.PrimitiveStaticTypeWord256,
+		},
 		{
 			label:    "Fix64",
 			actual:   cadence.Fix64Type{},
@@ -2268,6 +2319,16 @@ func TestRuntimeArgumentPassing(t *testing.T) {
 			typeSignature: "Word64",
 			exportedValue: cadence.NewWord64(42),
 		},
+		{
+			label:         "Word128",
+			typeSignature: "Word128",
+			exportedValue: cadence.NewWord128(42),
+		},
+		{
+			label:         "Word256",
+			typeSignature: "Word256",
+			exportedValue: cadence.NewWord256(42),
+		},
 		{
 			label:         "Fix64",
 			typeSignature: "Fix64",
@@ -3361,6 +3422,87 @@ func TestRuntimeImportExportDictionaryValue(t *testing.T) {
 	})
 }
 
+func TestRuntimeImportExportSetValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("export", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		value := interpreter.NewSetValue(
+			inter,
+			interpreter.SetStaticType{
+				ElementType: interpreter.PrimitiveStaticTypeString,
+			},
+			interpreter.NewStringValue("a"),
+		)
+
+		actual, err := exportValueWithInterpreter(value, inter, seenReferences{})
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewSet([]cadence.Value{
+				cadence.String("a"),
+			}),
+			actual,
+		)
+	})
+
+	t.Run("import", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := cadence.NewSet([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(2),
+		})
+
+		inter := newTestInterpreter(t)
+
+		actual, err := importValue(
+			inter,
+			value,
+			&sema.SetType{
+				ElementType: sema.IntType,
+			},
+		)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewSetValue(
+				inter,
+				interpreter.SetStaticType{
+					ElementType: interpreter.PrimitiveStaticTypeInt,
+				},
+				interpreter.NewIntValueFromInt64(1),
+				interpreter.NewIntValueFromInt64(2),
+			),
+			actual,
+		)
+	})
+
+	t.Run("import non-hashable element", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := cadence.NewSet([]cadence.Value{
+			cadence.NewArray([]cadence.Value{}),
+		})
+
+		_, err := importValue(
+			newTestInterpreter(t),
+			value,
+			sema.AnyStructType,
+		)
+		require.Error(t, err)
+	})
+}
+
 func TestRuntimeStringValueImport(t *testing.T) {
 
 	t.Parallel()
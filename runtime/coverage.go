@@ -18,42 +18,435 @@
 
 package runtime
 
-import "github.com/onflow/cadence/runtime/common"
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// BranchKind is the kind of element which has branches
+//
+type BranchKind string
+
+const (
+	BranchKindIf            BranchKind = "if"
+	BranchKindSwitch        BranchKind = "switch"
+	BranchKindNilCoalescing BranchKind = "nil-coalescing"
+	BranchKindConditional   BranchKind = "conditional"
+)
+
+// StatementCoverage records how often a coverable statement was executed
+//
+type StatementCoverage struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Hits   int `json:"hits"`
+}
+
+// FunctionCoverage records how often a function was entered
+//
+type FunctionCoverage struct {
+	Name    string `json:"name"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	EndLine int    `json:"end_line"`
+	Hits    int    `json:"hits"`
+}
+
+// BranchCoverage records how often each branch of an element was taken,
+// i.e. of an if-statement, a switch-statement, a nil-coalescing expression, or a conditional expression.
+//
+// See interpreter.OnBranchFunc for the meaning of the branch indices
+//
+type BranchCoverage struct {
+	Kind   BranchKind `json:"kind"`
+	Line   int        `json:"line"`
+	Column int        `json:"column"`
+	Hits   []int      `json:"hits"`
+}
+
+// Taken returns the number of branches which were taken at least once
+//
+func (c *BranchCoverage) Taken() int {
+	taken := 0
+	for _, hits := range c.Hits {
+		if hits > 0 {
+			taken++
+		}
+	}
+	return taken
+}
 
 // LocationCoverage records coverage information for a location
 //
 type LocationCoverage struct {
+	// LineHits maps each line to the number of times a statement on the line was executed
 	LineHits map[int]int `json:"line_hits"`
+	// Statements are the coverable statements of the program at the location
+	Statements []*StatementCoverage `json:"statements,omitempty"`
+	// Functions are the functions of the program at the location
+	Functions []*FunctionCoverage `json:"functions,omitempty"`
+	// Branches are the elements with branches of the program at the location
+	Branches []*BranchCoverage `json:"branches,omitempty"`
+
+	location   common.Location
+	inspected  bool
+	statements map[ast.Range]*StatementCoverage
+	functions  map[ast.Range]*FunctionCoverage
+	branches   map[ast.Range]*BranchCoverage
 }
 
 func (c *LocationCoverage) AddLineHit(line int) {
 	c.LineHits[line]++
 }
 
+// AddStatementHit records the execution of the given statement.
+// Statements which are not coverable only count as a line hit
+//
+func (c *LocationCoverage) AddStatementHit(statement ast.Statement) {
+	c.AddLineHit(statement.StartPosition().Line)
+
+	statementCoverage, ok := c.statements[ast.NewRangeFromPositioned(statement)]
+	if ok {
+		statementCoverage.Hits++
+	}
+}
+
+// AddFunctionHit records the entry of the function with the given declaration or expression
+//
+func (c *LocationCoverage) AddFunctionHit(element ast.Element) {
+	functionCoverage, ok := c.functions[ast.NewRangeFromPositioned(element)]
+	if ok {
+		functionCoverage.Hits++
+	}
+}
+
+// AddBranchHit records that the given branch of the given element was taken
+//
+func (c *LocationCoverage) AddBranchHit(element ast.Element, branch int) {
+	branchCoverage, ok := c.branches[ast.NewRangeFromPositioned(element)]
+	if ok && branch >= 0 && branch < len(branchCoverage.Hits) {
+		branchCoverage.Hits[branch]++
+	}
+}
+
+// CoverableLines returns the lines which contain at least one coverable statement, sorted
+//
+func (c *LocationCoverage) CoverableLines() []int {
+	lineSet := map[int]struct{}{}
+	for _, statement := range c.Statements {
+		lineSet[statement.Line] = struct{}{}
+	}
+
+	lines := make([]int, 0, len(lineSet))
+	for line := range lineSet {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+
+	return lines
+}
+
+// CoveredStatements returns the number of coverable statements which were executed at least once
+//
+func (c *LocationCoverage) CoveredStatements() int {
+	covered := 0
+	for _, statement := range c.Statements {
+		if statement.Hits > 0 {
+			covered++
+		}
+	}
+	return covered
+}
+
+// inspectProgram records the coverable statements, the functions,
+// and the elements with branches of the given program
+//
+func (c *LocationCoverage) inspectProgram(program *ast.Program) {
+	if c.inspected {
+		return
+	}
+	c.inspected = true
+
+	ast.Walk(coverageInspector{coverage: c}, program)
+
+	sort.SliceStable(c.Statements, func(i, j int) bool {
+		return comparePositions(
+			c.Statements[i].Line, c.Statements[i].Column,
+			c.Statements[j].Line, c.Statements[j].Column,
+		)
+	})
+	sort.SliceStable(c.Functions, func(i, j int) bool {
+		return comparePositions(
+			c.Functions[i].Line, c.Functions[i].Column,
+			c.Functions[j].Line, c.Functions[j].Column,
+		)
+	})
+	sort.SliceStable(c.Branches, func(i, j int) bool {
+		return comparePositions(
+			c.Branches[i].Line, c.Branches[i].Column,
+			c.Branches[j].Line, c.Branches[j].Column,
+		)
+	})
+}
+
+// functionCoverage returns the coverage of the given function declaration or function expression,
+// or nil if the function is not known
+//
+func (c *LocationCoverage) functionCoverage(element ast.Element) *FunctionCoverage {
+	return c.functions[ast.NewRangeFromPositioned(element)]
+}
+
+func comparePositions(line1, column1, line2, column2 int) bool {
+	return line1 < line2 || (line1 == line2 && column1 < column2)
+}
+
+func (c *LocationCoverage) addStatement(statement ast.Statement) {
+	key := ast.NewRangeFromPositioned(statement)
+	if _, ok := c.statements[key]; ok {
+		return
+	}
+
+	statementCoverage := &StatementCoverage{
+		Line:   key.StartPos.Line,
+		Column: key.StartPos.Column,
+	}
+	c.statements[key] = statementCoverage
+	c.Statements = append(c.Statements, statementCoverage)
+}
+
+func (c *LocationCoverage) addFunction(name string, element ast.Element) {
+	key := ast.NewRangeFromPositioned(element)
+	if _, ok := c.functions[key]; ok {
+		return
+	}
+
+	functionCoverage := &FunctionCoverage{
+		Name:    name,
+		Line:    key.StartPos.Line,
+		Column:  key.StartPos.Column,
+		EndLine: key.EndPos.Line,
+	}
+	c.functions[key] = functionCoverage
+	c.Functions = append(c.Functions, functionCoverage)
+}
+
+func (c *LocationCoverage) addBranch(kind BranchKind, element ast.Element, branchCount int) {
+	key := ast.NewRangeFromPositioned(element)
+	if _, ok := c.branches[key]; ok {
+		return
+	}
+
+	branchCoverage := &BranchCoverage{
+		Kind:   kind,
+		Line:   key.StartPos.Line,
+		Column: key.StartPos.Column,
+		Hits:   make([]int, branchCount),
+	}
+	c.branches[key] = branchCoverage
+	c.Branches = append(c.Branches, branchCoverage)
+}
+
+// coverageInspector is an AST walker which records the coverable elements of a program.
+// Function names are qualified by the names of the enclosing composites and interfaces
+//
+type coverageInspector struct {
+	coverage *LocationCoverage
+	prefix   string
+}
+
+func (i coverageInspector) qualifiedName(name string) string {
+	if i.prefix == "" {
+		return name
+	}
+	return i.prefix + "." + name
+}
+
+func (i coverageInspector) Walk(element ast.Element) ast.Walker {
+	switch element := element.(type) {
+	case nil:
+		return nil
+
+	case *ast.CompositeDeclaration:
+		return coverageInspector{
+			coverage: i.coverage,
+			prefix:   i.qualifiedName(element.Identifier.Identifier),
+		}
+
+	case *ast.InterfaceDeclaration:
+		return coverageInspector{
+			coverage: i.coverage,
+			prefix:   i.qualifiedName(element.Identifier.Identifier),
+		}
+
+	// Functions without a body, e.g. interface requirements, cannot be entered
+
+	case *ast.FunctionDeclaration:
+		if element.FunctionBlock != nil {
+			i.coverage.addFunction(i.qualifiedName(element.Identifier.Identifier), element)
+		}
+
+	case *ast.SpecialFunctionDeclaration:
+		functionDeclaration := element.FunctionDeclaration
+		if functionDeclaration.FunctionBlock != nil {
+			i.coverage.addFunction(i.qualifiedName(functionDeclaration.Identifier.Identifier), functionDeclaration)
+		}
+
+	case *ast.FunctionExpression:
+		position := element.StartPosition()
+		name := fmt.Sprintf("anonymous@%d:%d", position.Line, position.Column)
+		i.coverage.addFunction(i.qualifiedName(name), element)
+
+	case *ast.Block:
+		for _, statement := range element.Statements {
+			i.coverage.addStatement(statement)
+		}
+
+	case *ast.IfStatement:
+		i.coverage.addBranch(BranchKindIf, element, 2)
+
+	case *ast.SwitchStatement:
+		branchCount := len(element.Cases)
+		hasDefault := false
+		for _, switchCase := range element.Cases {
+			for _, statement := range switchCase.Statements {
+				i.coverage.addStatement(statement)
+			}
+			if switchCase.IsDefault() {
+				hasDefault = true
+			}
+		}
+		// If there is no default case, no case might be executed
+		if !hasDefault {
+			branchCount++
+		}
+		i.coverage.addBranch(BranchKindSwitch, element, branchCount)
+
+	case *ast.ConditionalExpression:
+		i.coverage.addBranch(BranchKindConditional, element, 2)
+
+	case *ast.BinaryExpression:
+		if element.Operation == ast.OperationNilCoalesce {
+			i.coverage.addBranch(BranchKindNilCoalescing, element, 2)
+		}
+	}
+
+	return i
+}
+
 func NewLocationCoverage() *LocationCoverage {
 	return &LocationCoverage{
-		LineHits: map[int]int{},
+		LineHits:   map[int]int{},
+		statements: map[ast.Range]*StatementCoverage{},
+		functions:  map[ast.Range]*FunctionCoverage{},
+		branches:   map[ast.Range]*BranchCoverage{},
 	}
 }
 
 // CoverageReport is a collection of coverage per location
 //
 type CoverageReport struct {
-	Coverage map[common.LocationID]*LocationCoverage `json:"coverage"`
+	Coverage          map[common.LocationID]*LocationCoverage `json:"coverage"`
+	excludedLocations map[common.LocationID]struct{}
 }
 
-func (r *CoverageReport) AddLineHit(location common.Location, line int) {
+// ExcludeLocation excludes the given location from the report,
+// e.g. the standard library or test helpers.
+// No coverage is recorded for excluded locations
+//
+func (r *CoverageReport) ExcludeLocation(location common.Location) {
+	locationID := location.ID()
+	r.excludedLocations[locationID] = struct{}{}
+	delete(r.Coverage, locationID)
+}
+
+// IsLocationExcluded returns true if the given location is excluded from the report
+//
+func (r *CoverageReport) IsLocationExcluded(location common.Location) bool {
+	_, ok := r.excludedLocations[location.ID()]
+	return ok
+}
+
+// locationCoverage returns the coverage for the given location,
+// or nil if the location is excluded
+//
+func (r *CoverageReport) locationCoverage(location common.Location) *LocationCoverage {
+	if r.IsLocationExcluded(location) {
+		return nil
+	}
+
 	locationID := location.ID()
 	locationCoverage := r.Coverage[locationID]
 	if locationCoverage == nil {
 		locationCoverage = NewLocationCoverage()
+		locationCoverage.location = location
 		r.Coverage[locationID] = locationCoverage
 	}
+	return locationCoverage
+}
+
+// InspectProgram records the coverable statements, functions, and branches
+// of the given program at the given location.
+// Programs are only inspected once, so this function may be called each time a program is loaded
+//
+func (r *CoverageReport) InspectProgram(location common.Location, program *ast.Program) {
+	locationCoverage := r.locationCoverage(location)
+	if locationCoverage == nil || program == nil {
+		return
+	}
+	locationCoverage.inspectProgram(program)
+}
+
+func (r *CoverageReport) AddLineHit(location common.Location, line int) {
+	locationCoverage := r.locationCoverage(location)
+	if locationCoverage == nil {
+		return
+	}
 	locationCoverage.AddLineHit(line)
 }
 
+func (r *CoverageReport) AddStatementHit(location common.Location, statement ast.Statement) {
+	locationCoverage := r.locationCoverage(location)
+	if locationCoverage == nil {
+		return
+	}
+	locationCoverage.AddStatementHit(statement)
+}
+
+func (r *CoverageReport) AddFunctionHit(location common.Location, element ast.Element) {
+	locationCoverage := r.locationCoverage(location)
+	if locationCoverage == nil {
+		return
+	}
+	locationCoverage.AddFunctionHit(element)
+}
+
+func (r *CoverageReport) AddBranchHit(location common.Location, element ast.Element, branch int) {
+	locationCoverage := r.locationCoverage(location)
+	if locationCoverage == nil {
+		return
+	}
+	locationCoverage.AddBranchHit(element, branch)
+}
+
+// sortedLocationIDs returns the IDs of the locations of the report, sorted
+//
+func (r *CoverageReport) sortedLocationIDs() []common.LocationID {
+	locationIDs := make([]common.LocationID, 0, len(r.Coverage))
+	for locationID := range r.Coverage {
+		locationIDs = append(locationIDs, locationID)
+	}
+	sort.Slice(locationIDs, func(i, j int) bool {
+		return locationIDs[i] < locationIDs[j]
+	})
+	return locationIDs
+}
+
 func NewCoverageReport() *CoverageReport {
 	return &CoverageReport{
-		Coverage: map[common.LocationID]*LocationCoverage{},
+		Coverage:          map[common.LocationID]*LocationCoverage{},
+		excludedLocations: map[common.LocationID]struct{}{},
 	}
 }
@@ -0,0 +1,332 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+// sourceFile returns the name of the source file reported for the given location coverage
+//
+func (c *LocationCoverage) sourceFile(locationID string) string {
+	if c.location == nil {
+		return locationID
+	}
+	return c.location.String()
+}
+
+// WriteLCOV writes the report in the LCOV tracefile format,
+// as e.g. understood by genhtml and most coverage services.
+//
+// Each location is reported as a source file
+//
+func (r *CoverageReport) WriteLCOV(writer io.Writer) error {
+	var builder strings.Builder
+
+	for _, locationID := range r.sortedLocationIDs() {
+		locationCoverage := r.Coverage[locationID]
+
+		builder.WriteString("TN:\n")
+		builder.WriteString(fmt.Sprintf("SF:%s\n", locationCoverage.sourceFile(string(locationID))))
+
+		// Functions
+
+		functionsHit := 0
+		for _, function := range locationCoverage.Functions {
+			builder.WriteString(fmt.Sprintf("FN:%d,%s\n", function.Line, function.Name))
+		}
+		for _, function := range locationCoverage.Functions {
+			builder.WriteString(fmt.Sprintf("FNDA:%d,%s\n", function.Hits, function.Name))
+			if function.Hits > 0 {
+				functionsHit++
+			}
+		}
+		builder.WriteString(fmt.Sprintf("FNF:%d\n", len(locationCoverage.Functions)))
+		builder.WriteString(fmt.Sprintf("FNH:%d\n", functionsHit))
+
+		// Branches.
+		// Branches of elements which were never evaluated are reported as "-"
+
+		branchesFound := 0
+		branchesHit := 0
+		for block, branchCoverage := range locationCoverage.Branches {
+			evaluated := branchCoverage.Taken() > 0
+
+			for branch, hits := range branchCoverage.Hits {
+				taken := "-"
+				if evaluated {
+					taken = fmt.Sprint(hits)
+				}
+
+				builder.WriteString(
+					fmt.Sprintf("BRDA:%d,%d,%d,%s\n", branchCoverage.Line, block, branch, taken),
+				)
+
+				branchesFound++
+				if hits > 0 {
+					branchesHit++
+				}
+			}
+		}
+		builder.WriteString(fmt.Sprintf("BRF:%d\n", branchesFound))
+		builder.WriteString(fmt.Sprintf("BRH:%d\n", branchesHit))
+
+		// Lines
+
+		lines := locationCoverage.CoverableLines()
+		linesHit := 0
+		for _, line := range lines {
+			hits := locationCoverage.LineHits[line]
+			builder.WriteString(fmt.Sprintf("DA:%d,%d\n", line, hits))
+			if hits > 0 {
+				linesHit++
+			}
+		}
+		builder.WriteString(fmt.Sprintf("LF:%d\n", len(lines)))
+		builder.WriteString(fmt.Sprintf("LH:%d\n", linesHit))
+
+		builder.WriteString("end_of_record\n")
+	}
+
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+// Cobertura XML report
+
+type coberturaCoverage struct {
+	XMLName         xml.Name           `xml:"coverage"`
+	LineRate        string             `xml:"line-rate,attr"`
+	BranchRate      string             `xml:"branch-rate,attr"`
+	LinesCovered    int                `xml:"lines-covered,attr"`
+	LinesValid      int                `xml:"lines-valid,attr"`
+	BranchesCovered int                `xml:"branches-covered,attr"`
+	BranchesValid   int                `xml:"branches-valid,attr"`
+	Complexity      string             `xml:"complexity,attr"`
+	Version         string             `xml:"version,attr"`
+	Timestamp       int64              `xml:"timestamp,attr"`
+	Packages        []coberturaPackage `xml:"packages>package"`
+}
+
+type coberturaPackage struct {
+	Name       string           `xml:"name,attr"`
+	LineRate   string           `xml:"line-rate,attr"`
+	BranchRate string           `xml:"branch-rate,attr"`
+	Complexity string           `xml:"complexity,attr"`
+	Classes    []coberturaClass `xml:"classes>class"`
+}
+
+type coberturaClass struct {
+	Name       string            `xml:"name,attr"`
+	Filename   string            `xml:"filename,attr"`
+	LineRate   string            `xml:"line-rate,attr"`
+	BranchRate string            `xml:"branch-rate,attr"`
+	Complexity string            `xml:"complexity,attr"`
+	Methods    []coberturaMethod `xml:"methods>method"`
+	Lines      []coberturaLine   `xml:"lines>line"`
+}
+
+type coberturaMethod struct {
+	Name       string          `xml:"name,attr"`
+	Signature  string          `xml:"signature,attr"`
+	LineRate   string          `xml:"line-rate,attr"`
+	BranchRate string          `xml:"branch-rate,attr"`
+	Complexity string          `xml:"complexity,attr"`
+	Hits       int             `xml:"hits,attr"`
+	Lines      []coberturaLine `xml:"lines>line"`
+}
+
+type coberturaLine struct {
+	Number            int    `xml:"number,attr"`
+	Hits              int    `xml:"hits,attr"`
+	Branch            bool   `xml:"branch,attr"`
+	ConditionCoverage string `xml:"condition-coverage,attr,omitempty"`
+}
+
+// coberturaCounts counts the covered and valid lines and branches
+//
+type coberturaCounts struct {
+	linesCovered    int
+	linesValid      int
+	branchesCovered int
+	branchesValid   int
+}
+
+func (c *coberturaCounts) add(other coberturaCounts) {
+	c.linesCovered += other.linesCovered
+	c.linesValid += other.linesValid
+	c.branchesCovered += other.branchesCovered
+	c.branchesValid += other.branchesValid
+}
+
+func (c coberturaCounts) lineRate() string {
+	return coberturaRate(c.linesCovered, c.linesValid)
+}
+
+func (c coberturaCounts) branchRate() string {
+	return coberturaRate(c.branchesCovered, c.branchesValid)
+}
+
+func coberturaRate(covered, valid int) string {
+	if valid == 0 {
+		return "1"
+	}
+	return fmt.Sprintf("%.4f", float64(covered)/float64(valid))
+}
+
+// coberturaLines returns the coverable lines of the given location coverage
+// within the given line range (inclusive), including the branch coverage of each line
+//
+func coberturaLines(locationCoverage *LocationCoverage, startLine, endLine int) ([]coberturaLine, coberturaCounts) {
+	type branchCounts struct {
+		covered int
+		valid   int
+	}
+
+	lineBranches := map[int]*branchCounts{}
+	for _, branchCoverage := range locationCoverage.Branches {
+		counts, ok := lineBranches[branchCoverage.Line]
+		if !ok {
+			counts = &branchCounts{}
+			lineBranches[branchCoverage.Line] = counts
+		}
+		counts.covered += branchCoverage.Taken()
+		counts.valid += len(branchCoverage.Hits)
+	}
+
+	var lines []coberturaLine
+	var counts coberturaCounts
+
+	for _, number := range locationCoverage.CoverableLines() {
+		if number < startLine || number > endLine {
+			continue
+		}
+
+		line := coberturaLine{
+			Number: number,
+			Hits:   locationCoverage.LineHits[number],
+		}
+
+		counts.linesValid++
+		if line.Hits > 0 {
+			counts.linesCovered++
+		}
+
+		if branches, ok := lineBranches[number]; ok {
+			line.Branch = true
+			line.ConditionCoverage = fmt.Sprintf(
+				"%d%% (%d/%d)",
+				branches.covered*100/branches.valid,
+				branches.covered,
+				branches.valid,
+			)
+
+			counts.branchesCovered += branches.covered
+			counts.branchesValid += branches.valid
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, counts
+}
+
+// WriteCobertura writes the report in the Cobertura XML format,
+// as e.g. understood by most CI systems.
+//
+// All locations are reported in a single package, each location as a class,
+// and each function as a method of the class
+//
+func (r *CoverageReport) WriteCobertura(writer io.Writer) error {
+	var totalCounts coberturaCounts
+
+	var classes []coberturaClass
+
+	for _, locationID := range r.sortedLocationIDs() {
+		locationCoverage := r.Coverage[locationID]
+
+		lines, classCounts := coberturaLines(locationCoverage, 0, math.MaxInt32)
+		totalCounts.add(classCounts)
+
+		var methods []coberturaMethod
+		for _, function := range locationCoverage.Functions {
+			methodLines, methodCounts := coberturaLines(locationCoverage, function.Line, function.EndLine)
+			methods = append(methods, coberturaMethod{
+				Name:       function.Name,
+				LineRate:   methodCounts.lineRate(),
+				BranchRate: methodCounts.branchRate(),
+				Complexity: "0",
+				Hits:       function.Hits,
+				Lines:      methodLines,
+			})
+		}
+
+		classes = append(classes, coberturaClass{
+			Name:       string(locationID),
+			Filename:   locationCoverage.sourceFile(string(locationID)),
+			LineRate:   classCounts.lineRate(),
+			BranchRate: classCounts.branchRate(),
+			Complexity: "0",
+			Methods:    methods,
+			Lines:      lines,
+		})
+	}
+
+	report := coberturaCoverage{
+		LineRate:        totalCounts.lineRate(),
+		BranchRate:      totalCounts.branchRate(),
+		LinesCovered:    totalCounts.linesCovered,
+		LinesValid:      totalCounts.linesValid,
+		BranchesCovered: totalCounts.branchesCovered,
+		BranchesValid:   totalCounts.branchesValid,
+		Complexity:      "0",
+		Version:         "cadence",
+		Timestamp:       time.Now().UnixNano() / int64(time.Millisecond),
+		Packages: []coberturaPackage{
+			{
+				Name:       "cadence",
+				LineRate:   totalCounts.lineRate(),
+				BranchRate: totalCounts.branchRate(),
+				Complexity: "0",
+				Classes:    classes,
+			},
+		},
+	}
+
+	_, err := io.WriteString(writer, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	err = encoder.Encode(report)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(writer, "\n")
+	return err
+}
@@ -19,7 +19,9 @@
 package runtime
 
 import (
+	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"testing"
 
@@ -101,14 +103,35 @@ func TestRuntimeCoverage(t *testing.T) {
                 "4": 1,
                 "5": 42,
                 "7": 1
-              }
+              },
+              "statements": [
+                {"line": 3, "column": 8, "hits": 1},
+                {"line": 4, "column": 8, "hits": 1},
+                {"line": 5, "column": 10, "hits": 42},
+                {"line": 7, "column": 8, "hits": 1}
+              ],
+              "functions": [
+                {"name": "answer", "line": 2, "column": 6, "end_line": 8, "hits": 1}
+              ]
             },
             "t.00": {
               "line_hits": {
                 "5": 1,
                 "6": 1,
                 "9": 1
-              }
+              },
+              "statements": [
+                {"line": 5, "column": 10, "hits": 1},
+                {"line": 6, "column": 10, "hits": 1},
+                {"line": 7, "column": 12, "hits": 0},
+                {"line": 9, "column": 10, "hits": 1}
+              ],
+              "functions": [
+                {"name": "main", "line": 4, "column": 6, "end_line": 10, "hits": 1}
+              ],
+              "branches": [
+                {"kind": "if", "line": 6, "column": 10, "hits": [0, 1]}
+              ]
             }
           }
         }
@@ -116,3 +139,271 @@ func TestRuntimeCoverage(t *testing.T) {
 		string(actual),
 	)
 }
+
+func TestRuntimeCoverageBranchesAndFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	script := []byte(`
+      pub struct S {
+          pub let x: Int
+
+          init(x: Int) {
+              self.x = x
+          }
+
+          pub fun unused() {}
+      }
+
+      pub fun classify(_ x: Int): String {
+          switch x {
+          case 1:
+              return "one"
+          case 2:
+              return "two"
+          }
+          return "many"
+      }
+
+      pub fun main(): Int {
+          let s = S(x: 1)
+          let optional: Int? = nil
+          let y = optional ?? s.x
+          let z = y > 0 ? 1 : 2
+          let f = fun (): String { return classify(z) }
+          f()
+          if let value = optional {
+              return value
+          }
+          return classify(3) == "many" ? y : z
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{}
+
+	coverageReport := NewCoverageReport()
+	runtime.SetCoverageReport(coverageReport)
+
+	location := common.ScriptLocation{0x1}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  location,
+		},
+	)
+	require.NoError(t, err)
+
+	locationCoverage := coverageReport.Coverage[location.ID()]
+	require.NotNil(t, locationCoverage)
+
+	functionHits := map[string]int{}
+	for _, function := range locationCoverage.Functions {
+		functionHits[function.Name] = function.Hits
+	}
+
+	assert.Equal(t,
+		map[string]int{
+			"S.init":          1,
+			"S.unused":        0,
+			"classify":        2,
+			"main":            1,
+			"anonymous@27:18": 1,
+		},
+		functionHits,
+	)
+
+	branches := map[BranchKind][][]int{}
+	for _, branch := range locationCoverage.Branches {
+		branches[branch.Kind] = append(branches[branch.Kind], branch.Hits)
+	}
+
+	assert.Equal(t,
+		map[BranchKind][][]int{
+			// case 1, case 2, no case
+			BranchKindSwitch:        {{1, 0, 1}},
+			BranchKindNilCoalescing: {{0, 1}},
+			BranchKindConditional:   {{1, 0}, {1, 0}},
+			BranchKindIf:            {{0, 1}},
+		},
+		branches,
+	)
+
+	assert.Equal(t, 13, locationCoverage.CoveredStatements())
+	assert.Len(t, locationCoverage.Statements, 15)
+}
+
+func TestRuntimeCoverageExcludedLocation(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	importedScript := []byte(`
+      pub fun answer(): Int {
+        return 42
+      }
+    `)
+
+	script := []byte(`
+      import "imported"
+
+      pub fun main(): Int {
+          return answer()
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(location Location) (bytes []byte, err error) {
+			switch location {
+			case common.StringLocation("imported"):
+				return importedScript, nil
+			default:
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			}
+		},
+	}
+
+	coverageReport := NewCoverageReport()
+	coverageReport.ExcludeLocation(common.StringLocation("imported"))
+
+	assert.True(t, coverageReport.IsLocationExcluded(common.StringLocation("imported")))
+
+	runtime.SetCoverageReport(coverageReport)
+
+	location := common.ScriptLocation{0x1}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  location,
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, coverageReport.Coverage, 1)
+	require.Contains(t, coverageReport.Coverage, location.ID())
+}
+
+func newTestCoverageReport(t *testing.T) *CoverageReport {
+
+	runtime := newTestInterpreterRuntime()
+
+	script := []byte(`
+      pub fun unused() {}
+
+      pub fun main(): Int {
+          let x: Int? = 1
+          if x == nil {
+              return 0
+          }
+          return x ?? 2
+      }
+    `)
+
+	coverageReport := NewCoverageReport()
+	runtime.SetCoverageReport(coverageReport)
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: &testRuntimeInterface{},
+			Location:  common.StringLocation("test.cdc"),
+		},
+	)
+	require.NoError(t, err)
+
+	return coverageReport
+}
+
+func TestCoverageReport_WriteLCOV(t *testing.T) {
+
+	t.Parallel()
+
+	coverageReport := newTestCoverageReport(t)
+
+	var buffer bytes.Buffer
+	err := coverageReport.WriteLCOV(&buffer)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`TN:
+SF:test.cdc
+FN:2,unused
+FN:4,main
+FNDA:0,unused
+FNDA:1,main
+FNF:2
+FNH:1
+BRDA:6,0,0,0
+BRDA:6,0,1,1
+BRDA:9,1,0,1
+BRDA:9,1,1,0
+BRF:4
+BRH:2
+DA:5,1
+DA:6,1
+DA:7,0
+DA:9,1
+LF:4
+LH:3
+end_of_record
+`,
+		buffer.String(),
+	)
+}
+
+func TestCoverageReport_WriteCobertura(t *testing.T) {
+
+	t.Parallel()
+
+	coverageReport := newTestCoverageReport(t)
+
+	var buffer bytes.Buffer
+	err := coverageReport.WriteCobertura(&buffer)
+	require.NoError(t, err)
+
+	var report coberturaCoverage
+	err = xml.Unmarshal(buffer.Bytes(), &report)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, report.LinesCovered)
+	assert.Equal(t, 4, report.LinesValid)
+	assert.Equal(t, 2, report.BranchesCovered)
+	assert.Equal(t, 4, report.BranchesValid)
+	assert.Equal(t, "0.7500", report.LineRate)
+	assert.Equal(t, "0.5000", report.BranchRate)
+
+	require.Len(t, report.Packages, 1)
+	require.Len(t, report.Packages[0].Classes, 1)
+
+	class := report.Packages[0].Classes[0]
+	assert.Equal(t, "test.cdc", class.Filename)
+
+	require.Len(t, class.Methods, 2)
+	assert.Equal(t, "unused", class.Methods[0].Name)
+	assert.Equal(t, 0, class.Methods[0].Hits)
+	assert.Equal(t, "main", class.Methods[1].Name)
+	assert.Equal(t, 1, class.Methods[1].Hits)
+	assert.Len(t, class.Methods[1].Lines, 4)
+
+	assert.Equal(t,
+		[]coberturaLine{
+			{Number: 5, Hits: 1},
+			{Number: 6, Hits: 1, Branch: true, ConditionCoverage: "50% (1/2)"},
+			{Number: 7, Hits: 0},
+			{Number: 9, Hits: 1, Branch: true, ConditionCoverage: "50% (1/2)"},
+		},
+		class.Lines,
+	)
+}
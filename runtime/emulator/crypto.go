@@ -0,0 +1,255 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secp256k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime"
+)
+
+// domainTagLength is the length of domain separation tags.
+// Shorter tags are padded with zeros
+//
+const domainTagLength = 32
+
+func newHasher(hashAlgorithm runtime.HashAlgorithm) (hash.Hash, error) {
+	switch hashAlgorithm {
+	case runtime.HashAlgorithmSHA2_256:
+		return sha256.New(), nil
+	case runtime.HashAlgorithmSHA2_384:
+		return sha512.New384(), nil
+	case runtime.HashAlgorithmSHA3_256:
+		return sha3.New256(), nil
+	case runtime.HashAlgorithmSHA3_384:
+		return sha3.New384(), nil
+	case runtime.HashAlgorithmKECCAK_256:
+		return sha3.NewLegacyKeccak256(), nil
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm: %s", hashAlgorithm.Name())
+	}
+}
+
+// hashWithTag hashes the given data using the given hash algorithm.
+// If the tag is not empty, it is padded with zeros to the domain tag length,
+// and is prepended to the data
+//
+func hashWithTag(data []byte, tag string, hashAlgorithm runtime.HashAlgorithm) ([]byte, error) {
+	hasher, err := newHasher(hashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	if tag != "" {
+		if len(tag) > domainTagLength {
+			return nil, fmt.Errorf(
+				"domain separation tag is longer than %d bytes: %s",
+				domainTagLength,
+				tag,
+			)
+		}
+
+		var paddedTag [domainTagLength]byte
+		copy(paddedTag[:], tag)
+		hasher.Write(paddedTag[:])
+	}
+
+	hasher.Write(data)
+
+	return hasher.Sum(nil), nil
+}
+
+func sha3_256(data []byte) [32]byte {
+	return sha3.Sum256(data)
+}
+
+// ecdsaCurve is an elliptic curve used for ECDSA signatures
+//
+type ecdsaCurve interface {
+	isOnCurve(x, y *big.Int) bool
+	// verify verifies the signature (r, s) of the given hash
+	verify(x, y *big.Int, hash []byte, r, s *big.Int) bool
+}
+
+// p256Curve is the NIST P-256 curve, implemented by the Go standard library
+//
+type p256Curve struct{}
+
+func (p256Curve) isOnCurve(x, y *big.Int) bool {
+	return elliptic.P256().IsOnCurve(x, y)
+}
+
+func (p256Curve) verify(x, y *big.Int, hash []byte, r, s *big.Int) bool {
+	publicKey := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     x,
+		Y:     y,
+	}
+	return ecdsa.Verify(publicKey, hash, r, s)
+}
+
+// secp256k1Curve is the secp256k1 curve, implemented by the decred secp256k1 package
+//
+type secp256k1Curve struct{}
+
+// publicKey returns the public key for the given point,
+// or false if the coordinates are out of range or the point is not on the curve
+//
+func (secp256k1Curve) publicKey(x, y *big.Int) (*secp256k1.PublicKey, bool) {
+	if x.BitLen() > 8*ecdsaCoordinateLength || y.BitLen() > 8*ecdsaCoordinateLength {
+		return nil, false
+	}
+
+	// Encode the point in the uncompressed format,
+	// which is prefixed with the tag 0x04
+
+	encoded := make([]byte, 1+2*ecdsaCoordinateLength)
+	encoded[0] = secp256k1.PubKeyFormatUncompressed
+	x.FillBytes(encoded[1 : 1+ecdsaCoordinateLength])
+	y.FillBytes(encoded[1+ecdsaCoordinateLength:])
+
+	publicKey, err := secp256k1.ParsePubKey(encoded)
+	if err != nil {
+		return nil, false
+	}
+
+	return publicKey, true
+}
+
+func (c secp256k1Curve) isOnCurve(x, y *big.Int) bool {
+	_, ok := c.publicKey(x, y)
+	return ok
+}
+
+func (c secp256k1Curve) verify(x, y *big.Int, hash []byte, r, s *big.Int) bool {
+	publicKey, ok := c.publicKey(x, y)
+	if !ok {
+		return false
+	}
+
+	if r.BitLen() > 8*ecdsaCoordinateLength || s.BitLen() > 8*ecdsaCoordinateLength {
+		return false
+	}
+
+	// Scalars which are not less than the order of the curve are invalid
+
+	var rScalar, sScalar secp256k1.ModNScalar
+	if rScalar.SetByteSlice(r.Bytes()) || sScalar.SetByteSlice(s.Bytes()) {
+		return false
+	}
+
+	return secp256k1ecdsa.NewSignature(&rScalar, &sScalar).Verify(hash, publicKey)
+}
+
+func ecdsaCurveForAlgorithm(signatureAlgorithm runtime.SignatureAlgorithm) ecdsaCurve {
+	switch signatureAlgorithm {
+	case runtime.SignatureAlgorithmECDSA_P256:
+		return p256Curve{}
+	case runtime.SignatureAlgorithmECDSA_secp256k1:
+		return secp256k1Curve{}
+	default:
+		return nil
+	}
+}
+
+// ecdsaCoordinateLength is the length of an encoded coordinate or scalar
+//
+const ecdsaCoordinateLength = 32
+
+// decodeECDSAPublicKey decodes the given public key,
+// which is the concatenation of the coordinates of the point.
+// It returns false if the key has the wrong length or the point is not on the curve
+//
+func decodeECDSAPublicKey(curve ecdsaCurve, publicKey []byte) (x, y *big.Int, ok bool) {
+	if len(publicKey) != 2*ecdsaCoordinateLength {
+		return nil, nil, false
+	}
+
+	x = new(big.Int).SetBytes(publicKey[:ecdsaCoordinateLength])
+	y = new(big.Int).SetBytes(publicKey[ecdsaCoordinateLength:])
+
+	if !curve.isOnCurve(x, y) {
+		return nil, nil, false
+	}
+
+	return x, y, true
+}
+
+// validatePublicKey returns true if the given public key is a valid key for the given signature algorithm.
+// Only ECDSA keys are supported
+//
+func validatePublicKey(publicKey []byte, signatureAlgorithm runtime.SignatureAlgorithm) bool {
+	curve := ecdsaCurveForAlgorithm(signatureAlgorithm)
+	if curve == nil {
+		return false
+	}
+
+	_, _, ok := decodeECDSAPublicKey(curve, publicKey)
+	return ok
+}
+
+// verifySignature verifies the given ECDSA signature of the given data.
+//
+// The signature is the concatenation of r and s,
+// and the data is hashed with the given tag, like by hashWithTag.
+// Signatures of an invalid length or for an invalid public key are not valid
+//
+func verifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm runtime.SignatureAlgorithm,
+	hashAlgorithm runtime.HashAlgorithm,
+) (bool, error) {
+
+	curve := ecdsaCurveForAlgorithm(signatureAlgorithm)
+	if curve == nil {
+		return false, fmt.Errorf("unsupported signature algorithm: %s", signatureAlgorithm.Name())
+	}
+
+	hash, err := hashWithTag(signedData, tag, hashAlgorithm)
+	if err != nil {
+		return false, err
+	}
+
+	x, y, ok := decodeECDSAPublicKey(curve, publicKey)
+	if !ok {
+		return false, nil
+	}
+
+	if len(signature) != 2*ecdsaCoordinateLength {
+		return false, nil
+	}
+
+	r := new(big.Int).SetBytes(signature[:ecdsaCoordinateLength])
+	s := new(big.Int).SetBytes(signature[ecdsaCoordinateLength:])
+
+	return curve.verify(x, y, hash, r, s), nil
+}
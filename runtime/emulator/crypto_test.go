@@ -0,0 +1,228 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime"
+)
+
+func TestSecp256k1IsOnCurve(t *testing.T) {
+
+	t.Parallel()
+
+	parse := func(s string) *big.Int {
+		value, ok := new(big.Int).SetString(s, 16)
+		require.True(t, ok)
+		return value
+	}
+
+	// The generator point
+
+	gx := parse("79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	gy := parse("483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8")
+
+	curve := secp256k1Curve{}
+
+	assert.True(t, curve.isOnCurve(gx, gy))
+
+	assert.False(t, curve.isOnCurve(gx, new(big.Int).Add(gy, big.NewInt(1))))
+
+	// Coordinates must be less than the field prime
+
+	p := parse("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F")
+
+	assert.False(t, curve.isOnCurve(new(big.Int).Add(gx, p), gy))
+
+	// Coordinates must fit into 32 bytes
+
+	assert.False(t, curve.isOnCurve(new(big.Int).Lsh(gx, 8), gy))
+}
+
+func TestHashWithTag(t *testing.T) {
+
+	t.Parallel()
+
+	hash, err := hashWithTag([]byte("abc"), "", runtime.HashAlgorithmSHA2_256)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		hex.EncodeToString(hash),
+	)
+
+	hash, err = hashWithTag([]byte("abc"), "", runtime.HashAlgorithmSHA3_256)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532",
+		hex.EncodeToString(hash),
+	)
+
+	// The tag is padded to 32 bytes
+
+	paddedData := make([]byte, domainTagLength)
+	copy(paddedData, "tag")
+	paddedData = append(paddedData, "abc"...)
+
+	expected, err := hashWithTag(paddedData, "", runtime.HashAlgorithmSHA3_384)
+	require.NoError(t, err)
+
+	hash, err = hashWithTag([]byte("abc"), "tag", runtime.HashAlgorithmSHA3_384)
+	require.NoError(t, err)
+	assert.Equal(t, expected, hash)
+
+	_, err = hashWithTag([]byte("abc"), "", runtime.HashAlgorithmKMAC128_BLS_BLS12_381)
+	require.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+
+	t.Parallel()
+
+	// The signatures were created with OpenSSL,
+	// for the data "hello cadence" with the tag "FLOW-V0.0-user"
+
+	const tag = "FLOW-V0.0-user"
+	signedData := []byte("hello cadence")
+
+	type testCase struct {
+		signatureAlgorithm runtime.SignatureAlgorithm
+		hashAlgorithm      runtime.HashAlgorithm
+		publicKey          string
+		signature          string
+	}
+
+	const secp256k1PublicKey = "4be5db095be1e406e86ef3890d9d320ea80ae49b20e8f9f29c9908d3f2ccf401" +
+		"4000195935353037ed05182809f1ea9274cc1a2ff64ed9ddf057f22374568644"
+
+	const p256PublicKey = "aa2f8c63efc90f6847562dfffa651ec0285c36764c38d40033600a4f0cfbe5e1" +
+		"5d31f1dff5530282ac25b6a8724a896fa9f7e16e6272a3809857a8a45cea8cd7"
+
+	testCases := []testCase{
+		{
+			signatureAlgorithm: runtime.SignatureAlgorithmECDSA_secp256k1,
+			hashAlgorithm:      runtime.HashAlgorithmSHA2_256,
+			publicKey:          secp256k1PublicKey,
+			signature: "d4d7cf011cd1bbcbb3c1c9dcb4ce7019f92f7f00cf578a93dabc44ee3b2aa3f6" +
+				"768b72716573dfbb05511d4899a65ecc3128952e9332f380a55cb690ce3d7d57",
+		},
+		{
+			signatureAlgorithm: runtime.SignatureAlgorithmECDSA_secp256k1,
+			hashAlgorithm:      runtime.HashAlgorithmSHA3_256,
+			publicKey:          secp256k1PublicKey,
+			signature: "2df9125422b9bcc67a3a22d1c4070499495f2fa3644bd82f25d1e5796455f346" +
+				"257f9190da7aac8b6565e27bffbdcd6a3cc0685b0efe7388d16329ae6e057059",
+		},
+		{
+			signatureAlgorithm: runtime.SignatureAlgorithmECDSA_P256,
+			hashAlgorithm:      runtime.HashAlgorithmSHA2_256,
+			publicKey:          p256PublicKey,
+			signature: "a08892b1f14316303dc9685a5f5751d04f233c942548b09ebc50939b24659f33" +
+				"cc938fcb805d1c8894936d4bd9bb88367ec48dab952862545166606a23927eaa",
+		},
+		{
+			signatureAlgorithm: runtime.SignatureAlgorithmECDSA_P256,
+			hashAlgorithm:      runtime.HashAlgorithmSHA3_256,
+			publicKey:          p256PublicKey,
+			signature: "d695fee914d7a70fdd75a123006226230710e9a28e4d37807b6bdfa175e36faf" +
+				"3767cd48efd1ad993a6e0b3c4c65dc9f6c8ea27de2d8e0a5a8889fce3a6f0e27",
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		testCase := testCase
+
+		name := testCase.signatureAlgorithm.Name() + " " + testCase.hashAlgorithm.Name()
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			publicKey, err := hex.DecodeString(testCase.publicKey)
+			require.NoError(t, err)
+
+			signature, err := hex.DecodeString(testCase.signature)
+			require.NoError(t, err)
+
+			assert.True(t, validatePublicKey(publicKey, testCase.signatureAlgorithm))
+
+			valid, err := verifySignature(
+				signature,
+				tag,
+				signedData,
+				publicKey,
+				testCase.signatureAlgorithm,
+				testCase.hashAlgorithm,
+			)
+			require.NoError(t, err)
+			assert.True(t, valid)
+
+			// Different data
+
+			valid, err = verifySignature(
+				signature,
+				tag,
+				[]byte("hello world"),
+				publicKey,
+				testCase.signatureAlgorithm,
+				testCase.hashAlgorithm,
+			)
+			require.NoError(t, err)
+			assert.False(t, valid)
+
+			// Different tag
+
+			valid, err = verifySignature(
+				signature,
+				"",
+				signedData,
+				publicKey,
+				testCase.signatureAlgorithm,
+				testCase.hashAlgorithm,
+			)
+			require.NoError(t, err)
+			assert.False(t, valid)
+
+			// Invalid public key
+
+			invalidPublicKey := make([]byte, len(publicKey))
+			copy(invalidPublicKey, publicKey)
+			invalidPublicKey[0] ^= 1
+
+			assert.False(t, validatePublicKey(invalidPublicKey, testCase.signatureAlgorithm))
+
+			valid, err = verifySignature(
+				signature,
+				tag,
+				signedData,
+				invalidPublicKey,
+				testCase.signatureAlgorithm,
+				testCase.hashAlgorithm,
+			)
+			require.NoError(t, err)
+			assert.False(t, valid)
+		})
+	}
+}
@@ -0,0 +1,331 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package emulator provides an in-process emulation of a blockchain,
+// against which transactions and scripts can be executed without a network.
+//
+// The Emulator implements the runtime interface:
+// It has an in-memory ledger, accounts with keys and contracts,
+// deterministic UUIDs and block heights, and collects events and logs.
+// Signatures are verified and data is hashed using the Go cryptography libraries.
+//
+package emulator
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// GenesisTimestamp is the timestamp of the genesis block, i.e. the block at height 0.
+// Each following block is one second later
+//
+var GenesisTimestamp = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// BlockInterval is the duration between two blocks
+//
+const BlockInterval = time.Second
+
+// DefaultStorageCapacity is the storage capacity of each account, in bytes
+//
+const DefaultStorageCapacity = 100 * 1024 * 1024
+
+// Emulator is an emulated, in-memory blockchain.
+//
+// Each transaction is executed in a new block. If the execution of a transaction fails,
+// all changes of the transaction are discarded.
+// Scripts are executed against the latest block, and their changes are always discarded
+//
+type Emulator struct {
+	runtime          runtime.Runtime
+	state            *state
+	programs         map[common.LocationID]*interpreter.Program
+	logHandler       func(string)
+	computationLimit uint64
+
+	// execution state, reset for each transaction and script
+
+	signers         []common.Address
+	events          []cadence.Event
+	logs            []string
+	computationUsed uint64
+	randomCount     uint64
+	// contractsChanged is true if contracts were updated or removed
+	contractsChanged bool
+}
+
+var _ runtime.Interface = &Emulator{}
+
+type Option func(*Emulator)
+
+// WithRuntime returns an emulator option
+// that sets the runtime which executes transactions and scripts.
+// By default, a new interpreter runtime is used
+//
+func WithRuntime(runtime runtime.Runtime) Option {
+	return func(emulator *Emulator) {
+		emulator.runtime = runtime
+	}
+}
+
+// WithLogHandler returns an emulator option
+// that sets the function which is called for each logged message,
+// in addition to the message being recorded in the result
+//
+func WithLogHandler(handler func(message string)) Option {
+	return func(emulator *Emulator) {
+		emulator.logHandler = handler
+	}
+}
+
+// WithComputationLimit returns an emulator option
+// that sets the computation limit of transactions and scripts.
+// By default, the computation is not limited
+//
+func WithComputationLimit(limit uint64) Option {
+	return func(emulator *Emulator) {
+		emulator.computationLimit = limit
+	}
+}
+
+// NewEmulator returns a new emulator, which has no accounts,
+// and whose latest block is the genesis block
+//
+func NewEmulator(options ...Option) *Emulator {
+	emulator := &Emulator{
+		state:    newState(),
+		programs: map[common.LocationID]*interpreter.Program{},
+	}
+
+	for _, option := range options {
+		option(emulator)
+	}
+
+	if emulator.runtime == nil {
+		emulator.runtime = runtime.NewInterpreterRuntime()
+	}
+
+	return emulator
+}
+
+// LoadEmulator returns a new emulator with the state read from the given reader,
+// which must have been written by Save
+//
+func LoadEmulator(reader io.Reader, options ...Option) (*Emulator, error) {
+	emulator := NewEmulator(options...)
+
+	state := newState()
+	err := gob.NewDecoder(reader).Decode(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load emulator state: %w", err)
+	}
+
+	emulator.state = state
+
+	return emulator, nil
+}
+
+// Save writes the state of the emulator to the given writer,
+// i.e. the ledger, the accounts, and the counters for UUIDs, blocks, and transactions.
+// Cached programs are not saved
+//
+func (e *Emulator) Save(writer io.Writer) error {
+	return gob.NewEncoder(writer).Encode(e.state)
+}
+
+// TransactionResult is the result of the execution of a transaction
+//
+type TransactionResult struct {
+	BlockHeight     uint64
+	Events          []cadence.Event
+	Logs            []string
+	ComputationUsed uint64
+}
+
+// ScriptResult is the result of the execution of a script
+//
+type ScriptResult struct {
+	Value           cadence.Value
+	Logs            []string
+	ComputationUsed uint64
+}
+
+// ExecuteTransaction executes the given transaction in a new block,
+// with the given accounts as signers.
+// The arguments must be encoded as JSON-Cadence.
+//
+// The result is also returned if the execution fails,
+// but then contains no events, and no block is committed
+//
+func (e *Emulator) ExecuteTransaction(
+	code []byte,
+	signers []common.Address,
+	arguments [][]byte,
+) (*TransactionResult, error) {
+
+	snapshot := e.state.copy()
+
+	e.startExecution()
+	e.signers = signers
+
+	e.state.BlockHeight++
+
+	result := &TransactionResult{
+		BlockHeight: e.state.BlockHeight,
+	}
+
+	var err error
+	for _, signer := range signers {
+		if _, ok := e.state.Accounts[signer]; !ok {
+			err = fmt.Errorf("signer account does not exist: %s", signer.ShortHexWithPrefix())
+			break
+		}
+	}
+
+	if err == nil {
+		err = e.runtime.ExecuteTransaction(
+			runtime.Script{
+				Source:    code,
+				Arguments: arguments,
+			},
+			runtime.Context{
+				Interface: e,
+				Location:  common.TransactionLocation(e.nextLocation()),
+			},
+		)
+	}
+
+	result.Logs = e.logs
+	result.ComputationUsed = e.computationUsed
+
+	if err != nil {
+		e.restore(snapshot)
+		return result, err
+	}
+
+	result.Events = e.events
+
+	return result, nil
+}
+
+// ExecuteScript executes the given script against the latest block, and returns its result.
+// The arguments must be encoded as JSON-Cadence.
+//
+// The result is also returned if the execution fails, but then has no value
+//
+func (e *Emulator) ExecuteScript(code []byte, arguments [][]byte) (*ScriptResult, error) {
+
+	snapshot := e.state.copy()
+	defer e.restore(snapshot)
+
+	e.startExecution()
+
+	value, err := e.runtime.ExecuteScript(
+		runtime.Script{
+			Source:    code,
+			Arguments: arguments,
+		},
+		runtime.Context{
+			Interface: e,
+			Location:  common.ScriptLocation(e.nextLocation()),
+		},
+	)
+
+	return &ScriptResult{
+		Value:           value,
+		Logs:            e.logs,
+		ComputationUsed: e.computationUsed,
+	}, err
+}
+
+// Account returns the account with the given address, or nil if it does not exist
+//
+func (e *Emulator) Account(address common.Address) *Account {
+	return e.state.Accounts[address]
+}
+
+// Accounts returns the addresses of all accounts, sorted
+//
+func (e *Emulator) Accounts() []common.Address {
+	addresses := make([]common.Address, 0, len(e.state.Accounts))
+	for address := range e.state.Accounts { //nolint:maprangecheck
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Hex() < addresses[j].Hex()
+	})
+	return addresses
+}
+
+// BlockHeight returns the height of the latest block
+//
+func (e *Emulator) BlockHeight() uint64 {
+	return e.state.BlockHeight
+}
+
+func (e *Emulator) startExecution() {
+	e.signers = nil
+	e.events = nil
+	e.logs = nil
+	e.computationUsed = 0
+	e.randomCount = 0
+	e.contractsChanged = false
+}
+
+// restore discards all changes since the given snapshot was taken.
+// Programs are cached across executions,
+// so they are discarded too if contracts were changed
+//
+func (e *Emulator) restore(snapshot *state) {
+	e.state = snapshot
+	if e.contractsChanged {
+		e.programs = map[common.LocationID]*interpreter.Program{}
+	}
+}
+
+// nextLocation returns a unique identifier for the next transaction or script
+//
+func (e *Emulator) nextLocation() []byte {
+	e.state.TransactionCount++
+	var location [8]byte
+	binary.BigEndian.PutUint64(location[:], e.state.TransactionCount)
+	return location[:]
+}
+
+// block returns the block at the given height.
+// Blocks are derived deterministically from their height
+//
+func block(height uint64) runtime.Block {
+	var heightBytes [8]byte
+	binary.BigEndian.PutUint64(heightBytes[:], height)
+
+	return runtime.Block{
+		Height:    height,
+		View:      height,
+		Hash:      sha3_256(heightBytes[:]),
+		Timestamp: GenesisTimestamp.Add(time.Duration(height) * BlockInterval).UnixNano(),
+	}
+}
@@ -0,0 +1,404 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const testCounterContract = `
+  pub contract Counter {
+
+      pub event Incremented(count: Int)
+
+      pub resource R {}
+
+      pub var count: Int
+
+      init() {
+          self.count = 0
+      }
+
+      pub fun increment() {
+          self.count = self.count + 1
+          emit Incremented(count: self.count)
+      }
+
+      pub fun createR(): @R {
+          return <-create R()
+      }
+  }
+`
+
+func deployTestContract(t *testing.T, emulator *Emulator, address common.Address, name string, code string) {
+	_, err := emulator.ExecuteTransaction(
+		[]byte(`
+          transaction(name: String, code: String) {
+              prepare(signer: AuthAccount) {
+                  signer.contracts.add(name: name, code: code.utf8)
+              }
+          }
+        `),
+		[]common.Address{address},
+		[][]byte{
+			jsoncdc.MustEncode(cadence.String(name)),
+			jsoncdc.MustEncode(cadence.String(code)),
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestEmulatorAccountsAndContracts(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address, err := emulator.CreateAccount(common.Address{})
+	require.NoError(t, err)
+	assert.Equal(t, common.Address{0, 0, 0, 0, 0, 0, 0, 1}, address)
+
+	deployTestContract(t, emulator, address, "Counter", testCounterContract)
+
+	names, err := emulator.GetAccountContractNames(address)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Counter"}, names)
+
+	result, err := emulator.ExecuteTransaction(
+		[]byte(`
+          import Counter from 0x1
+
+          transaction {
+              prepare(signer: AuthAccount) {
+                  Counter.increment()
+                  signer.save(<-Counter.createR(), to: /storage/r)
+                  log(Counter.count)
+              }
+          }
+        `),
+		[]common.Address{address},
+		nil,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2), result.BlockHeight)
+	assert.Equal(t, []string{"1"}, result.Logs)
+	require.Len(t, result.Events, 1)
+	assert.Equal(t,
+		"A.0000000000000001.Counter.Incremented",
+		result.Events[0].EventType.ID(),
+	)
+
+	scriptResult, err := emulator.ExecuteScript(
+		[]byte(`
+          import Counter from 0x1
+
+          pub fun main(): [AnyStruct] {
+              let account = getAccount(0x1)
+              return [
+                  Counter.count,
+                  getAuthAccount(0x1).borrow<&Counter.R>(from: /storage/r)!.uuid,
+                  getCurrentBlock().height,
+                  account.contracts.names
+              ]
+          }
+        `),
+		nil,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewInt(1),
+			// UUIDs are assigned sequentially, starting at 1
+			cadence.NewUInt64(1),
+			cadence.NewUInt64(2),
+			cadence.NewArray([]cadence.Value{
+				cadence.String("Counter"),
+			}),
+		}),
+		scriptResult.Value,
+	)
+
+	assert.Equal(t, []common.Address{address}, emulator.Accounts())
+	assert.Equal(t, uint64(2), emulator.BlockHeight())
+}
+
+func TestEmulatorFailedTransaction(t *testing.T) {
+
+	t.Parallel()
+
+	var logs []string
+
+	emulator := NewEmulator(
+		WithLogHandler(func(message string) {
+			logs = append(logs, message)
+		}),
+	)
+
+	address, err := emulator.CreateAccount(common.Address{})
+	require.NoError(t, err)
+
+	deployTestContract(t, emulator, address, "Counter", testCounterContract)
+
+	result, err := emulator.ExecuteTransaction(
+		[]byte(`
+          import Counter from 0x1
+
+          transaction {
+              prepare(signer: AuthAccount) {
+                  Counter.increment()
+                  signer.contracts.remove(name: "Counter")
+                  log("failing")
+                  panic("failure")
+              }
+          }
+        `),
+		[]common.Address{address},
+		nil,
+	)
+	require.Error(t, err)
+
+	assert.Equal(t, []string{`"failing"`}, result.Logs)
+	assert.Empty(t, result.Events)
+	assert.Equal(t, []string{`"failing"`}, logs)
+
+	// All changes are discarded
+
+	assert.Equal(t, uint64(1), emulator.BlockHeight())
+	assert.Equal(t, []string{"Counter"}, emulator.Account(address).ContractNames())
+
+	scriptResult, err := emulator.ExecuteScript(
+		[]byte(`
+          import Counter from 0x1
+
+          pub fun main(): Int {
+              return Counter.count
+          }
+        `),
+		nil,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewInt(0), scriptResult.Value)
+
+	// Signers must exist
+
+	_, err = emulator.ExecuteTransaction(
+		[]byte(`transaction { prepare(signer: AuthAccount) {} }`),
+		[]common.Address{{0x2}},
+		nil,
+	)
+	require.Error(t, err)
+}
+
+func TestEmulatorAccountKeysAndSignatures(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address, err := emulator.CreateAccount(common.Address{})
+	require.NoError(t, err)
+
+	// The public key and signature are the test vectors of TestVerifySignature
+
+	_, err = emulator.ExecuteTransaction(
+		[]byte(`
+          transaction(publicKey: String) {
+              prepare(signer: AuthAccount) {
+                  signer.keys.add(
+                      publicKey: PublicKey(
+                          publicKey: publicKey.decodeHex(),
+                          signatureAlgorithm: SignatureAlgorithm.ECDSA_secp256k1
+                      ),
+                      hashAlgorithm: HashAlgorithm.SHA2_256,
+                      weight: 1000.0
+                  )
+              }
+          }
+        `),
+		[]common.Address{address},
+		[][]byte{
+			jsoncdc.MustEncode(cadence.String(
+				"4be5db095be1e406e86ef3890d9d320ea80ae49b20e8f9f29c9908d3f2ccf401" +
+					"4000195935353037ed05182809f1ea9274cc1a2ff64ed9ddf057f22374568644",
+			)),
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, emulator.Account(address).Keys, 1)
+
+	result, err := emulator.ExecuteScript(
+		[]byte(`
+          pub fun main(signature: String): [Bool] {
+              let key = getAccount(0x1).keys.get(keyIndex: 0)!
+              let signedData = "hello cadence".utf8
+              return [
+                  key.publicKey.verify(
+                      signature: signature.decodeHex(),
+                      signedData: signedData,
+                      domainSeparationTag: "FLOW-V0.0-user",
+                      hashAlgorithm: HashAlgorithm.SHA2_256
+                  ),
+                  key.publicKey.verify(
+                      signature: signature.decodeHex(),
+                      signedData: "hello world".utf8,
+                      domainSeparationTag: "FLOW-V0.0-user",
+                      hashAlgorithm: HashAlgorithm.SHA2_256
+                  )
+              ]
+          }
+        `),
+		[][]byte{
+			jsoncdc.MustEncode(cadence.String(
+				"d4d7cf011cd1bbcbb3c1c9dcb4ce7019f92f7f00cf578a93dabc44ee3b2aa3f6" +
+					"768b72716573dfbb05511d4899a65ecc3128952e9332f380a55cb690ce3d7d57",
+			)),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewBool(true),
+			cadence.NewBool(false),
+		}),
+		result.Value,
+	)
+}
+
+func TestEmulatorBlocks(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address, err := emulator.CreateAccount(common.Address{})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = emulator.ExecuteTransaction(
+			[]byte(`transaction { prepare(signer: AuthAccount) {} }`),
+			[]common.Address{address},
+			nil,
+		)
+		require.NoError(t, err)
+	}
+
+	result, err := emulator.ExecuteScript(
+		[]byte(`
+          pub fun main(): [AnyStruct] {
+              let current = getCurrentBlock()
+              let genesis = getBlock(at: 0)!
+              return [
+                  current.height,
+                  current.timestamp - genesis.timestamp,
+                  getBlock(at: 3) == nil
+              ]
+          }
+        `),
+		nil,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewUInt64(2),
+			cadence.UFix64(2_00000000),
+			cadence.NewBool(true),
+		}),
+		result.Value,
+	)
+}
+
+func TestEmulatorSaveAndLoad(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address, err := emulator.CreateAccount(common.Address{})
+	require.NoError(t, err)
+
+	deployTestContract(t, emulator, address, "Counter", testCounterContract)
+
+	_, err = emulator.ExecuteTransaction(
+		[]byte(`
+          import Counter from 0x1
+
+          transaction {
+              prepare(signer: AuthAccount) {
+                  Counter.increment()
+                  signer.save(<-Counter.createR(), to: /storage/r)
+              }
+          }
+        `),
+		[]common.Address{address},
+		nil,
+	)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	err = emulator.Save(&buffer)
+	require.NoError(t, err)
+
+	loaded, err := LoadEmulator(&buffer)
+	require.NoError(t, err)
+
+	assert.Equal(t, emulator.BlockHeight(), loaded.BlockHeight())
+	assert.Equal(t, emulator.Accounts(), loaded.Accounts())
+
+	result, err := loaded.ExecuteScript(
+		[]byte(`
+          import Counter from 0x1
+
+          pub fun main(): [AnyStruct] {
+              return [
+                  Counter.count,
+                  getAuthAccount(0x1).borrow<&Counter.R>(from: /storage/r)!.uuid
+              ]
+          }
+        `),
+		nil,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewUInt64(1),
+		}),
+		result.Value,
+	)
+
+	// UUIDs continue where the saved emulator left off
+
+	uuid, err := loaded.GenerateUUID()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), uuid)
+}
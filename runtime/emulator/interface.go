@@ -0,0 +1,412 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onflow/atree"
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func (e *Emulator) ResolveLocation(
+	identifiers []runtime.Identifier,
+	location runtime.Location,
+) ([]runtime.ResolvedLocation, error) {
+
+	addressLocation, ok := location.(common.AddressLocation)
+
+	// Only address locations are resolved,
+	// all other locations are returned as-is
+
+	if !ok {
+		return []runtime.ResolvedLocation{
+			{
+				Location:    location,
+				Identifiers: identifiers,
+			},
+		}, nil
+	}
+
+	// If no identifiers are imported, import all contracts of the account
+
+	if len(identifiers) == 0 {
+		names, err := e.GetAccountContractNames(addressLocation.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			identifiers = append(identifiers, runtime.Identifier{
+				Identifier: name,
+			})
+		}
+	}
+
+	resolvedLocations := make([]runtime.ResolvedLocation, len(identifiers))
+	for i, identifier := range identifiers {
+		resolvedLocations[i] = runtime.ResolvedLocation{
+			Location: common.AddressLocation{
+				Address: addressLocation.Address,
+				Name:    identifier.Identifier,
+			},
+			Identifiers: []runtime.Identifier{identifier},
+		}
+	}
+
+	return resolvedLocations, nil
+}
+
+func (e *Emulator) GetCode(location runtime.Location) ([]byte, error) {
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return nil, fmt.Errorf("cannot import `%s`: only account contracts are supported", location)
+	}
+
+	return e.GetAccountContractCode(addressLocation.Address, addressLocation.Name)
+}
+
+// GetProgram returns the cached program for the given location.
+// Only the programs of account contracts are cached
+//
+func (e *Emulator) GetProgram(location runtime.Location) (*interpreter.Program, error) {
+	return e.programs[location.ID()], nil
+}
+
+func (e *Emulator) SetProgram(location runtime.Location, program *interpreter.Program) error {
+	if _, ok := location.(common.AddressLocation); ok {
+		e.programs[location.ID()] = program
+	}
+	return nil
+}
+
+func (e *Emulator) GetValue(owner, key []byte) (value []byte, err error) {
+	return e.state.Registers[RegisterID{Owner: string(owner), Key: string(key)}], nil
+}
+
+func (e *Emulator) SetValue(owner, key, value []byte) (err error) {
+	id := RegisterID{Owner: string(owner), Key: string(key)}
+	if len(value) == 0 {
+		delete(e.state.Registers, id)
+	} else {
+		e.state.Registers[id] = value
+	}
+	return nil
+}
+
+func (e *Emulator) ValueExists(owner, key []byte) (exists bool, err error) {
+	value := e.state.Registers[RegisterID{Owner: string(owner), Key: string(key)}]
+	return len(value) > 0, nil
+}
+
+func (e *Emulator) AllocateStorageIndex(owner []byte) (result atree.StorageIndex, err error) {
+	index := e.state.StorageIndices[string(owner)] + 1
+	e.state.StorageIndices[string(owner)] = index
+	binary.BigEndian.PutUint64(result[:], index)
+	return
+}
+
+// CreateAccount creates a new account without keys and contracts.
+// Addresses are assigned sequentially, starting at 0x1
+//
+func (e *Emulator) CreateAccount(_ runtime.Address) (address runtime.Address, err error) {
+	var addressBytes [8]byte
+	binary.BigEndian.PutUint64(addressBytes[:], uint64(len(e.state.Accounts)+1))
+	address = common.Address(addressBytes)
+
+	e.state.Accounts[address] = &Account{
+		Address:   address,
+		Contracts: map[string][]byte{},
+	}
+
+	return address, nil
+}
+
+func (e *Emulator) account(address runtime.Address) (*Account, error) {
+	account, ok := e.state.Accounts[address]
+	if !ok {
+		return nil, fmt.Errorf("account does not exist: %s", address.ShortHexWithPrefix())
+	}
+	return account, nil
+}
+
+func (e *Emulator) AddEncodedAccountKey(address runtime.Address, publicKey []byte) error {
+	account, err := e.account(address)
+	if err != nil {
+		return err
+	}
+
+	account.EncodedKeys = append(account.EncodedKeys, publicKey)
+	return nil
+}
+
+func (e *Emulator) RevokeEncodedAccountKey(address runtime.Address, index int) (publicKey []byte, err error) {
+	account, err := e.account(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 || index >= len(account.EncodedKeys) {
+		return nil, fmt.Errorf("invalid key index: %d", index)
+	}
+
+	publicKey = account.EncodedKeys[index]
+	account.EncodedKeys = append(account.EncodedKeys[:index], account.EncodedKeys[index+1:]...)
+	return publicKey, nil
+}
+
+func (e *Emulator) AddAccountKey(
+	address runtime.Address,
+	publicKey *runtime.PublicKey,
+	hashAlgo runtime.HashAlgorithm,
+	weight int,
+) (*runtime.AccountKey, error) {
+	account, err := e.account(address)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &runtime.AccountKey{
+		KeyIndex:  len(account.Keys),
+		PublicKey: publicKey,
+		HashAlgo:  hashAlgo,
+		Weight:    weight,
+	}
+	account.Keys = append(account.Keys, key)
+
+	return key, nil
+}
+
+// GetAccountKey returns the key with the given index, or nil if the account has no such key
+//
+func (e *Emulator) GetAccountKey(address runtime.Address, index int) (*runtime.AccountKey, error) {
+	account, ok := e.state.Accounts[address]
+	if !ok || index < 0 || index >= len(account.Keys) {
+		return nil, nil
+	}
+	return account.Keys[index], nil
+}
+
+func (e *Emulator) RevokeAccountKey(address runtime.Address, index int) (*runtime.AccountKey, error) {
+	key, err := e.GetAccountKey(address, index)
+	if key == nil || err != nil {
+		return nil, err
+	}
+	key.IsRevoked = true
+	return key, nil
+}
+
+func (e *Emulator) UpdateAccountContractCode(address runtime.Address, name string, code []byte) (err error) {
+	account, err := e.account(address)
+	if err != nil {
+		return err
+	}
+
+	account.Contracts[name] = code
+
+	// The program of the previous code is outdated
+
+	delete(e.programs, common.AddressLocation{Address: address, Name: name}.ID())
+	e.contractsChanged = true
+
+	return nil
+}
+
+func (e *Emulator) GetAccountContractCode(address runtime.Address, name string) (code []byte, err error) {
+	account, ok := e.state.Accounts[address]
+	if !ok {
+		return nil, nil
+	}
+	return account.Contracts[name], nil
+}
+
+func (e *Emulator) RemoveAccountContractCode(address runtime.Address, name string) (err error) {
+	account, err := e.account(address)
+	if err != nil {
+		return err
+	}
+
+	delete(account.Contracts, name)
+
+	delete(e.programs, common.AddressLocation{Address: address, Name: name}.ID())
+	e.contractsChanged = true
+
+	return nil
+}
+
+func (e *Emulator) GetAccountContractNames(address runtime.Address) ([]string, error) {
+	account, ok := e.state.Accounts[address]
+	if !ok {
+		return nil, nil
+	}
+	return account.ContractNames(), nil
+}
+
+func (e *Emulator) GetSigningAccounts() ([]runtime.Address, error) {
+	return e.signers, nil
+}
+
+func (e *Emulator) ProgramLog(message string) error {
+	e.logs = append(e.logs, message)
+	if e.logHandler != nil {
+		e.logHandler(message)
+	}
+	return nil
+}
+
+func (e *Emulator) EmitEvent(event cadence.Event) error {
+	e.events = append(e.events, event)
+	return nil
+}
+
+// GenerateUUID returns the next UUID.
+// UUIDs are assigned sequentially, starting at 1
+//
+func (e *Emulator) GenerateUUID() (uint64, error) {
+	e.state.UUID++
+	return e.state.UUID, nil
+}
+
+func (e *Emulator) GetComputationLimit() uint64 {
+	return e.computationLimit
+}
+
+func (e *Emulator) SetComputationUsed(used uint64) error {
+	e.computationUsed = used
+	return nil
+}
+
+func (e *Emulator) DecodeArgument(argument []byte, _ cadence.Type) (cadence.Value, error) {
+	return jsoncdc.Decode(argument)
+}
+
+func (e *Emulator) GetCurrentBlockHeight() (uint64, error) {
+	return e.state.BlockHeight, nil
+}
+
+func (e *Emulator) GetBlockAtHeight(height uint64) (runtime.Block, bool, error) {
+	if height > e.state.BlockHeight {
+		return runtime.Block{}, false, nil
+	}
+
+	return block(height), true, nil
+}
+
+// UnsafeRandom returns a deterministic pseudo-random number,
+// derived from the number of executed transactions and scripts
+//
+func (e *Emulator) UnsafeRandom() (uint64, error) {
+	e.randomCount++
+
+	var seed [16]byte
+	binary.BigEndian.PutUint64(seed[:8], e.state.TransactionCount)
+	binary.BigEndian.PutUint64(seed[8:], e.randomCount)
+
+	hash := sha3_256(seed[:])
+	return binary.BigEndian.Uint64(hash[:8]), nil
+}
+
+func (e *Emulator) VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm runtime.SignatureAlgorithm,
+	hashAlgorithm runtime.HashAlgorithm,
+) (bool, error) {
+	return verifySignature(
+		signature,
+		tag,
+		signedData,
+		publicKey,
+		signatureAlgorithm,
+		hashAlgorithm,
+	)
+}
+
+func (e *Emulator) Hash(data []byte, tag string, hashAlgorithm runtime.HashAlgorithm) ([]byte, error) {
+	return hashWithTag(data, tag, hashAlgorithm)
+}
+
+func (e *Emulator) GetAccountBalance(_ common.Address) (value uint64, err error) {
+	return 0, nil
+}
+
+func (e *Emulator) GetAccountAvailableBalance(_ common.Address) (value uint64, err error) {
+	return 0, nil
+}
+
+// GetStorageUsed returns the total size of the keys and values of the account's registers
+//
+func (e *Emulator) GetStorageUsed(address runtime.Address) (used uint64, err error) {
+	owner := string(address[:])
+	for id, value := range e.state.Registers { //nolint:maprangecheck
+		if id.Owner == owner {
+			used += uint64(len(id.Key) + len(value))
+		}
+	}
+	return used, nil
+}
+
+func (e *Emulator) GetStorageCapacity(_ runtime.Address) (value uint64, err error) {
+	return DefaultStorageCapacity, nil
+}
+
+func (e *Emulator) ImplementationDebugLog(_ string) error {
+	return nil
+}
+
+func (e *Emulator) ValidatePublicKey(key *runtime.PublicKey) (bool, error) {
+	return validatePublicKey(key.PublicKey, key.SignAlgo), nil
+}
+
+func (e *Emulator) RecordTrace(_ string, _ common.Location, _ time.Duration, _ []opentracing.LogRecord) {
+	// NO-OP
+}
+
+func (e *Emulator) BLSVerifyPOP(_ *runtime.PublicKey, _ []byte) (bool, error) {
+	return false, errBLSNotSupported
+}
+
+func (e *Emulator) AggregateBLSSignatures(_ [][]byte) ([]byte, error) {
+	return nil, errBLSNotSupported
+}
+
+func (e *Emulator) AggregateBLSPublicKeys(_ []*runtime.PublicKey) (*runtime.PublicKey, error) {
+	return nil, errBLSNotSupported
+}
+
+func (e *Emulator) ResourceOwnerChanged(
+	_ *interpreter.CompositeValue,
+	_ common.Address,
+	_ common.Address,
+) {
+	// NO-OP
+}
+
+var errBLSNotSupported = errors.New("BLS is not supported")
@@ -0,0 +1,127 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"sort"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// Account is an account of the emulator
+//
+type Account struct {
+	Address common.Address
+	Keys    []*runtime.AccountKey
+	// EncodedKeys are the keys added using the deprecated encoded key functions.
+	// They are opaque and cannot be used for signature verification
+	EncodedKeys [][]byte
+	Contracts   map[string][]byte
+}
+
+func (a *Account) copy() *Account {
+	keys := make([]*runtime.AccountKey, len(a.Keys))
+	for i, key := range a.Keys {
+		keyCopy := *key
+		keys[i] = &keyCopy
+	}
+
+	encodedKeys := make([][]byte, len(a.EncodedKeys))
+	copy(encodedKeys, a.EncodedKeys)
+
+	contracts := make(map[string][]byte, len(a.Contracts))
+	for name, code := range a.Contracts { //nolint:maprangecheck
+		contracts[name] = code
+	}
+
+	return &Account{
+		Address:     a.Address,
+		Keys:        keys,
+		EncodedKeys: encodedKeys,
+		Contracts:   contracts,
+	}
+}
+
+// ContractNames returns the names of the contracts deployed to the account, sorted
+//
+func (a *Account) ContractNames() []string {
+	names := make([]string, 0, len(a.Contracts))
+	for name := range a.Contracts { //nolint:maprangecheck
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterID identifies a register of the ledger
+//
+type RegisterID struct {
+	Owner string
+	Key   string
+}
+
+// state is the mutable state of an emulator:
+// The ledger, which stores the atree slabs and the other storage registers, and the accounts.
+//
+// The state is copied before a transaction is executed,
+// and restored if the execution of the transaction fails
+//
+type state struct {
+	Registers        map[RegisterID][]byte
+	StorageIndices   map[string]uint64
+	Accounts         map[common.Address]*Account
+	UUID             uint64
+	BlockHeight      uint64
+	TransactionCount uint64
+}
+
+func newState() *state {
+	return &state{
+		Registers:      map[RegisterID][]byte{},
+		StorageIndices: map[string]uint64{},
+		Accounts:       map[common.Address]*Account{},
+	}
+}
+
+func (s *state) copy() *state {
+	registers := make(map[RegisterID][]byte, len(s.Registers))
+	for id, value := range s.Registers { //nolint:maprangecheck
+		registers[id] = value
+	}
+
+	storageIndices := make(map[string]uint64, len(s.StorageIndices))
+	for owner, index := range s.StorageIndices { //nolint:maprangecheck
+		storageIndices[owner] = index
+	}
+
+	accounts := make(map[common.Address]*Account, len(s.Accounts))
+	for address, account := range s.Accounts { //nolint:maprangecheck
+		accounts[address] = account.copy()
+	}
+
+	return &state{
+		Registers:        registers,
+		StorageIndices:   storageIndices,
+		Accounts:         accounts,
+		UUID:             s.UUID,
+		BlockHeight:      s.BlockHeight,
+		TransactionCount: s.TransactionCount,
+	}
+}
@@ -483,3 +483,21 @@ func (e *MissingCompositeDeclarationError) Error() string {
 		e.Name,
 	)
 }
+
+// ImportHashMismatchError is reported when the hash of the code of an imported program
+// does not match the hash expected by the import declaration
+//
+type ImportHashMismatchError struct {
+	Location     common.Location
+	ExpectedHash []byte
+	ActualHash   []byte
+}
+
+func (e *ImportHashMismatchError) Error() string {
+	return fmt.Sprintf(
+		"mismatching hash of imported code at %s: expected %x, got %x",
+		e.Location,
+		e.ExpectedHash,
+		e.ActualHash,
+	)
+}
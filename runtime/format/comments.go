@@ -0,0 +1,221 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"strings"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// comment is a line comment or a (potentially nested) block comment in the source code
+//
+type comment struct {
+	text string
+	ast.Range
+}
+
+func (c comment) isSingleLine() bool {
+	return c.StartPos.Line == c.EndPos.Line
+}
+
+// doc returns the document for the comment.
+//
+// Line comments are printed as-is.
+// The lines of block comments are re-indented:
+// Lines starting with an asterisk are aligned with the start of the comment,
+// all other lines have their common indentation removed.
+//
+func (c comment) doc() prettier.Doc {
+	lines := strings.Split(c.text, "\n")
+	if len(lines) == 1 {
+		return prettier.Text(strings.TrimRight(c.text, " \t\r"))
+	}
+
+	rest := lines[1:]
+
+	javadocStyle := true
+	commonIndentation := -1
+
+	for _, line := range rest {
+		trimmed := strings.TrimLeft(line, " \t")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(trimmed, "*") {
+			javadocStyle = false
+		}
+
+		indentation := len(line) - len(trimmed)
+		if commonIndentation < 0 || indentation < commonIndentation {
+			commonIndentation = indentation
+		}
+	}
+
+	doc := prettier.Concat{
+		prettier.Text(strings.TrimRight(lines[0], " \t\r")),
+	}
+
+	for _, line := range rest {
+		line = strings.TrimRight(line, " \t\r")
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			line = ""
+		case javadocStyle:
+			line = " " + strings.TrimLeft(line, " \t")
+		default:
+			line = line[commonIndentation:]
+		}
+
+		doc = append(
+			doc,
+			prettier.HardLine{},
+			prettier.Text(line),
+		)
+	}
+
+	return doc
+}
+
+// parseComments returns all comments in the given code, in order of appearance.
+//
+// The code is assumed to be syntactically valid,
+// so only string literals need to be skipped.
+//
+func parseComments(code string) []comment {
+	var comments []comment
+
+	line := 1
+	lineStart := 0
+
+	position := func(offset int) ast.Position {
+		return ast.Position{
+			Offset: offset,
+			Line:   line,
+			Column: offset - lineStart,
+		}
+	}
+
+	length := len(code)
+
+	for offset := 0; offset < length; offset++ {
+		switch code[offset] {
+		case '\n':
+			line++
+			lineStart = offset + 1
+
+		case '"':
+			// Skip the string literal, including escaped quotes
+			for offset++; offset < length; offset++ {
+				c := code[offset]
+				if c == '\\' {
+					offset++
+				} else if c == '"' {
+					break
+				} else if c == '\n' {
+					// Unterminated string literal, continue at the line break
+					offset--
+					break
+				}
+			}
+
+		case '/':
+			if offset+1 >= length {
+				continue
+			}
+
+			switch code[offset+1] {
+			case '/':
+				startPos := position(offset)
+
+				end := strings.IndexByte(code[offset:], '\n')
+				if end < 0 {
+					end = length
+				} else {
+					end += offset
+				}
+
+				comments = append(
+					comments,
+					comment{
+						text: code[offset:end],
+						Range: ast.Range{
+							StartPos: startPos,
+							EndPos:   position(end - 1),
+						},
+					},
+				)
+
+				// Continue at the line break, if any
+				offset = end - 1
+
+			case '*':
+				startPos := position(offset)
+				startOffset := offset
+
+				nesting := 0
+
+			blockComment:
+				for ; offset < length; offset++ {
+					switch code[offset] {
+					case '\n':
+						line++
+						lineStart = offset + 1
+
+					case '/':
+						if offset+1 < length && code[offset+1] == '*' {
+							nesting++
+							offset++
+						}
+
+					case '*':
+						if offset+1 < length && code[offset+1] == '/' {
+							nesting--
+							offset++
+							if nesting == 0 {
+								break blockComment
+							}
+						}
+					}
+				}
+
+				if offset >= length {
+					offset = length - 1
+				}
+
+				comments = append(
+					comments,
+					comment{
+						text: code[startOffset : offset+1],
+						Range: ast.Range{
+							StartPos: startPos,
+							EndPos:   position(offset),
+						},
+					},
+				)
+			}
+		}
+	}
+
+	return comments
+}
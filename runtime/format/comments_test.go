@@ -0,0 +1,54 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+func TestParseComments(t *testing.T) {
+
+	t.Parallel()
+
+	code := "let x = \"// \\\" /*\" // line\n/* outer /* inner */\n */ let y = 1 / 2"
+
+	assert.Equal(t,
+		[]comment{
+			{
+				text: "// line",
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 19, Line: 1, Column: 19},
+					EndPos:   ast.Position{Offset: 25, Line: 1, Column: 25},
+				},
+			},
+			{
+				text: "/* outer /* inner */\n */",
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 27, Line: 2, Column: 0},
+					EndPos:   ast.Position{Offset: 50, Line: 3, Column: 2},
+				},
+			},
+		},
+		parseComments(code),
+	)
+}
@@ -19,6 +19,7 @@
 package format
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,3 +31,22 @@ func TestUFix64(t *testing.T) {
 
 	require.Equal(t, "99999999999.70000000", UFix64(9999999999970000000))
 }
+
+func TestFix128(t *testing.T) {
+
+	t.Parallel()
+
+	for expected, value := range map[string]string{
+		"0.000000000000000000000000":                "0",
+		"1.500000000000000000000000":                "1500000000000000000000000",
+		"-0.000000000000000000000001":               "-1",
+		"-2.000000000000000000000001":               "-2000000000000000000000001",
+		"170141183460469.231731687303715884105727":  "170141183460469231731687303715884105727",
+		"-170141183460469.231731687303715884105728": "-170141183460469231731687303715884105728",
+		"340282366920938.463463374607431768211455":  "340282366920938463463374607431768211455",
+	} {
+		v, ok := new(big.Int).SetString(value, 10)
+		require.True(t, ok)
+		require.Equal(t, expected, Fix128(v))
+	}
+}
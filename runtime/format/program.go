@@ -0,0 +1,967 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"strings"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// DefaultLineWidth is the maximum line width used when formatting programs,
+// unless another line width is specified using WithLineWidth
+//
+const DefaultLineWidth = 80
+
+const indentation = "    "
+
+// Option configures the formatting of programs
+//
+type Option func(*programPrinter)
+
+// WithLineWidth returns an option which sets the maximum line width
+//
+func WithLineWidth(lineWidth int) Option {
+	return func(printer *programPrinter) {
+		printer.lineWidth = lineWidth
+	}
+}
+
+// WithComments returns an option which preserves the comments
+// of the given code, i.e. the code the formatted program was parsed from
+//
+func WithComments(code string) Option {
+	return func(printer *programPrinter) {
+		printer.code = code
+		printer.comments = parseComments(code)
+		printer.preserveComments = true
+	}
+}
+
+// Program returns the canonical source code for the given program.
+//
+// Empty lines between declarations and statements are preserved (at most one),
+// and declarations which are not fields, enum cases, imports, or pragmas
+// are always separated by an empty line.
+//
+// Comments are only preserved if the code of the program is provided using WithComments.
+// Doc strings are always preserved.
+//
+func Program(program *ast.Program, options ...Option) string {
+	printer := &programPrinter{
+		lineWidth: DefaultLineWidth,
+	}
+
+	for _, option := range options {
+		option(printer)
+	}
+
+	doc := printer.programDoc(program)
+
+	var builder strings.Builder
+	prettier.Prettier(&builder, doc, printer.lineWidth, indentation)
+
+	return normalizeWhitespace(builder.String())
+}
+
+// normalizeWhitespace removes trailing whitespace from all lines,
+// and ensures the result ends with exactly one line break, unless it is empty
+//
+func normalizeWhitespace(code string) string {
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	result := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+	if result == "" {
+		return result
+	}
+	return result + "\n"
+}
+
+// programPrinter builds the document for a program.
+//
+// It mirrors the structure of declarations and statements which contain blocks,
+// so that comments can be placed between the elements of the blocks.
+// All other elements are printed using their Doc function.
+//
+// Comments are emitted in order of appearance:
+// Before each element, all preceding comments are emitted on separate lines.
+// A comment on the same line after an element is kept at the end of the line.
+// Comments inside of elements, e.g. expressions, are emitted after the element.
+//
+type programPrinter struct {
+	lineWidth        int
+	preserveComments bool
+	code             string
+	// comments are the remaining comments, i.e. which have not been emitted yet
+	comments []comment
+}
+
+// item is a document which is printed on separate lines
+//
+type item struct {
+	doc       prettier.Doc
+	startLine int
+	endLine   int
+	// separated indicates if the item should be preceded by an empty line,
+	// independent of the source code
+	separated bool
+}
+
+// itemsDoc returns the document for the given items, each on a separate line.
+//
+// An empty line is inserted if the item is separated,
+// or if there was an empty line in the source code.
+//
+func itemsDoc(items []item) prettier.Concat {
+	doc := make(prettier.Concat, 0, len(items)*2)
+
+	for i, item := range items {
+		if i > 0 {
+			previous := items[i-1]
+			if item.separated ||
+				(previous.endLine > 0 && item.startLine > previous.endLine+1) {
+
+				doc = append(doc, prettier.HardLine{})
+			}
+		}
+
+		doc = append(
+			doc,
+			prettier.HardLine{},
+			item.doc,
+		)
+	}
+
+	return doc
+}
+
+var blockStartDoc prettier.Doc = prettier.Text("{")
+var blockEndDoc prettier.Doc = prettier.Text("}")
+var blockEmptyDoc prettier.Doc = prettier.Text("{}")
+var typeSeparatorDoc prettier.Doc = prettier.Text(": ")
+
+// bodyDoc returns the document for the given items, enclosed in braces
+//
+func bodyDoc(items []item) prettier.Doc {
+	if len(items) == 0 {
+		return blockEmptyDoc
+	}
+
+	return prettier.Concat{
+		blockStartDoc,
+		prettier.Indent{
+			Doc: itemsDoc(items),
+		},
+		prettier.HardLine{},
+		blockEndDoc,
+	}
+}
+
+// commentItems returns the items for all remaining comments before the given offset
+//
+func (p *programPrinter) commentItems(offset int) []item {
+	var items []item
+
+	for len(p.comments) > 0 {
+		comment := p.comments[0]
+		if comment.StartPos.Offset >= offset {
+			break
+		}
+
+		p.comments = p.comments[1:]
+
+		items = append(
+			items,
+			item{
+				doc:       comment.doc(),
+				startLine: comment.StartPos.Line,
+				endLine:   comment.EndPos.Line,
+			},
+		)
+	}
+
+	return items
+}
+
+// commentItemsBeforeBlockStart returns the items for all remaining comments
+// which precede the last opening brace before the given offset.
+//
+// For example, for the given offset of the first condition in a block of conditions,
+// the items for the comments before the pre/post keyword are returned,
+// but not the items for the comments inside of the block.
+//
+func (p *programPrinter) commentItemsBeforeBlockStart(offset int) []item {
+	count := 0
+
+	for i, comment := range p.comments {
+		if comment.StartPos.Offset >= offset {
+			break
+		}
+
+		nextOffset := offset
+		if i+1 < len(p.comments) && p.comments[i+1].StartPos.Offset < offset {
+			nextOffset = p.comments[i+1].StartPos.Offset
+		}
+
+		if strings.Contains(p.code[comment.EndPos.Offset+1:nextOffset], "{") {
+			count = i + 1
+		}
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	return p.commentItems(p.comments[count-1].EndPos.Offset + 1)
+}
+
+// remainingCommentItems returns the items for all remaining comments
+//
+func (p *programPrinter) remainingCommentItems() []item {
+	items := make([]item, 0, len(p.comments))
+
+	for _, comment := range p.comments {
+		items = append(
+			items,
+			item{
+				doc:       comment.doc(),
+				startLine: comment.StartPos.Line,
+				endLine:   comment.EndPos.Line,
+			},
+		)
+	}
+
+	p.comments = nil
+
+	return items
+}
+
+// elementItems returns the items for the given element:
+// the items for the comments preceding it, and the item for the element itself,
+// which includes a trailing comment on the same line, if any.
+//
+// The document for the element is built by the given function,
+// after the preceding comments have been consumed.
+//
+func (p *programPrinter) elementItems(
+	element ast.HasPosition,
+	separated bool,
+	elementDoc func() prettier.Doc,
+) []item {
+	startPos := element.StartPosition()
+	endPos := p.sourceEndPosition(element.EndPosition())
+
+	items := p.commentItems(startPos.Offset)
+
+	doc := elementDoc()
+
+	if len(p.comments) > 0 {
+		trailingComment := p.comments[0]
+		if trailingComment.isSingleLine() &&
+			trailingComment.StartPos.Line == endPos.Line &&
+			trailingComment.StartPos.Offset > endPos.Offset &&
+			p.isSeparator(endPos.Offset+1, trailingComment.StartPos.Offset) {
+
+			p.comments = p.comments[1:]
+
+			doc = prettier.Concat{
+				doc,
+				prettier.Space,
+				trailingComment.doc(),
+			}
+		}
+	}
+
+	items = append(
+		items,
+		item{
+			doc:       doc,
+			startLine: startPos.Line,
+			endLine:   endPos.Line,
+		},
+	)
+
+	items[0].separated = separated
+
+	return items
+}
+
+// sourceEndPosition returns the end position of an element in the code,
+// given the end position of the element in the AST.
+//
+// Parentheses are not part of the AST, so if the element ends with a parenthesized expression,
+// e.g. `let x = (1)`, the end position is extended to include the closing parentheses
+//
+func (p *programPrinter) sourceEndPosition(endPos ast.Position) ast.Position {
+	result := endPos
+
+	line := endPos.Line
+	column := endPos.Column
+
+	for offset := endPos.Offset + 1; offset < len(p.code); offset++ {
+		character := p.code[offset]
+		if character == '\n' {
+			line++
+			column = -1
+			continue
+		}
+
+		column++
+
+		switch character {
+		case ' ', '\t', '\r':
+			continue
+
+		case ')':
+			result = ast.Position{
+				Offset: offset,
+				Line:   line,
+				Column: column,
+			}
+
+		default:
+			return result
+		}
+	}
+
+	return result
+}
+
+// isSeparator returns true if the code in the given range
+// only consists of whitespace and semicolons
+//
+func (p *programPrinter) isSeparator(startOffset, endOffset int) bool {
+	return strings.Trim(p.code[startOffset:endOffset], " \t;") == ""
+}
+
+func (p *programPrinter) programDoc(program *ast.Program) prettier.Doc {
+	declarations := program.Declarations()
+
+	items := p.declarationItems(declarations)
+	items = append(items, p.remainingCommentItems()...)
+
+	doc := itemsDoc(items)
+
+	// The first item should not be preceded by a line break
+
+	if len(doc) > 0 {
+		doc = doc[1:]
+	}
+
+	return doc
+}
+
+func (p *programPrinter) declarationItems(declarations []ast.Declaration) []item {
+	var items []item
+
+	for i, declaration := range declarations {
+		separated := i > 0 &&
+			!isCompactDeclarationPair(declarations[i-1], declaration)
+
+		declaration := declaration
+
+		items = append(
+			items,
+			p.elementItems(
+				declaration,
+				separated,
+				func() prettier.Doc {
+					return p.declarationDoc(declaration)
+				},
+			)...,
+		)
+	}
+
+	return items
+}
+
+// isCompactDeclarationPair returns true if the given consecutive declarations
+// do not need to be separated by an empty line, e.g. consecutive fields
+//
+func isCompactDeclarationPair(previous, next ast.Declaration) bool {
+	switch previous.(type) {
+	case *ast.FieldDeclaration:
+		_, ok := next.(*ast.FieldDeclaration)
+		return ok
+	case *ast.EnumCaseDeclaration:
+		_, ok := next.(*ast.EnumCaseDeclaration)
+		return ok
+	case *ast.ImportDeclaration:
+		_, ok := next.(*ast.ImportDeclaration)
+		return ok
+	case *ast.PragmaDeclaration:
+		_, ok := next.(*ast.PragmaDeclaration)
+		return ok
+	case *ast.TypeAliasDeclaration:
+		_, ok := next.(*ast.TypeAliasDeclaration)
+		return ok
+	}
+
+	return false
+}
+
+// docStringDoc returns the document for the given doc string of a declaration, if any.
+//
+// If comments are preserved, the doc string is already emitted as a comment.
+//
+func (p *programPrinter) docStringDoc(docString string) prettier.Concat {
+	if p.preserveComments {
+		return nil
+	}
+	return ast.DocStringDoc(docString)
+}
+
+func (p *programPrinter) declarationDoc(declaration ast.Declaration) prettier.Doc {
+	switch declaration := declaration.(type) {
+	case *ast.CompositeDeclaration:
+		if declaration.CompositeKind == common.CompositeKindEvent {
+			return p.leafDeclarationDoc(declaration)
+		}
+		return p.compositeDeclarationDoc(declaration)
+
+	case *ast.InterfaceDeclaration:
+		return p.interfaceDeclarationDoc(declaration)
+
+	case *ast.FunctionDeclaration:
+		return p.functionDeclarationDoc(declaration)
+
+	case *ast.SpecialFunctionDeclaration:
+		return p.specialFunctionDeclarationDoc(declaration)
+
+	case *ast.TransactionDeclaration:
+		return p.transactionDeclarationDoc(declaration)
+
+	default:
+		return p.leafDeclarationDoc(declaration)
+	}
+}
+
+// leafDeclarationDoc returns the document for a declaration without blocks.
+//
+// If comments are preserved, the doc string of the declaration is not printed,
+// as it is already emitted as a comment.
+//
+func (p *programPrinter) leafDeclarationDoc(declaration ast.Declaration) prettier.Doc {
+	if !p.preserveComments {
+		return declaration.Doc()
+	}
+
+	switch declaration := declaration.(type) {
+	case *ast.CompositeDeclaration:
+		withoutDocString := *declaration
+		withoutDocString.DocString = ""
+		return withoutDocString.Doc()
+
+	case *ast.FieldDeclaration:
+		withoutDocString := *declaration
+		withoutDocString.DocString = ""
+		return withoutDocString.Doc()
+
+	case *ast.EnumCaseDeclaration:
+		withoutDocString := *declaration
+		withoutDocString.DocString = ""
+		return withoutDocString.Doc()
+
+	case *ast.VariableDeclaration:
+		withoutDocString := *declaration
+		withoutDocString.DocString = ""
+		return withoutDocString.Doc()
+
+	case *ast.TypeAliasDeclaration:
+		withoutDocString := *declaration
+		withoutDocString.DocString = ""
+		return withoutDocString.Doc()
+
+	default:
+		return declaration.Doc()
+	}
+}
+
+func accessDoc(access ast.Access) prettier.Concat {
+	if access == ast.AccessNotSpecified {
+		return nil
+	}
+	return prettier.Concat{
+		prettier.Text(access.Keyword()),
+		prettier.Space,
+	}
+}
+
+var compositeConformanceSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (p *programPrinter) compositeDeclarationDoc(declaration *ast.CompositeDeclaration) prettier.Doc {
+	doc := prettier.Concat{
+		p.docStringDoc(declaration.DocString),
+		accessDoc(declaration.Access),
+		prettier.Text(declaration.CompositeKind.Keyword()),
+		prettier.Space,
+		prettier.Text(declaration.Identifier.Identifier),
+	}
+
+	if len(declaration.Conformances) > 0 {
+		conformanceDocs := make([]prettier.Doc, len(declaration.Conformances))
+		for i, conformance := range declaration.Conformances {
+			conformanceDocs[i] = conformance.Doc()
+		}
+
+		doc = append(
+			doc,
+			prettier.Group{
+				Doc: prettier.Concat{
+					typeSeparatorDoc,
+					prettier.Indent{
+						Doc: prettier.Join(
+							compositeConformanceSeparatorDoc,
+							conformanceDocs...,
+						),
+					},
+				},
+			},
+		)
+	}
+
+	return append(
+		doc,
+		prettier.Space,
+		p.membersDoc(declaration.Members, declaration.EndPos),
+	)
+}
+
+var interfaceKeywordSpaceDoc prettier.Doc = prettier.Text("interface ")
+
+func (p *programPrinter) interfaceDeclarationDoc(declaration *ast.InterfaceDeclaration) prettier.Doc {
+	return prettier.Concat{
+		p.docStringDoc(declaration.DocString),
+		accessDoc(declaration.Access),
+		prettier.Text(declaration.CompositeKind.Keyword()),
+		prettier.Space,
+		interfaceKeywordSpaceDoc,
+		prettier.Text(declaration.Identifier.Identifier),
+		prettier.Space,
+		p.membersDoc(declaration.Members, declaration.EndPos),
+	}
+}
+
+func (p *programPrinter) membersDoc(members *ast.Members, endPos ast.Position) prettier.Doc {
+	items := p.declarationItems(members.Declarations())
+	items = append(items, p.commentItems(endPos.Offset)...)
+	return bodyDoc(items)
+}
+
+var functionKeywordSpaceDoc prettier.Doc = prettier.Text("fun ")
+
+func (p *programPrinter) functionDeclarationDoc(declaration *ast.FunctionDeclaration) prettier.Doc {
+	return prettier.Concat{
+		p.docStringDoc(declaration.DocString),
+		accessDoc(declaration.Access),
+		functionKeywordSpaceDoc,
+		prettier.Text(declaration.Identifier.Identifier),
+		p.signatureAndBlockDoc(declaration),
+	}
+}
+
+func (p *programPrinter) specialFunctionDeclarationDoc(declaration *ast.SpecialFunctionDeclaration) prettier.Doc {
+	functionDeclaration := declaration.FunctionDeclaration
+
+	doc := prettier.Concat{
+		p.docStringDoc(functionDeclaration.DocString),
+		accessDoc(functionDeclaration.Access),
+		prettier.Text(functionDeclaration.Identifier.Identifier),
+	}
+
+	// The execute block of a transaction has no parameter list
+
+	if declaration.Kind == common.DeclarationKindExecute {
+		return append(
+			doc,
+			prettier.Space,
+			p.functionBlockDoc(functionDeclaration.FunctionBlock),
+		)
+	}
+
+	return append(
+		doc,
+		p.signatureAndBlockDoc(functionDeclaration),
+	)
+}
+
+func (p *programPrinter) signatureAndBlockDoc(declaration *ast.FunctionDeclaration) prettier.Doc {
+	signatureDoc := declaration.ParameterList.Doc()
+
+	if declaration.ReturnTypeAnnotation != nil &&
+		!ast.IsEmptyType(declaration.ReturnTypeAnnotation.Type) {
+
+		signatureDoc = prettier.Concat{
+			signatureDoc,
+			typeSeparatorDoc,
+			declaration.ReturnTypeAnnotation.Doc(),
+		}
+	}
+
+	doc := prettier.Concat{
+		prettier.Group{
+			Doc: signatureDoc,
+		},
+	}
+
+	if declaration.FunctionBlock == nil {
+		return doc
+	}
+
+	return append(
+		doc,
+		prettier.Space,
+		p.functionBlockDoc(declaration.FunctionBlock),
+	)
+}
+
+var transactionKeywordDoc prettier.Doc = prettier.Text("transaction")
+
+func (p *programPrinter) transactionDeclarationDoc(declaration *ast.TransactionDeclaration) prettier.Doc {
+	doc := prettier.Concat{
+		p.docStringDoc(declaration.DocString),
+		transactionKeywordDoc,
+	}
+
+	if declaration.ParameterList != nil && len(declaration.ParameterList.Parameters) > 0 {
+		doc = append(doc, declaration.ParameterList.Doc())
+	}
+
+	// Sections are separated by an empty line
+
+	var items []item
+
+	for _, field := range declaration.Fields {
+		field := field
+		items = append(
+			items,
+			p.elementItems(
+				field,
+				false,
+				func() prettier.Doc {
+					return p.leafDeclarationDoc(field)
+				},
+			)...,
+		)
+	}
+
+	sectionItems := func(sectionItems []item) {
+		if len(sectionItems) == 0 {
+			return
+		}
+		sectionItems[0].separated = len(items) > 0
+		items = append(items, sectionItems...)
+	}
+
+	if declaration.Prepare != nil {
+		sectionItems(p.elementItems(
+			declaration.Prepare,
+			false,
+			func() prettier.Doc {
+				return p.specialFunctionDeclarationDoc(declaration.Prepare)
+			},
+		))
+	}
+
+	if !declaration.PreConditions.IsEmpty() {
+		sectionItems(p.conditionsItems(ast.ConditionKindPre, declaration.PreConditions))
+	}
+
+	if declaration.Execute != nil {
+		sectionItems(p.elementItems(
+			declaration.Execute,
+			false,
+			func() prettier.Doc {
+				return p.specialFunctionDeclarationDoc(declaration.Execute)
+			},
+		))
+	}
+
+	if !declaration.PostConditions.IsEmpty() {
+		sectionItems(p.conditionsItems(ast.ConditionKindPost, declaration.PostConditions))
+	}
+
+	items = append(items, p.commentItems(declaration.EndPos.Offset)...)
+
+	return append(
+		doc,
+		prettier.Space,
+		bodyDoc(items),
+	)
+}
+
+// conditionsItems returns the items for the given conditions:
+// the items for the comments preceding the first condition,
+// and the item for the conditions block
+//
+func (p *programPrinter) conditionsItems(kind ast.ConditionKind, conditions *ast.Conditions) []item {
+	if len(*conditions) == 0 {
+		return nil
+	}
+
+	firstCondition := (*conditions)[0]
+
+	leadingItems := p.commentItemsBeforeBlockStart(firstCondition.Test.StartPosition().Offset)
+
+	var items []item
+
+	for _, condition := range *conditions {
+		condition := condition
+		items = append(
+			items,
+			p.elementItems(
+				conditionRange(condition),
+				false,
+				condition.Doc,
+			)...,
+		)
+	}
+
+	doc := prettier.Concat{
+		prettier.Text(kind.Keyword()),
+		prettier.Space,
+		bodyDoc(items),
+	}
+
+	return append(
+		leadingItems,
+		item{
+			doc: doc,
+		},
+	)
+}
+
+func conditionRange(condition *ast.Condition) ast.Range {
+	endPos := condition.Test.EndPosition()
+	if condition.Message != nil {
+		endPos = condition.Message.EndPosition()
+	}
+
+	return ast.Range{
+		StartPos: condition.Test.StartPosition(),
+		EndPos:   endPos,
+	}
+}
+
+func (p *programPrinter) functionBlockDoc(functionBlock *ast.FunctionBlock) prettier.Doc {
+	var items []item
+
+	if !functionBlock.PreConditions.IsEmpty() {
+		items = append(
+			items,
+			p.conditionsItems(ast.ConditionKindPre, functionBlock.PreConditions)...,
+		)
+	}
+
+	if !functionBlock.PostConditions.IsEmpty() {
+		items = append(
+			items,
+			p.conditionsItems(ast.ConditionKindPost, functionBlock.PostConditions)...,
+		)
+	}
+
+	items = append(
+		items,
+		p.statementItems(functionBlock.Block.Statements)...,
+	)
+
+	items = append(
+		items,
+		p.commentItems(functionBlock.Block.EndPos.Offset)...,
+	)
+
+	return bodyDoc(items)
+}
+
+func (p *programPrinter) blockDoc(block *ast.Block) prettier.Doc {
+	items := p.statementItems(block.Statements)
+	items = append(items, p.commentItems(block.EndPos.Offset)...)
+	return bodyDoc(items)
+}
+
+func (p *programPrinter) statementItems(statements []ast.Statement) []item {
+	var items []item
+
+	for _, statement := range statements {
+		statement := statement
+		items = append(
+			items,
+			p.elementItems(
+				statement,
+				false,
+				func() prettier.Doc {
+					return p.statementDoc(statement)
+				},
+			)...,
+		)
+	}
+
+	return items
+}
+
+var ifKeywordSpaceDoc prettier.Doc = prettier.Text("if ")
+var spaceElseKeywordSpaceDoc prettier.Doc = prettier.Text(" else ")
+var whileKeywordSpaceDoc prettier.Doc = prettier.Text("while ")
+var forKeywordSpaceDoc prettier.Doc = prettier.Text("for ")
+var spaceInKeywordSpaceDoc prettier.Doc = prettier.Text(" in ")
+var switchKeywordSpaceDoc prettier.Doc = prettier.Text("switch ")
+var caseKeywordSpaceDoc prettier.Doc = prettier.Text("case ")
+var defaultKeywordColonDoc prettier.Doc = prettier.Text("default:")
+var colonDoc prettier.Doc = prettier.Text(":")
+
+func (p *programPrinter) statementDoc(statement ast.Statement) prettier.Doc {
+	switch statement := statement.(type) {
+	case *ast.IfStatement:
+		return p.ifStatementDoc(statement)
+
+	case *ast.WhileStatement:
+		return prettier.Concat{
+			whileKeywordSpaceDoc,
+			statement.Test.Doc(),
+			prettier.Space,
+			p.blockDoc(statement.Block),
+		}
+
+	case *ast.ForStatement:
+		doc := prettier.Concat{
+			forKeywordSpaceDoc,
+		}
+
+		if statement.Index != nil {
+			doc = append(
+				doc,
+				prettier.Text(statement.Index.Identifier),
+				prettier.Text(", "),
+			)
+		}
+
+		return append(
+			doc,
+			prettier.Text(statement.Identifier.Identifier),
+			spaceInKeywordSpaceDoc,
+			statement.Value.Doc(),
+			prettier.Space,
+			p.blockDoc(statement.Block),
+		)
+
+	case *ast.SwitchStatement:
+		return p.switchStatementDoc(statement)
+
+	case ast.Declaration:
+		return p.declarationDoc(statement)
+
+	default:
+		return statement.Doc()
+	}
+}
+
+func (p *programPrinter) ifStatementDoc(statement *ast.IfStatement) prettier.Doc {
+	doc := prettier.Concat{
+		ifKeywordSpaceDoc,
+		statement.Test.Doc(),
+		prettier.Space,
+		p.blockDoc(statement.Then),
+	}
+
+	if statement.Else == nil {
+		return doc
+	}
+
+	var elseDoc prettier.Doc
+	if len(statement.Else.Statements) == 1 {
+		if elseIfStatement, ok := statement.Else.Statements[0].(*ast.IfStatement); ok {
+			elseDoc = p.ifStatementDoc(elseIfStatement)
+		}
+	}
+	if elseDoc == nil {
+		elseDoc = p.blockDoc(statement.Else)
+	}
+
+	return append(
+		doc,
+		spaceElseKeywordSpaceDoc,
+		elseDoc,
+	)
+}
+
+func (p *programPrinter) switchStatementDoc(statement *ast.SwitchStatement) prettier.Doc {
+	var items []item
+
+	for _, switchCase := range statement.Cases {
+		switchCase := switchCase
+		items = append(
+			items,
+			p.elementItems(
+				switchCase,
+				false,
+				func() prettier.Doc {
+					return p.switchCaseDoc(switchCase)
+				},
+			)...,
+		)
+	}
+
+	items = append(items, p.commentItems(statement.EndPos.Offset)...)
+
+	return prettier.Concat{
+		switchKeywordSpaceDoc,
+		statement.Expression.Doc(),
+		prettier.Space,
+		bodyDoc(items),
+	}
+}
+
+func (p *programPrinter) switchCaseDoc(switchCase *ast.SwitchCase) prettier.Doc {
+	var doc prettier.Concat
+
+	switch {
+	case switchCase.IsDefault():
+		doc = prettier.Concat{
+			defaultKeywordColonDoc,
+		}
+	case switchCase.Pattern != nil:
+		doc = prettier.Concat{
+			caseKeywordSpaceDoc,
+			switchCase.Pattern.Doc(),
+			colonDoc,
+		}
+	default:
+		doc = prettier.Concat{
+			caseKeywordSpaceDoc,
+			switchCase.Expression.Doc(),
+			colonDoc,
+		}
+	}
+
+	return append(
+		doc,
+		prettier.Indent{
+			Doc: itemsDoc(p.statementItems(switchCase.Statements)),
+		},
+	)
+}
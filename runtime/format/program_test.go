@@ -0,0 +1,526 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/parser2"
+)
+
+const fungibleTokenCode = `
+/// FungibleToken
+///
+/// The interface that fungible token contracts implement.
+///
+pub contract interface FungibleToken {
+
+    /// The total number of tokens in existence.
+    pub var totalSupply: UFix64
+
+    /// TokensInitialized
+    ///
+    /// The event that is emitted when the contract is created
+    pub event TokensInitialized(initialSupply: UFix64)
+
+    /// TokensWithdrawn
+    ///
+    /// The event that is emitted when tokens are withdrawn from a Vault
+    pub event TokensWithdrawn(amount: UFix64, from: Address?)
+
+    /// Provider
+    ///
+    pub resource interface Provider {
+
+        /// withdraw subtracts tokens from the owner's Vault
+        /// and returns a Vault with the removed tokens.
+        ///
+        pub fun withdraw(amount: UFix64): @Vault {
+            post {
+                // ` + "`result`" + ` refers to the return value
+                result.balance == amount:
+                    "Withdrawal amount must be the same as the balance of the withdrawn Vault"
+            }
+        }
+    }
+
+    /// Receiver
+    ///
+    pub resource interface Receiver {
+        /// deposit takes a Vault and deposits it into the implementing resource type
+        ///
+        pub fun deposit(from: @Vault)
+    }
+
+    pub resource interface Balance {
+        pub var balance: UFix64
+
+        init(balance: UFix64) {
+            post {
+                self.balance == balance:
+                    "Balance must be initialized to the initial balance"
+            }
+        }
+    }
+
+    pub resource Vault: Provider, Receiver, Balance {
+
+        // The total balance of the vault
+        pub var balance: UFix64
+
+        // The conforming type must declare an initializer
+        // that allows prioviding the initial balance of the Vault
+        //
+        init(balance: UFix64)
+
+        pub fun withdraw(amount: UFix64): @Vault {
+            pre {
+                self.balance >= amount:
+                    "Amount withdrawn must be less than or equal than the balance of the Vault"
+            }
+            post {
+                // use the special function ` + "`before`" + ` to get the value of the ` + "`balance`" + ` field
+                // at the beginning of the function execution
+                //
+                self.balance == before(self.balance) - amount:
+                    "New Vault balance must be the difference of the previous balance and the withdrawn Vault"
+            }
+        }
+
+        pub fun deposit(from: @Vault) {
+            pre {
+                from.isInstance(self.getType()):
+                    "Cannot deposit an incompatible token type"
+            }
+        }
+    }
+
+    pub fun createEmptyVault(): @Vault {
+        post {
+            result.balance == 0.0: "The newly created Vault must have zero balance"
+        }
+    }
+}
+`
+
+const exampleTokenCode = `
+import FungibleToken from 0xee82856bf20e2aa6
+
+pub contract ExampleToken: FungibleToken {
+
+    /// Total supply of ExampleTokens in existence
+    pub var totalSupply: UFix64
+
+    pub event TokensInitialized(initialSupply: UFix64)
+    pub event TokensWithdrawn(amount: UFix64, from: Address?)
+
+    pub resource Vault: FungibleToken.Provider, FungibleToken.Receiver, FungibleToken.Balance {
+
+        pub var balance: UFix64
+
+        init(balance: UFix64) {
+            self.balance = balance
+        }
+
+        pub fun withdraw(amount: UFix64): @FungibleToken.Vault {
+            self.balance = self.balance - amount
+            emit TokensWithdrawn(amount: amount, from: self.owner?.address)
+            return <-create Vault(balance: amount)
+        }
+
+        pub fun deposit(from: @FungibleToken.Vault) {
+            let vault <- from as! @ExampleToken.Vault
+            self.balance = self.balance + vault.balance
+            vault.balance = 0.0
+            destroy vault
+        }
+
+        destroy() {
+            ExampleToken.totalSupply = ExampleToken.totalSupply - self.balance
+        }
+    }
+
+    init() {
+        self.totalSupply = 1000.0
+
+        let vault <- create Vault(balance: self.totalSupply)
+        self.account.save(<-vault, to: /storage/exampleTokenVault)
+
+        // Create a public capability to the stored Vault that only exposes
+        // the deposit method through the Receiver interface
+        self.account.link<&{FungibleToken.Receiver}>(
+            /public/exampleTokenReceiver,
+            target: /storage/exampleTokenVault
+        )
+
+        emit TokensInitialized(initialSupply: self.totalSupply)
+    }
+}
+`
+
+const transferTokensCode = `
+import FungibleToken from 0xee82856bf20e2aa6
+import ExampleToken from 0xCADE
+
+// This transaction is a template for a transaction that
+// could be used by anyone to send tokens to another account
+transaction(amount: UFix64, to: Address) {
+
+    // The Vault resource that holds the tokens that are being transferred
+    let sentVault: @FungibleToken.Vault
+
+    prepare(signer: AuthAccount) {
+        // Get a reference to the signer's stored vault
+        let vaultRef = signer.borrow<&ExampleToken.Vault>(from: /storage/exampleTokenVault)
+            ?? panic("Could not borrow reference to the owner's Vault!")
+
+        // Withdraw tokens from the signer's stored vault
+        self.sentVault <- vaultRef.withdraw(amount: amount)
+    }
+
+    execute {
+        let receiverRef = getAccount(to)
+            .getCapability(/public/exampleTokenReceiver)
+            .borrow<&{FungibleToken.Receiver}>()
+            ?? panic("Could not borrow receiver reference to the recipient's Vault")
+
+        receiverRef.deposit(from: <-self.sentVault)
+    }
+}
+`
+
+func formatCode(code string, options ...Option) (string, error) {
+	program, err := parser2.ParseProgram(code)
+	if err != nil {
+		return "", err
+	}
+
+	options = append(
+		[]Option{WithComments(code)},
+		options...,
+	)
+
+	return Program(program, options...), nil
+}
+
+func TestProgram_Comments(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+    // The header
+  import Foo from 0x1
+  let c = (2)   // three
+  pub   contract  C : Foo.I,Foo.J{
+    pub   let  x : Int   // the x
+    pub var y: [String]
+
+
+    init() { self.x = 1; self.y = [] }
+    /* before the function */
+    pub fun foo(a: Int, _ b: Int): Int {
+      pre { a > 0 : "a must be positive" }
+      if a > b { return a } else if b > a { return b }
+      else {
+        // neither
+        return (a + b) * 2
+      }
+      return ((a))  // unreachable
+    }
+    pub enum E: UInt8 { pub case a
+      pub case b }
+  }
+  // The end
+`
+
+	expected := `// The header
+import Foo from 0x1
+
+let c = 2 // three
+
+pub contract C: Foo.I, Foo.J {
+    pub let x: Int // the x
+    pub var y: [String]
+
+    init() {
+        self.x = 1
+        self.y = []
+    }
+
+    /* before the function */
+    pub fun foo(a: Int, _ b: Int): Int {
+        pre {
+            a > 0: "a must be positive"
+        }
+        if a > b {
+            return a
+        } else if b > a {
+            return b
+        } else {
+            // neither
+            return (a + b) * 2
+        }
+        return a // unreachable
+    }
+
+    pub enum E: UInt8 {
+        pub case a
+        pub case b
+    }
+}
+// The end
+`
+
+	actual, err := formatCode(code)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestProgram_BlockComments(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+fun test() {
+        /**
+         * Javadoc style
+         */
+    let x = 1 /* trailing */
+
+            /*
+              Indented
+                More indented
+            */
+    let y = x
+}
+`
+
+	expected := `fun test() {
+    /**
+     * Javadoc style
+     */
+    let x = 1 /* trailing */
+
+    /*
+      Indented
+        More indented
+    */
+    let y = x
+}
+`
+
+	actual, err := formatCode(code)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestProgram_LineWidth(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+pub resource Vault: FungibleToken.Provider, FungibleToken.Receiver, FungibleToken.Balance {}
+`
+
+	t.Run("default", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := formatCode(code)
+		require.NoError(t, err)
+		assert.Equal(t,
+			`pub resource Vault: FungibleToken.Provider,
+    FungibleToken.Receiver,
+    FungibleToken.Balance {}
+`,
+			actual,
+		)
+	})
+
+	t.Run("wide", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := formatCode(code, WithLineWidth(100))
+		require.NoError(t, err)
+		assert.Equal(t,
+			`pub resource Vault: FungibleToken.Provider, FungibleToken.Receiver, FungibleToken.Balance {}
+`,
+			actual,
+		)
+	})
+}
+
+func TestProgram_DocStrings(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+/**
+ * A resource
+ */
+pub resource R {
+    /// The field
+    pub let x: Int
+
+    init() {
+        self.x = answer
+    }
+}
+
+/// The answer
+pub let answer = 42
+`
+
+	program, err := parser2.ParseProgram(code)
+	require.NoError(t, err)
+
+	expected := `/**
+ * A resource
+ */
+pub resource R {
+    /// The field
+    pub let x: Int
+
+    init() {
+        self.x = answer
+    }
+}
+
+/// The answer
+pub let answer = 42
+`
+
+	// Doc strings are preserved without the comments of the code,
+	// and are not duplicated with the comments of the code
+
+	assert.Equal(t, expected, Program(program))
+	assert.Equal(t, expected, Program(program, WithComments(code)))
+}
+
+func TestProgram_TypeAliases(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+import FungibleToken from 0x1
+
+/// The token
+pub  typealias  Token=@{FungibleToken.Receiver,FungibleToken.Balance}
+typealias Tokens = [Token]
+pub contract C {
+    access(contract)   typealias  T = Int
+    pub typealias U = T
+}
+`
+
+	formatted, err := formatCode(code)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`import FungibleToken from 0x1
+
+/// The token
+pub typealias Token = @{FungibleToken.Receiver, FungibleToken.Balance}
+typealias Tokens = [Token]
+
+pub contract C {
+    access(contract) typealias T = Int
+    pub typealias U = T
+}
+`,
+		formatted,
+	)
+}
+
+func TestProgram_Patterns(t *testing.T) {
+
+	t.Parallel()
+
+	code := `
+fun test(xs: [Int; 2], value: AnyStruct) {
+    let ( a,b )=xs
+    var (x:c ,  y:d) <-  p
+    switch value {
+    case let  n   as Int : return
+    case let o ? :
+      return
+    case nil: return
+    }
+}
+`
+
+	formatted, err := formatCode(code)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`fun test(xs: [Int; 2], value: AnyStruct) {
+    let (a, b) = xs
+    var (x: c, y: d) <- p
+    switch value {
+        case let n as Int:
+            return
+        case let o?:
+            return
+        case nil:
+            return
+    }
+}
+`,
+		formatted,
+	)
+}
+
+func TestProgram_Idempotent(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, code string) {
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			formatted, err := formatCode(code)
+			require.NoError(t, err)
+
+			reformatted, err := formatCode(formatted)
+			require.NoError(t, err)
+
+			assert.Equal(t, formatted, reformatted)
+
+			// The formatted code must be equivalent to the original code
+
+			program, err := parser2.ParseProgram(code)
+			require.NoError(t, err)
+
+			formattedProgram, err := parser2.ParseProgram(formatted)
+			require.NoError(t, err)
+
+			assert.Equal(t, Program(program), Program(formattedProgram))
+		})
+	}
+
+	test("FungibleToken", fungibleTokenCode)
+	test("ExampleToken", exampleTokenCode)
+	test("transfer tokens", transferTokensCode)
+}
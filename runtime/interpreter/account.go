@@ -21,6 +21,7 @@ package interpreter
 import (
 	"fmt"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -113,6 +114,36 @@ func NewAuthAccountValue(
 		sema.AuthAccountGetLinkTargetField: func(inter *Interpreter, _ func() LocationRange) Value {
 			return inter.accountGetLinkTargetFunction(address)
 		},
+		sema.AuthAccountStoragePathsField: func(inter *Interpreter, getLocationRange func() LocationRange) Value {
+			return inter.accountPaths(address, common.PathDomainStorage, getLocationRange)
+		},
+		sema.AuthAccountPublicPathsField: func(inter *Interpreter, getLocationRange func() LocationRange) Value {
+			return inter.accountPaths(address, common.PathDomainPublic, getLocationRange)
+		},
+		sema.AuthAccountPrivatePathsField: func(inter *Interpreter, getLocationRange func() LocationRange) Value {
+			return inter.accountPaths(address, common.PathDomainPrivate, getLocationRange)
+		},
+		sema.AuthAccountForEachStoredField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainStorage,
+				sema.AuthAccountForEachStoredFunctionType,
+			)
+		},
+		sema.AuthAccountForEachPublicField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainPublic,
+				sema.AccountForEachPublicFunctionType,
+			)
+		},
+		sema.AuthAccountForEachPrivateField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainPrivate,
+				sema.AuthAccountForEachPrivateFunctionType,
+			)
+		},
 	}
 
 	var str string
@@ -199,6 +230,16 @@ func NewPublicAccountValue(
 		sema.PublicAccountGetTargetLinkField: func(inter *Interpreter, _ func() LocationRange) Value {
 			return inter.accountGetLinkTargetFunction(address)
 		},
+		sema.PublicAccountPublicPathsField: func(inter *Interpreter, getLocationRange func() LocationRange) Value {
+			return inter.accountPaths(address, common.PathDomainPublic, getLocationRange)
+		},
+		sema.PublicAccountForEachPublicField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountForEachFunction(
+				address,
+				common.PathDomainPublic,
+				sema.AccountForEachPublicFunctionType,
+			)
+		},
 	}
 
 	var str string
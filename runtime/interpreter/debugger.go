@@ -19,26 +19,109 @@
 package interpreter
 
 import (
+	"sync"
 	"sync/atomic"
 
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 )
 
 type Stop struct {
 	Interpreter *Interpreter
 	Statement   ast.Statement
+	// Breakpoint is the breakpoint which caused the stop, if any
+	Breakpoint *Breakpoint
+}
+
+// Breakpoint is a line breakpoint.
+//
+// The breakpoint is hit before a statement starting on the line is executed,
+// if the optional condition evaluates to true.
+// The execution stops when the breakpoint is hit,
+// or if a hit count is given, only once it has been hit at least that many times.
+//
+type Breakpoint struct {
+	Location  common.Location
+	Line      int
+	Condition ast.Expression
+	HitCount  int
+	hits      uint64
+}
+
+// Hits returns the number of times the breakpoint has been hit
+//
+func (b *Breakpoint) Hits() int {
+	return int(atomic.LoadUint64(&b.hits))
+}
+
+// hit records a hit of the breakpoint,
+// and returns true if the execution should stop
+//
+func (b *Breakpoint) hit() bool {
+	hits := atomic.AddUint64(&b.hits, 1)
+	return hits >= uint64(b.HitCount)
+}
+
+// StepMode determines where the execution stops after a step
+//
+type StepMode uint8
+
+const (
+	// StepModeInto stops at the next statement
+	StepModeInto StepMode = iota
+	// StepModeOver stops at the next statement in the current function or in a caller
+	StepModeOver
+	// StepModeOut stops at the next statement in a caller
+	StepModeOut
+)
+
+// StackFrame is a frame of the call stack
+//
+type StackFrame struct {
+	// FunctionName is the name of the invoked function.
+	// It is empty for the outermost frame
+	FunctionName string
+	// Interpreter is the interpreter executing the frame.
+	// It is nil if the frame has not executed a statement yet, e.g. for host functions
+	Interpreter *Interpreter
+	Location    common.Location
+	// Position is the position of the current statement in the innermost frame,
+	// and the position of the invocation in all other frames
+	Position   ast.Position
+	Activation *VariableActivation
+}
+
+type debuggerFrame struct {
+	functionName string
+	interpreter  *Interpreter
+	position     ast.Position
+	activation   *VariableActivation
 }
 
 type Debugger struct {
 	pauseRequested uint32
+	stopped        uint32
 	stops          chan Stop
 	continues      chan struct{}
+
+	// mutex protects the breakpoints and the step state,
+	// which may be changed while the program is running
+	mutex       sync.Mutex
+	breakpoints map[common.LocationID]map[int]*Breakpoint
+	stepping    bool
+	stepMode    StepMode
+	stepDepth   int
+
+	// frames is only accessed by the interpreter,
+	// and by clients while the execution is stopped
+	frames []*debuggerFrame
 }
 
 func NewDebugger() *Debugger {
 	return &Debugger{
-		stops:     make(chan Stop),
-		continues: make(chan struct{}),
+		stops:       make(chan Stop),
+		continues:   make(chan struct{}),
+		breakpoints: map[common.LocationID]map[int]*Breakpoint{},
 	}
 }
 
@@ -47,18 +130,134 @@ func (d *Debugger) Stops() <-chan Stop {
 }
 
 func (d *Debugger) onStatement(interpreter *Interpreter, statement ast.Statement) {
-	if !d.PauseRequested() {
+
+	frame := d.currentFrame()
+	frame.interpreter = interpreter
+	frame.position = statement.StartPosition()
+	frame.activation = nil
+
+	stop, ok := d.stopFor(interpreter, statement)
+	if !ok {
 		return
 	}
 
-	d.stops <- Stop{
+	d.mutex.Lock()
+	d.stepping = false
+	d.mutex.Unlock()
+
+	atomic.StoreUint32(&d.stopped, 1)
+
+	d.stops <- stop
+
+	d.resetPauseRequest()
+
+	<-d.continues
+}
+
+// stopFor returns the stop for the given statement,
+// and true if the execution should stop before executing it
+//
+func (d *Debugger) stopFor(interpreter *Interpreter, statement ast.Statement) (Stop, bool) {
+
+	stop := Stop{
 		Interpreter: interpreter,
 		Statement:   statement,
 	}
 
-	d.resetPauseRequest()
+	d.mutex.Lock()
 
-	<-d.continues
+	stepping := d.stepping
+	stepMode := d.stepMode
+	stepDepth := d.stepDepth
+
+	var breakpoint *Breakpoint
+	if interpreter.Location != nil {
+		breakpoint = d.breakpoints[interpreter.Location.ID()][statement.StartPosition().Line]
+	}
+
+	d.mutex.Unlock()
+
+	// Breakpoints are hit even if the execution stops for another reason,
+	// so hit counts are accurate
+
+	if breakpoint != nil && d.breakpointHit(interpreter, breakpoint) {
+		stop.Breakpoint = breakpoint
+		return stop, true
+	}
+
+	if d.PauseRequested() {
+		return stop, true
+	}
+
+	if stepping {
+		depth := len(d.frames)
+
+		switch stepMode {
+		case StepModeInto:
+			return stop, true
+		case StepModeOver:
+			return stop, depth <= stepDepth
+		case StepModeOut:
+			return stop, depth < stepDepth
+		}
+	}
+
+	return stop, false
+}
+
+// breakpointHit returns true if the given breakpoint is hit,
+// and the execution should stop
+//
+func (d *Debugger) breakpointHit(interpreter *Interpreter, breakpoint *Breakpoint) bool {
+
+	if breakpoint.Condition != nil {
+		value, err := d.evaluate(
+			interpreter,
+			interpreter.activations.Current(),
+			breakpoint.Condition,
+			boolTypeAnnotation,
+		)
+		if err != nil {
+			// Stop if the condition cannot be evaluated,
+			// so the problem does not go unnoticed
+			return true
+		}
+
+		result, ok := value.(BoolValue)
+		if !ok || !bool(result) {
+			return false
+		}
+	}
+
+	return breakpoint.hit()
+}
+
+func (d *Debugger) onFunctionInvocation(interpreter *Interpreter, invocationExpression *ast.InvocationExpression) {
+	caller := d.currentFrame()
+	caller.interpreter = interpreter
+	caller.position = invocationExpression.StartPosition()
+	caller.activation = interpreter.activations.Current()
+
+	d.frames = append(
+		d.frames,
+		&debuggerFrame{
+			functionName: invocationExpression.InvokedExpression.String(),
+		},
+	)
+}
+
+func (d *Debugger) onInvokedFunctionReturn() {
+	d.frames = d.frames[:len(d.frames)-1]
+}
+
+// currentFrame returns the innermost frame.
+// The outermost frame is created lazily, as execution may start in any function
+//
+func (d *Debugger) currentFrame() *debuggerFrame {
+	if len(d.frames) == 0 {
+		d.frames = append(d.frames, &debuggerFrame{})
+	}
+	return d.frames[len(d.frames)-1]
 }
 
 func (d *Debugger) PauseRequested() bool {
@@ -73,13 +272,16 @@ func (d *Debugger) RequestPause() {
 	atomic.StoreUint32(&d.pauseRequested, 1)
 }
 
+// Continue continues the execution, if it is stopped.
+// It returns false if the execution is not stopped.
+//
 func (d *Debugger) Continue() bool {
-	select {
-	case d.continues <- struct{}{}:
-		return true
-	default:
+	if !atomic.CompareAndSwapUint32(&d.stopped, 1, 0) {
 		return false
 	}
+
+	d.continues <- struct{}{}
+	return true
 }
 
 func (d *Debugger) Pause() Stop {
@@ -93,6 +295,93 @@ func (d *Debugger) Next() Stop {
 	return <-d.Stops()
 }
 
+// Step continues the execution, if it is stopped,
+// and stops again at the next statement determined by the given step mode.
+// It returns false if the execution is not stopped.
+//
+func (d *Debugger) Step(mode StepMode) bool {
+	if atomic.LoadUint32(&d.stopped) != 1 {
+		return false
+	}
+
+	d.mutex.Lock()
+	d.stepping = true
+	d.stepMode = mode
+	d.stepDepth = len(d.frames)
+	d.mutex.Unlock()
+
+	return d.Continue()
+}
+
+// AddBreakpoint adds the given breakpoint.
+// An existing breakpoint for the same location and line is replaced.
+//
+func (d *Debugger) AddBreakpoint(breakpoint *Breakpoint) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	locationID := breakpoint.Location.ID()
+
+	lineBreakpoints, ok := d.breakpoints[locationID]
+	if !ok {
+		lineBreakpoints = map[int]*Breakpoint{}
+		d.breakpoints[locationID] = lineBreakpoints
+	}
+
+	lineBreakpoints[breakpoint.Line] = breakpoint
+}
+
+// RemoveBreakpoint removes the breakpoint for the given location and line, if any.
+//
+func (d *Debugger) RemoveBreakpoint(location common.Location, line int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	delete(d.breakpoints[location.ID()], line)
+}
+
+// ClearBreakpoints removes all breakpoints for the given location.
+//
+func (d *Debugger) ClearBreakpoints(location common.Location) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	delete(d.breakpoints, location.ID())
+}
+
+// CallStack returns the frames of the call stack, starting with the innermost frame.
+// It must only be called while the execution is stopped.
+//
+func (d *Debugger) CallStack() []StackFrame {
+	count := len(d.frames)
+	callStack := make([]StackFrame, 0, count)
+
+	for i := count - 1; i >= 0; i-- {
+		frame := d.frames[i]
+
+		stackFrame := StackFrame{
+			FunctionName: frame.functionName,
+			Interpreter:  frame.interpreter,
+			Position:     frame.position,
+			Activation:   frame.activation,
+		}
+
+		if frame.interpreter != nil {
+			stackFrame.Location = frame.interpreter.Location
+
+			// The innermost frame is still executing,
+			// so its activation is the current one
+			if stackFrame.Activation == nil {
+				stackFrame.Activation = frame.interpreter.activations.Current()
+			}
+		}
+
+		callStack = append(callStack, stackFrame)
+	}
+
+	return callStack
+}
+
 func (d *Debugger) CurrentActivation(interpreter *Interpreter) *VariableActivation {
 	return interpreter.activations.Current()
 }
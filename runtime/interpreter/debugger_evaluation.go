@@ -0,0 +1,228 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// evaluationLocation is the location of the programs
+// which the debugger evaluates expressions in
+//
+const evaluationLocation = common.IdentifierLocation("debugger")
+
+const evaluationResultName = "result"
+
+var boolTypeAnnotation = &ast.TypeAnnotation{
+	Type: &ast.NominalType{
+		Identifier: ast.Identifier{
+			Identifier: sema.BoolType.Name,
+		},
+	},
+}
+
+// debuggerValueDeclaration declares a variable of the evaluated frame
+// in the program which evaluates an expression
+//
+type debuggerValueDeclaration struct {
+	name  string
+	ty    sema.Type
+	value Value
+}
+
+var _ sema.ValueDeclaration = debuggerValueDeclaration{}
+var _ ValueDeclaration = debuggerValueDeclaration{}
+
+func (d debuggerValueDeclaration) ValueDeclarationName() string {
+	return d.name
+}
+
+func (d debuggerValueDeclaration) ValueDeclarationType() sema.Type {
+	return d.ty
+}
+
+func (debuggerValueDeclaration) ValueDeclarationDocString() string {
+	return ""
+}
+
+func (debuggerValueDeclaration) ValueDeclarationKind() common.DeclarationKind {
+	return common.DeclarationKindConstant
+}
+
+func (debuggerValueDeclaration) ValueDeclarationPosition() ast.Position {
+	return ast.Position{}
+}
+
+func (debuggerValueDeclaration) ValueDeclarationIsConstant() bool {
+	return true
+}
+
+func (debuggerValueDeclaration) ValueDeclarationArgumentLabels() []string {
+	return nil
+}
+
+func (debuggerValueDeclaration) ValueDeclarationAvailable(_ common.Location) bool {
+	return true
+}
+
+func (d debuggerValueDeclaration) ValueDeclarationValue(_ *Interpreter) Value {
+	return d.value
+}
+
+// Evaluate evaluates the given expression in the given frame.
+// It must only be called while the execution is stopped.
+//
+func (d *Debugger) Evaluate(frame StackFrame, expression ast.Expression) (Value, error) {
+	if frame.Interpreter == nil {
+		return nil, fmt.Errorf("cannot evaluate in a frame which has not executed a statement")
+	}
+
+	return d.evaluate(frame.Interpreter, frame.Activation, expression, nil)
+}
+
+// evaluate evaluates the given expression in the given activation,
+// by checking and interpreting a program which declares a constant with the expression as its value.
+//
+// The variables referred to by the expression are declared with the static types of their current values.
+// If a type annotation is given, the expression must be a subtype.
+//
+func (d *Debugger) evaluate(
+	interpreter *Interpreter,
+	activation *VariableActivation,
+	expression ast.Expression,
+	typeAnnotation *ast.TypeAnnotation,
+) (
+	result Value,
+	err error,
+) {
+	// recover internal panics and return them as an error
+	defer interpreter.RecoverErrors(func(internalErr error) {
+		err = internalErr
+	})
+
+	var valueDeclarations []debuggerValueDeclaration
+	declared := map[string]struct{}{}
+
+	ast.Inspect(expression, func(element ast.Element) bool {
+		identifierExpression, ok := element.(*ast.IdentifierExpression)
+		if !ok {
+			return true
+		}
+
+		name := identifierExpression.Identifier.Identifier
+		if _, ok := declared[name]; ok {
+			return true
+		}
+		declared[name] = struct{}{}
+
+		var variable *Variable
+		if activation != nil {
+			variable = activation.Find(name)
+		}
+		if variable == nil {
+			variable, _ = interpreter.Globals.Get(name)
+		}
+		if variable == nil {
+			// Undeclared variables are reported by the checker
+			return true
+		}
+
+		value := variable.GetValue()
+
+		ty, err := interpreter.ConvertStaticToSemaType(value.StaticType())
+		if err != nil {
+			panic(err)
+		}
+
+		valueDeclarations = append(
+			valueDeclarations,
+			debuggerValueDeclaration{
+				name:  name,
+				ty:    ty,
+				value: value,
+			},
+		)
+
+		return true
+	})
+
+	semaValueDeclarations := make([]sema.ValueDeclaration, len(valueDeclarations))
+	interpreterValueDeclarations := make([]ValueDeclaration, len(valueDeclarations))
+	for i, valueDeclaration := range valueDeclarations {
+		semaValueDeclarations[i] = valueDeclaration
+		interpreterValueDeclarations[i] = valueDeclaration
+	}
+
+	program := ast.NewProgram([]ast.Declaration{
+		&ast.VariableDeclaration{
+			IsConstant: true,
+			Identifier: ast.Identifier{
+				Identifier: evaluationResultName,
+			},
+			TypeAnnotation: typeAnnotation,
+			Value:          expression,
+			Transfer: &ast.Transfer{
+				Operation: ast.TransferOperationCopy,
+			},
+		},
+	})
+
+	checker, err := sema.NewChecker(
+		program,
+		evaluationLocation,
+		sema.WithPredeclaredValues(semaValueDeclarations),
+		sema.WithAccessCheckMode(sema.AccessCheckModeNone),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checker.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	subInterpreter, err := interpreter.NewSubInterpreter(
+		ProgramFromChecker(checker),
+		evaluationLocation,
+		WithPredeclaredValues(interpreterValueDeclarations),
+		WithDebugger(nil),
+		WithOnStatementHandler(nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subInterpreter.Interpret()
+	if err != nil {
+		return nil, err
+	}
+
+	variable, ok := subInterpreter.Globals.Get(evaluationResultName)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return variable.GetValue(), nil
+}
@@ -194,16 +194,28 @@ func (d Decoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagWord64Value:
 			storable, err = d.decodeWord64()
 
+		case CBORTagWord128Value:
+			storable, err = d.decodeWord128()
+
+		case CBORTagWord256Value:
+			storable, err = d.decodeWord256()
+
 		// Fix*
 
 		case CBORTagFix64Value:
 			storable, err = d.decodeFix64()
 
+		case CBORTagFix128Value:
+			storable, err = d.decodeFix128()
+
 		// UFix*
 
 		case CBORTagUFix64Value:
 			storable, err = d.decodeUFix64()
 
+		case CBORTagUFix128Value:
+			storable, err = d.decodeUFix128()
+
 		// Storage
 
 		case CBORTagPathValue:
@@ -720,6 +732,48 @@ func (d Decoder) decodeWord64() (Word64Value, error) {
 	return Word64Value(value), nil
 }
 
+func (d Decoder) decodeWord128() (Word128Value, error) {
+	bigInt, err := d.decoder.DecodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Word128Value{}, fmt.Errorf("unknown Word128 encoding: %s", e.ActualType.String())
+		}
+		return Word128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return Word128Value{}, fmt.Errorf("invalid Word128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.Word128TypeMaxIntBig
+	if bigInt.Cmp(max) > 0 {
+		return Word128Value{}, fmt.Errorf("invalid Word128: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewWord128ValueFromBigInt(bigInt), nil
+}
+
+func (d Decoder) decodeWord256() (Word256Value, error) {
+	bigInt, err := d.decoder.DecodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Word256Value{}, fmt.Errorf("unknown Word256 encoding: %s", e.ActualType.String())
+		}
+		return Word256Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return Word256Value{}, fmt.Errorf("invalid Word256: got %s, expected positive", bigInt)
+	}
+
+	max := sema.Word256TypeMaxIntBig
+	if bigInt.Cmp(max) > 0 {
+		return Word256Value{}, fmt.Errorf("invalid Word256: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewWord256ValueFromBigInt(bigInt), nil
+}
+
 func (d Decoder) decodeFix64() (Fix64Value, error) {
 	value, err := d.decoder.DecodeInt64()
 	if err != nil {
@@ -742,6 +796,49 @@ func (d Decoder) decodeUFix64() (UFix64Value, error) {
 	return UFix64Value(value), nil
 }
 
+func (d Decoder) decodeFix128() (Fix128Value, error) {
+	bigInt, err := d.decoder.DecodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Fix128Value{}, fmt.Errorf("unknown Fix128 encoding: %s", e.ActualType.String())
+		}
+		return Fix128Value{}, err
+	}
+
+	min := sema.Int128TypeMinIntBig
+	if bigInt.Cmp(min) < 0 {
+		return Fix128Value{}, fmt.Errorf("invalid Fix128: got %s, expected min %s", bigInt, min)
+	}
+
+	max := sema.Int128TypeMaxIntBig
+	if bigInt.Cmp(max) > 0 {
+		return Fix128Value{}, fmt.Errorf("invalid Fix128: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewFix128ValueFromBigInt(bigInt), nil
+}
+
+func (d Decoder) decodeUFix128() (UFix128Value, error) {
+	bigInt, err := d.decoder.DecodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return UFix128Value{}, fmt.Errorf("unknown UFix128 encoding: %s", e.ActualType.String())
+		}
+		return UFix128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return UFix128Value{}, fmt.Errorf("invalid UFix128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.UInt128TypeMaxIntBig
+	if bigInt.Cmp(max) > 0 {
+		return UFix128Value{}, fmt.Errorf("invalid UFix128: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewUFix128ValueFromBigInt(bigInt), nil
+}
+
 func (d Decoder) decodeSome() (SomeStorable, error) {
 	storable, err := d.decodeStorable()
 	if err != nil {
@@ -1068,6 +1165,9 @@ func decodeStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
 	case CBORTagCapabilityStaticType:
 		return decodeCapabilityStaticType(dec)
 
+	case CBORTagSetStaticType:
+		return decodeSetStaticType(dec)
+
 	default:
 		return nil, fmt.Errorf("invalid static type encoding tag: %d", number)
 	}
@@ -1370,6 +1470,20 @@ func decodeDictionaryStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
 	}, nil
 }
 
+func decodeSetStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
+	elementType, err := decodeStaticType(dec)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"invalid set static type element type encoding: %w",
+			err,
+		)
+	}
+
+	return SetStaticType{
+		ElementType: elementType,
+	}, nil
+}
+
 func decodeRestrictedStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
 	const expectedLength = encodedRestrictedStaticTypeLength
 
@@ -144,6 +144,25 @@ func (t *DictionaryDynamicType) IsImportable() bool {
 	return true
 }
 
+// SetDynamicType
+
+type SetDynamicType struct {
+	ElementTypes []DynamicType
+	StaticType   SetStaticType
+}
+
+func (*SetDynamicType) IsDynamicType() {}
+
+func (t *SetDynamicType) IsImportable() bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsImportable() {
+			return false
+		}
+	}
+
+	return true
+}
+
 // NilDynamicType
 
 type NilDynamicType struct{}
@@ -158,8 +158,8 @@ const (
 	CBORTagWord16Value
 	CBORTagWord32Value
 	CBORTagWord64Value
-	_ // future: Word128
-	_ // future: Word256
+	CBORTagWord128Value
+	CBORTagWord256Value
 	_
 
 	// Fix*
@@ -168,7 +168,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	CBORTagFix64Value
-	_ // future: Fix128
+	CBORTagFix128Value
 	_ // future: Fix256
 	_
 
@@ -178,7 +178,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	CBORTagUFix64Value
-	_ // future: UFix128
+	CBORTagUFix128Value
 	_ // future: UFix256
 	_
 
@@ -218,6 +218,7 @@ const (
 	CBORTagReferenceStaticType
 	CBORTagRestrictedStaticType
 	CBORTagCapabilityStaticType
+	CBORTagSetStaticType
 )
 
 // CBOREncMode
@@ -580,6 +581,38 @@ func (v Word64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes Word128Value as
+// cbor.Tag{
+//		Number:  CBORTagWord128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Word128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagWord128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes Word256Value as
+// cbor.Tag{
+//		Number:  CBORTagWord256Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Word256Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagWord256Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
 // Encode encodes Fix64Value as
 // cbor.Tag{
 //		Number:  CBORTagFix64Value,
@@ -612,6 +645,38 @@ func (v UFix64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes Fix128Value as
+// cbor.Tag{
+//		Number:  CBORTagFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Fix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes UFix128Value as
+// cbor.Tag{
+//		Number:  CBORTagUFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v UFix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagUFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
 // Encode encodes SomeStorable as
 // cbor.Tag{
 //		Number: CBORTagSomeValue,
@@ -1212,6 +1277,22 @@ func (t DictionaryStaticType) Encode(e *cbor.StreamEncoder) error {
 	return EncodeStaticType(e, t.ValueType)
 }
 
+// Encode encodes SetStaticType as
+// cbor.Tag{
+//		Number:  CBORTagSetStaticType,
+//		Content: StaticType(v.ElementType),
+// }
+func (t SetStaticType) Encode(e *cbor.StreamEncoder) error {
+	err := e.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagSetStaticType,
+	})
+	if err != nil {
+		return err
+	}
+	return EncodeStaticType(e, t.ElementType)
+}
+
 // NOTE: NEVER change, only add/increment; ensure uint64
 const (
 	// encodedRestrictedStaticTypeTypeFieldKey         uint64 = 0
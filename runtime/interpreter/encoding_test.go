@@ -2350,6 +2350,162 @@ func TestEncodeDecodeWord64Value(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodeWord128Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewWord128ValueFromUint64(0),
+				encoded: []byte{
+					0xd8, CBORTagWord128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 0
+					0x40,
+				},
+			},
+		)
+	})
+
+	t.Run("positive", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewWord128ValueFromUint64(42),
+				encoded: []byte{
+					0xd8, CBORTagWord128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+			},
+		)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+				encoded: []byte{
+					0xd8, CBORTagWord128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagWord128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
+func TestEncodeDecodeWord256Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewWord256ValueFromUint64(0),
+				encoded: []byte{
+					0xd8, CBORTagWord256Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 0
+					0x40,
+				},
+			},
+		)
+	})
+
+	t.Run("positive", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewWord256ValueFromUint64(42),
+				encoded: []byte{
+					0xd8, CBORTagWord256Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+			},
+		)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+				encoded: []byte{
+					0xd8, CBORTagWord256Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 32
+					0x58, 0x20,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagWord256Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeSomeValue(t *testing.T) {
 
 	t.Parallel()
@@ -2678,6 +2834,144 @@ func TestEncodeDecodeUFix64Value(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodeFix128Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(big.NewInt(0)),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 0
+					0x40,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(big.NewInt(-42)),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x29,
+				},
+			},
+		)
+	})
+
+	t.Run("min", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 16
+					0x50,
+					0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run(">max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+					0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
+func TestEncodeDecodeUFix128Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("positive", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUFix128ValueFromBigInt(big.NewInt(42)),
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+			},
+		)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeAddressValue(t *testing.T) {
 
 	t.Parallel()
@@ -3641,6 +3935,35 @@ func TestEncodeDecodeLinkValue(t *testing.T) {
 		)
 	})
 
+	t.Run("set, bool", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := LinkValue{
+			TargetPath: publicPathValue,
+			Type: SetStaticType{
+				ElementType: PrimitiveStaticTypeBool,
+			},
+		}
+
+		//nolint:gocritic
+		encoded := append(
+			expectedLinkEncodingPrefix[:],
+			// tag
+			0xd8, CBORTagSetStaticType,
+			// tag
+			0xd8, CBORTagPrimitiveStaticType,
+			0x6,
+		)
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
 	t.Run("larger than max inline size", func(t *testing.T) {
 
 		t.Parallel()
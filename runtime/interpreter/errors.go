@@ -527,6 +527,16 @@ func (e ContainerMutationError) Error() string {
 	)
 }
 
+// ContainerMutatedDuringIterationError
+//
+type ContainerMutatedDuringIterationError struct {
+	LocationRange
+}
+
+func (ContainerMutatedDuringIterationError) Error() string {
+	return "invalid container update: container was mutated during iteration"
+}
+
 // NonStorableValueError
 //
 type NonStorableValueError struct {
@@ -61,6 +61,9 @@ type InterpretedFunctionValue struct {
 	PreConditions    ast.Conditions
 	Statements       []ast.Statement
 	PostConditions   ast.Conditions
+	// Element is the function declaration or function expression
+	// from which the function was created
+	Element ast.Element
 }
 
 var _ Value = &InterpretedFunctionValue{}
@@ -23,8 +23,11 @@ import (
 	goErrors "errors"
 	"fmt"
 	"math"
+	"math/big"
 	goRuntime "runtime"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/onflow/atree"
 	"github.com/opentracing/opentracing-go"
@@ -116,6 +119,39 @@ type OnInvokedFunctionReturnFunc func(
 	line int,
 )
 
+// OnFunctionEntryFunc is a function that is triggered when an interpreted function is entered,
+// i.e. after its arguments are bound, and before its body is executed.
+//
+type OnFunctionEntryFunc func(
+	inter *Interpreter,
+	function *InterpretedFunctionValue,
+)
+
+// OnFunctionExitFunc is a function that is triggered when an interpreted function is exited,
+// i.e. after its body is executed, or when the execution of its body failed.
+//
+type OnFunctionExitFunc func(
+	inter *Interpreter,
+	function *InterpretedFunctionValue,
+)
+
+// OnBranchFunc is a function that is triggered when a branch of an element is taken.
+//
+// The element is an if-statement, a switch-statement, a nil-coalescing expression,
+// or a conditional expression, and the branch is the index of the taken branch:
+//
+// - For if-statements and conditional expressions, 0 is the then-branch and 1 is the else-branch,
+//   even if the if-statement has no else-block.
+// - For switch-statements, the branch is the index of the executed case.
+//   If no case is executed, the branch is the number of cases.
+// - For nil-coalescing expressions, 0 is the non-nil left-hand side, and 1 is the right-hand side.
+//
+type OnBranchFunc func(
+	inter *Interpreter,
+	element ast.Element,
+	branch int,
+)
+
 // OnRecordTraceFunc is a function thats records a trace.
 type OnRecordTraceFunc func(
 	inter *Interpreter,
@@ -243,7 +279,9 @@ type FunctionWrapper = func(inner FunctionValue) FunctionValue
 type WrapperCode struct {
 	InitializerFunctionWrapper FunctionWrapper
 	DestructorFunctionWrapper  FunctionWrapper
-	FunctionWrappers           map[string]FunctionWrapper
+	// FunctionWrappers are keyed by the overloaded function name of the wrapped function,
+	// as the conforming type might declare overloads in a different order
+	FunctionWrappers map[string]FunctionWrapper
 }
 
 // TypeCodes is the value which stores the "prepared" / "callable" "code"
@@ -281,6 +319,11 @@ type Storage interface {
 
 type ReferencedResourceKindedValues map[atree.StorageID]map[ReferenceTrackedResourceKindedValue]struct{}
 
+// IteratedContainers are the containers which are currently iterated,
+// with the number of iterations of each
+//
+type IteratedContainers map[atree.StorageID]int
+
 type Interpreter struct {
 	Program                        *Program
 	Location                       common.Location
@@ -297,6 +340,9 @@ type Interpreter struct {
 	onLoopIteration                OnLoopIterationFunc
 	onFunctionInvocation           OnFunctionInvocationFunc
 	onInvokedFunctionReturn        OnInvokedFunctionReturnFunc
+	onFunctionEntry                OnFunctionEntryFunc
+	onFunctionExit                 OnFunctionExitFunc
+	onBranch                       OnBranchFunc
 	onRecordTrace                  OnRecordTraceFunc
 	onResourceOwnerChange          OnResourceOwnerChangeFunc
 	injectedCompositeFieldsHandler InjectedCompositeFieldsHandlerFunc
@@ -319,6 +365,7 @@ type Interpreter struct {
 	tracingEnabled                 bool
 	// TODO: ideally this would be a weak map, but Go has no weak references
 	referencedResourceKindedValues ReferencedResourceKindedValues
+	iteratedContainers             IteratedContainers
 }
 
 type Option func(*Interpreter) error
@@ -373,6 +420,36 @@ func WithOnInvokedFunctionReturnHandler(handler OnInvokedFunctionReturnFunc) Opt
 	}
 }
 
+// WithOnFunctionEntryHandler returns an interpreter option which sets
+// the given function as the function entry handler.
+//
+func WithOnFunctionEntryHandler(handler OnFunctionEntryFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnFunctionEntryHandler(handler)
+		return nil
+	}
+}
+
+// WithOnFunctionExitHandler returns an interpreter option which sets
+// the given function as the function exit handler.
+//
+func WithOnFunctionExitHandler(handler OnFunctionExitFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnFunctionExitHandler(handler)
+		return nil
+	}
+}
+
+// WithOnBranchHandler returns an interpreter option which sets
+// the given function as the branch handler.
+//
+func WithOnBranchHandler(handler OnBranchFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnBranchHandler(handler)
+		return nil
+	}
+}
+
 // WithOnRecordTraceHandler returns an interpreter option which sets
 // the given function as the record trace handler.
 //
@@ -590,6 +667,15 @@ func withReferencedResourceKindedValues(referencedResourceKindedValues Reference
 	}
 }
 
+// withIteratedContainers returns an interpreter option which sets the iterated containers.
+//
+func withIteratedContainers(iteratedContainers IteratedContainers) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.iteratedContainers = iteratedContainers
+		return nil
+	}
+}
+
 // WithDebugger returns an interpreter option which sets the given debugger
 //
 func WithDebugger(debugger *Debugger) Option {
@@ -629,6 +715,7 @@ func NewInterpreter(program *Program, location common.Location, options ...Optio
 			TypeRequirementCodes: map[sema.TypeID]WrapperCode{},
 		}),
 		withReferencedResourceKindedValues(map[atree.StorageID]map[ReferenceTrackedResourceKindedValue]struct{}{}),
+		withIteratedContainers(IteratedContainers{}),
 	}
 
 	for _, option := range defaultOptions {
@@ -678,6 +765,24 @@ func (interpreter *Interpreter) SetOnInvokedFunctionReturnHandler(function OnInv
 	interpreter.onInvokedFunctionReturn = function
 }
 
+// SetOnFunctionEntryHandler sets the function that is triggered when an interpreted function is entered.
+//
+func (interpreter *Interpreter) SetOnFunctionEntryHandler(function OnFunctionEntryFunc) {
+	interpreter.onFunctionEntry = function
+}
+
+// SetOnFunctionExitHandler sets the function that is triggered when an interpreted function is exited.
+//
+func (interpreter *Interpreter) SetOnFunctionExitHandler(function OnFunctionExitFunc) {
+	interpreter.onFunctionExit = function
+}
+
+// SetOnBranchHandler sets the function that is triggered when a branch of an element is taken.
+//
+func (interpreter *Interpreter) SetOnBranchHandler(function OnBranchFunc) {
+	interpreter.onBranch = function
+}
+
 // SetOnRecordTraceHandler sets the function that is triggered when a trace is recorded.
 //
 func (interpreter *Interpreter) SetOnRecordTraceHandler(function OnRecordTraceFunc) {
@@ -865,6 +970,23 @@ func (interpreter *Interpreter) declareGlobal(declaration ast.Declaration) {
 		return
 	}
 	name := identifier.Identifier
+
+	switch declaration := declaration.(type) {
+	case *ast.FunctionDeclaration:
+		name = interpreter.functionDeclarationName(declaration)
+
+	case *ast.CompositeDeclaration:
+		// Constructor overloads are globals as well
+
+		for _, initializer := range declaration.Members.Initializers() {
+			overloadName, ok := interpreter.Program.Elaboration.ConstructorOverloadNames[initializer]
+			if !ok {
+				continue
+			}
+			interpreter.Globals.Set(overloadName, interpreter.findVariable(overloadName))
+		}
+	}
+
 	// NOTE: semantic analysis already checked possible invalid redeclaration
 	interpreter.Globals.Set(name, interpreter.findVariable(name))
 }
@@ -970,6 +1092,25 @@ func (interpreter *Interpreter) prepareInvoke(
 		preparedArguments[i] = interpreter.ConvertAndBox(argument, nil, parameterType)
 	}
 
+	// fills in the default arguments for the parameters which have no argument
+
+	for i := argumentCount; i < parameterCount; i++ {
+		parameter := parameters[i]
+
+		defaultArgument := parameter.DefaultArgument
+		if defaultArgument == nil {
+			break
+		}
+
+		parameterType := parameter.TypeAnnotation.Type
+		argument := interpreter.evalExpression(defaultArgument)
+
+		preparedArguments = append(
+			preparedArguments,
+			interpreter.ConvertAndBox(argument, nil, parameterType),
+		)
+	}
+
 	// NOTE: can't fill argument types, as they are unknown
 	invocation := Invocation{
 		Arguments:        preparedArguments,
@@ -1140,7 +1281,7 @@ func (interpreter *Interpreter) VisitProgram(program *ast.Program) ast.Repr {
 
 func (interpreter *Interpreter) VisitFunctionDeclaration(declaration *ast.FunctionDeclaration) ast.Repr {
 
-	identifier := declaration.Identifier.Identifier
+	identifier := interpreter.functionDeclarationName(declaration)
 
 	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[declaration]
 
@@ -1195,6 +1336,7 @@ func (interpreter *Interpreter) functionDeclarationValue(
 		PreConditions:    preConditions,
 		Statements:       declaration.FunctionBlock.Block.Statements,
 		PostConditions:   rewrittenPostConditions,
+		Element:          declaration,
 	}
 }
 
@@ -1462,6 +1604,16 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 
 			memberIdentifier := nestedCompositeDeclaration.Identifier.Identifier
 			nestedVariables[memberIdentifier] = nestedVariable
+
+			// Constructor overloads are nested values as well
+
+			for _, initializer := range nestedCompositeDeclaration.Members.Initializers() {
+				overloadName, ok := interpreter.Program.Elaboration.ConstructorOverloadNames[initializer]
+				if !ok {
+					continue
+				}
+				nestedVariables[overloadName] = interpreter.findVariable(overloadName)
+			}
 		}
 	})()
 
@@ -1508,6 +1660,15 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 
 	functions := interpreter.compositeFunctions(declaration, lexicalScope)
 
+	// Function wrappers are keyed by the overloaded function names of the functions,
+	// see `WrapperCode`
+
+	functionNames := map[string]string{}
+	for _, functionDeclaration := range declaration.Members.Functions() {
+		functionNames[functionSignatureName(functionDeclaration)] =
+			interpreter.functionDeclarationName(functionDeclaration)
+	}
+
 	wrapFunctions := func(code WrapperCode) {
 
 		// Wrap initializer
@@ -1534,7 +1695,13 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 		// we only apply the function wrapper to each function,
 		// the order does not matter.
 
-		for name, functionWrapper := range code.FunctionWrappers { //nolint:maprangecheck
+		for signatureName, functionWrapper := range code.FunctionWrappers { //nolint:maprangecheck
+			name, ok := functionNames[signatureName]
+			if !ok {
+				// The composite does not declare the function,
+				// declare the wrapper under the function's identifier
+				name = signatureName[:strings.IndexByte(signatureName, '(')]
+			}
 			functions[name] = functionWrapper(functions[name])
 		}
 	}
@@ -1569,7 +1736,11 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 
 	qualifiedIdentifier := compositeType.QualifiedIdentifier()
 
-	constructorGenerator := func(address common.Address) *HostFunctionValue {
+	newConstructor := func(
+		address common.Address,
+		initializerFunction FunctionValue,
+		constructorType *sema.FunctionType,
+	) *HostFunctionValue {
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
 
@@ -1658,6 +1829,10 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 		)
 	}
 
+	constructorGenerator := func(address common.Address) *HostFunctionValue {
+		return newConstructor(address, initializerFunction, constructorType)
+	}
+
 	// Contract declarations declare a value / instance (singleton),
 	// for all other composite kinds, the constructor is declared
 
@@ -1677,6 +1852,35 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 		constructor := constructorGenerator(common.Address{})
 		constructor.NestedVariables = nestedVariables
 		variable.SetValue(constructor)
+
+		// Declare the constructors of the initializers
+		// which overload the first initializer, if any
+
+		elaboration := interpreter.Program.Elaboration
+
+		for _, initializer := range declaration.Members.Initializers() {
+			overloadName, ok := elaboration.ConstructorOverloadNames[initializer]
+			if !ok {
+				continue
+			}
+
+			initializerType := elaboration.ConstructorFunctionTypes[initializer]
+
+			overloadConstructor := newConstructor(
+				common.Address{},
+				interpreter.initializerFunction(initializer, lexicalScope),
+				&sema.FunctionType{
+					IsConstructor:        true,
+					Parameters:           initializerType.Parameters,
+					ReturnTypeAnnotation: constructorType.ReturnTypeAnnotation,
+				},
+			)
+			overloadConstructor.NestedVariables = nestedVariables
+
+			overloadVariable := interpreter.findOrDeclareVariable(overloadName)
+			overloadVariable.SetValue(overloadConstructor)
+			lexicalScope.Set(overloadName, overloadVariable)
+		}
 	}
 
 	return lexicalScope, variable
@@ -1790,20 +1994,27 @@ func EnumConstructorFunction(
 	return constructor
 }
 
+// compositeInitializerFunction returns the function of the first initializer of the composite, if any.
+// Initializers which overload the first initializer are declared as separate constructors
+//
 func (interpreter *Interpreter) compositeInitializerFunction(
 	compositeDeclaration *ast.CompositeDeclaration,
 	lexicalScope *VariableActivation,
 ) *InterpretedFunctionValue {
 
-	// TODO: support multiple overloaded initializers
-
 	initializers := compositeDeclaration.Members.Initializers()
-	var initializer *ast.SpecialFunctionDeclaration
 	if len(initializers) == 0 {
 		return nil
 	}
 
-	initializer = initializers[0]
+	return interpreter.initializerFunction(initializers[0], lexicalScope)
+}
+
+func (interpreter *Interpreter) initializerFunction(
+	initializer *ast.SpecialFunctionDeclaration,
+	lexicalScope *VariableActivation,
+) *InterpretedFunctionValue {
+
 	functionType := interpreter.Program.Elaboration.ConstructorFunctionTypes[initializer]
 
 	parameterList := initializer.FunctionDeclaration.ParameterList
@@ -1836,6 +2047,7 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 		PreConditions:    preConditions,
 		Statements:       statements,
 		PostConditions:   rewrittenPostConditions,
+		Element:          initializer.FunctionDeclaration,
 	}
 }
 
@@ -1878,6 +2090,7 @@ func (interpreter *Interpreter) compositeDestructorFunction(
 		PreConditions:    preConditions,
 		Statements:       statements,
 		PostConditions:   rewrittenPostConditions,
+		Element:          destructor.FunctionDeclaration,
 	}
 }
 
@@ -1889,7 +2102,7 @@ func (interpreter *Interpreter) compositeFunctions(
 	functions := map[string]FunctionValue{}
 
 	for _, functionDeclaration := range compositeDeclaration.Members.Functions() {
-		name := functionDeclaration.Identifier.Identifier
+		name := interpreter.functionDeclarationName(functionDeclaration)
 		functions[name] =
 			interpreter.compositeFunction(
 				functionDeclaration,
@@ -1900,6 +2113,28 @@ func (interpreter *Interpreter) compositeFunctions(
 	return functions
 }
 
+// functionDeclarationName returns the name under which the function is declared:
+// If the function is an overload of a previously declared function,
+// the overloaded function name, otherwise the identifier of the function
+//
+func (interpreter *Interpreter) functionDeclarationName(declaration *ast.FunctionDeclaration) string {
+	overloadName, ok := interpreter.Program.Elaboration.FunctionDeclarationOverloadNames[declaration]
+	if ok {
+		return overloadName
+	}
+	return declaration.Identifier.Identifier
+}
+
+// functionSignatureName returns the overloaded function name of the function,
+// independent of whether it is an overload or not
+//
+func functionSignatureName(declaration *ast.FunctionDeclaration) string {
+	return sema.OverloadedFunctionName(
+		declaration.Identifier.Identifier,
+		declaration.ParameterList.EffectiveArgumentLabels(),
+	)
+}
+
 func (interpreter *Interpreter) functionWrappers(
 	members *ast.Members,
 	lexicalScope *VariableActivation,
@@ -1911,7 +2146,7 @@ func (interpreter *Interpreter) functionWrappers(
 
 		functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[functionDeclaration]
 
-		name := functionDeclaration.Identifier.Identifier
+		name := functionSignatureName(functionDeclaration)
 		functionWrapper := interpreter.functionConditionsWrapper(
 			functionDeclaration,
 			functionType.ReturnTypeAnnotation.Type,
@@ -1963,6 +2198,7 @@ func (interpreter *Interpreter) compositeFunction(
 		PreConditions:    preConditions,
 		Statements:       statements,
 		PostConditions:   postConditions,
+		Element:          functionDeclaration,
 	}
 }
 
@@ -2153,6 +2389,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 			return ConvertWord64(value)
 		}
 
+	case sema.Word128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertWord128(value)
+		}
+
+	case sema.Word256Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertWord256(value)
+		}
+
 	// Fix*
 
 	case sema.Fix64Type:
@@ -2164,6 +2410,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 		if !valueType.Equal(unwrappedTargetType) {
 			return ConvertUFix64(value)
 		}
+
+	case sema.Fix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertFix128(value)
+		}
+
+	case sema.UFix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertUFix128(value)
+		}
 	}
 
 	switch unwrappedTargetType.(type) {
@@ -2300,7 +2556,8 @@ func (interpreter *Interpreter) initializerFunctionWrapper(
 	lexicalScope *VariableActivation,
 ) FunctionWrapper {
 
-	// TODO: support multiple overloaded initializers
+	// NOTE: Interfaces and type requirements declare at most one initializer,
+	//   which is wrapped around the first initializer of the conforming composite
 
 	initializers := members.Initializers()
 	if len(initializers) == 0 {
@@ -2512,6 +2769,9 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithOnLoopIterationHandler(interpreter.onLoopIteration),
 		WithOnFunctionInvocationHandler(interpreter.onFunctionInvocation),
 		WithOnInvokedFunctionReturnHandler(interpreter.onInvokedFunctionReturn),
+		WithOnFunctionEntryHandler(interpreter.onFunctionEntry),
+		WithOnFunctionExitHandler(interpreter.onFunctionExit),
+		WithOnBranchHandler(interpreter.onBranch),
 		WithInjectedCompositeFieldsHandler(interpreter.injectedCompositeFieldsHandler),
 		WithContractValueHandler(interpreter.contractValueHandler),
 		WithImportLocationHandler(interpreter.importLocationHandler),
@@ -2521,6 +2781,7 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithAtreeStorageValidationEnabled(interpreter.atreeStorageValidationEnabled),
 		withTypeCodes(interpreter.typeCodes),
 		withReferencedResourceKindedValues(interpreter.referencedResourceKindedValues),
+		withIteratedContainers(interpreter.iteratedContainers),
 		WithPublicAccountHandler(interpreter.publicAccountHandler),
 		WithPublicKeyValidationHandler(interpreter.PublicKeyValidationHandler),
 		WithSignatureVerificationHandler(interpreter.SignatureVerificationHandler),
@@ -2726,6 +2987,22 @@ var converterDeclarations = []valueConverterDeclaration{
 		min: Word64Value(0),
 		max: Word64Value(math.MaxUint64),
 	},
+	{
+		name: sema.Word128TypeName,
+		convert: func(value Value) Value {
+			return ConvertWord128(value)
+		},
+		min: NewWord128ValueFromUint64(0),
+		max: NewWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+	},
+	{
+		name: sema.Word256TypeName,
+		convert: func(value Value) Value {
+			return ConvertWord256(value)
+		},
+		min: NewWord256ValueFromUint64(0),
+		max: NewWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+	},
 	{
 		name: sema.Fix64TypeName,
 		convert: func(value Value) Value {
@@ -2742,6 +3019,22 @@ var converterDeclarations = []valueConverterDeclaration{
 		min: UFix64Value(0),
 		max: UFix64Value(math.MaxUint64),
 	},
+	{
+		name: sema.Fix128TypeName,
+		convert: func(value Value) Value {
+			return ConvertFix128(value)
+		},
+		min: NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+		max: NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+	},
+	{
+		name: sema.UFix128TypeName,
+		convert: func(value Value) Value {
+			return ConvertUFix128(value)
+		},
+		min: NewUFix128ValueFromBigInt(new(big.Int)),
+		max: NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+	},
 	{
 		name: "Address",
 		convert: func(value Value) Value {
@@ -3206,6 +3499,110 @@ var stringFunction = func() Value {
 		),
 	)
 
+	addMember(
+		sema.StringTypeFromUTF8FunctionName,
+		NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				argument, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				// Meter the bytes before the string is created,
+				// a string has at most as many characters as bytes
+
+				reportCharacterIterationCount(invocation, argument.Count())
+
+				bytes, _ := ByteArrayValueToByteSlice(argument)
+				if !utf8.Valid(bytes) {
+					return NilValue{}
+				}
+
+				return NewSomeValueNonCopying(NewStringValue(string(bytes)))
+			},
+			sema.StringTypeFromUTF8FunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeFromCharactersFunctionName,
+		NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				argument, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				// Each element is a character
+
+				reportCharacterIterationCount(invocation, argument.Count())
+
+				var sb strings.Builder
+
+				argument.Iterate(func(element Value) (resume bool) {
+					character, ok := element.(*StringValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+					sb.WriteString(character.Str)
+					return true
+				})
+
+				return NewStringValue(sb.String())
+			},
+			sema.StringTypeFromCharactersFunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeJoinFunctionName,
+		NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				argument, argumentOk := invocation.Arguments[0].(*ArrayValue)
+				separator, separatorOk := invocation.Arguments[1].(*StringValue)
+				if !argumentOk || !separatorOk {
+					panic(errors.NewUnreachableError())
+				}
+
+				// Meter the characters of the elements and the separators
+				// before the result is created
+
+				count := argument.Count()
+				if count > 1 {
+					reportCharacterIterationCount(invocation, (count-1)*separator.Length())
+				}
+
+				argument.Iterate(func(element Value) (resume bool) {
+					str, ok := element.(*StringValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+					str.reportCharacterIterations(invocation)
+					return true
+				})
+
+				var sb strings.Builder
+
+				first := true
+				argument.Iterate(func(element Value) (resume bool) {
+					str, ok := element.(*StringValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+					if !first {
+						sb.WriteString(separator.Str)
+					}
+					first = false
+					sb.WriteString(str.Str)
+					return true
+				})
+
+				return NewStringValue(sb.String())
+			},
+			sema.StringTypeJoinFunctionType,
+		),
+	)
+
 	return functionValue
 }()
 
@@ -3335,6 +3732,35 @@ func (interpreter *Interpreter) IsSubType(subType DynamicType, superType sema.Ty
 			return true
 		}
 
+	case *SetDynamicType:
+
+		if typedSuperType, ok := superType.(*sema.SetType); ok {
+
+			subTypeStaticType := interpreter.MustConvertStaticToSemaType(typedSubType.StaticType)
+			if !sema.IsSubType(subTypeStaticType, typedSuperType) {
+				return false
+			}
+
+			// The unparameterized type `Set` has no element type
+
+			if typedSuperType.ElementType == nil {
+				return true
+			}
+
+			for _, elementType := range typedSubType.ElementTypes {
+				if !interpreter.IsSubType(elementType, typedSuperType.ElementType) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		switch superType {
+		case sema.AnyStructType:
+			return true
+		}
+
 	case NilDynamicType:
 		if _, ok := superType.(*sema.OptionalType); ok {
 			return true
@@ -3539,6 +3965,132 @@ func (interpreter *Interpreter) authAccountTypeFunction(addressValue AddressValu
 	)
 }
 
+// storedPaths returns the paths of all values stored in the given domain of the account.
+//
+// The paths are collected before they are used,
+// so the storage may be modified while iterating over them.
+// Each collected path is reported as a loop iteration, so it is metered
+//
+func (interpreter *Interpreter) storedPaths(
+	address common.Address,
+	domain common.PathDomain,
+	getLocationRange func() LocationRange,
+) []PathValue {
+	storageMap := interpreter.Storage.GetStorageMap(address, domain.Identifier())
+	iterator := storageMap.Iterator()
+
+	locationRange := getLocationRange()
+
+	var paths []PathValue
+	for key := iterator.NextKey(); key != ""; key = iterator.NextKey() {
+
+		interpreter.reportLoopIteration(locationRange)
+
+		paths = append(
+			paths,
+			PathValue{
+				Domain:     domain,
+				Identifier: key,
+			},
+		)
+	}
+
+	return paths
+}
+
+// accountPaths returns an array of the paths of all values stored in the given domain of the account
+//
+func (interpreter *Interpreter) accountPaths(
+	addressValue AddressValue,
+	domain common.PathDomain,
+	getLocationRange func() LocationRange,
+) *ArrayValue {
+	paths := interpreter.storedPaths(addressValue.ToAddress(), domain, getLocationRange)
+
+	values := make([]Value, len(paths))
+	for i, path := range paths {
+		values[i] = path
+	}
+
+	return NewArrayValue(
+		interpreter,
+		VariableSizedStaticType{
+			Type: ConvertSemaToStaticType(sema.PathTypeForDomain(domain)),
+		},
+		common.Address{},
+		values...,
+	)
+}
+
+// accountForEachFunction returns a function which iterates over the paths of the given domain of the account,
+// and calls the given iteration function with each path and the type of the stored value.
+//
+// The stored values are not loaded, only their types.
+// Values stored during the iteration are not visited, and removed values are skipped
+//
+func (interpreter *Interpreter) accountForEachFunction(
+	addressValue AddressValue,
+	domain common.PathDomain,
+	functionType *sema.FunctionType,
+) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	pathType := sema.PathTypeForDomain(domain)
+	iterationFunctionArgumentTypes := []sema.Type{
+		pathType,
+		sema.MetaType,
+	}
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+
+			iterationFunction, ok := invocation.Arguments[0].(FunctionValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			locationRange := invocation.GetLocationRange()
+
+			storageMap := inter.Storage.GetStorageMap(address, domain.Identifier())
+
+			// NOTE: each path is already metered when the paths are collected
+
+			for _, path := range inter.storedPaths(address, domain, invocation.GetLocationRange) {
+
+				staticType := storageMap.ReadStaticType(path.Identifier)
+				if staticType == nil {
+					continue
+				}
+
+				result := inter.invokeFunctionValue(
+					iterationFunction,
+					[]Value{
+						path,
+						TypeValue{
+							Type: staticType,
+						},
+					},
+					nil,
+					iterationFunctionArgumentTypes,
+					iterationFunctionArgumentTypes,
+					nil,
+					locationRange,
+				)
+
+				if !bool(result.(BoolValue)) {
+					break
+				}
+			}
+
+			return VoidValue{}
+		},
+		functionType,
+	)
+}
+
 func (interpreter *Interpreter) authAccountLoadFunction(addressValue AddressValue) *HostFunctionValue {
 	return interpreter.authAccountReadFunction(addressValue, true)
 }
@@ -4138,6 +4690,30 @@ func (interpreter *Interpreter) reportInvokedFunctionReturn(line int) {
 	interpreter.onInvokedFunctionReturn(interpreter, line)
 }
 
+func (interpreter *Interpreter) reportFunctionEntry(function *InterpretedFunctionValue) {
+	if interpreter.onFunctionEntry == nil {
+		return
+	}
+
+	interpreter.onFunctionEntry(interpreter, function)
+}
+
+func (interpreter *Interpreter) reportFunctionExit(function *InterpretedFunctionValue) {
+	if interpreter.onFunctionExit == nil {
+		return
+	}
+
+	interpreter.onFunctionExit(interpreter, function)
+}
+
+func (interpreter *Interpreter) reportBranch(element ast.Element, branch int) {
+	if interpreter.onBranch == nil {
+		return
+	}
+
+	interpreter.onBranch(interpreter, element, branch)
+}
+
 // getMember gets the member value by the given identifier from the given Value depending on its type.
 // May return nil if the member does not exist.
 func (interpreter *Interpreter) getMember(self Value, getLocationRange func() LocationRange, identifier string) Value {
@@ -4287,6 +4863,8 @@ func (interpreter *Interpreter) ValidateAtreeValue(v atree.Value) {
 			return info.Equal(other.(StaticType))
 		case DictionaryStaticType:
 			return info.Equal(other.(StaticType))
+		case SetStaticType:
+			return info.Equal(other.(StaticType))
 		case compositeTypeInfo:
 			return info.Equal(other)
 		case EmptyTypeInfo:
@@ -4383,6 +4961,40 @@ func (interpreter *Interpreter) trackReferencedResourceKindedValue(
 	values[value] = struct{}{}
 }
 
+// startContainerIteration records that the container with the given storage ID is iterated,
+// until the returned function is called.
+//
+// While the container is iterated, it must not be mutated, see checkContainerNotIterated.
+// The container is identified by its storage ID, and not by its value,
+// as the same container might be accessed through different values
+//
+func (interpreter *Interpreter) startContainerIteration(id atree.StorageID) (end func()) {
+	interpreter.iteratedContainers[id]++
+
+	return func() {
+		count := interpreter.iteratedContainers[id] - 1
+		if count > 0 {
+			interpreter.iteratedContainers[id] = count
+		} else {
+			delete(interpreter.iteratedContainers, id)
+		}
+	}
+}
+
+// checkContainerNotIterated reports an error if the container with the given storage ID
+// is mutated while it is iterated
+//
+func (interpreter *Interpreter) checkContainerNotIterated(
+	id atree.StorageID,
+	getLocationRange func() LocationRange,
+) {
+	if interpreter.iteratedContainers[id] > 0 {
+		panic(ContainerMutatedDuringIterationError{
+			LocationRange: getLocationRange(),
+		})
+	}
+}
+
 func (interpreter *Interpreter) updateReferencedResource(
 	currentStorageID atree.StorageID,
 	newStorageID atree.StorageID,
@@ -41,7 +41,7 @@ func (interpreter *Interpreter) assignmentGetterSetter(expression ast.Expression
 		return interpreter.indexExpressionGetterSetter(expression)
 
 	case *ast.MemberExpression:
-		return interpreter.memberExpressionGetterSetter(expression)
+		return interpreter.memberExpressionGetterSetter(expression, expression.Identifier.Identifier)
 
 	default:
 		return getterSetter{
@@ -101,11 +101,19 @@ func (interpreter *Interpreter) indexExpressionGetterSetter(indexExpression *ast
 }
 
 // memberExpressionGetterSetter returns a getter/setter function pair
-// for the target member expression
+// for the member with the given identifier of the target of the member expression.
 //
-func (interpreter *Interpreter) memberExpressionGetterSetter(memberExpression *ast.MemberExpression) getterSetter {
+// The identifier is the identifier of the member expression,
+// or the overloaded function name if the member expression is invoked and resolved to an overload.
+// If the target has no member with the overloaded function name,
+// e.g. because the target implements a function of an interface without overloading it,
+// the member with the identifier of the member expression is returned
+//
+func (interpreter *Interpreter) memberExpressionGetterSetter(
+	memberExpression *ast.MemberExpression,
+	identifier string,
+) getterSetter {
 	target := interpreter.evalExpression(memberExpression.Expression)
-	identifier := memberExpression.Identifier.Identifier
 	getLocationRange := locationRangeGetter(interpreter.Location, memberExpression)
 	_, isNestedResourceMove := interpreter.Program.Elaboration.IsNestedResourceMoveExpression[memberExpression]
 	return getterSetter{
@@ -131,6 +139,9 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(memberExpression *a
 				resultValue = target.(MemberAccessibleValue).RemoveMember(interpreter, getLocationRange, identifier)
 			} else {
 				resultValue = interpreter.getMember(target, getLocationRange, identifier)
+				if resultValue == nil && identifier != memberExpression.Identifier.Identifier {
+					resultValue = interpreter.getMember(target, getLocationRange, memberExpression.Identifier.Identifier)
+				}
 			}
 			if resultValue == nil && !allowMissing {
 				panic(MissingMemberValueError{
@@ -346,9 +357,12 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 	case ast.OperationNilCoalesce:
 		// only evaluate right-hand side if left-hand side is nil
 		if some, ok := leftValue.(*SomeValue); ok {
+			interpreter.reportBranch(expression, 0)
 			return some.Value
 		}
 
+		interpreter.reportBranch(expression, 1)
+
 		value := rightValue()
 
 		rightType := interpreter.Program.Elaboration.BinaryExpressionRightTypes[expression]
@@ -478,6 +492,10 @@ func NewIntValue(value *big.Int, intSubType sema.Type) Value {
 		return Word32Value(value.Int64())
 	case sema.Word64Type:
 		return Word64Value(value.Int64())
+	case sema.Word128Type:
+		return NewWord128ValueFromBigInt(value)
+	case sema.Word256Type:
+		return NewWord256ValueFromBigInt(value)
 
 	default:
 		panic(errors.NewUnreachableError())
@@ -485,22 +503,30 @@ func NewIntValue(value *big.Int, intSubType sema.Type) Value {
 }
 
 func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedPointExpression) ast.Repr {
-	// TODO: adjust once/if we support more fixed point types
-
 	fixedPointSubType := interpreter.Program.Elaboration.FixedPointExpression[expression]
 
+	scale := sema.Fix64Scale
+	switch fixedPointSubType {
+	case sema.Fix128Type, sema.UFix128Type:
+		scale = sema.Fix128Scale
+	}
+
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		expression.Negative,
 		expression.UnsignedInteger,
 		expression.Fractional,
 		expression.Scale,
-		sema.Fix64Scale,
+		scale,
 	)
 	switch fixedPointSubType {
 	case sema.Fix64Type, sema.SignedFixedPointType:
 		return Fix64Value(value.Int64())
 	case sema.UFix64Type:
 		return UFix64Value(value.Uint64())
+	case sema.Fix128Type:
+		return NewFix128ValueFromBigInt(value)
+	case sema.UFix128Type:
+		return NewUFix128ValueFromBigInt(value)
 	case sema.FixedPointType:
 		if expression.Negative {
 			return Fix64Value(value.Int64())
@@ -520,6 +546,13 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	values := interpreter.visitExpressionsNonCopying(expression.Values)
 
 	argumentTypes := interpreter.Program.Elaboration.ArrayExpressionArgumentTypes[expression]
+
+	// The array literal is a set literal if a set type was expected
+
+	if setType, ok := interpreter.Program.Elaboration.ArrayExpressionSetType[expression]; ok {
+		return interpreter.newSetValueFromLiteral(expression, values, argumentTypes, setType)
+	}
+
 	arrayType := interpreter.Program.Elaboration.ArrayExpressionArrayType[expression]
 	elementType := arrayType.ElementType(false)
 
@@ -542,6 +575,31 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	)
 }
 
+func (interpreter *Interpreter) newSetValueFromLiteral(
+	expression *ast.ArrayExpression,
+	values []Value,
+	argumentTypes []sema.Type,
+	setType *sema.SetType,
+) *SetValue {
+
+	copies := make([]Value, len(values))
+	for i, argument := range values {
+		argumentType := argumentTypes[i]
+		argumentExpression := expression.Values[i]
+		getLocationRange := locationRangeGetter(interpreter.Location, argumentExpression)
+		copies[i] = interpreter.transferAndConvert(argument, argumentType, setType.ElementType, getLocationRange)
+	}
+
+	// TODO: cache
+	setStaticType := ConvertSemaSetTypeToStaticSetType(setType)
+
+	return NewSetValue(
+		interpreter,
+		setStaticType,
+		copies...,
+	)
+}
+
 func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.DictionaryExpression) ast.Repr {
 	values := interpreter.visitEntries(expression.Entries)
 
@@ -584,7 +642,8 @@ func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.Dictio
 
 func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpression) ast.Repr {
 	const allowMissing = false
-	return interpreter.memberExpressionGetterSetter(expression).get(allowMissing)
+	return interpreter.memberExpressionGetterSetter(expression, expression.Identifier.Identifier).
+		get(allowMissing)
 }
 
 func (interpreter *Interpreter) VisitIndexExpression(expression *ast.IndexExpression) ast.Repr {
@@ -603,12 +662,41 @@ func (interpreter *Interpreter) VisitConditionalExpression(expression *ast.Condi
 		panic(errors.NewUnreachableError())
 	}
 	if value {
+		interpreter.reportBranch(expression, 0)
 		return interpreter.evalExpression(expression.Then)
 	} else {
+		interpreter.reportBranch(expression, 1)
 		return interpreter.evalExpression(expression.Else)
 	}
 }
 
+// evalInvokedExpression evaluates the invoked expression of the given invocation.
+//
+// If the invocation invokes an overload of a function,
+// the overload is looked up by its overloaded function name
+//
+func (interpreter *Interpreter) evalInvokedExpression(invocationExpression *ast.InvocationExpression) Value {
+	invokedExpression := invocationExpression.InvokedExpression
+
+	overloadName, ok := interpreter.Program.Elaboration.InvocationExpressionOverloadNames[invocationExpression]
+	if !ok {
+		return interpreter.evalExpression(invokedExpression)
+	}
+
+	switch invokedExpression := invokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		return interpreter.findVariable(overloadName).GetValue()
+
+	case *ast.MemberExpression:
+		const allowMissing = false
+		return interpreter.memberExpressionGetterSetter(invokedExpression, overloadName).
+			get(allowMissing)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
 func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *ast.InvocationExpression) ast.Repr {
 
 	// tracing
@@ -620,7 +708,7 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 	}
 
 	// interpret the invoked expression
-	result := interpreter.evalExpression(invocationExpression.InvokedExpression)
+	result := interpreter.evalInvokedExpression(invocationExpression)
 
 	// Handle optional chaining on member expression, if any:
 	// - If the member expression is nil, finish execution
@@ -656,6 +744,12 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 		argumentExpressions[i] = argument.Expression
 	}
 
+	// Fill in the default arguments for the parameters which have no argument
+
+	defaultArguments :=
+		interpreter.Program.Elaboration.InvocationDefaultArguments[invocationExpression]
+	argumentExpressions = append(argumentExpressions, defaultArguments...)
+
 	arguments := interpreter.visitExpressionsNonCopying(argumentExpressions)
 
 	typeParameterTypes :=
@@ -669,6 +763,11 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 
 	interpreter.reportFunctionInvocation(line)
 
+	if interpreter.debugger != nil {
+		interpreter.debugger.onFunctionInvocation(interpreter, invocationExpression)
+		defer interpreter.debugger.onInvokedFunctionReturn()
+	}
+
 	resultValue := interpreter.invokeFunctionValue(
 		function,
 		arguments,
@@ -754,6 +853,7 @@ func (interpreter *Interpreter) VisitFunctionExpression(expression *ast.Function
 		PreConditions:    preConditions,
 		Statements:       statements,
 		PostConditions:   rewrittenPostConditions,
+		Element:          expression,
 	}
 }
 
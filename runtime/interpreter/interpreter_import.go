@@ -59,6 +59,12 @@ func (interpreter *Interpreter) importResolvedLocation(resolvedLocation sema.Res
 		for _, identifier := range resolvedLocation.Identifiers {
 			variable, _ := subInterpreter.Globals.Get(identifier.Identifier)
 			variables[identifier.Identifier] = variable
+
+			// Also import the overloads of the identified function, if any
+
+			for _, overloadName := range subInterpreter.overloadNames(identifier.Identifier) {
+				variables[overloadName], _ = subInterpreter.Globals.Get(overloadName)
+			}
 		}
 	} else {
 		// Only take the global values defined in the program.
@@ -92,3 +98,26 @@ func (interpreter *Interpreter) importResolvedLocation(resolvedLocation sema.Res
 	}
 
 }
+
+// overloadNames returns the overloaded function names of the overloads
+// of the global function or constructor with the given identifier, if any
+//
+func (interpreter *Interpreter) overloadNames(identifier string) []string {
+	if interpreter.Program == nil {
+		return nil
+	}
+
+	variable, ok := interpreter.Program.Elaboration.GlobalValues.Get(identifier)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(variable.Overloads))
+	for _, overload := range variable.Overloads {
+		names = append(
+			names,
+			sema.OverloadedFunctionName(identifier, overload.ArgumentLabels),
+		)
+	}
+	return names
+}
@@ -140,6 +140,9 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 		interpreter.bindParameterArguments(function.ParameterList, arguments)
 	}
 
+	interpreter.reportFunctionEntry(function)
+	defer interpreter.reportFunctionExit(function)
+
 	return interpreter.visitFunctionBody(
 		function.BeforeStatements,
 		function.PreConditions,
@@ -23,6 +23,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func (interpreter *Interpreter) evalStatement(statement ast.Statement) interface{} {
@@ -91,17 +92,17 @@ func (interpreter *Interpreter) VisitContinueStatement(_ *ast.ContinueStatement)
 func (interpreter *Interpreter) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
 	switch test := statement.Test.(type) {
 	case ast.Expression:
-		return interpreter.visitIfStatementWithTestExpression(test, statement.Then, statement.Else)
+		return interpreter.visitIfStatementWithTestExpression(statement, test)
 	case *ast.VariableDeclaration:
-		return interpreter.visitIfStatementWithVariableDeclaration(test, statement.Then, statement.Else)
+		return interpreter.visitIfStatementWithVariableDeclaration(statement, test)
 	default:
 		panic(errors.NewUnreachableError())
 	}
 }
 
 func (interpreter *Interpreter) visitIfStatementWithTestExpression(
+	statement *ast.IfStatement,
 	test ast.Expression,
-	thenBlock, elseBlock *ast.Block,
 ) controlReturn {
 
 	value, ok := interpreter.evalExpression(test).(BoolValue)
@@ -110,9 +111,13 @@ func (interpreter *Interpreter) visitIfStatementWithTestExpression(
 	}
 	var result interface{}
 	if value {
-		result = thenBlock.Accept(interpreter)
-	} else if elseBlock != nil {
-		result = elseBlock.Accept(interpreter)
+		interpreter.reportBranch(statement, 0)
+		result = statement.Then.Accept(interpreter)
+	} else {
+		interpreter.reportBranch(statement, 1)
+		if statement.Else != nil {
+			result = statement.Else.Accept(interpreter)
+		}
 	}
 
 	if ret, ok := result.(controlReturn); ok {
@@ -122,8 +127,8 @@ func (interpreter *Interpreter) visitIfStatementWithTestExpression(
 }
 
 func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
+	statement *ast.IfStatement,
 	declaration *ast.VariableDeclaration,
-	thenBlock, elseBlock *ast.Block,
 ) controlReturn {
 
 	// NOTE: It is *REQUIRED* that the getter for the value is used
@@ -179,9 +184,13 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 			transferredUnwrappedValue,
 		)
 
-		result = thenBlock.Accept(interpreter)
-	} else if elseBlock != nil {
-		result = elseBlock.Accept(interpreter)
+		interpreter.reportBranch(statement, 0)
+		result = statement.Then.Accept(interpreter)
+	} else {
+		interpreter.reportBranch(statement, 1)
+		if statement.Else != nil {
+			result = statement.Else.Accept(interpreter)
+		}
 	}
 
 	if ret, ok := result.(controlReturn); ok {
@@ -192,14 +201,16 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 
 func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.SwitchStatement) ast.Repr {
 
-	testValue, ok := interpreter.evalExpression(switchStatement.Expression).(EquatableValue)
-	if !ok {
-		panic(errors.NewUnreachableError())
-	}
+	testValue := interpreter.evalExpression(switchStatement.Expression)
 
-	for _, switchCase := range switchStatement.Cases {
+	for i, switchCase := range switchStatement.Cases {
+
+		// NOTE: don't capture loop variable
+		branch := i
 
 		runStatements := func() ast.Repr {
+			interpreter.reportBranch(switchStatement, branch)
+
 			// NOTE: the new block ensures that a new scope is introduced
 
 			block := &ast.Block{
@@ -215,16 +226,52 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 			return result
 		}
 
-		// If the case has no expression it is the default case.
+		// If the case has neither an expression nor a pattern, it is the default case.
 		// Evaluate it, i.e. all statements
 
-		if switchCase.Expression == nil {
+		if switchCase.IsDefault() {
+			return runStatements()
+		}
+
+		// If the case has a pattern, match the test value against it.
+		// If it matches, evaluate the case's statements
+		// in a new scope, which contains the bound value
+
+		if switchCase.Pattern != nil {
+			boundValue := interpreter.matchSwitchCasePattern(switchCase, testValue)
+			if boundValue == nil {
+				continue
+			}
+
+			interpreter.activations.PushNewWithCurrent()
+			defer interpreter.activations.Pop()
+
+			interpreter.declareVariable(
+				switchCase.Pattern.Identifier.Identifier,
+				boundValue,
+			)
+
 			return runStatements()
 		}
 
+		// If the case is `nil`, the test value matches if it is `nil`
+
+		if _, ok := switchCase.Expression.(*ast.NilExpression); ok {
+			if _, ok := testValue.(NilValue); ok {
+				return runStatements()
+			}
+
+			continue
+		}
+
 		// The case has an expression.
 		// Evaluate it and compare it to the test value
 
+		equatableTestValue, ok := testValue.(EquatableValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
 		result := interpreter.evalExpression(switchCase.Expression)
 
 		caseValue, ok := result.(EquatableValue)
@@ -238,7 +285,7 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 
 		getLocationRange := locationRangeGetter(interpreter.Location, switchCase.Expression)
 
-		if testValue.Equal(interpreter, getLocationRange, caseValue) {
+		if equatableTestValue.Equal(interpreter, getLocationRange, caseValue) {
 			return runStatements()
 		}
 
@@ -246,9 +293,41 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 		// then try the next case
 	}
 
+	interpreter.reportBranch(switchStatement, len(switchStatement.Cases))
+
 	return nil
 }
 
+// matchSwitchCasePattern matches the given test value against the pattern of the given switch case.
+//
+// Returns the value which is bound by the pattern, if the test value matches,
+// or nil, if the test value does not match
+//
+func (interpreter *Interpreter) matchSwitchCasePattern(switchCase *ast.SwitchCase, testValue Value) Value {
+	pattern := switchCase.Pattern
+	patternType := interpreter.Program.Elaboration.SwitchCasePatternTypes[switchCase]
+
+	var value Value
+
+	if pattern.IsOptional {
+		someValue, ok := testValue.(*SomeValue)
+		if !ok {
+			return nil
+		}
+		value = someValue.Value
+	} else {
+		dynamicType := testValue.DynamicType(interpreter, SeenReferences{})
+		if !interpreter.IsSubType(dynamicType, patternType) {
+			return nil
+		}
+		value = testValue
+	}
+
+	getLocationRange := locationRangeGetter(interpreter.Location, pattern)
+
+	return interpreter.transferAndConvert(value, patternType, patternType, getLocationRange)
+}
+
 func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatement) ast.Repr {
 
 	for {
@@ -296,9 +375,25 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 		nil,
 	)
 
-	iterator, err := transferredValue.(*ArrayValue).array.Iterator()
-	if err != nil {
-		panic(ExternalError{err})
+	var next func() (atree.Value, error)
+
+	switch transferredValue := transferredValue.(type) {
+	case *ArrayValue:
+		iterator, err := transferredValue.array.Iterator()
+		if err != nil {
+			panic(ExternalError{err})
+		}
+		next = iterator.Next
+
+	case *SetValue:
+		iterator, err := transferredValue.set.Iterator()
+		if err != nil {
+			panic(ExternalError{err})
+		}
+		next = iterator.NextKey
+
+	default:
+		panic(errors.NewUnreachableError())
 	}
 
 	var indexVariable *Variable
@@ -311,8 +406,7 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 	}
 
 	for {
-		var atreeValue atree.Value
-		atreeValue, err = iterator.Next()
+		atreeValue, err := next()
 		if err != nil {
 			panic(ExternalError{err})
 		}
@@ -323,7 +417,7 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 
 		interpreter.reportLoopIteration(statement)
 
-		// atree.Array iterator returns low-level atree.Value,
+		// atree.Array and atree.OrderedMap iterators return low-level atree.Value,
 		// convert to high-level interpreter.Value
 		value := MustConvertStoredValue(atreeValue)
 
@@ -376,6 +470,13 @@ func (interpreter *Interpreter) VisitPragmaDeclaration(_ *ast.PragmaDeclaration)
 	return nil
 }
 
+// VisitTypeAliasDeclaration does nothing:
+// Type aliases are fully resolved during checking
+//
+func (interpreter *Interpreter) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	return nil
+}
+
 // VisitVariableDeclaration first visits the declaration's value,
 // then declares the variable with the name bound to the value
 func (interpreter *Interpreter) VisitVariableDeclaration(declaration *ast.VariableDeclaration) ast.Repr {
@@ -447,6 +548,82 @@ func (interpreter *Interpreter) visitVariableDeclaration(
 	)
 }
 
+func (interpreter *Interpreter) VisitDestructuringDeclaration(declaration *ast.DestructuringDeclaration) ast.Repr {
+
+	valueType := interpreter.Program.Elaboration.DestructuringDeclarationValueTypes[declaration]
+	elementTypes := interpreter.Program.Elaboration.DestructuringElementTypes[declaration]
+
+	// NOTE: It is *REQUIRED* that the getter for the value is used
+	// instead of just evaluating value expression,
+	// as the value may be an access expression (member access, index access),
+	// which implicitly removes a resource.
+
+	const allowMissing = false
+	result := interpreter.assignmentGetterSetter(declaration.Value).get(allowMissing)
+	if result == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	getLocationRange := locationRangeGetter(interpreter.Location, declaration.Value)
+
+	var getElement func(index int, element *ast.DestructuringElement) Value
+
+	switch value := result.(type) {
+	case *ArrayValue:
+		if valueType.IsResourceType() {
+
+			// Move the elements out of the array, in order.
+			// Afterwards the array is empty and can be removed
+
+			defer interpreter.RemoveReferencedSlab(atree.StorageIDStorable(value.StorageID()))
+
+			getElement = func(_ int, _ *ast.DestructuringElement) Value {
+				return value.Remove(interpreter, getLocationRange, 0)
+			}
+		} else {
+			getElement = func(index int, _ *ast.DestructuringElement) Value {
+				return value.Get(interpreter, getLocationRange, index)
+			}
+		}
+
+	case *CompositeValue:
+		getElement = func(_ int, element *ast.DestructuringElement) Value {
+			return value.GetMember(interpreter, getLocationRange, element.Label)
+		}
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	for i, element := range declaration.Elements {
+		elementType := elementTypes[i]
+
+		elementValue := getElement(i, element)
+
+		identifier := element.Identifier.Identifier
+		if identifier == sema.DestructuringIgnoredIdentifier {
+			continue
+		}
+
+		transferredValue := interpreter.transferAndConvert(
+			elementValue,
+			elementType,
+			elementType,
+			getLocationRange,
+		)
+
+		// NOTE: lexical scope, always declare a new variable.
+		// Do not find an existing variable and assign the value!
+
+		_ = interpreter.declareVariable(
+			identifier,
+			transferredValue,
+		)
+	}
+
+	return nil
+}
+
 func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.AssignmentStatement) ast.Repr {
 	targetType := interpreter.Program.Elaboration.AssignmentStatementTargetTypes[assignment]
 	valueType := interpreter.Program.Elaboration.AssignmentStatementValueTypes[assignment]
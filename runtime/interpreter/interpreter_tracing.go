@@ -30,6 +30,7 @@ const (
 	tracingImportPrefix     = "import."
 	tracingArrayPrefix      = "array."
 	tracingDictionaryPrefix = "dictionary."
+	tracingSetPrefix        = "set."
 	tracingTransferPrefix   = "transfer."
 )
 
@@ -66,3 +67,16 @@ func (interpreter *Interpreter) reportDictionaryValueTransferTrace(typeInfo stri
 	}
 	interpreter.onRecordTrace(interpreter, tracingDictionaryPrefix+tracingTransferPrefix, duration, logs)
 }
+
+func (interpreter *Interpreter) reportSetValueTransferTrace(typeInfo string, count int, duration time.Duration) {
+	logs := []opentracing.LogRecord{
+		{
+			Timestamp: time.Now(),
+			Fields: []log.Field{
+				log.Int("count", count),
+				log.String("type", typeInfo),
+			},
+		},
+	}
+	interpreter.onRecordTrace(interpreter, tracingSetPrefix+tracingTransferPrefix, duration, logs)
+}
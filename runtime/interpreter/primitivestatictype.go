@@ -120,8 +120,8 @@ const (
 	PrimitiveStaticTypeWord16
 	PrimitiveStaticTypeWord32
 	PrimitiveStaticTypeWord64
-	_ // future: Word128
-	_ // future: Word256
+	PrimitiveStaticTypeWord128
+	PrimitiveStaticTypeWord256
 	_
 
 	// Fix*
@@ -130,7 +130,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	PrimitiveStaticTypeFix64
-	_ // future: Fix128
+	PrimitiveStaticTypeFix128
 	_ // future: Fix256
 	_
 
@@ -140,7 +140,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	PrimitiveStaticTypeUFix64
-	_ // future: UFix128
+	PrimitiveStaticTypeUFix128
 	_ // future: UFix256
 	_
 
@@ -268,14 +268,22 @@ func (i PrimitiveStaticType) SemaType() sema.Type {
 		return sema.Word32Type
 	case PrimitiveStaticTypeWord64:
 		return sema.Word64Type
+	case PrimitiveStaticTypeWord128:
+		return sema.Word128Type
+	case PrimitiveStaticTypeWord256:
+		return sema.Word256Type
 
 	// Fix*
 	case PrimitiveStaticTypeFix64:
 		return sema.Fix64Type
+	case PrimitiveStaticTypeFix128:
+		return sema.Fix128Type
 
 	// UFix*
 	case PrimitiveStaticTypeUFix64:
 		return sema.UFix64Type
+	case PrimitiveStaticTypeUFix128:
+		return sema.UFix128Type
 
 	// Storage
 
@@ -379,14 +387,22 @@ func ConvertSemaToPrimitiveStaticType(t sema.Type) PrimitiveStaticType {
 		return PrimitiveStaticTypeWord32
 	case sema.Word64Type:
 		return PrimitiveStaticTypeWord64
+	case sema.Word128Type:
+		return PrimitiveStaticTypeWord128
+	case sema.Word256Type:
+		return PrimitiveStaticTypeWord256
 
 	// Fix*
 	case sema.Fix64Type:
 		return PrimitiveStaticTypeFix64
+	case sema.Fix128Type:
+		return PrimitiveStaticTypeFix128
 
 	// UFix*
 	case sema.UFix64Type:
 		return PrimitiveStaticTypeUFix64
+	case sema.UFix128Type:
+		return PrimitiveStaticTypeUFix128
 
 	case sema.PathType:
 		return PrimitiveStaticTypePath
@@ -44,8 +44,12 @@ func _() {
 	_ = x[PrimitiveStaticTypeWord16-54]
 	_ = x[PrimitiveStaticTypeWord32-55]
 	_ = x[PrimitiveStaticTypeWord64-56]
+	_ = x[PrimitiveStaticTypeWord128-57]
+	_ = x[PrimitiveStaticTypeWord256-58]
 	_ = x[PrimitiveStaticTypeFix64-64]
+	_ = x[PrimitiveStaticTypeFix128-65]
 	_ = x[PrimitiveStaticTypeUFix64-72]
+	_ = x[PrimitiveStaticTypeUFix128-73]
 	_ = x[PrimitiveStaticTypePath-76]
 	_ = x[PrimitiveStaticTypeCapability-77]
 	_ = x[PrimitiveStaticTypeStoragePath-78]
@@ -62,7 +66,7 @@ func _() {
 	_ = x[PrimitiveStaticTypeAccountKey-97]
 }
 
-const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockNumberSignedNumberIntegerSignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Fix64UFix64PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKey"
+const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockNumberSignedNumberIntegerSignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Word128Word256Fix64Fix128UFix64UFix128PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKey"
 
 var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	0:  _PrimitiveStaticType_name[0:7],
@@ -101,22 +105,26 @@ var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	54: _PrimitiveStaticType_name[222:228],
 	55: _PrimitiveStaticType_name[228:234],
 	56: _PrimitiveStaticType_name[234:240],
-	64: _PrimitiveStaticType_name[240:245],
-	72: _PrimitiveStaticType_name[245:251],
-	76: _PrimitiveStaticType_name[251:255],
-	77: _PrimitiveStaticType_name[255:265],
-	78: _PrimitiveStaticType_name[265:276],
-	79: _PrimitiveStaticType_name[276:290],
-	80: _PrimitiveStaticType_name[290:300],
-	81: _PrimitiveStaticType_name[300:311],
-	90: _PrimitiveStaticType_name[311:322],
-	91: _PrimitiveStaticType_name[322:335],
-	92: _PrimitiveStaticType_name[335:351],
-	93: _PrimitiveStaticType_name[351:371],
-	94: _PrimitiveStaticType_name[371:393],
-	95: _PrimitiveStaticType_name[393:408],
-	96: _PrimitiveStaticType_name[408:425],
-	97: _PrimitiveStaticType_name[425:435],
+	57: _PrimitiveStaticType_name[240:247],
+	58: _PrimitiveStaticType_name[247:254],
+	64: _PrimitiveStaticType_name[254:259],
+	65: _PrimitiveStaticType_name[259:265],
+	72: _PrimitiveStaticType_name[265:271],
+	73: _PrimitiveStaticType_name[271:278],
+	76: _PrimitiveStaticType_name[278:282],
+	77: _PrimitiveStaticType_name[282:292],
+	78: _PrimitiveStaticType_name[292:303],
+	79: _PrimitiveStaticType_name[303:317],
+	80: _PrimitiveStaticType_name[317:327],
+	81: _PrimitiveStaticType_name[327:338],
+	90: _PrimitiveStaticType_name[338:349],
+	91: _PrimitiveStaticType_name[349:362],
+	92: _PrimitiveStaticType_name[362:378],
+	93: _PrimitiveStaticType_name[378:398],
+	94: _PrimitiveStaticType_name[398:420],
+	95: _PrimitiveStaticType_name[420:435],
+	96: _PrimitiveStaticType_name[435:452],
+	97: _PrimitiveStaticType_name[452:462],
 }
 
 func (i PrimitiveStaticType) String() string {
@@ -207,6 +207,30 @@ func (t DictionaryStaticType) Equal(other StaticType) bool {
 		t.ValueType.Equal(otherDictionaryType.ValueType)
 }
 
+// SetStaticType
+
+type SetStaticType struct {
+	ElementType StaticType
+}
+
+var _ StaticType = SetStaticType{}
+var _ atree.TypeInfo = SetStaticType{}
+
+func (SetStaticType) isStaticType() {}
+
+func (t SetStaticType) String() string {
+	return fmt.Sprintf("{%s}", t.ElementType)
+}
+
+func (t SetStaticType) Equal(other StaticType) bool {
+	otherSetType, ok := other.(SetStaticType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Equal(otherSetType.ElementType)
+}
+
 // OptionalStaticType
 
 type OptionalStaticType struct {
@@ -359,6 +383,9 @@ func ConvertSemaToStaticType(t sema.Type) StaticType {
 	case *sema.DictionaryType:
 		return ConvertSemaDictionaryTypeToStaticDictionaryType(t)
 
+	case *sema.SetType:
+		return ConvertSemaSetTypeToStaticSetType(t)
+
 	case *sema.OptionalType:
 		return OptionalStaticType{
 			Type: ConvertSemaToStaticType(t.Type),
@@ -424,6 +451,12 @@ func ConvertSemaDictionaryTypeToStaticDictionaryType(t *sema.DictionaryType) Dic
 	}
 }
 
+func ConvertSemaSetTypeToStaticSetType(t *sema.SetType) SetStaticType {
+	return SetStaticType{
+		ElementType: ConvertSemaToStaticType(t.ElementType),
+	}
+}
+
 func ConvertSemaReferenceTyoeToStaticReferenceType(t *sema.ReferenceType) ReferenceStaticType {
 	return ReferenceStaticType{
 		Authorized: t.Authorized,
@@ -474,6 +507,12 @@ func ConvertStaticToSemaType(
 			ValueType: valueType,
 		}, err
 
+	case SetStaticType:
+		elementType, err := ConvertStaticToSemaType(t.ElementType, getInterface, getComposite)
+		return &sema.SetType{
+			ElementType: elementType,
+		}, err
+
 	case OptionalStaticType:
 		ty, err := ConvertStaticToSemaType(t.Type, getInterface, getComposite)
 		return &sema.OptionalType{
@@ -42,6 +42,77 @@ func StoredValue(storable atree.Storable, storage atree.SlabStorage) Value {
 	return MustConvertStoredValue(storedValue)
 }
 
+// StoredValueStaticType returns the static type of the value stored as the given storable,
+// without loading the value.
+//
+// For arrays, dictionaries, sets, and composites, only the root slab is retrieved,
+// as it contains the type information. Their elements are not loaded.
+//
+func StoredValueStaticType(storable atree.Storable, storage atree.SlabStorage) StaticType {
+	switch storable := storable.(type) {
+	case atree.StorageIDStorable:
+		slab, found, err := storage.Retrieve(atree.StorageID(storable))
+		if err != nil {
+			panic(ExternalError{err})
+		}
+		if !found {
+			panic(errors.NewUnreachableError())
+		}
+
+		switch slab := slab.(type) {
+		case atree.ArraySlab:
+			return slab.ExtraData().TypeInfo.(ArrayStaticType)
+
+		case atree.MapSlab:
+			switch typeInfo := slab.ExtraData().TypeInfo.(type) {
+			case DictionaryStaticType:
+				return typeInfo
+
+			case SetStaticType:
+				return typeInfo
+
+			case compositeTypeInfo:
+				return NewCompositeStaticType(
+					typeInfo.location,
+					typeInfo.qualifiedIdentifier,
+				)
+
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+		case *atree.StorableSlab:
+			return StoredValueStaticType(slab.Storable, storage)
+
+		default:
+			panic(errors.NewUnreachableError())
+		}
+
+	case SomeStorable:
+		innerType := StoredValueStaticType(storable.Storable, storage)
+		if innerType == nil {
+			return nil
+		}
+		return OptionalStaticType{
+			Type: innerType,
+		}
+
+	case LinkValue:
+		// Links have no static type,
+		// so use the type of the capability which can be created from the link
+
+		return CapabilityStaticType{
+			BorrowType: storable.Type,
+		}
+
+	case Value:
+		return storable.StaticType()
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
 func MustConvertStoredValue(value atree.Value) Value {
 	converted, err := ConvertStoredValue(value)
 	if err != nil {
@@ -67,6 +138,12 @@ func ConvertStoredValue(value atree.Value) (Value, error) {
 				dictionary: value,
 			}, nil
 
+		case SetStaticType:
+			return &SetValue{
+				Type: typeInfo,
+				set:  value,
+			}, nil
+
 		case compositeTypeInfo:
 			return &CompositeValue{
 				dictionary:          value,
@@ -108,6 +185,8 @@ func DecodeTypeInfo(dec *cbor.StreamDecoder) (atree.TypeInfo, error) {
 			return decodeVariableSizedStaticType(dec)
 		case CBORTagDictionaryStaticType:
 			return decodeDictionaryStaticType(dec)
+		case CBORTagSetStaticType:
+			return decodeSetStaticType(dec)
 		case CBORTagCompositeValue:
 			return decodeCompositeTypeInfo(dec)
 		default:
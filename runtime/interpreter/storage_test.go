@@ -422,6 +422,53 @@ func TestDictionaryStorage(t *testing.T) {
 	})
 }
 
+func TestSetStorage(t *testing.T) {
+
+	t.Parallel()
+
+	storage := NewInMemoryStorage()
+
+	inter, err := NewInterpreter(
+		nil,
+		common.AddressLocation{},
+		WithStorage(storage),
+	)
+	require.NoError(t, err)
+
+	value := NewSetValue(
+		inter,
+		SetStaticType{
+			ElementType: PrimitiveStaticTypeString,
+		},
+	)
+
+	require.NotEqual(t, atree.StorageIDUndefined, value.StorageID())
+
+	require.Equal(t, 1, storage.BasicSlabStorage.Count())
+
+	element := NewStringValue("test")
+
+	require.True(t, bool(value.Insert(inter, ReturnEmptyLocationRange, element)))
+	require.False(t, bool(value.Insert(inter, ReturnEmptyLocationRange, element)))
+
+	require.Equal(t, 1, storage.BasicSlabStorage.Count())
+
+	retrievedStorable, ok, err := storage.BasicSlabStorage.Retrieve(value.StorageID())
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	storedValue := StoredValue(retrievedStorable, storage)
+
+	require.IsType(t, storedValue, &SetValue{})
+	storedSet := storedValue.(*SetValue)
+
+	require.Equal(t, 1, storedSet.Count())
+	require.True(t, bool(storedSet.Contains(inter, ReturnEmptyLocationRange, element)))
+
+	require.True(t, bool(storedSet.Remove(inter, ReturnEmptyLocationRange, element)))
+	require.False(t, bool(storedSet.Contains(inter, ReturnEmptyLocationRange, element)))
+}
+
 func TestStorageOverwriteAndRemove(t *testing.T) {
 
 	t.Parallel()
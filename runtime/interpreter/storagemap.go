@@ -96,6 +96,27 @@ func (s StorageMap) ReadValue(key string) Value {
 	return StoredValue(storable, s.orderedMap.Storage)
 }
 
+// ReadStaticType returns the static type of the value for the given key,
+// without loading the value, or nil if the key does not exist.
+//
+// For links, the type is the capability type for the link's borrow type.
+//
+func (s StorageMap) ReadStaticType(key string) StaticType {
+	storable, err := s.orderedMap.Get(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(key),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return nil
+		}
+		panic(ExternalError{err})
+	}
+
+	return StoredValueStaticType(storable, s.orderedMap.Storage)
+}
+
 // WriteValue sets or removes a value in the storage map.
 // If the given value is a SomeValue, the key is updated.
 // If the given value is NilValue, the key is removed.
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 
@@ -812,10 +813,124 @@ func (v *StringValue) GetMember(interpreter *Interpreter, _ func() LocationRange
 	case "toLower":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
+				v.reportCharacterIterations(invocation)
 				return v.ToLower()
 			},
 			sema.StringTypeToLowerFunctionType,
 		)
+
+	case "split":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				separator, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				v.reportCharacterIterations(invocation)
+				separator.reportCharacterIterations(invocation)
+				return v.Split(invocation.Interpreter, separator)
+			},
+			sema.StringTypeSplitFunctionType,
+		)
+
+	case "replaceAll":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				of, ofOk := invocation.Arguments[0].(*StringValue)
+				with, withOk := invocation.Arguments[1].(*StringValue)
+				if !ofOk || !withOk {
+					panic(errors.NewUnreachableError())
+				}
+				v.reportCharacterIterations(invocation)
+				of.reportCharacterIterations(invocation)
+
+				// Each occurrence is replaced by the characters of the replacement,
+				// so meter the replacement characters before the result is created
+
+				if len(of.Str) > 0 {
+					withLength := with.Length()
+					v.forEachOccurrence(of.Str, func(_ int, _ int, _ int) bool {
+						reportCharacterIterationCount(invocation, withLength)
+						return true
+					})
+				}
+
+				return v.ReplaceAll(of, with)
+			},
+			sema.StringTypeReplaceAllFunctionType,
+		)
+
+	case "contains":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				v.reportCharacterIterations(invocation)
+				other.reportCharacterIterations(invocation)
+				return v.Contains(other)
+			},
+			sema.StringTypeContainsFunctionType,
+		)
+
+	case "index":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				v.reportCharacterIterations(invocation)
+				other.reportCharacterIterations(invocation)
+				return v.IndexOf(other)
+			},
+			sema.StringTypeIndexFunctionType,
+		)
+
+	case "hasPrefix":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				prefix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				prefix.reportCharacterIterations(invocation)
+				return v.HasPrefix(prefix)
+			},
+			sema.StringTypeHasPrefixFunctionType,
+		)
+
+	case "hasSuffix":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				suffix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				suffix.reportCharacterIterations(invocation)
+				return v.HasSuffix(suffix)
+			},
+			sema.StringTypeHasSuffixFunctionType,
+		)
+
+	case "toUpper":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				v.reportCharacterIterations(invocation)
+				return v.ToUpper()
+			},
+			sema.StringTypeToUpperFunctionType,
+		)
+
+	case "trim":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				v.reportCharacterIterations(invocation)
+				return v.Trim()
+			},
+			sema.StringTypeTrimFunctionType,
+		)
 	}
 
 	return nil
@@ -849,6 +964,218 @@ func (v *StringValue) ToLower() *StringValue {
 	return NewStringValue(strings.ToLower(v.Str))
 }
 
+func (v *StringValue) ToUpper() *StringValue {
+	return NewStringValue(strings.ToUpper(v.Str))
+}
+
+// reportCharacterIterations reports one loop iteration per character of the string,
+// so string functions are metered like a loop over the characters
+//
+func (v *StringValue) reportCharacterIterations(invocation Invocation) {
+	if invocation.Interpreter.onLoopIteration == nil {
+		return
+	}
+
+	reportCharacterIterationCount(invocation, v.Length())
+}
+
+// reportCharacterIterationCount reports one loop iteration for each of the given number of characters.
+//
+// Functions which create strings report the characters of the result before it is created
+//
+func reportCharacterIterationCount(invocation Invocation, count int) {
+	inter := invocation.Interpreter
+	if inter.onLoopIteration == nil {
+		return
+	}
+
+	locationRange := invocation.GetLocationRange()
+
+	for i := 0; i < count; i++ {
+		inter.reportLoopIteration(locationRange)
+	}
+}
+
+// characterBoundaries returns the byte offsets of the start of each character (grapheme cluster),
+// followed by the length of the string
+//
+func (v *StringValue) characterBoundaries() []int {
+	boundaries := []int{0}
+
+	v.prepareGraphemes()
+	for v.graphemes.Next() {
+		_, end := v.graphemes.Positions()
+		boundaries = append(boundaries, end)
+	}
+
+	v.length = len(boundaries) - 1
+
+	return boundaries
+}
+
+// characterIndex returns the index of the character which starts at the given byte offset,
+// and false if the offset is not at a character boundary
+//
+func characterIndex(boundaries []int, offset int) (int, bool) {
+	index := sort.SearchInts(boundaries, offset)
+	return index, index < len(boundaries) && boundaries[index] == offset
+}
+
+// forEachOccurrence calls the given function with the character index and the byte offsets
+// of each non-overlapping occurrence of the given non-empty string, until the function returns false.
+//
+// Occurrences which do not start and end at character boundaries are skipped,
+// e.g. "e" does not occur in "e\u{301}"
+//
+func (v *StringValue) forEachOccurrence(other string, f func(index int, start int, end int) (resume bool)) {
+	boundaries := v.characterBoundaries()
+
+	offset := 0
+	for {
+		i := strings.Index(v.Str[offset:], other)
+		if i < 0 {
+			return
+		}
+
+		start := offset + i
+		end := start + len(other)
+
+		index, startOk := characterIndex(boundaries, start)
+		_, endOk := characterIndex(boundaries, end)
+		if !startOk || !endOk {
+			offset = start + 1
+			continue
+		}
+
+		if !f(index, start, end) {
+			return
+		}
+
+		offset = end
+	}
+}
+
+var StringArrayStaticType = VariableSizedStaticType{
+	Type: PrimitiveStaticTypeString,
+}
+
+// Split returns the substrings separated by the given separator.
+// If the separator is empty, the string is split into its characters
+//
+func (v *StringValue) Split(interpreter *Interpreter, separator *StringValue) *ArrayValue {
+	var values []Value
+
+	if len(separator.Str) == 0 {
+		v.prepareGraphemes()
+		for v.graphemes.Next() {
+			values = append(values, NewStringValue(v.graphemes.Str()))
+		}
+	} else {
+		offset := 0
+		v.forEachOccurrence(separator.Str, func(_ int, start int, end int) bool {
+			values = append(values, NewStringValue(v.Str[offset:start]))
+			offset = end
+			return true
+		})
+		values = append(values, NewStringValue(v.Str[offset:]))
+	}
+
+	return NewArrayValue(
+		interpreter,
+		StringArrayStaticType,
+		common.Address{},
+		values...,
+	)
+}
+
+// ReplaceAll returns a new string with all occurrences of the string of replaced by the string with.
+// If of is empty, the string is returned unchanged
+//
+func (v *StringValue) ReplaceAll(of *StringValue, with *StringValue) *StringValue {
+	if len(of.Str) == 0 {
+		return NewStringValue(v.Str)
+	}
+
+	var sb strings.Builder
+
+	offset := 0
+	v.forEachOccurrence(of.Str, func(_ int, start int, end int) bool {
+		sb.WriteString(v.Str[offset:start])
+		sb.WriteString(with.Str)
+		offset = end
+		return true
+	})
+	sb.WriteString(v.Str[offset:])
+
+	return NewStringValue(sb.String())
+}
+
+// IndexOf returns the index of the first character of the first occurrence of the given string,
+// or -1 if the string does not contain it
+//
+func (v *StringValue) IndexOf(other *StringValue) IntValue {
+	if len(other.Str) == 0 {
+		return NewIntValueFromInt64(0)
+	}
+
+	result := -1
+	v.forEachOccurrence(other.Str, func(index int, _ int, _ int) bool {
+		result = index
+		return false
+	})
+
+	return NewIntValueFromInt64(int64(result))
+}
+
+func (v *StringValue) Contains(other *StringValue) BoolValue {
+	return v.IndexOf(other).ToInt() >= 0
+}
+
+func (v *StringValue) HasPrefix(prefix *StringValue) BoolValue {
+	if !strings.HasPrefix(v.Str, prefix.Str) {
+		return false
+	}
+
+	_, ok := characterIndex(v.characterBoundaries(), len(prefix.Str))
+	return BoolValue(ok)
+}
+
+func (v *StringValue) HasSuffix(suffix *StringValue) BoolValue {
+	if !strings.HasSuffix(v.Str, suffix.Str) {
+		return false
+	}
+
+	_, ok := characterIndex(v.characterBoundaries(), len(v.Str)-len(suffix.Str))
+	return BoolValue(ok)
+}
+
+// Trim returns the string with the leading and trailing characters removed
+// which consist only of whitespace
+//
+func (v *StringValue) Trim() *StringValue {
+	start := -1
+	end := 0
+
+	v.prepareGraphemes()
+	for v.graphemes.Next() {
+		if strings.TrimSpace(v.graphemes.Str()) == "" {
+			continue
+		}
+
+		characterStart, characterEnd := v.graphemes.Positions()
+		if start < 0 {
+			start = characterStart
+		}
+		end = characterEnd
+	}
+
+	if start < 0 {
+		return NewStringValue("")
+	}
+
+	return NewStringValue(v.Str[start:end])
+}
+
 func (v *StringValue) Storable(storage atree.SlabStorage, address atree.Address, maxInlineSize uint64) (atree.Storable, error) {
 	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
 }
@@ -1200,6 +1527,8 @@ func (v *ArrayValue) Set(interpreter *Interpreter, getLocationRange func() Locat
 		})
 	}
 
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	interpreter.checkContainerMutation(v.Type.ElementType(), element, getLocationRange)
 
 	element = element.Transfer(
@@ -1243,6 +1572,8 @@ func (v *ArrayValue) RecursiveString(seenReferences SeenReferences) string {
 
 func (v *ArrayValue) Append(interpreter *Interpreter, getLocationRange func() LocationRange, element Value) {
 
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	interpreter.checkContainerMutation(v.Type.ElementType(), element, getLocationRange)
 
 	element = element.Transfer(
@@ -1284,6 +1615,8 @@ func (v *ArrayValue) Insert(interpreter *Interpreter, getLocationRange func() Lo
 		})
 	}
 
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	interpreter.checkContainerMutation(v.Type.ElementType(), element, getLocationRange)
 
 	element = element.Transfer(
@@ -1321,6 +1654,8 @@ func (v *ArrayValue) Remove(interpreter *Interpreter, getLocationRange func() Lo
 		})
 	}
 
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
 	storable, err := v.array.Remove(uint64(index))
 	if err != nil {
 		v.handleIndexOutOfBoundsError(err, index, getLocationRange)
@@ -1512,9 +1847,99 @@ func (v *ArrayValue) GetMember(inter *Interpreter, _ func() LocationRange, name
 				)
 			},
 			sema.ArraySliceFunctionType(
+				v.functionElementSemaType(inter),
+			),
+		)
+
+	case "firstIndex":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.FirstIndex(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.ArrayFirstIndexFunctionType(
 				v.SemaType(inter).ElementType(false),
 			),
 		)
+
+	case "filter":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				isIncluded, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				return v.Filter(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					isIncluded,
+				)
+			},
+			sema.ArrayFilterFunctionType(
+				v.functionElementSemaType(inter),
+			),
+		)
+
+	case "map":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				transform, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Map(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					transform,
+					typeParameterPair.Value,
+				)
+			},
+			sema.ArrayMapFunctionType(
+				v.functionElementSemaType(inter),
+			),
+		)
+
+	case "reverse":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.Reverse(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+				)
+			},
+			sema.ArrayReverseFunctionType(
+				v.SemaType(inter),
+				v.functionElementSemaType(inter),
+			),
+		)
+
+	case "sort":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				isOrderedBefore, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				v.Sort(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					isOrderedBefore,
+				)
+				return VoidValue{}
+			},
+			sema.ArraySortFunctionType(
+				v.functionElementSemaType(inter),
+			),
+		)
 	}
 
 	return nil
@@ -1837,7 +2262,7 @@ func (v *ArrayValue) Slice(
 	return NewArrayValueWithIterator(
 		interpreter,
 		VariableSizedStaticType{
-			Type: v.Type.ElementType(),
+			Type: v.functionElementStaticType(interpreter),
 		},
 		common.Address{},
 		func() Value {
@@ -1857,7 +2282,7 @@ func (v *ArrayValue) Slice(
 				return nil
 			}
 
-			return value.Transfer(
+			return v.functionElement(interpreter, value).Transfer(
 				interpreter,
 				getLocationRange,
 				atree.Address{},
@@ -1868,30 +2293,364 @@ func (v *ArrayValue) Slice(
 	)
 }
 
-// NumberValue
+// functionElementSemaType returns the type of the elements
+// when they are passed to and returned from array functions like `filter`,
+// see sema.ArrayFunctionElementType
 //
-type NumberValue interface {
-	EquatableValue
-	ToInt() int
-	Negate() NumberValue
-	Plus(other NumberValue) NumberValue
-	SaturatingPlus(other NumberValue) NumberValue
-	Minus(other NumberValue) NumberValue
-	SaturatingMinus(other NumberValue) NumberValue
-	Mod(other NumberValue) NumberValue
-	Mul(other NumberValue) NumberValue
-	SaturatingMul(other NumberValue) NumberValue
-	Div(other NumberValue) NumberValue
-	SaturatingDiv(other NumberValue) NumberValue
-	Less(other NumberValue) BoolValue
-	LessEqual(other NumberValue) BoolValue
-	Greater(other NumberValue) BoolValue
-	GreaterEqual(other NumberValue) BoolValue
-	ToBigEndianBytes() []byte
+func (v *ArrayValue) functionElementSemaType(interpreter *Interpreter) sema.Type {
+	return sema.ArrayFunctionElementType(v.SemaType(interpreter).ElementType(false))
 }
 
-func getNumberValueMember(v NumberValue, name string, typ sema.Type) Value {
-	switch name {
+func (v *ArrayValue) functionElementStaticType(interpreter *Interpreter) StaticType {
+	if v.IsResourceKinded(interpreter) {
+		return ReferenceStaticType{
+			Type: v.Type.ElementType(),
+		}
+	}
+	return v.Type.ElementType()
+}
+
+// functionElement returns the given element of the array
+// as it is passed to and returned from array functions like `filter`:
+// Elements of resource arrays are provided as references,
+// so they are neither moved nor copied
+//
+func (v *ArrayValue) functionElement(interpreter *Interpreter, element Value) Value {
+	if !v.IsResourceKinded(interpreter) {
+		return element
+	}
+
+	if trackedElement, ok := element.(ReferenceTrackedResourceKindedValue); ok {
+		interpreter.trackReferencedResourceKindedValue(trackedElement.StorageID(), trackedElement)
+	}
+
+	return &EphemeralReferenceValue{
+		Value:        element,
+		BorrowedType: v.SemaType(interpreter).ElementType(false),
+	}
+}
+
+// iterateFunctionElements calls the given function with each element of the array,
+// as it is passed to array functions (see functionElement), until the function returns false.
+//
+// Each element is metered as a loop iteration.
+// The array must not be mutated while it is iterated
+//
+func (v *ArrayValue) iterateFunctionElements(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	f func(element Value) (resume bool),
+) {
+	locationRange := getLocationRange()
+
+	endIteration := interpreter.startContainerIteration(v.StorageID())
+	defer endIteration()
+
+	v.Iterate(func(element Value) (resume bool) {
+		interpreter.reportLoopIteration(locationRange)
+
+		return f(v.functionElement(interpreter, element))
+	})
+}
+
+// FirstIndex returns the index of the first element which is equal to the given element,
+// or nil if the array does not contain the element
+//
+func (v *ArrayValue) FirstIndex(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	needleValue Value,
+) OptionalValue {
+
+	needleEquatable, ok := needleValue.(EquatableValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	index := 0
+	found := false
+
+	v.iterateFunctionElements(
+		interpreter,
+		getLocationRange,
+		func(element Value) (resume bool) {
+			if needleEquatable.Equal(interpreter, getLocationRange, element) {
+				found = true
+				// stop iteration
+				return false
+			}
+			index++
+			// continue iteration
+			return true
+		},
+	)
+
+	if !found {
+		return NilValue{}
+	}
+
+	return NewSomeValueNonCopying(NewIntValueFromInt64(int64(index)))
+}
+
+// Filter returns a new variable-sized array containing the elements
+// for which the given function returns true
+//
+func (v *ArrayValue) Filter(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	isIncluded FunctionValue,
+) *ArrayValue {
+
+	argumentTypes := []sema.Type{
+		v.functionElementSemaType(interpreter),
+	}
+
+	locationRange := getLocationRange()
+
+	var values []Value
+
+	v.iterateFunctionElements(
+		interpreter,
+		getLocationRange,
+		func(element Value) (resume bool) {
+			result := interpreter.invokeFunctionValue(
+				isIncluded,
+				[]Value{element},
+				nil,
+				argumentTypes,
+				argumentTypes,
+				nil,
+				locationRange,
+			)
+
+			if result.(BoolValue) {
+				values = append(
+					values,
+					element.Transfer(
+						interpreter,
+						getLocationRange,
+						atree.Address{},
+						false,
+						nil,
+					),
+				)
+			}
+
+			return true
+		},
+	)
+
+	return NewArrayValue(
+		interpreter,
+		VariableSizedStaticType{
+			Type: v.functionElementStaticType(interpreter),
+		},
+		common.Address{},
+		values...,
+	)
+}
+
+// Map returns a new variable-sized array containing the results
+// of calling the given function with each element
+//
+func (v *ArrayValue) Map(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	transform FunctionValue,
+	resultType sema.Type,
+) *ArrayValue {
+
+	argumentTypes := []sema.Type{
+		v.functionElementSemaType(interpreter),
+	}
+
+	locationRange := getLocationRange()
+
+	var values []Value
+
+	v.iterateFunctionElements(
+		interpreter,
+		getLocationRange,
+		func(element Value) (resume bool) {
+			result := interpreter.invokeFunctionValue(
+				transform,
+				[]Value{element},
+				nil,
+				argumentTypes,
+				argumentTypes,
+				nil,
+				locationRange,
+			)
+
+			values = append(
+				values,
+				interpreter.transferAndConvert(result, resultType, resultType, getLocationRange),
+			)
+
+			return true
+		},
+	)
+
+	return NewArrayValue(
+		interpreter,
+		VariableSizedStaticType{
+			Type: ConvertSemaToStaticType(resultType),
+		},
+		common.Address{},
+		values...,
+	)
+}
+
+// Reverse returns a new array containing the elements in reverse order
+//
+func (v *ArrayValue) Reverse(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+) *ArrayValue {
+
+	count := v.Count()
+	values := make([]Value, count)
+
+	index := count - 1
+	v.iterateFunctionElements(
+		interpreter,
+		getLocationRange,
+		func(element Value) (resume bool) {
+			values[index] = element.Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			)
+			index--
+			return true
+		},
+	)
+
+	elementType := v.functionElementStaticType(interpreter)
+
+	var arrayType ArrayStaticType
+	if constantSizedType, ok := v.Type.(ConstantSizedStaticType); ok {
+		arrayType = ConstantSizedStaticType{
+			Type: elementType,
+			Size: constantSizedType.Size,
+		}
+	} else {
+		arrayType = VariableSizedStaticType{
+			Type: elementType,
+		}
+	}
+
+	return NewArrayValue(
+		interpreter,
+		arrayType,
+		common.Address{},
+		values...,
+	)
+}
+
+// Sort sorts the array in place, using the given function to compare elements.
+// The sort is stable
+//
+func (v *ArrayValue) Sort(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	isOrderedBefore FunctionValue,
+) {
+
+	elementType := v.functionElementSemaType(interpreter)
+	argumentTypes := []sema.Type{
+		elementType,
+		elementType,
+	}
+
+	locationRange := getLocationRange()
+
+	count := v.Count()
+
+	elements := make([]Value, 0, count)
+	v.iterateFunctionElements(
+		interpreter,
+		getLocationRange,
+		func(element Value) (resume bool) {
+			elements = append(elements, element)
+			return true
+		},
+	)
+
+	// Determine the sorted order of the elements,
+	// and only then rearrange the array
+
+	order := make([]int, count)
+	for i := range order {
+		order[i] = i
+	}
+
+	// The array must not be mutated while the elements are compared
+
+	func() {
+		endIteration := interpreter.startContainerIteration(v.StorageID())
+		defer endIteration()
+
+		sort.SliceStable(order, func(i, j int) bool {
+			interpreter.reportLoopIteration(locationRange)
+
+			result := interpreter.invokeFunctionValue(
+				isOrderedBefore,
+				[]Value{
+					elements[order[i]],
+					elements[order[j]],
+				},
+				nil,
+				argumentTypes,
+				argumentTypes,
+				nil,
+				locationRange,
+			)
+
+			return bool(result.(BoolValue))
+		})
+	}()
+
+	// Move the elements out of the array, and back in, in sorted order.
+	// Removing and appending transfers the elements properly,
+	// e.g. resources are moved and not copied
+
+	removed := make([]Value, count)
+	for i := range removed {
+		removed[i] = v.RemoveFirst(interpreter, getLocationRange)
+	}
+
+	for _, index := range order {
+		v.Append(interpreter, getLocationRange, removed[index])
+	}
+}
+
+// NumberValue
+//
+type NumberValue interface {
+	EquatableValue
+	ToInt() int
+	Negate() NumberValue
+	Plus(other NumberValue) NumberValue
+	SaturatingPlus(other NumberValue) NumberValue
+	Minus(other NumberValue) NumberValue
+	SaturatingMinus(other NumberValue) NumberValue
+	Mod(other NumberValue) NumberValue
+	Mul(other NumberValue) NumberValue
+	SaturatingMul(other NumberValue) NumberValue
+	Div(other NumberValue) NumberValue
+	SaturatingDiv(other NumberValue) NumberValue
+	Less(other NumberValue) BoolValue
+	LessEqual(other NumberValue) BoolValue
+	Greater(other NumberValue) BoolValue
+	GreaterEqual(other NumberValue) BoolValue
+	ToBigEndianBytes() []byte
+}
+
+func getNumberValueMember(v NumberValue, name string, typ sema.Type) Value {
+	switch name {
 
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
@@ -10396,74 +11155,81 @@ func (Word64Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Fix64Value
-//
-type Fix64Value int64
-
-const Fix64MaxValue = math.MaxInt64
-
-func NewFix64ValueWithInteger(integer int64) Fix64Value {
+// Word128Value
 
-	if integer < sema.Fix64TypeMinInt {
-		panic(UnderflowError{})
-	}
+type Word128Value struct {
+	BigInt *big.Int
+}
 
-	if integer > sema.Fix64TypeMaxInt {
-		panic(OverflowError{})
-	}
+func NewWord128ValueFromUint64(value uint64) Word128Value {
+	return NewWord128ValueFromBigInt(new(big.Int).SetUint64(value))
+}
 
-	return Fix64Value(integer * sema.Fix64Factor)
+func NewWord128ValueFromBigInt(value *big.Int) Word128Value {
+	return Word128Value{BigInt: value}
 }
 
-var _ Value = Fix64Value(0)
-var _ atree.Storable = Fix64Value(0)
-var _ NumberValue = Fix64Value(0)
-var _ EquatableValue = Fix64Value(0)
-var _ HashableValue = Fix64Value(0)
-var _ MemberAccessibleValue = Fix64Value(0)
+var _ Value = Word128Value{}
+var _ atree.Storable = Word128Value{}
+var _ NumberValue = Word128Value{}
+var _ IntegerValue = Word128Value{}
+var _ EquatableValue = Word128Value{}
+var _ HashableValue = Word128Value{}
+var _ MemberAccessibleValue = Word128Value{}
 
-func (Fix64Value) IsValue() {}
+// NOTE: important, do *NOT* remove:
+// Word128 values > math.MaxInt64 overflow int.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+var _ BigNumberValue = Word128Value{}
 
-func (v Fix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitFix64Value(interpreter, v)
+func (Word128Value) IsValue() {}
+
+func (v Word128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord128Value(interpreter, v)
 }
 
-func (Fix64Value) Walk(_ func(Value)) {
+func (Word128Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var fix64DynamicType DynamicType = NumberDynamicType{sema.Fix64Type}
+var word128DynamicType DynamicType = NumberDynamicType{sema.Word128Type}
 
-func (Fix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return fix64DynamicType
+func (Word128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return word128DynamicType
 }
 
-func (Fix64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeFix64
+func (Word128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeWord128
 }
 
-func (v Fix64Value) String() string {
-	return format.Fix64(int64(v))
+func (v Word128Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
+		panic(OverflowError{})
+	}
+
+	return int(v.BigInt.Int64())
 }
 
-func (v Fix64Value) RecursiveString(_ SeenReferences) string {
-	return v.String()
+func (v Word128Value) ToBigInt() *big.Int {
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v Fix64Value) ToInt() int {
-	return int(v / sema.Fix64Factor)
+func (v Word128Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func (v Fix64Value) Negate() NumberValue {
-	// INT32-C
-	if v == math.MinInt64 {
-		panic(OverflowError{})
-	}
-	return -v
+func (v Word128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
 }
 
-func (v Fix64Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v Word128Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -10472,343 +11238,341 @@ func (v Fix64Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return Fix64Value(safeAddInt64(int64(v), int64(o)))
+	sum := new(big.Int)
+	sum.Add(v.BigInt, o.BigInt)
+	return newWrappedWord128Value(sum)
 }
 
-func (v Fix64Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) SaturatingPlus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
+}
+
+func (v Word128Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v > (math.MaxInt64 - o)) {
-		return Fix64Value(math.MaxInt64)
-	} else if (o < 0) && (v < (math.MinInt64 - o)) {
-		return Fix64Value(math.MinInt64)
-	}
-	return v + o
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, o.BigInt)
+	return newWrappedWord128Value(diff)
 }
 
-func (v Fix64Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) SaturatingMinus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
+}
+
+func (v Word128Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMinus,
+			Operation: ast.OperationMod,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt64 + o)) {
-		panic(OverflowError{})
-	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
-		panic(UnderflowError{})
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
 	}
-	return v - o
+	res.Rem(v.BigInt, o.BigInt)
+	return Word128Value{res}
 }
 
-func (v Fix64Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt64 + o)) {
-		return Fix64Value(math.MinInt64)
-	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
-		return Fix64Value(math.MaxInt64)
-	}
-	return v - o
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	return newWrappedWord128Value(res)
 }
 
-var minInt64Big = big.NewInt(math.MinInt64)
-var maxInt64Big = big.NewInt(math.MaxInt64)
+func (v Word128Value) SaturatingMul(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
+}
 
-func (v Fix64Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMul,
+			Operation: ast.OperationDiv,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
+	}
+	res.Div(v.BigInt, o.BigInt)
+	return Word128Value{res}
+}
 
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
+func (v Word128Value) SaturatingDiv(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
+}
 
-	if result.Cmp(minInt64Big) < 0 {
-		panic(UnderflowError{})
-	} else if result.Cmp(maxInt64Big) > 0 {
-		panic(OverflowError{})
+func (v Word128Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
 	}
 
-	return Fix64Value(result.Int64())
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == -1
 }
 
-func (v Fix64Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
-
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp <= 0
+}
 
-	if result.Cmp(minInt64Big) < 0 {
-		return Fix64Value(math.MinInt64)
-	} else if result.Cmp(maxInt64Big) > 0 {
-		return Fix64Value(math.MaxInt64)
+func (v Word128Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
 	}
 
-	return Fix64Value(result.Int64())
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == 1
 }
 
-func (v Fix64Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationDiv,
+			Operation: ast.OperationGreaterEqual,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp >= 0
+}
 
-	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-	result.Div(result, b)
+func (v Word128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherWord128, ok := other.(Word128Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherWord128.BigInt)
+	return cmp == 0
+}
 
-	if result.Cmp(minInt64Big) < 0 {
-		panic(UnderflowError{})
-	} else if result.Cmp(maxInt64Big) > 0 {
-		panic(OverflowError{})
+// HashInput returns a byte slice containing:
+// - HashInputTypeWord128 (1 byte)
+// - big int encoded in big endian (n bytes)
+func (v Word128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
 	}
 
-	return Fix64Value(result.Int64())
+	buffer[0] = byte(HashInputTypeWord128)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func (v Fix64Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
+func ConvertWord128(value Value) Word128Value {
+	var v *big.Int
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
+	switch value := value.(type) {
+	case BigNumberValue:
+		v = value.ToBigInt()
 
-	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-	result.Div(result, b)
+	case NumberValue:
+		v = big.NewInt(int64(value.ToInt()))
 
-	if result.Cmp(minInt64Big) < 0 {
-		return Fix64Value(math.MinInt64)
-	} else if result.Cmp(maxInt64Big) > 0 {
-		return Fix64Value(math.MaxInt64)
+	default:
+		panic(errors.NewUnreachableError())
 	}
 
-	return Fix64Value(result.Int64())
+	if v.Cmp(sema.Word128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	} else if v.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	return NewWord128ValueFromBigInt(v)
 }
 
-func (v Fix64Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+// newWrappedWord128Value returns a Word128 value for the given result of an arithmetic operation,
+// which wraps around if the result is not in the range of the type
+//
+func newWrappedWord128Value(value *big.Int) Word128Value {
+	// NOTE: And treats negative values as their infinite two's complement representation,
+	// so the result is also correct for negative values, i.e. underflows
+	value.And(value, sema.Word128TypeMaxIntBig)
+	return Word128Value{value}
+}
 
-	// v - int(v/o) * o
-	quotient, ok := v.Div(o).(Fix64Value)
+func (v Word128Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
+			Operation: ast.OperationBitwiseOr,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
-	truncatedQuotient := (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
-	return v.Minus(Fix64Value(truncatedQuotient).Mul(o))
+
+	res := new(big.Int)
+	res.Or(v.BigInt, o.BigInt)
+	return Word128Value{res}
 }
 
-func (v Fix64Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLess,
+			Operation: ast.OperationBitwiseXor,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v < o
+	res := new(big.Int)
+	res.Xor(v.BigInt, o.BigInt)
+	return Word128Value{res}
 }
 
-func (v Fix64Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLessEqual,
+			Operation: ast.OperationBitwiseAnd,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v <= o
+	res := new(big.Int)
+	res.And(v.BigInt, o.BigInt)
+	return Word128Value{res}
 }
 
-func (v Fix64Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreater,
+			Operation: ast.OperationBitwiseLeftShift,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v > o
+	// Like for the fixed-size word types,
+	// bits which are shifted out are discarded
+
+	if o.BigInt.Cmp(big.NewInt(128)) >= 0 {
+		return NewWord128ValueFromUint64(0)
+	}
+
+	res := new(big.Int)
+	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return newWrappedWord128Value(res)
 }
 
-func (v Fix64Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreaterEqual,
+			Operation: ast.OperationBitwiseRightShift,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v >= o
+	if o.BigInt.Cmp(big.NewInt(128)) >= 0 {
+		return NewWord128ValueFromUint64(0)
+	}
+
+	res := new(big.Int)
+	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return Word128Value{res}
 }
 
-func (v Fix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherFix64, ok := other.(Fix64Value)
-	if !ok {
-		return false
-	}
-	return v == otherFix64
+func (v Word128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Word128Type)
 }
 
-// HashInput returns a byte slice containing:
-// - HashInputTypeFix64 (1 byte)
-// - int64 value encoded in big-endian (8 bytes)
-func (v Fix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeFix64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
+func (Word128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
 }
 
-func ConvertFix64(value Value) Fix64Value {
-	switch value := value.(type) {
-	case Fix64Value:
-		return value
-
-	case UFix64Value:
-		if value > Fix64MaxValue {
-			panic(OverflowError{})
-		}
-		return Fix64Value(value)
-
-	case BigNumberValue:
-		v := value.ToBigInt()
-
-		// First, check if the value is at least in the int64 range.
-		// The integer range for Fix64 is smaller, but this test at least
-		// allows us to call `v.Int64()` safely.
-
-		if !v.IsInt64() {
-			panic(OverflowError{})
-		}
-
-		// Now check that the integer value fits the range of Fix64
-		return NewFix64ValueWithInteger(v.Int64())
-
-	case NumberValue:
-		v := value.ToInt()
-		// Check that the integer value fits the range of Fix64
-		return NewFix64ValueWithInteger(int64(v))
-
-	default:
-		panic(fmt.Sprintf("can't convert Fix64: %s", value))
-	}
-}
-
-func (v Fix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Fix64Type)
-}
-
-func (Fix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
-	// Numbers have no removable members (fields / functions)
-	panic(errors.NewUnreachableError())
-}
-
-func (Fix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Fix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v Word128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v Fix64Value) ConformsToDynamicType(
+func (v Word128Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Fix64Type.Equal(numberType.StaticType)
+	return ok && sema.Word128Type.Equal(numberType.StaticType)
 }
 
-func (Fix64Value) IsStorable() bool {
+func (Word128Value) IsStorable() bool {
 	return true
 }
 
-func (v Fix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Fix64Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word128Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Fix64Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word128Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Fix64Value) Transfer(
+func (v Word128Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -10821,85 +11585,101 @@ func (v Fix64Value) Transfer(
 	return v
 }
 
-func (v Fix64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v Word128Value) Clone(_ *Interpreter) Value {
+	return NewWord128ValueFromBigInt(v.BigInt)
 }
 
-func (Fix64Value) DeepRemove(_ *Interpreter) {
+func (Word128Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Fix64Value) ByteSize() uint32 {
-	return cborTagSize + getIntCBORSize(int64(v))
+func (v Word128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v Fix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Fix64Value) ChildStorables() []atree.Storable {
+func (Word128Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UFix64Value
-//
-type UFix64Value uint64
+// Word256Value
 
-const UFix64MaxValue = math.MaxUint64
+type Word256Value struct {
+	BigInt *big.Int
+}
 
-func NewUFix64ValueWithInteger(integer uint64) UFix64Value {
-	if integer > sema.UFix64TypeMaxInt {
-		panic(OverflowError{})
-	}
+func NewWord256ValueFromUint64(value uint64) Word256Value {
+	return NewWord256ValueFromBigInt(new(big.Int).SetUint64(value))
+}
 
-	return UFix64Value(integer * sema.Fix64Factor)
+func NewWord256ValueFromBigInt(value *big.Int) Word256Value {
+	return Word256Value{BigInt: value}
 }
 
-var _ Value = UFix64Value(0)
-var _ atree.Storable = UFix64Value(0)
-var _ NumberValue = UFix64Value(0)
-var _ EquatableValue = UFix64Value(0)
-var _ HashableValue = UFix64Value(0)
-var _ MemberAccessibleValue = UFix64Value(0)
+var _ Value = Word256Value{}
+var _ atree.Storable = Word256Value{}
+var _ NumberValue = Word256Value{}
+var _ IntegerValue = Word256Value{}
+var _ EquatableValue = Word256Value{}
+var _ HashableValue = Word256Value{}
+var _ MemberAccessibleValue = Word256Value{}
 
-func (UFix64Value) IsValue() {}
+// NOTE: important, do *NOT* remove:
+// Word256 values > math.MaxInt64 overflow int.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+var _ BigNumberValue = Word256Value{}
 
-func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUFix64Value(interpreter, v)
+func (Word256Value) IsValue() {}
+
+func (v Word256Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord256Value(interpreter, v)
 }
 
-func (UFix64Value) Walk(_ func(Value)) {
+func (Word256Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var ufix64DynamicType DynamicType = NumberDynamicType{sema.UFix64Type}
+var word256DynamicType DynamicType = NumberDynamicType{sema.Word256Type}
 
-func (UFix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return ufix64DynamicType
+func (Word256Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return word256DynamicType
 }
 
-func (UFix64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUFix64
+func (Word256Value) StaticType() StaticType {
+	return PrimitiveStaticTypeWord256
 }
 
-func (v UFix64Value) String() string {
-	return format.UFix64(uint64(v))
+func (v Word256Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
+		panic(OverflowError{})
+	}
+
+	return int(v.BigInt.Int64())
 }
 
-func (v UFix64Value) RecursiveString(_ SeenReferences) string {
-	return v.String()
+func (v Word256Value) ToBigInt() *big.Int {
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v UFix64Value) ToInt() int {
-	return int(v / sema.Fix64Factor)
+func (v Word256Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func (v UFix64Value) Negate() NumberValue {
+func (v Word256Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Word256Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -10908,29 +11688,17 @@ func (v UFix64Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return UFix64Value(safeAddUint64(uint64(v), uint64(o)))
+	sum := new(big.Int)
+	sum.Add(v.BigInt, o.BigInt)
+	return newWrappedWord256Value(sum)
 }
 
-func (v UFix64Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
-
-	sum := v + o
-	// INT30-C
-	if sum < v {
-		return UFix64Value(math.MaxUint64)
-	}
-	return sum
+func (v Word256Value) SaturatingPlus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UFix64Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -10939,36 +11707,35 @@ func (v UFix64Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := v - o
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, o.BigInt)
+	return newWrappedWord256Value(diff)
+}
 
-	// INT30-C
-	if diff > v {
-		panic(UnderflowError{})
-	}
-	return diff
+func (v Word256Value) SaturatingMinus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UFix64Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
 		})
 	}
 
-	diff := v - o
-
-	// INT30-C
-	if diff > v {
-		return UFix64Value(0)
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
 	}
-	return diff
+	res.Rem(v.BigInt, o.BigInt)
+	return Word256Value{res}
 }
 
-func (v UFix64Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -10977,257 +11744,285 @@ func (v UFix64Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
-
-	if !result.IsUint64() {
-		panic(OverflowError{})
-	}
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	return newWrappedWord256Value(res)
+}
 
-	return UFix64Value(result.Uint64())
+func (v Word256Value) SaturatingMul(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UFix64Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
-
-	if !result.IsUint64() {
-		return UFix64Value(math.MaxUint64)
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
 	}
+	res.Div(v.BigInt, o.BigInt)
+	return Word256Value{res}
+}
 
-	return UFix64Value(result.Uint64())
+func (v Word256Value) SaturatingDiv(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UFix64Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationDiv,
+			Operation: ast.OperationLess,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-	result.Div(result, b)
-
-	return UFix64Value(result.Uint64())
-}
-
-func (v UFix64Value) SaturatingDiv(other NumberValue) NumberValue {
-	defer func() {
-		r := recover()
-		if _, ok := r.(InvalidOperandsError); ok {
-			panic(InvalidOperandsError{
-				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-				LeftType:     v.StaticType(),
-				RightType:    other.StaticType(),
-			})
-		}
-	}()
-
-	return v.Div(other)
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == -1
 }
 
-func (v UFix64Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
+			Operation: ast.OperationLessEqual,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	// v - int(v/o) * o
-	quotient, ok := v.Div(o).(UFix64Value)
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp <= 0
+}
+
+func (v Word256Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
+			Operation: ast.OperationGreater,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
-	truncatedQuotient := (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
-	return v.Minus(UFix64Value(truncatedQuotient).Mul(o))
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == 1
 }
 
-func (v UFix64Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLess,
+			Operation: ast.OperationGreaterEqual,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v < o
-}
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp >= 0
+}
 
-func (v UFix64Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherWord256, ok := other.(Word256Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherWord256.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeWord256 (1 byte)
+// - big int encoded in big endian (n bytes)
+func (v Word256Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeWord256)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func ConvertWord256(value Value) Word256Value {
+	var v *big.Int
+
+	switch value := value.(type) {
+	case BigNumberValue:
+		v = value.ToBigInt()
+
+	case NumberValue:
+		v = big.NewInt(int64(value.ToInt()))
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	if v.Cmp(sema.Word256TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	} else if v.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	return NewWord256ValueFromBigInt(v)
+}
+
+// newWrappedWord256Value returns a Word256 value for the given result of an arithmetic operation,
+// which wraps around if the result is not in the range of the type
+//
+func newWrappedWord256Value(value *big.Int) Word256Value {
+	// NOTE: And treats negative values as their infinite two's complement representation,
+	// so the result is also correct for negative values, i.e. underflows
+	value.And(value, sema.Word256TypeMaxIntBig)
+	return Word256Value{value}
+}
+
+func (v Word256Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLessEqual,
+			Operation: ast.OperationBitwiseOr,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v <= o
+	res := new(big.Int)
+	res.Or(v.BigInt, o.BigInt)
+	return Word256Value{res}
 }
 
-func (v UFix64Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreater,
+			Operation: ast.OperationBitwiseXor,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v > o
+	res := new(big.Int)
+	res.Xor(v.BigInt, o.BigInt)
+	return Word256Value{res}
 }
 
-func (v UFix64Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreaterEqual,
+			Operation: ast.OperationBitwiseAnd,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v >= o
+	res := new(big.Int)
+	res.And(v.BigInt, o.BigInt)
+	return Word256Value{res}
 }
 
-func (v UFix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUFix64, ok := other.(UFix64Value)
+func (v Word256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
 	if !ok {
-		return false
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseLeftShift,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
 	}
-	return v == otherUFix64
-}
-
-// HashInput returns a byte slice containing:
-// - HashInputTypeUFix64 (1 byte)
-// - uint64 value encoded in big-endian (8 bytes)
-func (v UFix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeUFix64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
-}
-
-func ConvertUFix64(value Value) UFix64Value {
-	switch value := value.(type) {
-	case UFix64Value:
-		return value
-
-	case Fix64Value:
-		if value < 0 {
-			panic(UnderflowError{})
-		}
-		return UFix64Value(value)
-
-	case BigNumberValue:
-		v := value.ToBigInt()
-
-		if v.Sign() < 0 {
-			panic(UnderflowError{})
-		}
 
-		// First, check if the value is at least in the uint64 range.
-		// The integer range for UFix64 is smaller, but this test at least
-		// allows us to call `v.UInt64()` safely.
+	// Like for the fixed-size word types,
+	// bits which are shifted out are discarded
 
-		if !v.IsUint64() {
-			panic(OverflowError{})
-		}
+	if o.BigInt.Cmp(big.NewInt(256)) >= 0 {
+		return NewWord256ValueFromUint64(0)
+	}
 
-		// Now check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(v.Uint64())
+	res := new(big.Int)
+	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return newWrappedWord256Value(res)
+}
 
-	case NumberValue:
-		v := value.ToInt()
-		if v < 0 {
-			panic(UnderflowError{})
-		}
-		// Check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(uint64(v))
+func (v Word256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseRightShift,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
 
-	default:
-		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
+	if o.BigInt.Cmp(big.NewInt(256)) >= 0 {
+		return NewWord256ValueFromUint64(0)
 	}
+
+	res := new(big.Int)
+	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return Word256Value{res}
 }
 
-func (v UFix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UFix64Type)
+func (v Word256Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Word256Type)
 }
 
-func (UFix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Word256Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v Word256Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v UFix64Value) ConformsToDynamicType(
+func (v Word256Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UFix64Type.Equal(numberType.StaticType)
+	return ok && sema.Word256Type.Equal(numberType.StaticType)
 }
 
-func (UFix64Value) IsStorable() bool {
+func (Word256Value) IsStorable() bool {
 	return true
 }
 
-func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word256Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word256Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word256Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UFix64Value) Transfer(
+func (v Word256Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -11240,306 +12035,3212 @@ func (v UFix64Value) Transfer(
 	return v
 }
 
-func (v UFix64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v Word256Value) Clone(_ *Interpreter) Value {
+	return NewWord256ValueFromBigInt(v.BigInt)
 }
 
-func (UFix64Value) DeepRemove(_ *Interpreter) {
+func (Word256Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UFix64Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (v Word256Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word256Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UFix64Value) ChildStorables() []atree.Storable {
+func (Word256Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// CompositeValue
-
-type CompositeValue struct {
-	dictionary          *atree.OrderedMap
-	Location            common.Location
-	QualifiedIdentifier string
-	Kind                common.CompositeKind
-	InjectedFields      map[string]Value
-	ComputedFields      map[string]ComputedField
-	NestedVariables     map[string]*Variable
-	Functions           map[string]FunctionValue
-	Destructor          FunctionValue
-	Stringer            func(value *CompositeValue, seenReferences SeenReferences) string
-	isDestroyed         bool
-	typeID              common.TypeID
-	staticType          StaticType
-	dynamicType         DynamicType
-}
-
-type ComputedField func(*Interpreter, func() LocationRange) Value
-
-type CompositeField struct {
-	Name  string
-	Value Value
-}
+// Fix64Value
+//
+type Fix64Value int64
 
-func NewCompositeValue(
-	interpreter *Interpreter,
-	location common.Location,
-	qualifiedIdentifier string,
-	kind common.CompositeKind,
-	fields []CompositeField,
-	address common.Address,
-) *CompositeValue {
+const Fix64MaxValue = math.MaxInt64
 
-	dictionary, err := atree.NewMap(
-		interpreter.Storage,
-		atree.Address(address),
-		atree.NewDefaultDigesterBuilder(),
-		compositeTypeInfo{
-			location:            location,
-			qualifiedIdentifier: qualifiedIdentifier,
-			kind:                kind,
-		},
-	)
-	if err != nil {
-		panic(ExternalError{err})
-	}
+func NewFix64ValueWithInteger(integer int64) Fix64Value {
 
-	v := &CompositeValue{
-		dictionary:          dictionary,
-		Location:            location,
-		QualifiedIdentifier: qualifiedIdentifier,
-		Kind:                kind,
+	if integer < sema.Fix64TypeMinInt {
+		panic(UnderflowError{})
 	}
 
-	for _, field := range fields {
-		v.SetMember(
-			interpreter,
-			// TODO: provide proper location range
-			ReturnEmptyLocationRange,
-			field.Name,
-			field.Value,
-		)
+	if integer > sema.Fix64TypeMaxInt {
+		panic(OverflowError{})
 	}
 
-	return v
+	return Fix64Value(integer * sema.Fix64Factor)
 }
 
-var _ Value = &CompositeValue{}
-var _ EquatableValue = &CompositeValue{}
-var _ HashableValue = &CompositeValue{}
-var _ MemberAccessibleValue = &CompositeValue{}
-var _ ReferenceTrackedResourceKindedValue = &CompositeValue{}
-
-func (*CompositeValue) IsValue() {}
+var _ Value = Fix64Value(0)
+var _ atree.Storable = Fix64Value(0)
+var _ NumberValue = Fix64Value(0)
+var _ EquatableValue = Fix64Value(0)
+var _ HashableValue = Fix64Value(0)
+var _ MemberAccessibleValue = Fix64Value(0)
 
-func (v *CompositeValue) Accept(interpreter *Interpreter, visitor Visitor) {
-	descend := visitor.VisitCompositeValue(interpreter, v)
-	if !descend {
-		return
-	}
+func (Fix64Value) IsValue() {}
 
-	v.ForEachField(func(_ string, value Value) {
-		value.Accept(interpreter, visitor)
-	})
+func (v Fix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix64Value(interpreter, v)
 }
 
-// Walk iterates over all field values of the composite value.
+func (Fix64Value) Walk(_ func(Value)) {
+	// NO-OP
+}
+
+var fix64DynamicType DynamicType = NumberDynamicType{sema.Fix64Type}
+
+func (Fix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return fix64DynamicType
+}
+
+func (Fix64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeFix64
+}
+
+func (v Fix64Value) String() string {
+	return format.Fix64(int64(v))
+}
+
+func (v Fix64Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Fix64Value) ToInt() int {
+	return int(v / sema.Fix64Factor)
+}
+
+func (v Fix64Value) Negate() NumberValue {
+	// INT32-C
+	if v == math.MinInt64 {
+		panic(OverflowError{})
+	}
+	return -v
+}
+
+func (v Fix64Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return Fix64Value(safeAddInt64(int64(v), int64(o)))
+}
+
+func (v Fix64Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt64 - o)) {
+		return Fix64Value(math.MaxInt64)
+	} else if (o < 0) && (v < (math.MinInt64 - o)) {
+		return Fix64Value(math.MinInt64)
+	}
+	return v + o
+}
+
+func (v Fix64Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt64 + o)) {
+		panic(OverflowError{})
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+		panic(UnderflowError{})
+	}
+	return v - o
+}
+
+func (v Fix64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt64 + o)) {
+		return Fix64Value(math.MinInt64)
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+		return Fix64Value(math.MaxInt64)
+	}
+	return v - o
+}
+
+var minInt64Big = big.NewInt(math.MinInt64)
+var maxInt64Big = big.NewInt(math.MaxInt64)
+
+func (v Fix64Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if result.Cmp(minInt64Big) < 0 {
+		panic(UnderflowError{})
+	} else if result.Cmp(maxInt64Big) > 0 {
+		panic(OverflowError{})
+	}
+
+	return Fix64Value(result.Int64())
+}
+
+func (v Fix64Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if result.Cmp(minInt64Big) < 0 {
+		return Fix64Value(math.MinInt64)
+	} else if result.Cmp(maxInt64Big) > 0 {
+		return Fix64Value(math.MaxInt64)
+	}
+
+	return Fix64Value(result.Int64())
+}
+
+func (v Fix64Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	if result.Cmp(minInt64Big) < 0 {
+		panic(UnderflowError{})
+	} else if result.Cmp(maxInt64Big) > 0 {
+		panic(OverflowError{})
+	}
+
+	return Fix64Value(result.Int64())
+}
+
+func (v Fix64Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	if result.Cmp(minInt64Big) < 0 {
+		return Fix64Value(math.MinInt64)
+	} else if result.Cmp(maxInt64Big) > 0 {
+		return Fix64Value(math.MaxInt64)
+	}
+
+	return Fix64Value(result.Int64())
+}
+
+func (v Fix64Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(o).(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+	truncatedQuotient := (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+	return v.Minus(Fix64Value(truncatedQuotient).Mul(o))
+}
+
+func (v Fix64Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v < o
+}
+
+func (v Fix64Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v <= o
+}
+
+func (v Fix64Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v > o
+}
+
+func (v Fix64Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v >= o
+}
+
+func (v Fix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFix64, ok := other.(Fix64Value)
+	if !ok {
+		return false
+	}
+	return v == otherFix64
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeFix64 (1 byte)
+// - int64 value encoded in big-endian (8 bytes)
+func (v Fix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeFix64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+func ConvertFix64(value Value) Fix64Value {
+	switch value := value.(type) {
+	case Fix64Value:
+		return value
+
+	case UFix64Value:
+		if value > Fix64MaxValue {
+			panic(OverflowError{})
+		}
+		return Fix64Value(value)
+
+	case Fix128Value:
+		v := new(big.Int).Quo(value.BigInt, fix64ToFix128FactorBig)
+		if v.Cmp(minInt64Big) < 0 {
+			panic(UnderflowError{})
+		} else if v.Cmp(maxInt64Big) > 0 {
+			panic(OverflowError{})
+		}
+		return Fix64Value(v.Int64())
+
+	case UFix128Value:
+		v := new(big.Int).Quo(value.BigInt, fix64ToFix128FactorBig)
+		if v.Cmp(maxInt64Big) > 0 {
+			panic(OverflowError{})
+		}
+		return Fix64Value(v.Int64())
+
+	case BigNumberValue:
+		v := value.ToBigInt()
+
+		// First, check if the value is at least in the int64 range.
+		// The integer range for Fix64 is smaller, but this test at least
+		// allows us to call `v.Int64()` safely.
+
+		if !v.IsInt64() {
+			panic(OverflowError{})
+		}
+
+		// Now check that the integer value fits the range of Fix64
+		return NewFix64ValueWithInteger(v.Int64())
+
+	case NumberValue:
+		v := value.ToInt()
+		// Check that the integer value fits the range of Fix64
+		return NewFix64ValueWithInteger(int64(v))
+
+	default:
+		panic(fmt.Sprintf("can't convert Fix64: %s", value))
+	}
+}
+
+func (v Fix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Fix64Type)
+}
+
+func (Fix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Fix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func (v Fix64Value) ConformsToDynamicType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	dynamicType DynamicType,
+	_ TypeConformanceResults,
+) bool {
+	numberType, ok := dynamicType.(NumberDynamicType)
+	return ok && sema.Fix64Type.Equal(numberType.StaticType)
+}
+
+func (Fix64Value) IsStorable() bool {
+	return true
+}
+
+func (v Fix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (Fix64Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Fix64Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v Fix64Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Fix64Value) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (Fix64Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v Fix64Value) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
+}
+
+func (v Fix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Fix64Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// UFix64Value
+//
+type UFix64Value uint64
+
+const UFix64MaxValue = math.MaxUint64
+
+func NewUFix64ValueWithInteger(integer uint64) UFix64Value {
+	if integer > sema.UFix64TypeMaxInt {
+		panic(OverflowError{})
+	}
+
+	return UFix64Value(integer * sema.Fix64Factor)
+}
+
+var _ Value = UFix64Value(0)
+var _ atree.Storable = UFix64Value(0)
+var _ NumberValue = UFix64Value(0)
+var _ EquatableValue = UFix64Value(0)
+var _ HashableValue = UFix64Value(0)
+var _ MemberAccessibleValue = UFix64Value(0)
+
+func (UFix64Value) IsValue() {}
+
+func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix64Value(interpreter, v)
+}
+
+func (UFix64Value) Walk(_ func(Value)) {
+	// NO-OP
+}
+
+var ufix64DynamicType DynamicType = NumberDynamicType{sema.UFix64Type}
+
+func (UFix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return ufix64DynamicType
+}
+
+func (UFix64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUFix64
+}
+
+func (v UFix64Value) String() string {
+	return format.UFix64(uint64(v))
+}
+
+func (v UFix64Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UFix64Value) ToInt() int {
+	return int(v / sema.Fix64Factor)
+}
+
+func (v UFix64Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix64Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return UFix64Value(safeAddUint64(uint64(v), uint64(o)))
+}
+
+func (v UFix64Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	sum := v + o
+	// INT30-C
+	if sum < v {
+		return UFix64Value(math.MaxUint64)
+	}
+	return sum
+}
+
+func (v UFix64Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	diff := v - o
+
+	// INT30-C
+	if diff > v {
+		panic(UnderflowError{})
+	}
+	return diff
+}
+
+func (v UFix64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	diff := v - o
+
+	// INT30-C
+	if diff > v {
+		return UFix64Value(0)
+	}
+	return diff
+}
+
+func (v UFix64Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if !result.IsUint64() {
+		panic(OverflowError{})
+	}
+
+	return UFix64Value(result.Uint64())
+}
+
+func (v UFix64Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if !result.IsUint64() {
+		return UFix64Value(math.MaxUint64)
+	}
+
+	return UFix64Value(result.Uint64())
+}
+
+func (v UFix64Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	return UFix64Value(result.Uint64())
+}
+
+func (v UFix64Value) SaturatingDiv(other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(),
+				RightType:    other.StaticType(),
+			})
+		}
+	}()
+
+	return v.Div(other)
+}
+
+func (v UFix64Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(o).(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+	truncatedQuotient := (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+	return v.Minus(UFix64Value(truncatedQuotient).Mul(o))
+}
+
+func (v UFix64Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v < o
+}
+
+func (v UFix64Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v <= o
+}
+
+func (v UFix64Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v > o
+}
+
+func (v UFix64Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v >= o
+}
+
+func (v UFix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUFix64, ok := other.(UFix64Value)
+	if !ok {
+		return false
+	}
+	return v == otherUFix64
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeUFix64 (1 byte)
+// - uint64 value encoded in big-endian (8 bytes)
+func (v UFix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeUFix64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+func ConvertUFix64(value Value) UFix64Value {
+	switch value := value.(type) {
+	case UFix64Value:
+		return value
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{})
+		}
+		return UFix64Value(value)
+
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		v := new(big.Int).Quo(value.BigInt, fix64ToFix128FactorBig)
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+		return UFix64Value(v.Uint64())
+
+	case UFix128Value:
+		v := new(big.Int).Quo(value.BigInt, fix64ToFix128FactorBig)
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+		return UFix64Value(v.Uint64())
+
+	case BigNumberValue:
+		v := value.ToBigInt()
+
+		if v.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+
+		// First, check if the value is at least in the uint64 range.
+		// The integer range for UFix64 is smaller, but this test at least
+		// allows us to call `v.UInt64()` safely.
+
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+
+		// Now check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(v.Uint64())
+
+	case NumberValue:
+		v := value.ToInt()
+		if v < 0 {
+			panic(UnderflowError{})
+		}
+		// Check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(uint64(v))
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
+	}
+}
+
+func (v UFix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UFix64Type)
+}
+
+func (UFix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func (v UFix64Value) ConformsToDynamicType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	dynamicType DynamicType,
+	_ TypeConformanceResults,
+) bool {
+	numberType, ok := dynamicType.(NumberDynamicType)
+	return ok && sema.UFix64Type.Equal(numberType.StaticType)
+}
+
+func (UFix64Value) IsStorable() bool {
+	return true
+}
+
+func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v UFix64Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v UFix64Value) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (UFix64Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v UFix64Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
+}
+
+func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (UFix64Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// Fix128Value
+//
+type Fix128Value struct {
+	BigInt *big.Int
+}
+
+func NewFix128ValueFromBigInt(value *big.Int) Fix128Value {
+	return Fix128Value{BigInt: value}
+}
+
+func NewFix128ValueWithInteger(integer *big.Int) Fix128Value {
+
+	if integer.Cmp(sema.Fix128TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.Fix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewFix128ValueFromBigInt(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	)
+}
+
+var _ Value = Fix128Value{}
+var _ atree.Storable = Fix128Value{}
+var _ NumberValue = Fix128Value{}
+var _ EquatableValue = Fix128Value{}
+var _ HashableValue = Fix128Value{}
+var _ MemberAccessibleValue = Fix128Value{}
+
+// NOTE: important, do *NOT* remove:
+// The integer part of Fix128 values may exceed math.MaxInt64.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+var _ BigNumberValue = Fix128Value{}
+
+func (Fix128Value) IsValue() {}
+
+func (v Fix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix128Value(interpreter, v)
+}
+
+func (Fix128Value) Walk(_ func(Value)) {
+	// NO-OP
+}
+
+var fix128DynamicType DynamicType = NumberDynamicType{sema.Fix128Type}
+
+func (Fix128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return fix128DynamicType
+}
+
+func (Fix128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeFix128
+}
+
+func (v Fix128Value) String() string {
+	return format.Fix128(v.BigInt)
+}
+
+func (v Fix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Fix128Value) ToInt() int {
+	integer := v.ToBigInt()
+	if !integer.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(integer.Int64())
+}
+
+// ToBigInt returns the integer part of the value
+//
+func (v Fix128Value) ToBigInt() *big.Int {
+	return new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+}
+
+func (v Fix128Value) Negate() NumberValue {
+	// INT32-C
+	if v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0 {
+		panic(OverflowError{})
+	}
+	return NewFix128ValueFromBigInt(new(big.Int).Neg(v.BigInt))
+}
+
+// checkedFix128 returns the given result as a Fix128 value,
+// or panics if the result is outside the range of Fix128
+//
+func checkedFix128(result *big.Int) Fix128Value {
+	if result.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	} else if result.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+	return NewFix128ValueFromBigInt(result)
+}
+
+// saturatedFix128 returns the given result as a Fix128 value,
+// clamped to the range of Fix128
+//
+func saturatedFix128(result *big.Int) Fix128Value {
+	if result.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig)
+	} else if result.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig)
+	}
+	return NewFix128ValueFromBigInt(result)
+}
+
+func (v Fix128Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	return checkedFix128(new(big.Int).Add(v.BigInt, o.BigInt))
+}
+
+func (v Fix128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	return saturatedFix128(new(big.Int).Add(v.BigInt, o.BigInt))
+}
+
+func (v Fix128Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return checkedFix128(new(big.Int).Sub(v.BigInt, o.BigInt))
+}
+
+func (v Fix128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	return saturatedFix128(new(big.Int).Sub(v.BigInt, o.BigInt))
+}
+
+func (v Fix128Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
+
+	return checkedFix128(result)
+}
+
+func (v Fix128Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
+
+	return saturatedFix128(result)
+}
+
+func (v Fix128Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
+	}
+
+	result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	result.Div(result, o.BigInt)
+
+	return checkedFix128(result)
+}
+
+func (v Fix128Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
+	}
+
+	result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	result.Div(result, o.BigInt)
+
+	return saturatedFix128(result)
+}
+
+func (v Fix128Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(o).(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+	truncatedQuotient := new(big.Int).Mul(quotient.ToBigInt(), sema.Fix128FactorBig)
+	return v.Minus(NewFix128ValueFromBigInt(truncatedQuotient).Mul(o))
+}
+
+func (v Fix128Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) < 0
+}
+
+func (v Fix128Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) <= 0
+}
+
+func (v Fix128Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) > 0
+}
+
+func (v Fix128Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) >= 0
+}
+
+func (v Fix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFix128, ok := other.(Fix128Value)
+	if !ok {
+		return false
+	}
+	return v.BigInt.Cmp(otherFix128.BigInt) == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Fix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+// fix64ToFix128FactorBig is the factor between the scales of Fix64 and Fix128,
+// i.e. 10^(Fix128Scale - Fix64Scale)
+//
+var fix64ToFix128FactorBig = new(big.Int).Exp(
+	big.NewInt(10),
+	big.NewInt(int64(sema.Fix128Scale-sema.Fix64Scale)),
+	nil,
+)
+
+func ConvertFix128(value Value) Fix128Value {
+	switch value := value.(type) {
+	case Fix128Value:
+		return value
+
+	case UFix128Value:
+		if value.BigInt.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+			panic(OverflowError{})
+		}
+		return NewFix128ValueFromBigInt(new(big.Int).Set(value.BigInt))
+
+	case Fix64Value:
+		return NewFix128ValueFromBigInt(
+			new(big.Int).Mul(
+				big.NewInt(int64(value)),
+				fix64ToFix128FactorBig,
+			),
+		)
+
+	case UFix64Value:
+		return NewFix128ValueFromBigInt(
+			new(big.Int).Mul(
+				new(big.Int).SetUint64(uint64(value)),
+				fix64ToFix128FactorBig,
+			),
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(value.ToBigInt())
+
+	case NumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(big.NewInt(int64(value.ToInt())))
+
+	default:
+		panic(fmt.Sprintf("can't convert to Fix128: %s", value))
+	}
+}
+
+func (v Fix128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Fix128Type)
+}
+
+func (Fix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Fix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
+}
+
+func (v Fix128Value) ConformsToDynamicType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	dynamicType DynamicType,
+	_ TypeConformanceResults,
+) bool {
+	numberType, ok := dynamicType.(NumberDynamicType)
+	return ok && sema.Fix128Type.Equal(numberType.StaticType)
+}
+
+func (Fix128Value) IsStorable() bool {
+	return true
+}
+
+func (v Fix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (Fix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Fix128Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v Fix128Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Fix128Value) Clone(_ *Interpreter) Value {
+	return NewFix128ValueFromBigInt(v.BigInt)
+}
+
+func (Fix128Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v Fix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v Fix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Fix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// UFix128Value
+//
+type UFix128Value struct {
+	BigInt *big.Int
+}
+
+func NewUFix128ValueFromBigInt(value *big.Int) UFix128Value {
+	return UFix128Value{BigInt: value}
+}
+
+func NewUFix128ValueWithInteger(integer *big.Int) UFix128Value {
+
+	if integer.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.UFix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewUFix128ValueFromBigInt(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	)
+}
+
+var _ Value = UFix128Value{}
+var _ atree.Storable = UFix128Value{}
+var _ NumberValue = UFix128Value{}
+var _ EquatableValue = UFix128Value{}
+var _ HashableValue = UFix128Value{}
+var _ MemberAccessibleValue = UFix128Value{}
+
+// NOTE: important, do *NOT* remove:
+// The integer part of UFix128 values may exceed math.MaxInt64.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+var _ BigNumberValue = UFix128Value{}
+
+func (UFix128Value) IsValue() {}
+
+func (v UFix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix128Value(interpreter, v)
+}
+
+func (UFix128Value) Walk(_ func(Value)) {
+	// NO-OP
+}
+
+var ufix128DynamicType DynamicType = NumberDynamicType{sema.UFix128Type}
+
+func (UFix128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return ufix128DynamicType
+}
+
+func (UFix128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUFix128
+}
+
+func (v UFix128Value) String() string {
+	return format.Fix128(v.BigInt)
+}
+
+func (v UFix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UFix128Value) ToInt() int {
+	integer := v.ToBigInt()
+	if !integer.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(integer.Int64())
+}
+
+// ToBigInt returns the integer part of the value
+//
+func (v UFix128Value) ToBigInt() *big.Int {
+	return new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+}
+
+func (v UFix128Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+// checkedUFix128 returns the given result as a UFix128 value,
+// or panics if the result is outside the range of UFix128
+//
+func checkedUFix128(result *big.Int) UFix128Value {
+	if result.Sign() < 0 {
+		panic(UnderflowError{})
+	} else if result.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+	return NewUFix128ValueFromBigInt(result)
+}
+
+// saturatedUFix128 returns the given result as a UFix128 value,
+// clamped to the range of UFix128
+//
+func saturatedUFix128(result *big.Int) UFix128Value {
+	if result.Sign() < 0 {
+		return NewUFix128ValueFromBigInt(new(big.Int))
+	} else if result.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		return NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig)
+	}
+	return NewUFix128ValueFromBigInt(result)
+}
+
+func (v UFix128Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	return checkedUFix128(new(big.Int).Add(v.BigInt, o.BigInt))
+}
+
+func (v UFix128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	return saturatedUFix128(new(big.Int).Add(v.BigInt, o.BigInt))
+}
+
+func (v UFix128Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return checkedUFix128(new(big.Int).Sub(v.BigInt, o.BigInt))
+}
+
+func (v UFix128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	return saturatedUFix128(new(big.Int).Sub(v.BigInt, o.BigInt))
+}
+
+func (v UFix128Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
+
+	return checkedUFix128(result)
+}
+
+func (v UFix128Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
+
+	return saturatedUFix128(result)
+}
+
+func (v UFix128Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
+	}
+
+	result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	result.Div(result, o.BigInt)
+
+	return checkedUFix128(result)
+}
+
+func (v UFix128Value) SaturatingDiv(other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(),
+				RightType:    other.StaticType(),
+			})
+		} else if r != nil {
+			panic(r)
+		}
+	}()
+
+	return v.Div(other)
+}
+
+func (v UFix128Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(o).(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+	truncatedQuotient := new(big.Int).Mul(quotient.ToBigInt(), sema.Fix128FactorBig)
+	return v.Minus(NewUFix128ValueFromBigInt(truncatedQuotient).Mul(o))
+}
+
+func (v UFix128Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) < 0
+}
+
+func (v UFix128Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) <= 0
+}
+
+func (v UFix128Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) > 0
+}
+
+func (v UFix128Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v.BigInt.Cmp(o.BigInt) >= 0
+}
+
+func (v UFix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUFix128, ok := other.(UFix128Value)
+	if !ok {
+		return false
+	}
+	return v.BigInt.Cmp(otherUFix128.BigInt) == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeUFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v UFix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func ConvertUFix128(value Value) UFix128Value {
+	switch value := value.(type) {
+	case UFix128Value:
+		return value
+
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUFix128ValueFromBigInt(new(big.Int).Set(value.BigInt))
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUFix128ValueFromBigInt(
+			new(big.Int).Mul(
+				big.NewInt(int64(value)),
+				fix64ToFix128FactorBig,
+			),
+		)
+
+	case UFix64Value:
+		return NewUFix128ValueFromBigInt(
+			new(big.Int).Mul(
+				new(big.Int).SetUint64(uint64(value)),
+				fix64ToFix128FactorBig,
+			),
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(value.ToBigInt())
+
+	case NumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(big.NewInt(int64(value.ToInt())))
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix128: %s", value))
+	}
+}
+
+func (v UFix128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UFix128Type)
+}
+
+func (UFix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (UFix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
+}
+
+func (v UFix128Value) ConformsToDynamicType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	dynamicType DynamicType,
+	_ TypeConformanceResults,
+) bool {
+	numberType, ok := dynamicType.(NumberDynamicType)
+	return ok && sema.UFix128Type.Equal(numberType.StaticType)
+}
+
+func (UFix128Value) IsStorable() bool {
+	return true
+}
+
+func (v UFix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (UFix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (UFix128Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v UFix128Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v UFix128Value) Clone(_ *Interpreter) Value {
+	return NewUFix128ValueFromBigInt(v.BigInt)
+}
+
+func (UFix128Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v UFix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v UFix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (UFix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// CompositeValue
+
+type CompositeValue struct {
+	dictionary          *atree.OrderedMap
+	Location            common.Location
+	QualifiedIdentifier string
+	Kind                common.CompositeKind
+	InjectedFields      map[string]Value
+	ComputedFields      map[string]ComputedField
+	NestedVariables     map[string]*Variable
+	Functions           map[string]FunctionValue
+	Destructor          FunctionValue
+	Stringer            func(value *CompositeValue, seenReferences SeenReferences) string
+	isDestroyed         bool
+	typeID              common.TypeID
+	staticType          StaticType
+	dynamicType         DynamicType
+}
+
+type ComputedField func(*Interpreter, func() LocationRange) Value
+
+type CompositeField struct {
+	Name  string
+	Value Value
+}
+
+func NewCompositeValue(
+	interpreter *Interpreter,
+	location common.Location,
+	qualifiedIdentifier string,
+	kind common.CompositeKind,
+	fields []CompositeField,
+	address common.Address,
+) *CompositeValue {
+
+	dictionary, err := atree.NewMap(
+		interpreter.Storage,
+		atree.Address(address),
+		atree.NewDefaultDigesterBuilder(),
+		compositeTypeInfo{
+			location:            location,
+			qualifiedIdentifier: qualifiedIdentifier,
+			kind:                kind,
+		},
+	)
+	if err != nil {
+		panic(ExternalError{err})
+	}
+
+	v := &CompositeValue{
+		dictionary:          dictionary,
+		Location:            location,
+		QualifiedIdentifier: qualifiedIdentifier,
+		Kind:                kind,
+	}
+
+	for _, field := range fields {
+		v.SetMember(
+			interpreter,
+			// TODO: provide proper location range
+			ReturnEmptyLocationRange,
+			field.Name,
+			field.Value,
+		)
+	}
+
+	return v
+}
+
+var _ Value = &CompositeValue{}
+var _ EquatableValue = &CompositeValue{}
+var _ HashableValue = &CompositeValue{}
+var _ MemberAccessibleValue = &CompositeValue{}
+var _ ReferenceTrackedResourceKindedValue = &CompositeValue{}
+
+func (*CompositeValue) IsValue() {}
+
+func (v *CompositeValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitCompositeValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	v.ForEachField(func(_ string, value Value) {
+		value.Accept(interpreter, visitor)
+	})
+}
+
+// Walk iterates over all field values of the composite value.
 // It does NOT walk the computed fields and functions!
 //
-func (v *CompositeValue) Walk(walkChild func(Value)) {
-	v.ForEachField(func(_ string, value Value) {
+func (v *CompositeValue) Walk(walkChild func(Value)) {
+	v.ForEachField(func(_ string, value Value) {
+		walkChild(value)
+	})
+}
+
+func (v *CompositeValue) DynamicType(interpreter *Interpreter, _ SeenReferences) DynamicType {
+	if v.dynamicType == nil {
+		var staticType sema.Type
+		var err error
+		if v.Location == nil {
+			staticType, err = interpreter.getNativeCompositeType(v.QualifiedIdentifier)
+		} else {
+			staticType, err = interpreter.getUserCompositeType(v.Location, v.TypeID())
+		}
+		if err != nil {
+			panic(err)
+		}
+		v.dynamicType = CompositeDynamicType{
+			StaticType: staticType,
+		}
+	}
+	return v.dynamicType
+}
+
+func (v *CompositeValue) StaticType() StaticType {
+	if v.staticType == nil {
+		// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
+		// use the TypeID accessor, which may return an already computed type ID
+		v.staticType = CompositeStaticType{
+			Location:            v.Location,
+			QualifiedIdentifier: v.QualifiedIdentifier,
+			TypeID:              v.TypeID(),
+		}
+	}
+	return v.staticType
+}
+
+func (v *CompositeValue) IsDestroyed() bool {
+	return v.isDestroyed
+}
+
+func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	interpreter = v.getInterpreter(interpreter)
+
+	// if composite was deserialized, dynamically link in the destructor
+	if v.Destructor == nil {
+		v.Destructor = interpreter.typeCodes.CompositeCodes[v.TypeID()].DestructorFunction
+	}
+
+	destructor := v.Destructor
+
+	if destructor != nil {
+		invocation := Invocation{
+			Self:             v,
+			Arguments:        nil,
+			ArgumentTypes:    nil,
+			GetLocationRange: getLocationRange,
+			Interpreter:      interpreter,
+		}
+
+		destructor.invoke(invocation)
+	}
+
+	v.isDestroyed = true
+}
+
+func (v *CompositeValue) GetMember(interpreter *Interpreter, getLocationRange func() LocationRange, name string) Value {
+
+	if v.Kind == common.CompositeKindResource &&
+		name == sema.ResourceOwnerFieldName {
+
+		return v.OwnerValue(interpreter, getLocationRange)
+	}
+
+	storable, err := v.dictionary.Get(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); !ok {
+			panic(ExternalError{err})
+		}
+	}
+	if storable != nil {
+		return StoredValue(storable, interpreter.Storage)
+	}
+
+	if v.NestedVariables != nil {
+		variable, ok := v.NestedVariables[name]
+		if ok {
+			return variable.GetValue()
+		}
+	}
+
+	interpreter = v.getInterpreter(interpreter)
+
+	if v.ComputedFields != nil {
+		if computedField, ok := v.ComputedFields[name]; ok {
+			return computedField(interpreter, getLocationRange)
+		}
+	}
+
+	// If the composite value was deserialized, dynamically link in the functions
+	// and get injected fields
+
+	v.InitializeFunctions(interpreter)
+
+	if v.InjectedFields == nil && interpreter.injectedCompositeFieldsHandler != nil {
+		v.InjectedFields = interpreter.injectedCompositeFieldsHandler(
+			interpreter,
+			v.Location,
+			v.QualifiedIdentifier,
+			v.Kind,
+		)
+	}
+
+	if v.InjectedFields != nil {
+		value, ok := v.InjectedFields[name]
+		if ok {
+			return value
+		}
+	}
+
+	function, ok := v.Functions[name]
+	if ok {
+		return BoundFunctionValue{
+			Self:     v,
+			Function: function,
+		}
+	}
+
+	return nil
+}
+
+func (v *CompositeValue) getInterpreter(interpreter *Interpreter) *Interpreter {
+
+	// Get the correct interpreter. The program code might need to be loaded.
+	// NOTE: standard library values have no location
+
+	location := v.Location
+
+	if location == nil || common.LocationsMatch(interpreter.Location, location) {
+		return interpreter
+	}
+
+	return interpreter.EnsureLoaded(v.Location)
+}
+
+func (v *CompositeValue) InitializeFunctions(interpreter *Interpreter) {
+	if v.Functions != nil {
+		return
+	}
+
+	v.Functions = interpreter.typeCodes.CompositeCodes[v.TypeID()].CompositeFunctions
+}
+
+func (v *CompositeValue) OwnerValue(interpreter *Interpreter, getLocationRange func() LocationRange) OptionalValue {
+	address := v.StorageID().Address
+
+	if address == (atree.Address{}) {
+		return NilValue{}
+	}
+
+	ownerAccount := interpreter.publicAccountHandler(interpreter, AddressValue(address))
+
+	// Owner must be of `PublicAccount` type.
+	interpreter.ExpectType(ownerAccount, sema.PublicAccountType, getLocationRange)
+
+	return NewSomeValueNonCopying(ownerAccount)
+}
+
+func (v *CompositeValue) RemoveMember(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	name string,
+) Value {
+
+	// No need to clean up storable for passed-in key value,
+	// as atree never calls Storable()
+	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return nil
+		}
+		panic(ExternalError{err})
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+
+	storage := interpreter.Storage
+
+	// Key
+	interpreter.RemoveReferencedSlab(existingKeyStorable)
+
+	// Value
+
+	storedValue := StoredValue(existingValueStorable, storage)
+	return storedValue.
+		Transfer(
+			interpreter,
+			getLocationRange,
+			atree.Address{},
+			true,
+			existingValueStorable,
+		)
+}
+
+func (v *CompositeValue) SetMember(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	name string,
+	value Value,
+) {
+	address := v.StorageID().Address
+
+	value = value.Transfer(
+		interpreter,
+		getLocationRange,
+		address,
+		true,
+		nil,
+	)
+
+	existingStorable, err := v.dictionary.Set(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+		value,
+	)
+	if err != nil {
+		panic(ExternalError{err})
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+
+	if existingStorable != nil {
+		existingValue := StoredValue(existingStorable, interpreter.Storage)
+
+		existingValue.DeepRemove(interpreter)
+
+		interpreter.RemoveReferencedSlab(existingStorable)
+	}
+}
+
+func (v *CompositeValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *CompositeValue) RecursiveString(seenReferences SeenReferences) string {
+	if v.Stringer != nil {
+		return v.Stringer(v, seenReferences)
+	}
+
+	var fields []CompositeField
+
+	v.ForEachField(func(name string, value Value) {
+		fields = append(
+			fields,
+			CompositeField{
+				Name:  name,
+				Value: value,
+			},
+		)
+	})
+
+	return formatComposite(string(v.TypeID()), fields, seenReferences)
+}
+
+func formatComposite(typeId string, fields []CompositeField, seenReferences SeenReferences) string {
+	preparedFields := make(
+		[]struct {
+			Name  string
+			Value string
+		},
+		0,
+		len(fields),
+	)
+
+	for _, field := range fields {
+		preparedFields = append(
+			preparedFields,
+			struct {
+				Name  string
+				Value string
+			}{
+				Name:  field.Name,
+				Value: field.Value.RecursiveString(seenReferences),
+			},
+		)
+	}
+
+	return format.Composite(typeId, preparedFields)
+}
+
+func (v *CompositeValue) GetField(name string) Value {
+
+	storable, err := v.dictionary.Get(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return nil
+		}
+		panic(ExternalError{err})
+	}
+
+	return StoredValue(storable, v.dictionary.Storage)
+}
+
+func (v *CompositeValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
+	otherComposite, ok := other.(*CompositeValue)
+	if !ok {
+		return false
+	}
+
+	if !v.StaticType().Equal(otherComposite.StaticType()) ||
+		v.Kind != otherComposite.Kind ||
+		v.dictionary.Count() != otherComposite.dictionary.Count() {
+
+		return false
+	}
+
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(ExternalError{err})
+	}
+
+	for {
+		key, value, err := iterator.Next()
+		if err != nil {
+			panic(ExternalError{err})
+		}
+		if key == nil {
+			return true
+		}
+
+		fieldName := string(key.(StringAtreeValue))
+
+		// NOTE: Do NOT use an iterator, iteration order of fields may be different
+		// (if stored in different account, as storage ID is used as hash seed)
+		otherValue := otherComposite.GetField(fieldName)
+
+		equatableValue, ok := MustConvertStoredValue(value).(EquatableValue)
+		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
+			return false
+		}
+	}
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeEnum (1 byte)
+// - type id (n bytes)
+// - hash input of raw value field name (n bytes)
+func (v *CompositeValue) HashInput(interpreter *Interpreter, getLocationRange func() LocationRange, scratch []byte) []byte {
+	if v.Kind == common.CompositeKindEnum {
+		typeID := v.TypeID()
+
+		rawValue := v.GetField(sema.EnumRawValueFieldName)
+		rawValueHashInput := rawValue.(HashableValue).
+			HashInput(interpreter, getLocationRange, scratch)
+
+		length := 1 + len(typeID) + len(rawValueHashInput)
+		if length <= len(scratch) {
+			// Copy rawValueHashInput first because
+			// rawValueHashInput and scratch can point to the same underlying scratch buffer
+			copy(scratch[1+len(typeID):], rawValueHashInput)
+
+			scratch[0] = byte(HashInputTypeEnum)
+			copy(scratch[1:], typeID)
+			return scratch[:length]
+		}
+
+		buffer := make([]byte, length)
+		buffer[0] = byte(HashInputTypeEnum)
+		copy(buffer[1:], typeID)
+		copy(buffer[1+len(typeID):], rawValueHashInput)
+		return buffer
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (v *CompositeValue) TypeID() common.TypeID {
+	if v.typeID == "" {
+		location := v.Location
+		qualifiedIdentifier := v.QualifiedIdentifier
+		if location == nil {
+			return common.TypeID(qualifiedIdentifier)
+		}
+		v.typeID = location.TypeID(qualifiedIdentifier)
+	}
+	return v.typeID
+}
+
+func (v *CompositeValue) ConformsToDynamicType(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	dynamicType DynamicType,
+	results TypeConformanceResults,
+) bool {
+	compositeDynamicType, ok := dynamicType.(CompositeDynamicType)
+	if !ok {
+		return false
+	}
+
+	compositeType, ok := compositeDynamicType.StaticType.(*sema.CompositeType)
+	if !ok ||
+		v.Kind != compositeType.Kind ||
+		v.TypeID() != compositeType.ID() {
+
+		return false
+	}
+
+	fieldsLen := int(v.dictionary.Count())
+	if v.ComputedFields != nil {
+		fieldsLen += len(v.ComputedFields)
+	}
+
+	if fieldsLen != len(compositeType.Fields) {
+		return false
+	}
+
+	for _, fieldName := range compositeType.Fields {
+		value := v.GetField(fieldName)
+		if value == nil {
+			if v.ComputedFields == nil {
+				return false
+			}
+
+			fieldGetter, ok := v.ComputedFields[fieldName]
+			if !ok {
+				return false
+			}
+
+			value = fieldGetter(interpreter, getLocationRange)
+		}
+
+		member, ok := compositeType.Members.Get(fieldName)
+		if !ok {
+			return false
+		}
+
+		fieldDynamicType := value.DynamicType(interpreter, SeenReferences{})
+
+		if !interpreter.IsSubType(fieldDynamicType, member.TypeAnnotation.Type) {
+			return false
+		}
+
+		if !value.ConformsToDynamicType(
+			interpreter,
+			getLocationRange,
+			fieldDynamicType,
+			results,
+		) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *CompositeValue) IsStorable() bool {
+
+	// Only structures, resources, enums, and contracts can be stored.
+	// Contracts are not directly storable by programs,
+	// but they are still stored in storage by the interpreter
+
+	switch v.Kind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource,
+		common.CompositeKindEnum,
+		common.CompositeKindContract:
+		break
+	default:
+		return false
+	}
+
+	// Composite value's of native/built-in types are not storable for now
+	return v.Location != nil
+}
+
+func (v *CompositeValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	if !v.IsStorable() {
+		return NonStorable{Value: v}, nil
+	}
+
+	return atree.StorageIDStorable(v.StorageID()), nil
+}
+
+func (v *CompositeValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageID().Address
+}
+
+func (v *CompositeValue) IsResourceKinded(_ *Interpreter) bool {
+	return v.Kind == common.CompositeKindResource
+}
+
+func (v *CompositeValue) IsReferenceTrackedResourceKindedValue() {}
+
+func (v *CompositeValue) Transfer(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+
+	currentStorageID := v.StorageID()
+	currentAddress := currentStorageID.Address
+
+	dictionary := v.dictionary
+
+	needsStoreTo := address != currentAddress
+	isResourceKinded := v.IsResourceKinded(interpreter)
+
+	if needsStoreTo || !isResourceKinded {
+		iterator, err := v.dictionary.Iterator()
+		if err != nil {
+			panic(ExternalError{err})
+		}
+
+		dictionary, err = atree.NewMapFromBatchData(
+			interpreter.Storage,
+			address,
+			atree.NewDefaultDigesterBuilder(),
+			v.dictionary.Type(),
+			StringAtreeComparator,
+			StringAtreeHashInput,
+			v.dictionary.Seed(),
+			func() (atree.Value, atree.Value, error) {
+
+				atreeKey, atreeValue, err := iterator.Next()
+				if err != nil {
+					return nil, nil, err
+				}
+				if atreeKey == nil || atreeValue == nil {
+					return nil, nil, nil
+				}
+
+				// NOTE: key is stringAtreeValue
+				// and does not need to be converted or copied
+
+				value := MustConvertStoredValue(atreeValue).
+					Transfer(interpreter, getLocationRange, address, remove, nil)
+
+				return atreeKey, value, nil
+			},
+		)
+		if err != nil {
+			panic(ExternalError{err})
+		}
+
+		if remove {
+			err = v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
+				interpreter.RemoveReferencedSlab(nameStorable)
+				interpreter.RemoveReferencedSlab(valueStorable)
+			})
+			if err != nil {
+				panic(ExternalError{err})
+			}
+			interpreter.maybeValidateAtreeValue(v.dictionary)
+
+			interpreter.RemoveReferencedSlab(storable)
+		}
+	}
+
+	var res *CompositeValue
+	if isResourceKinded {
+		// Update the resource in-place,
+		// and also update all values that are referencing the same value
+		// (but currently point to an outdated Go instance of the value)
+
+		v.dictionary = dictionary
+
+		newStorageID := dictionary.StorageID()
+
+		interpreter.updateReferencedResource(
+			currentStorageID,
+			newStorageID,
+			func(value ReferenceTrackedResourceKindedValue) {
+				compositeValue, ok := value.(*CompositeValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				compositeValue.dictionary = dictionary
+			},
+		)
+
+		res = v
+	} else {
+		res = &CompositeValue{
+			dictionary:          dictionary,
+			Location:            v.Location,
+			QualifiedIdentifier: v.QualifiedIdentifier,
+			Kind:                v.Kind,
+			InjectedFields:      v.InjectedFields,
+			ComputedFields:      v.ComputedFields,
+			NestedVariables:     v.NestedVariables,
+			Functions:           v.Functions,
+			Destructor:          v.Destructor,
+			Stringer:            v.Stringer,
+			isDestroyed:         v.isDestroyed,
+			typeID:              v.typeID,
+			staticType:          v.staticType,
+			dynamicType:         v.dynamicType,
+		}
+	}
+
+	if needsStoreTo &&
+		res.Kind == common.CompositeKindResource &&
+		interpreter.onResourceOwnerChange != nil {
+
+		interpreter.onResourceOwnerChange(
+			interpreter,
+			res,
+			common.Address(currentAddress),
+			common.Address(address),
+		)
+	}
+
+	return res
+}
+
+func (v *CompositeValue) ResourceUUID() *UInt64Value {
+	fieldValue := v.GetField(sema.ResourceUUIDFieldName)
+	uuid, ok := fieldValue.(UInt64Value)
+	if !ok {
+		return nil
+	}
+	return &uuid
+}
+
+func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
+
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(ExternalError{err})
+	}
+
+	dictionary, err := atree.NewMapFromBatchData(
+		interpreter.Storage,
+		v.StorageID().Address,
+		atree.NewDefaultDigesterBuilder(),
+		v.dictionary.Type(),
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		v.dictionary.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeKey, atreeValue, err := iterator.Next()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeKey == nil || atreeValue == nil {
+				return nil, nil, nil
+			}
+
+			key := MustConvertStoredValue(atreeKey).Clone(interpreter)
+			value := MustConvertStoredValue(atreeValue).Clone(interpreter)
+
+			return key, value, nil
+		},
+	)
+	if err != nil {
+		panic(ExternalError{err})
+	}
+
+	return &CompositeValue{
+		dictionary:          dictionary,
+		Location:            v.Location,
+		QualifiedIdentifier: v.QualifiedIdentifier,
+		Kind:                v.Kind,
+		InjectedFields:      v.InjectedFields,
+		ComputedFields:      v.ComputedFields,
+		NestedVariables:     v.NestedVariables,
+		Functions:           v.Functions,
+		Destructor:          v.Destructor,
+		Stringer:            v.Stringer,
+		isDestroyed:         v.isDestroyed,
+		typeID:              v.typeID,
+		staticType:          v.staticType,
+		dynamicType:         v.dynamicType,
+	}
+}
+
+func (v *CompositeValue) DeepRemove(interpreter *Interpreter) {
+
+	// Remove nested values and storables
+
+	storage := v.dictionary.Storage
+
+	err := v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
+		// NOTE: key / field name is stringAtreeValue,
+		// and not a Value, so no need to deep remove
+		interpreter.RemoveReferencedSlab(nameStorable)
+
+		value := StoredValue(valueStorable, storage)
+		value.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(valueStorable)
+	})
+	if err != nil {
+		panic(ExternalError{err})
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+}
+
+func (v *CompositeValue) GetOwner() common.Address {
+	return common.Address(v.StorageID().Address)
+}
+
+// ForEachField iterates over all field-name field-value pairs of the composite value.
+// It does NOT iterate over computed fields and functions!
+//
+func (v *CompositeValue) ForEachField(f func(fieldName string, fieldValue Value)) {
+	err := v.dictionary.Iterate(func(key atree.Value, value atree.Value) (resume bool, err error) {
+		f(
+			string(key.(StringAtreeValue)),
+			MustConvertStoredValue(value),
+		)
+		return true, nil
+	})
+	if err != nil {
+		panic(ExternalError{err})
+	}
+}
+
+func (v *CompositeValue) StorageID() atree.StorageID {
+	return v.dictionary.StorageID()
+}
+
+func (v *CompositeValue) RemoveField(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	name string,
+) {
+
+	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return
+		}
+		panic(ExternalError{err})
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+
+	storage := interpreter.Storage
+
+	// Key
+
+	// NOTE: key / field name is stringAtreeValue,
+	// and not a Value, so no need to deep remove
+	interpreter.RemoveReferencedSlab(existingKeyStorable)
+
+	// Value
+
+	existingValue := StoredValue(existingValueStorable, storage)
+	existingValue.DeepRemove(interpreter)
+	interpreter.RemoveReferencedSlab(existingValueStorable)
+}
+
+func NewEnumCaseValue(
+	interpreter *Interpreter,
+	enumType *sema.CompositeType,
+	rawValue NumberValue,
+	functions map[string]FunctionValue,
+) *CompositeValue {
+
+	fields := []CompositeField{
+		{
+			Name:  sema.EnumRawValueFieldName,
+			Value: rawValue,
+		},
+	}
+
+	v := NewCompositeValue(
+		interpreter,
+		enumType.Location,
+		enumType.QualifiedIdentifier(),
+		enumType.Kind,
+		fields,
+		common.Address{},
+	)
+
+	v.Functions = functions
+
+	return v
+}
+
+// DictionaryValue
+
+type DictionaryValue struct {
+	Type             DictionaryStaticType
+	semaType         *sema.DictionaryType
+	isResourceKinded *bool
+	dictionary       *atree.OrderedMap
+	isDestroyed      bool
+}
+
+func NewDictionaryValue(
+	interpreter *Interpreter,
+	dictionaryType DictionaryStaticType,
+	keysAndValues ...Value,
+) *DictionaryValue {
+	return NewDictionaryValueWithAddress(
+		interpreter,
+		dictionaryType,
+		common.Address{},
+		keysAndValues...,
+	)
+}
+
+func NewDictionaryValueWithAddress(
+	interpreter *Interpreter,
+	dictionaryType DictionaryStaticType,
+	address common.Address,
+	keysAndValues ...Value,
+) *DictionaryValue {
+
+	keysAndValuesCount := len(keysAndValues)
+	if keysAndValuesCount%2 != 0 {
+		panic("uneven number of keys and values")
+	}
+
+	dictionary, err := atree.NewMap(
+		interpreter.Storage,
+		atree.Address(address),
+		atree.NewDefaultDigesterBuilder(),
+		dictionaryType,
+	)
+	if err != nil {
+		panic(ExternalError{err})
+	}
+
+	v := &DictionaryValue{
+		Type:       dictionaryType,
+		dictionary: dictionary,
+	}
+
+	for i := 0; i < keysAndValuesCount; i += 2 {
+		key := keysAndValues[i]
+		value := keysAndValues[i+1]
+		// TODO: handle existing value
+		// TODO: provide proper location range
+		_ = v.Insert(interpreter, ReturnEmptyLocationRange, key, value)
+	}
+
+	return v
+}
+
+var _ Value = &DictionaryValue{}
+var _ atree.Value = &DictionaryValue{}
+var _ EquatableValue = &DictionaryValue{}
+var _ ValueIndexableValue = &DictionaryValue{}
+var _ MemberAccessibleValue = &DictionaryValue{}
+var _ ReferenceTrackedResourceKindedValue = &DictionaryValue{}
+
+func (*DictionaryValue) IsValue() {}
+
+func (v *DictionaryValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitDictionaryValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	v.Walk(func(value Value) {
+		value.Accept(interpreter, visitor)
+	})
+}
+
+func (v *DictionaryValue) Iterate(f func(key, value Value) (resume bool)) {
+	err := v.dictionary.Iterate(func(key, value atree.Value) (resume bool, err error) {
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+
+		resume = f(
+			MustConvertStoredValue(key),
+			MustConvertStoredValue(value),
+		)
+
+		return resume, nil
+	})
+	if err != nil {
+		panic(ExternalError{err})
+	}
+}
+
+func (v *DictionaryValue) Walk(walkChild func(Value)) {
+	v.Iterate(func(key, value Value) (resume bool) {
+		walkChild(key)
 		walkChild(value)
+		return true
 	})
 }
 
-func (v *CompositeValue) DynamicType(interpreter *Interpreter, _ SeenReferences) DynamicType {
-	if v.dynamicType == nil {
-		var staticType sema.Type
-		var err error
-		if v.Location == nil {
-			staticType, err = interpreter.getNativeCompositeType(v.QualifiedIdentifier)
-		} else {
-			staticType, err = interpreter.getUserCompositeType(v.Location, v.TypeID())
-		}
-		if err != nil {
-			panic(err)
+func (v *DictionaryValue) DynamicType(interpreter *Interpreter, seenReferences SeenReferences) DynamicType {
+	entryTypes := make([]DictionaryStaticTypeEntry, v.Count())
+
+	index := 0
+	v.Iterate(func(key, value Value) (resume bool) {
+		entryTypes[index] =
+			DictionaryStaticTypeEntry{
+				KeyType:   key.DynamicType(interpreter, seenReferences),
+				ValueType: value.DynamicType(interpreter, seenReferences),
+			}
+		index++
+		return true
+	})
+
+	return &DictionaryDynamicType{
+		EntryTypes: entryTypes,
+		StaticType: v.Type,
+	}
+}
+
+func (v *DictionaryValue) StaticType() StaticType {
+	return v.Type
+}
+
+func (v *DictionaryValue) IsDestroyed() bool {
+	return v.isDestroyed
+}
+
+func (v *DictionaryValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	v.Iterate(func(key, value Value) (resume bool) {
+		// Resources cannot be keys at the moment, so should theoretically not be needed
+		maybeDestroy(interpreter, getLocationRange, key)
+		maybeDestroy(interpreter, getLocationRange, value)
+		return true
+	})
+
+	v.isDestroyed = true
+}
+
+func (v *DictionaryValue) ContainsKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue Value,
+) BoolValue {
+
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
+	_, err := v.dictionary.Get(
+		valueComparator,
+		hashInputProvider,
+		keyValue,
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return false
 		}
-		v.dynamicType = CompositeDynamicType{
-			StaticType: staticType,
+		panic(ExternalError{err})
+	}
+	return true
+}
+
+func (v *DictionaryValue) Get(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue Value,
+) (Value, bool) {
+
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
+	storable, err := v.dictionary.Get(
+		valueComparator,
+		hashInputProvider,
+		keyValue,
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return nil, false
 		}
+		panic(ExternalError{err})
 	}
-	return v.dynamicType
+
+	storage := v.dictionary.Storage
+	value := StoredValue(storable, storage)
+	return value, true
 }
 
-func (v *CompositeValue) StaticType() StaticType {
-	if v.staticType == nil {
-		// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
-		// use the TypeID accessor, which may return an already computed type ID
-		v.staticType = CompositeStaticType{
-			Location:            v.Location,
-			QualifiedIdentifier: v.QualifiedIdentifier,
-			TypeID:              v.TypeID(),
+func (v *DictionaryValue) GetKey(interpreter *Interpreter, getLocationRange func() LocationRange, keyValue Value) Value {
+	value, ok := v.Get(interpreter, getLocationRange, keyValue)
+	if ok {
+		return NewSomeValueNonCopying(value)
+	}
+
+	return NilValue{}
+}
+
+func (v *DictionaryValue) SetKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue Value,
+	value Value,
+) {
+	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
+	interpreter.checkContainerMutation(
+		OptionalStaticType{
+			Type: v.Type.ValueType,
+		},
+		value,
+		getLocationRange,
+	)
+
+	switch value := value.(type) {
+	case *SomeValue:
+		_ = v.Insert(interpreter, getLocationRange, keyValue, value.Value)
+
+	case NilValue:
+		_ = v.Remove(interpreter, getLocationRange, keyValue)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (v *DictionaryValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *DictionaryValue) RecursiveString(seenReferences SeenReferences) string {
+
+	pairs := make([]struct {
+		Key   string
+		Value string
+	}, v.Count())
+
+	index := 0
+	v.Iterate(func(key, value Value) (resume bool) {
+		pairs[index] = struct {
+			Key   string
+			Value string
+		}{
+			Key:   key.RecursiveString(seenReferences),
+			Value: value.RecursiveString(seenReferences),
 		}
-	}
-	return v.staticType
-}
 
-func (v *CompositeValue) IsDestroyed() bool {
-	return v.isDestroyed
+		index++
+
+		return true
+	})
+
+	return format.Dictionary(pairs)
 }
 
-func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
-	interpreter = v.getInterpreter(interpreter)
+func (v *DictionaryValue) GetMember(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	name string,
+) Value {
 
-	// if composite was deserialized, dynamically link in the destructor
-	if v.Destructor == nil {
-		v.Destructor = interpreter.typeCodes.CompositeCodes[v.TypeID()].DestructorFunction
-	}
+	switch name {
+	case "length":
+		return NewIntValueFromInt64(int64(v.Count()))
 
-	destructor := v.Destructor
+	case "keys":
 
-	if destructor != nil {
-		invocation := Invocation{
-			Self:             v,
-			Arguments:        nil,
-			ArgumentTypes:    nil,
-			GetLocationRange: getLocationRange,
-			Interpreter:      interpreter,
+		iterator, err := v.dictionary.Iterator()
+		if err != nil {
+			panic(ExternalError{err})
 		}
 
-		destructor.invoke(invocation)
-	}
-
-	v.isDestroyed = true
-}
+		return NewArrayValueWithIterator(
+			interpreter,
+			VariableSizedStaticType{
+				Type: v.Type.KeyType,
+			},
+			common.Address{},
+			func() Value {
 
-func (v *CompositeValue) GetMember(interpreter *Interpreter, getLocationRange func() LocationRange, name string) Value {
+				key, err := iterator.NextKey()
+				if err != nil {
+					panic(ExternalError{err})
+				}
+				if key == nil {
+					return nil
+				}
 
-	if v.Kind == common.CompositeKindResource &&
-		name == sema.ResourceOwnerFieldName {
+				return MustConvertStoredValue(key).
+					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
+			},
+		)
 
-		return v.OwnerValue(interpreter, getLocationRange)
-	}
+	case "values":
 
-	storable, err := v.dictionary.Get(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
-	)
-	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); !ok {
+		iterator, err := v.dictionary.Iterator()
+		if err != nil {
 			panic(ExternalError{err})
 		}
-	}
-	if storable != nil {
-		return StoredValue(storable, interpreter.Storage)
-	}
 
-	if v.NestedVariables != nil {
-		variable, ok := v.NestedVariables[name]
-		if ok {
-			return variable.GetValue()
-		}
-	}
+		return NewArrayValueWithIterator(
+			interpreter,
+			VariableSizedStaticType{
+				Type: v.Type.ValueType,
+			},
+			common.Address{},
+			func() Value {
 
-	interpreter = v.getInterpreter(interpreter)
+				value, err := iterator.NextValue()
+				if err != nil {
+					panic(ExternalError{err})
+				}
+				if value == nil {
+					return nil
+				}
 
-	if v.ComputedFields != nil {
-		if computedField, ok := v.ComputedFields[name]; ok {
-			return computedField(interpreter, getLocationRange)
-		}
-	}
+				return MustConvertStoredValue(value).
+					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
+			})
 
-	// If the composite value was deserialized, dynamically link in the functions
-	// and get injected fields
+	case "remove":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				keyValue := invocation.Arguments[0]
 
-	v.InitializeFunctions(interpreter)
+				return v.Remove(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					keyValue,
+				)
+			},
+			sema.DictionaryRemoveFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-	if v.InjectedFields == nil && interpreter.injectedCompositeFieldsHandler != nil {
-		v.InjectedFields = interpreter.injectedCompositeFieldsHandler(
-			interpreter,
-			v.Location,
-			v.QualifiedIdentifier,
-			v.Kind,
+	case "insert":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				keyValue := invocation.Arguments[0]
+				newValue := invocation.Arguments[1]
+
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					keyValue,
+					newValue,
+				)
+			},
+			sema.DictionaryInsertFunctionType(
+				v.SemaType(interpreter),
+			),
 		)
-	}
 
-	if v.InjectedFields != nil {
-		value, ok := v.InjectedFields[name]
-		if ok {
-			return value
-		}
-	}
+	case "containsKey":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.ContainsKey(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.DictionaryContainsKeyFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-	function, ok := v.Functions[name]
-	if ok {
-		return BoundFunctionValue{
-			Self:     v,
-			Function: function,
-		}
+	case "forEachKey":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				function, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				v.ForEachKey(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					function,
+				)
+				return VoidValue{}
+			},
+			sema.DictionaryForEachKeyFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 	}
 
 	return nil
 }
 
-func (v *CompositeValue) getInterpreter(interpreter *Interpreter) *Interpreter {
-
-	// Get the correct interpreter. The program code might need to be loaded.
-	// NOTE: standard library values have no location
-
-	location := v.Location
-
-	if location == nil || common.LocationsMatch(interpreter.Location, location) {
-		return interpreter
+// ForEachKey calls the given function with each key of the dictionary,
+// until the function returns false.
+//
+// Unlike the field `keys`, no array of the keys is created.
+// Each key is metered as a loop iteration.
+// The dictionary must not be mutated while it is iterated
+//
+func (v *DictionaryValue) ForEachKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	function FunctionValue,
+) {
+	argumentTypes := []sema.Type{
+		v.SemaType(interpreter).KeyType,
 	}
 
-	return interpreter.EnsureLoaded(v.Location)
-}
+	locationRange := getLocationRange()
 
-func (v *CompositeValue) InitializeFunctions(interpreter *Interpreter) {
-	if v.Functions != nil {
-		return
-	}
+	endIteration := interpreter.startContainerIteration(v.StorageID())
+	defer endIteration()
 
-	v.Functions = interpreter.typeCodes.CompositeCodes[v.TypeID()].CompositeFunctions
-}
+	err := v.dictionary.IterateKeys(func(key atree.Value) (resume bool, err error) {
+		interpreter.reportLoopIteration(locationRange)
 
-func (v *CompositeValue) OwnerValue(interpreter *Interpreter, getLocationRange func() LocationRange) OptionalValue {
-	address := v.StorageID().Address
+		result := interpreter.invokeFunctionValue(
+			function,
+			[]Value{MustConvertStoredValue(key)},
+			nil,
+			argumentTypes,
+			argumentTypes,
+			nil,
+			locationRange,
+		)
 
-	if address == (atree.Address{}) {
-		return NilValue{}
+		return bool(result.(BoolValue)), nil
+	})
+	if err != nil {
+		panic(ExternalError{err})
 	}
+}
 
-	ownerAccount := interpreter.publicAccountHandler(interpreter, AddressValue(address))
+func (*DictionaryValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Dictionaries have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
 
-	// Owner must be of `PublicAccount` type.
-	interpreter.ExpectType(ownerAccount, sema.PublicAccountType, getLocationRange)
+func (*DictionaryValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Dictionaries have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
 
-	return NewSomeValueNonCopying(ownerAccount)
+func (v *DictionaryValue) Count() int {
+	return int(v.dictionary.Count())
 }
 
-func (v *CompositeValue) RemoveMember(
+func (v *DictionaryValue) RemoveKey(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	name string,
+	key Value,
 ) Value {
+	return v.Remove(interpreter, getLocationRange, key)
+}
+
+func (v *DictionaryValue) Remove(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue Value,
+) OptionalValue {
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
 	// No need to clean up storable for passed-in key value,
 	// as atree never calls Storable()
 	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
+		valueComparator,
+		hashInputProvider,
+		keyValue,
 	)
 	if err != nil {
 		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return nil
+			return NilValue{}
 		}
 		panic(ExternalError{err})
 	}
@@ -11548,12 +15249,14 @@ func (v *CompositeValue) RemoveMember(
 	storage := interpreter.Storage
 
 	// Key
+
+	existingKeyValue := StoredValue(existingKeyStorable, storage)
+	existingKeyValue.DeepRemove(interpreter)
 	interpreter.RemoveReferencedSlab(existingKeyStorable)
 
 	// Value
 
-	storedValue := StoredValue(existingValueStorable, storage)
-	return storedValue.
+	existingValue := StoredValue(existingValueStorable, storage).
 		Transfer(
 			interpreter,
 			getLocationRange,
@@ -11561,15 +15264,38 @@ func (v *CompositeValue) RemoveMember(
 			true,
 			existingValueStorable,
 		)
+
+	return NewSomeValueNonCopying(existingValue)
 }
 
-func (v *CompositeValue) SetMember(
+func (v *DictionaryValue) InsertKey(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	name string,
-	value Value,
+	key, value Value,
 ) {
-	address := v.StorageID().Address
+	v.SetKey(interpreter, getLocationRange, key, value)
+}
+
+func (v *DictionaryValue) Insert(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue, value Value,
+) OptionalValue {
+
+	interpreter.checkContainerNotIterated(v.StorageID(), getLocationRange)
+
+	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
+	interpreter.checkContainerMutation(v.Type.ValueType, value, getLocationRange)
+
+	address := v.dictionary.Address()
+
+	keyValue = keyValue.Transfer(
+		interpreter,
+		getLocationRange,
+		address,
+		true,
+		nil,
+	)
 
 	value = value.Transfer(
 		interpreter,
@@ -11579,10 +15305,15 @@ func (v *CompositeValue) SetMember(
 		nil,
 	)
 
-	existingStorable, err := v.dictionary.Set(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
+	// atree only calls Storable() on keyValue if needed,
+	// i.e., if the key is a new key
+	existingValueStorable, err := v.dictionary.Set(
+		valueComparator,
+		hashInputProvider,
+		keyValue,
 		value,
 	)
 	if err != nil {
@@ -11590,92 +15321,36 @@ func (v *CompositeValue) SetMember(
 	}
 	interpreter.maybeValidateAtreeValue(v.dictionary)
 
-	if existingStorable != nil {
-		existingValue := StoredValue(existingStorable, interpreter.Storage)
-
-		existingValue.DeepRemove(interpreter)
-
-		interpreter.RemoveReferencedSlab(existingStorable)
-	}
-}
-
-func (v *CompositeValue) String() string {
-	return v.RecursiveString(SeenReferences{})
-}
-
-func (v *CompositeValue) RecursiveString(seenReferences SeenReferences) string {
-	if v.Stringer != nil {
-		return v.Stringer(v, seenReferences)
+	if existingValueStorable == nil {
+		return NilValue{}
 	}
 
-	var fields []CompositeField
-
-	v.ForEachField(func(name string, value Value) {
-		fields = append(
-			fields,
-			CompositeField{
-				Name:  name,
-				Value: value,
-			},
-		)
-	})
-
-	return formatComposite(string(v.TypeID()), fields, seenReferences)
-}
-
-func formatComposite(typeId string, fields []CompositeField, seenReferences SeenReferences) string {
-	preparedFields := make(
-		[]struct {
-			Name  string
-			Value string
-		},
-		0,
-		len(fields),
-	)
-
-	for _, field := range fields {
-		preparedFields = append(
-			preparedFields,
-			struct {
-				Name  string
-				Value string
-			}{
-				Name:  field.Name,
-				Value: field.Value.RecursiveString(seenReferences),
-			},
+	existingValue := StoredValue(existingValueStorable, interpreter.Storage).
+		Transfer(
+			interpreter,
+			getLocationRange,
+			atree.Address{},
+			true,
+			existingValueStorable,
 		)
-	}
 
-	return format.Composite(typeId, preparedFields)
+	return NewSomeValueNonCopying(existingValue)
 }
 
-func (v *CompositeValue) GetField(name string) Value {
-
-	storable, err := v.dictionary.Get(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
-	)
-	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return nil
-		}
-		panic(ExternalError{err})
-	}
-
-	return StoredValue(storable, v.dictionary.Storage)
+type DictionaryEntryValues struct {
+	Key   Value
+	Value Value
 }
 
-func (v *CompositeValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
-	otherComposite, ok := other.(*CompositeValue)
-	if !ok {
-		return false
-	}
-
-	if !v.StaticType().Equal(otherComposite.StaticType()) ||
-		v.Kind != otherComposite.Kind ||
-		v.dictionary.Count() != otherComposite.dictionary.Count() {
+func (v *DictionaryValue) ConformsToDynamicType(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	dynamicType DynamicType,
+	results TypeConformanceResults,
+) bool {
 
+	dictionaryType, ok := dynamicType.(*DictionaryDynamicType)
+	if !ok || v.Count() != len(dictionaryType.EntryTypes) {
 		return false
 	}
 
@@ -11684,6 +15359,7 @@ func (v *CompositeValue) Equal(interpreter *Interpreter, getLocationRange func()
 		panic(ExternalError{err})
 	}
 
+	index := 0
 	for {
 		key, value, err := iterator.Next()
 		if err != nil {
@@ -11693,170 +15369,96 @@ func (v *CompositeValue) Equal(interpreter *Interpreter, getLocationRange func()
 			return true
 		}
 
-		fieldName := string(key.(StringAtreeValue))
-
-		// NOTE: Do NOT use an iterator, iteration order of fields may be different
-		// (if stored in different account, as storage ID is used as hash seed)
-		otherValue := otherComposite.GetField(fieldName)
-
-		equatableValue, ok := MustConvertStoredValue(value).(EquatableValue)
-		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
-			return false
-		}
-	}
-}
-
-// HashInput returns a byte slice containing:
-// - HashInputTypeEnum (1 byte)
-// - type id (n bytes)
-// - hash input of raw value field name (n bytes)
-func (v *CompositeValue) HashInput(interpreter *Interpreter, getLocationRange func() LocationRange, scratch []byte) []byte {
-	if v.Kind == common.CompositeKindEnum {
-		typeID := v.TypeID()
-
-		rawValue := v.GetField(sema.EnumRawValueFieldName)
-		rawValueHashInput := rawValue.(HashableValue).
-			HashInput(interpreter, getLocationRange, scratch)
-
-		length := 1 + len(typeID) + len(rawValueHashInput)
-		if length <= len(scratch) {
-			// Copy rawValueHashInput first because
-			// rawValueHashInput and scratch can point to the same underlying scratch buffer
-			copy(scratch[1+len(typeID):], rawValueHashInput)
-
-			scratch[0] = byte(HashInputTypeEnum)
-			copy(scratch[1:], typeID)
-			return scratch[:length]
-		}
-
-		buffer := make([]byte, length)
-		buffer[0] = byte(HashInputTypeEnum)
-		copy(buffer[1:], typeID)
-		copy(buffer[1+len(typeID):], rawValueHashInput)
-		return buffer
-	}
-
-	panic(errors.NewUnreachableError())
-}
-
-func (v *CompositeValue) TypeID() common.TypeID {
-	if v.typeID == "" {
-		location := v.Location
-		qualifiedIdentifier := v.QualifiedIdentifier
-		if location == nil {
-			return common.TypeID(qualifiedIdentifier)
-		}
-		v.typeID = location.TypeID(qualifiedIdentifier)
-	}
-	return v.typeID
-}
-
-func (v *CompositeValue) ConformsToDynamicType(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	dynamicType DynamicType,
-	results TypeConformanceResults,
-) bool {
-	compositeDynamicType, ok := dynamicType.(CompositeDynamicType)
-	if !ok {
-		return false
-	}
-
-	compositeType, ok := compositeDynamicType.StaticType.(*sema.CompositeType)
-	if !ok ||
-		v.Kind != compositeType.Kind ||
-		v.TypeID() != compositeType.ID() {
-
-		return false
-	}
-
-	fieldsLen := int(v.dictionary.Count())
-	if v.ComputedFields != nil {
-		fieldsLen += len(v.ComputedFields)
-	}
-
-	if fieldsLen != len(compositeType.Fields) {
-		return false
-	}
-
-	for _, fieldName := range compositeType.Fields {
-		value := v.GetField(fieldName)
-		if value == nil {
-			if v.ComputedFields == nil {
-				return false
-			}
-
-			fieldGetter, ok := v.ComputedFields[fieldName]
-			if !ok {
-				return false
-			}
+		entryType := dictionaryType.EntryTypes[index]
 
-			value = fieldGetter(interpreter, getLocationRange)
-		}
+		// Check the key
 
-		member, ok := compositeType.Members.Get(fieldName)
-		if !ok {
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+		entryKey := MustConvertStoredValue(key)
+		if !entryKey.ConformsToDynamicType(
+			interpreter,
+			getLocationRange,
+			entryType.KeyType,
+			results,
+		) {
 			return false
 		}
 
-		fieldDynamicType := value.DynamicType(interpreter, SeenReferences{})
-
-		if !interpreter.IsSubType(fieldDynamicType, member.TypeAnnotation.Type) {
-			return false
-		}
+		// Check the value
 
-		if !value.ConformsToDynamicType(
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+		entryValue := MustConvertStoredValue(value)
+		if !entryValue.ConformsToDynamicType(
 			interpreter,
 			getLocationRange,
-			fieldDynamicType,
+			entryType.ValueType,
 			results,
 		) {
 			return false
 		}
-	}
 
-	return true
+		index++
+	}
 }
 
-func (v *CompositeValue) IsStorable() bool {
+func (v *DictionaryValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
 
-	// Only structures, resources, enums, and contracts can be stored.
-	// Contracts are not directly storable by programs,
-	// but they are still stored in storage by the interpreter
+	otherDictionary, ok := other.(*DictionaryValue)
+	if !ok {
+		return false
+	}
 
-	switch v.Kind {
-	case common.CompositeKindStructure,
-		common.CompositeKindResource,
-		common.CompositeKindEnum,
-		common.CompositeKindContract:
-		break
-	default:
+	if v.Count() != otherDictionary.Count() {
 		return false
 	}
 
-	// Composite value's of native/built-in types are not storable for now
-	return v.Location != nil
-}
+	if !v.Type.Equal(otherDictionary.Type) {
+		return false
+	}
 
-func (v *CompositeValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
-	if !v.IsStorable() {
-		return NonStorable{Value: v}, nil
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(ExternalError{err})
 	}
 
-	return atree.StorageIDStorable(v.StorageID()), nil
-}
+	for {
+		key, value, err := iterator.Next()
+		if err != nil {
+			panic(ExternalError{err})
+		}
+		if key == nil {
+			return true
+		}
 
-func (v *CompositeValue) NeedsStoreTo(address atree.Address) bool {
-	return address != v.StorageID().Address
+		// Do NOT use an iterator, as other value may be stored in another account,
+		// leading to a different iteration order, as the storage ID is used in the seed
+		otherValue, otherValueExists :=
+			otherDictionary.Get(
+				interpreter,
+				getLocationRange,
+				MustConvertStoredValue(key),
+			)
+
+		if !otherValueExists {
+			return false
+		}
+
+		equatableValue, ok := MustConvertStoredValue(value).(EquatableValue)
+		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
+			return false
+		}
+	}
 }
 
-func (v *CompositeValue) IsResourceKinded(_ *Interpreter) bool {
-	return v.Kind == common.CompositeKindResource
+func (v *DictionaryValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return atree.StorageIDStorable(v.StorageID()), nil
 }
 
-func (v *CompositeValue) IsReferenceTrackedResourceKindedValue() {}
+func (v *DictionaryValue) IsReferenceTrackedResourceKindedValue() {}
 
-func (v *CompositeValue) Transfer(
+func (v *DictionaryValue) Transfer(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
 	address atree.Address,
@@ -11864,6 +15466,13 @@ func (v *CompositeValue) Transfer(
 	storable atree.Storable,
 ) Value {
 
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+		defer func() {
+			interpreter.reportDictionaryValueTransferTrace(v.Type.String(), v.Count(), time.Since(startTime))
+		}()
+	}
+
 	currentStorageID := v.StorageID()
 	currentAddress := currentStorageID.Address
 
@@ -11873,6 +15482,10 @@ func (v *CompositeValue) Transfer(
 	isResourceKinded := v.IsResourceKinded(interpreter)
 
 	if needsStoreTo || !isResourceKinded {
+
+		valueComparator := newValueComparator(interpreter, getLocationRange)
+		hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
 		iterator, err := v.dictionary.Iterator()
 		if err != nil {
 			panic(ExternalError{err})
@@ -11883,8 +15496,8 @@ func (v *CompositeValue) Transfer(
 			address,
 			atree.NewDefaultDigesterBuilder(),
 			v.dictionary.Type(),
-			StringAtreeComparator,
-			StringAtreeHashInput,
+			valueComparator,
+			hashInputProvider,
 			v.dictionary.Seed(),
 			func() (atree.Value, atree.Value, error) {
 
@@ -11896,13 +15509,13 @@ func (v *CompositeValue) Transfer(
 					return nil, nil, nil
 				}
 
-				// NOTE: key is stringAtreeValue
-				// and does not need to be converted or copied
+				key := MustConvertStoredValue(atreeKey).
+					Transfer(interpreter, getLocationRange, address, remove, nil)
 
 				value := MustConvertStoredValue(atreeValue).
 					Transfer(interpreter, getLocationRange, address, remove, nil)
 
-				return atreeKey, value, nil
+				return key, value, nil
 			},
 		)
 		if err != nil {
@@ -11910,8 +15523,8 @@ func (v *CompositeValue) Transfer(
 		}
 
 		if remove {
-			err = v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
-				interpreter.RemoveReferencedSlab(nameStorable)
+			err = v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
+				interpreter.RemoveReferencedSlab(keyStorable)
 				interpreter.RemoveReferencedSlab(valueStorable)
 			})
 			if err != nil {
@@ -11923,7 +15536,6 @@ func (v *CompositeValue) Transfer(
 		}
 	}
 
-	var res *CompositeValue
 	if isResourceKinded {
 		// Update the resource in-place,
 		// and also update all values that are referencing the same value
@@ -11937,59 +15549,30 @@ func (v *CompositeValue) Transfer(
 			currentStorageID,
 			newStorageID,
 			func(value ReferenceTrackedResourceKindedValue) {
-				compositeValue, ok := value.(*CompositeValue)
+				dictionaryValue, ok := value.(*DictionaryValue)
 				if !ok {
 					panic(errors.NewUnreachableError())
 				}
-				compositeValue.dictionary = dictionary
+				dictionaryValue.dictionary = dictionary
 			},
 		)
 
-		res = v
+		return v
 	} else {
-		res = &CompositeValue{
-			dictionary:          dictionary,
-			Location:            v.Location,
-			QualifiedIdentifier: v.QualifiedIdentifier,
-			Kind:                v.Kind,
-			InjectedFields:      v.InjectedFields,
-			ComputedFields:      v.ComputedFields,
-			NestedVariables:     v.NestedVariables,
-			Functions:           v.Functions,
-			Destructor:          v.Destructor,
-			Stringer:            v.Stringer,
-			isDestroyed:         v.isDestroyed,
-			typeID:              v.typeID,
-			staticType:          v.staticType,
-			dynamicType:         v.dynamicType,
+		return &DictionaryValue{
+			Type:             v.Type,
+			semaType:         v.semaType,
+			isResourceKinded: v.isResourceKinded,
+			dictionary:       dictionary,
+			isDestroyed:      v.isDestroyed,
 		}
 	}
-
-	if needsStoreTo &&
-		res.Kind == common.CompositeKindResource &&
-		interpreter.onResourceOwnerChange != nil {
-
-		interpreter.onResourceOwnerChange(
-			interpreter,
-			res,
-			common.Address(currentAddress),
-			common.Address(address),
-		)
-	}
-
-	return res
 }
 
-func (v *CompositeValue) ResourceUUID() *UInt64Value {
-	fieldValue := v.GetField(sema.ResourceUUIDFieldName)
-	uuid, ok := fieldValue.(UInt64Value)
-	if !ok {
-		return nil
-	}
-	return &uuid
-}
+func (v *DictionaryValue) Clone(interpreter *Interpreter) Value {
 
-func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
+	valueComparator := newValueComparator(interpreter, ReturnEmptyLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, ReturnEmptyLocationRange)
 
 	iterator, err := v.dictionary.Iterator()
 	if err != nil {
@@ -12001,8 +15584,8 @@ func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
 		v.StorageID().Address,
 		atree.NewDefaultDigesterBuilder(),
 		v.dictionary.Type(),
-		StringAtreeComparator,
-		StringAtreeHashInput,
+		valueComparator,
+		hashInputProvider,
 		v.dictionary.Seed(),
 		func() (atree.Value, atree.Value, error) {
 
@@ -12014,230 +15597,154 @@ func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
 				return nil, nil, nil
 			}
 
-			key := MustConvertStoredValue(atreeKey).Clone(interpreter)
-			value := MustConvertStoredValue(atreeValue).Clone(interpreter)
-
-			return key, value, nil
-		},
-	)
-	if err != nil {
-		panic(ExternalError{err})
-	}
-
-	return &CompositeValue{
-		dictionary:          dictionary,
-		Location:            v.Location,
-		QualifiedIdentifier: v.QualifiedIdentifier,
-		Kind:                v.Kind,
-		InjectedFields:      v.InjectedFields,
-		ComputedFields:      v.ComputedFields,
-		NestedVariables:     v.NestedVariables,
-		Functions:           v.Functions,
-		Destructor:          v.Destructor,
-		Stringer:            v.Stringer,
-		isDestroyed:         v.isDestroyed,
-		typeID:              v.typeID,
-		staticType:          v.staticType,
-		dynamicType:         v.dynamicType,
-	}
-}
-
-func (v *CompositeValue) DeepRemove(interpreter *Interpreter) {
-
-	// Remove nested values and storables
-
-	storage := v.dictionary.Storage
-
-	err := v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
-		// NOTE: key / field name is stringAtreeValue,
-		// and not a Value, so no need to deep remove
-		interpreter.RemoveReferencedSlab(nameStorable)
-
-		value := StoredValue(valueStorable, storage)
-		value.DeepRemove(interpreter)
-		interpreter.RemoveReferencedSlab(valueStorable)
-	})
-	if err != nil {
-		panic(ExternalError{err})
-	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
-}
-
-func (v *CompositeValue) GetOwner() common.Address {
-	return common.Address(v.StorageID().Address)
-}
-
-// ForEachField iterates over all field-name field-value pairs of the composite value.
-// It does NOT iterate over computed fields and functions!
-//
-func (v *CompositeValue) ForEachField(f func(fieldName string, fieldValue Value)) {
-	err := v.dictionary.Iterate(func(key atree.Value, value atree.Value) (resume bool, err error) {
-		f(
-			string(key.(StringAtreeValue)),
-			MustConvertStoredValue(value),
-		)
-		return true, nil
-	})
-	if err != nil {
-		panic(ExternalError{err})
-	}
-}
-
-func (v *CompositeValue) StorageID() atree.StorageID {
-	return v.dictionary.StorageID()
-}
+			key := MustConvertStoredValue(atreeKey).
+				Clone(interpreter)
 
-func (v *CompositeValue) RemoveField(
-	interpreter *Interpreter,
-	_ func() LocationRange,
-	name string,
-) {
+			value := MustConvertStoredValue(atreeValue).
+				Clone(interpreter)
 
-	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
+			return key, value, nil
+		},
 	)
 	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return
-		}
 		panic(ExternalError{err})
 	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
 
-	storage := interpreter.Storage
+	return &DictionaryValue{
+		Type:             v.Type,
+		semaType:         v.semaType,
+		isResourceKinded: v.isResourceKinded,
+		dictionary:       dictionary,
+		isDestroyed:      v.isDestroyed,
+	}
+}
 
-	// Key
+func (v *DictionaryValue) DeepRemove(interpreter *Interpreter) {
 
-	// NOTE: key / field name is stringAtreeValue,
-	// and not a Value, so no need to deep remove
-	interpreter.RemoveReferencedSlab(existingKeyStorable)
+	// Remove nested values and storables
 
-	// Value
+	storage := v.dictionary.Storage
 
-	existingValue := StoredValue(existingValueStorable, storage)
-	existingValue.DeepRemove(interpreter)
-	interpreter.RemoveReferencedSlab(existingValueStorable)
-}
+	err := v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
 
-func NewEnumCaseValue(
-	interpreter *Interpreter,
-	enumType *sema.CompositeType,
-	rawValue NumberValue,
-	functions map[string]FunctionValue,
-) *CompositeValue {
+		key := StoredValue(keyStorable, storage)
+		key.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(keyStorable)
 
-	fields := []CompositeField{
-		{
-			Name:  sema.EnumRawValueFieldName,
-			Value: rawValue,
-		},
+		value := StoredValue(valueStorable, storage)
+		value.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(valueStorable)
+	})
+	if err != nil {
+		panic(ExternalError{err})
 	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+}
 
-	v := NewCompositeValue(
-		interpreter,
-		enumType.Location,
-		enumType.QualifiedIdentifier(),
-		enumType.Kind,
-		fields,
-		common.Address{},
-	)
+func (v *DictionaryValue) GetOwner() common.Address {
+	return common.Address(v.StorageID().Address)
+}
 
-	v.Functions = functions
+func (v *DictionaryValue) StorageID() atree.StorageID {
+	return v.dictionary.StorageID()
+}
 
-	return v
+func (v *DictionaryValue) SemaType(interpreter *Interpreter) *sema.DictionaryType {
+	if v.semaType == nil {
+		// this function will panic already if this conversion fails
+		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.DictionaryType)
+	}
+	return v.semaType
 }
 
-// DictionaryValue
+func (v *DictionaryValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageID().Address
+}
 
-type DictionaryValue struct {
-	Type             DictionaryStaticType
-	semaType         *sema.DictionaryType
-	isResourceKinded *bool
-	dictionary       *atree.OrderedMap
-	isDestroyed      bool
+func (v *DictionaryValue) IsResourceKinded(interpreter *Interpreter) bool {
+	if v.isResourceKinded == nil {
+		isResourceKinded := v.SemaType(interpreter).IsResourceType()
+		v.isResourceKinded = &isResourceKinded
+	}
+	return *v.isResourceKinded
 }
 
-func NewDictionaryValue(
+// SetValue
+
+type SetValue struct {
+	Type     SetStaticType
+	semaType *sema.SetType
+	set      *atree.OrderedMap
+}
+
+func NewSetValue(
 	interpreter *Interpreter,
-	dictionaryType DictionaryStaticType,
-	keysAndValues ...Value,
-) *DictionaryValue {
-	return NewDictionaryValueWithAddress(
+	setType SetStaticType,
+	elements ...Value,
+) *SetValue {
+	return NewSetValueWithAddress(
 		interpreter,
-		dictionaryType,
+		setType,
 		common.Address{},
-		keysAndValues...,
+		elements...,
 	)
 }
 
-func NewDictionaryValueWithAddress(
+func NewSetValueWithAddress(
 	interpreter *Interpreter,
-	dictionaryType DictionaryStaticType,
+	setType SetStaticType,
 	address common.Address,
-	keysAndValues ...Value,
-) *DictionaryValue {
-
-	keysAndValuesCount := len(keysAndValues)
-	if keysAndValuesCount%2 != 0 {
-		panic("uneven number of keys and values")
-	}
+	elements ...Value,
+) *SetValue {
 
-	dictionary, err := atree.NewMap(
+	set, err := atree.NewMap(
 		interpreter.Storage,
 		atree.Address(address),
 		atree.NewDefaultDigesterBuilder(),
-		dictionaryType,
+		setType,
 	)
 	if err != nil {
 		panic(ExternalError{err})
 	}
 
-	v := &DictionaryValue{
-		Type:       dictionaryType,
-		dictionary: dictionary,
+	v := &SetValue{
+		Type: setType,
+		set:  set,
 	}
 
-	for i := 0; i < keysAndValuesCount; i += 2 {
-		key := keysAndValues[i]
-		value := keysAndValues[i+1]
-		// TODO: handle existing value
+	for _, element := range elements {
 		// TODO: provide proper location range
-		_ = v.Insert(interpreter, ReturnEmptyLocationRange, key, value)
+		_ = v.Insert(interpreter, ReturnEmptyLocationRange, element)
 	}
 
 	return v
 }
 
-var _ Value = &DictionaryValue{}
-var _ atree.Value = &DictionaryValue{}
-var _ EquatableValue = &DictionaryValue{}
-var _ ValueIndexableValue = &DictionaryValue{}
-var _ MemberAccessibleValue = &DictionaryValue{}
-var _ ReferenceTrackedResourceKindedValue = &DictionaryValue{}
+var _ Value = &SetValue{}
+var _ atree.Value = &SetValue{}
+var _ EquatableValue = &SetValue{}
+var _ MemberAccessibleValue = &SetValue{}
 
-func (*DictionaryValue) IsValue() {}
+func (*SetValue) IsValue() {}
 
-func (v *DictionaryValue) Accept(interpreter *Interpreter, visitor Visitor) {
-	descend := visitor.VisitDictionaryValue(interpreter, v)
+func (v *SetValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitSetValue(interpreter, v)
 	if !descend {
 		return
 	}
 
-	v.Walk(func(value Value) {
-		value.Accept(interpreter, visitor)
+	v.Walk(func(element Value) {
+		element.Accept(interpreter, visitor)
 	})
 }
 
-func (v *DictionaryValue) Iterate(f func(key, value Value) (resume bool)) {
-	err := v.dictionary.Iterate(func(key, value atree.Value) (resume bool, err error) {
+func (v *SetValue) Iterate(f func(element Value) (resume bool)) {
+	err := v.set.Iterate(func(element, _ atree.Value) (resume bool, err error) {
 		// atree.OrderedMap iteration provides low-level atree.Value,
 		// convert to high-level interpreter.Value
 
 		resume = f(
-			MustConvertStoredValue(key),
-			MustConvertStoredValue(value),
+			MustConvertStoredValue(element),
 		)
 
 		return resume, nil
@@ -12247,170 +15754,75 @@ func (v *DictionaryValue) Iterate(f func(key, value Value) (resume bool)) {
 	}
 }
 
-func (v *DictionaryValue) Walk(walkChild func(Value)) {
-	v.Iterate(func(key, value Value) (resume bool) {
-		walkChild(key)
-		walkChild(value)
-		return true
-	})
-}
-
-func (v *DictionaryValue) DynamicType(interpreter *Interpreter, seenReferences SeenReferences) DynamicType {
-	entryTypes := make([]DictionaryStaticTypeEntry, v.Count())
-
-	index := 0
-	v.Iterate(func(key, value Value) (resume bool) {
-		entryTypes[index] =
-			DictionaryStaticTypeEntry{
-				KeyType:   key.DynamicType(interpreter, seenReferences),
-				ValueType: value.DynamicType(interpreter, seenReferences),
-			}
-		index++
+func (v *SetValue) Walk(walkChild func(Value)) {
+	v.Iterate(func(element Value) (resume bool) {
+		walkChild(element)
 		return true
 	})
-
-	return &DictionaryDynamicType{
-		EntryTypes: entryTypes,
-		StaticType: v.Type,
-	}
-}
-
-func (v *DictionaryValue) StaticType() StaticType {
-	return v.Type
 }
 
-func (v *DictionaryValue) IsDestroyed() bool {
-	return v.isDestroyed
-}
+func (v *SetValue) DynamicType(interpreter *Interpreter, seenReferences SeenReferences) DynamicType {
+	elementTypes := make([]DynamicType, 0, v.Count())
 
-func (v *DictionaryValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
-	v.Iterate(func(key, value Value) (resume bool) {
-		// Resources cannot be keys at the moment, so should theoretically not be needed
-		maybeDestroy(interpreter, getLocationRange, key)
-		maybeDestroy(interpreter, getLocationRange, value)
+	v.Iterate(func(element Value) (resume bool) {
+		elementTypes = append(
+			elementTypes,
+			element.DynamicType(interpreter, seenReferences),
+		)
 		return true
 	})
 
-	v.isDestroyed = true
-}
-
-func (v *DictionaryValue) ContainsKey(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	keyValue Value,
-) BoolValue {
-
-	valueComparator := newValueComparator(interpreter, getLocationRange)
-	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
-
-	_, err := v.dictionary.Get(
-		valueComparator,
-		hashInputProvider,
-		keyValue,
-	)
-	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return false
-		}
-		panic(ExternalError{err})
-	}
-	return true
-}
-
-func (v *DictionaryValue) Get(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	keyValue Value,
-) (Value, bool) {
-
-	valueComparator := newValueComparator(interpreter, getLocationRange)
-	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
-
-	storable, err := v.dictionary.Get(
-		valueComparator,
-		hashInputProvider,
-		keyValue,
-	)
-	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return nil, false
-		}
-		panic(ExternalError{err})
-	}
-
-	storage := v.dictionary.Storage
-	value := StoredValue(storable, storage)
-	return value, true
-}
-
-func (v *DictionaryValue) GetKey(interpreter *Interpreter, getLocationRange func() LocationRange, keyValue Value) Value {
-	value, ok := v.Get(interpreter, getLocationRange, keyValue)
-	if ok {
-		return NewSomeValueNonCopying(value)
-	}
-
-	return NilValue{}
-}
-
-func (v *DictionaryValue) SetKey(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	keyValue Value,
-	value Value,
-) {
-	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
-	interpreter.checkContainerMutation(
-		OptionalStaticType{
-			Type: v.Type.ValueType,
-		},
-		value,
-		getLocationRange,
-	)
-
-	switch value := value.(type) {
-	case *SomeValue:
-		_ = v.Insert(interpreter, getLocationRange, keyValue, value.Value)
-
-	case NilValue:
-		_ = v.Remove(interpreter, getLocationRange, keyValue)
-
-	default:
-		panic(errors.NewUnreachableError())
+	return &SetDynamicType{
+		ElementTypes: elementTypes,
+		StaticType:   v.Type,
 	}
 }
 
-func (v *DictionaryValue) String() string {
-	return v.RecursiveString(SeenReferences{})
+func (v *SetValue) StaticType() StaticType {
+	return v.Type
 }
 
-func (v *DictionaryValue) RecursiveString(seenReferences SeenReferences) string {
+func (v *SetValue) Contains(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	element Value,
+) BoolValue {
 
-	pairs := make([]struct {
-		Key   string
-		Value string
-	}, v.Count())
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-	index := 0
-	v.Iterate(func(key, value Value) (resume bool) {
-		pairs[index] = struct {
-			Key   string
-			Value string
-		}{
-			Key:   key.RecursiveString(seenReferences),
-			Value: value.RecursiveString(seenReferences),
+	_, err := v.set.Get(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return false
 		}
+		panic(ExternalError{err})
+	}
+	return true
+}
 
-		index++
+func (v *SetValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
 
+func (v *SetValue) RecursiveString(seenReferences SeenReferences) string {
+	values := make([]string, 0, v.Count())
+
+	v.Iterate(func(element Value) (resume bool) {
+		values = append(values, element.RecursiveString(seenReferences))
 		return true
 	})
 
-	return format.Dictionary(pairs)
+	return format.Set(values)
 }
 
-func (v *DictionaryValue) GetMember(
+func (v *SetValue) GetMember(
 	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
+	_ func() LocationRange,
 	name string,
 ) Value {
 
@@ -12418,306 +15830,305 @@ func (v *DictionaryValue) GetMember(
 	case "length":
 		return NewIntValueFromInt64(int64(v.Count()))
 
-	case "keys":
-
-		iterator, err := v.dictionary.Iterator()
-		if err != nil {
-			panic(ExternalError{err})
-		}
-
-		return NewArrayValueWithIterator(
-			interpreter,
-			VariableSizedStaticType{
-				Type: v.Type.KeyType,
-			},
-			common.Address{},
-			func() Value {
-
-				key, err := iterator.NextKey()
-				if err != nil {
-					panic(ExternalError{err})
-				}
-				if key == nil {
-					return nil
-				}
-
-				return MustConvertStoredValue(key).
-					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
+	case "contains":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.Contains(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
 			},
+			sema.SetContainsFunctionType(
+				v.SemaType(interpreter),
+			),
 		)
 
-	case "values":
-
-		iterator, err := v.dictionary.Iterator()
-		if err != nil {
-			panic(ExternalError{err})
-		}
-
-		return NewArrayValueWithIterator(
-			interpreter,
-			VariableSizedStaticType{
-				Type: v.Type.ValueType,
+	case "insert":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
 			},
-			common.Address{},
-			func() Value {
-
-				value, err := iterator.NextValue()
-				if err != nil {
-					panic(ExternalError{err})
-				}
-				if value == nil {
-					return nil
-				}
-
-				return MustConvertStoredValue(value).
-					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
-			})
+			sema.SetInsertFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
 	case "remove":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
-				keyValue := invocation.Arguments[0]
-
 				return v.Remove(
 					invocation.Interpreter,
 					invocation.GetLocationRange,
-					keyValue,
+					invocation.Arguments[0],
 				)
 			},
-			sema.DictionaryRemoveFunctionType(
+			sema.SetRemoveFunctionType(
 				v.SemaType(interpreter),
 			),
 		)
 
-	case "insert":
+	case "union":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
-				keyValue := invocation.Arguments[0]
-				newValue := invocation.Arguments[1]
+				other, ok := invocation.Arguments[0].(*SetValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
-				return v.Insert(
+				return v.Union(
 					invocation.Interpreter,
 					invocation.GetLocationRange,
-					keyValue,
-					newValue,
+					other,
 				)
 			},
-			sema.DictionaryInsertFunctionType(
+			sema.SetOperationFunctionType(
 				v.SemaType(interpreter),
 			),
 		)
 
-	case "containsKey":
+	case "intersection":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
-				return v.ContainsKey(
+				other, ok := invocation.Arguments[0].(*SetValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Intersection(
 					invocation.Interpreter,
 					invocation.GetLocationRange,
-					invocation.Arguments[0],
+					other,
 				)
 			},
-			sema.DictionaryContainsKeyFunctionType(
+			sema.SetOperationFunctionType(
 				v.SemaType(interpreter),
 			),
 		)
 
+	case "difference":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*SetValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Difference(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					other,
+				)
+			},
+			sema.SetOperationFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 	}
 
 	return nil
 }
 
-func (*DictionaryValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
-	// Dictionaries have no removable members (fields / functions)
+func (*SetValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Sets have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (*DictionaryValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
-	// Dictionaries have no settable members (fields / functions)
+func (*SetValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Sets have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v *DictionaryValue) Count() int {
-	return int(v.dictionary.Count())
+func (v *SetValue) Count() int {
+	return int(v.set.Count())
 }
 
-func (v *DictionaryValue) RemoveKey(
+// Insert inserts the given element into the set.
+// Returns true if the element was inserted,
+// and false if the set already contained the element
+//
+func (v *SetValue) Insert(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	key Value,
-) Value {
-	return v.Remove(interpreter, getLocationRange, key)
-}
+	element Value,
+) BoolValue {
 
-func (v *DictionaryValue) Remove(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	keyValue Value,
-) OptionalValue {
+	interpreter.checkContainerMutation(v.Type.ElementType, element, getLocationRange)
+
+	address := v.set.Address()
+
+	element = element.Transfer(
+		interpreter,
+		getLocationRange,
+		address,
+		true,
+		nil,
+	)
 
 	valueComparator := newValueComparator(interpreter, getLocationRange)
 	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-	// No need to clean up storable for passed-in key value,
-	// as atree never calls Storable()
-	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
+	// The set is backed by an ordered map,
+	// the elements are the keys and nil is used as the value for all keys.
+	//
+	// atree only calls Storable() on the element if needed,
+	// i.e., if the element is a new element
+	existingStorable, err := v.set.Set(
 		valueComparator,
 		hashInputProvider,
-		keyValue,
+		element,
+		NilValue{},
 	)
 	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return NilValue{}
-		}
 		panic(ExternalError{err})
 	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
-
-	storage := interpreter.Storage
-
-	// Key
-
-	existingKeyValue := StoredValue(existingKeyStorable, storage)
-	existingKeyValue.DeepRemove(interpreter)
-	interpreter.RemoveReferencedSlab(existingKeyStorable)
-
-	// Value
-
-	existingValue := StoredValue(existingValueStorable, storage).
-		Transfer(
-			interpreter,
-			getLocationRange,
-			atree.Address{},
-			true,
-			existingValueStorable,
-		)
-
-	return NewSomeValueNonCopying(existingValue)
-}
+	interpreter.maybeValidateAtreeValue(v.set)
 
-func (v *DictionaryValue) InsertKey(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	key, value Value,
-) {
-	v.SetKey(interpreter, getLocationRange, key, value)
+	return existingStorable == nil
 }
 
-func (v *DictionaryValue) Insert(
+// Remove removes the given element from the set.
+// Returns true if the element was removed,
+// and false if the set did not contain the element
+//
+func (v *SetValue) Remove(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	keyValue, value Value,
-) OptionalValue {
-
-	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
-	interpreter.checkContainerMutation(v.Type.ValueType, value, getLocationRange)
-
-	address := v.dictionary.Address()
-
-	keyValue = keyValue.Transfer(
-		interpreter,
-		getLocationRange,
-		address,
-		true,
-		nil,
-	)
-
-	value = value.Transfer(
-		interpreter,
-		getLocationRange,
-		address,
-		true,
-		nil,
-	)
+	element Value,
+) BoolValue {
 
 	valueComparator := newValueComparator(interpreter, getLocationRange)
 	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-	// atree only calls Storable() on keyValue if needed,
-	// i.e., if the key is a new key
-	existingValueStorable, err := v.dictionary.Set(
+	// No need to clean up storable for passed-in element,
+	// as atree never calls Storable()
+	existingElementStorable, existingValueStorable, err := v.set.Remove(
 		valueComparator,
 		hashInputProvider,
-		keyValue,
-		value,
+		element,
 	)
 	if err != nil {
-		panic(ExternalError{err})
-	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
-
-	if existingValueStorable == nil {
-		return NilValue{}
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return false
+		}
+		panic(ExternalError{err})
 	}
+	interpreter.maybeValidateAtreeValue(v.set)
 
-	existingValue := StoredValue(existingValueStorable, interpreter.Storage).
-		Transfer(
-			interpreter,
-			getLocationRange,
-			atree.Address{},
-			true,
-			existingValueStorable,
+	existingElement := StoredValue(existingElementStorable, interpreter.Storage)
+	existingElement.DeepRemove(interpreter)
+	interpreter.RemoveReferencedSlab(existingElementStorable)
+	interpreter.RemoveReferencedSlab(existingValueStorable)
+
+	return true
+}
+
+// Union returns a new set containing all elements of this set and the given set
+//
+func (v *SetValue) Union(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	other *SetValue,
+) *SetValue {
+	elements := make([]Value, 0, v.Count()+other.Count())
+
+	collect := func(element Value) (resume bool) {
+		elements = append(
+			elements,
+			element.Transfer(interpreter, getLocationRange, atree.Address{}, false, nil),
 		)
+		return true
+	}
 
-	return NewSomeValueNonCopying(existingValue)
+	v.Iterate(collect)
+	other.Iterate(collect)
+
+	return NewSetValue(interpreter, v.Type, elements...)
 }
 
-type DictionaryEntryValues struct {
-	Key   Value
-	Value Value
+// Intersection returns a new set containing the elements of this set
+// which are also contained in the given set
+//
+func (v *SetValue) Intersection(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	other *SetValue,
+) *SetValue {
+	return v.filter(interpreter, getLocationRange, func(element Value) bool {
+		return bool(other.Contains(interpreter, getLocationRange, element))
+	})
 }
 
-func (v *DictionaryValue) ConformsToDynamicType(
+// Difference returns a new set containing the elements of this set
+// which are not contained in the given set
+//
+func (v *SetValue) Difference(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	other *SetValue,
+) *SetValue {
+	return v.filter(interpreter, getLocationRange, func(element Value) bool {
+		return !bool(other.Contains(interpreter, getLocationRange, element))
+	})
+}
+
+func (v *SetValue) filter(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	include func(element Value) bool,
+) *SetValue {
+	var elements []Value
+
+	v.Iterate(func(element Value) (resume bool) {
+		if include(element) {
+			elements = append(
+				elements,
+				element.Transfer(interpreter, getLocationRange, atree.Address{}, false, nil),
+			)
+		}
+		return true
+	})
+
+	return NewSetValue(interpreter, v.Type, elements...)
+}
+
+func (v *SetValue) ConformsToDynamicType(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
 	dynamicType DynamicType,
 	results TypeConformanceResults,
 ) bool {
 
-	dictionaryType, ok := dynamicType.(*DictionaryDynamicType)
-	if !ok || v.Count() != len(dictionaryType.EntryTypes) {
+	setType, ok := dynamicType.(*SetDynamicType)
+	if !ok || v.Count() != len(setType.ElementTypes) {
 		return false
 	}
 
-	iterator, err := v.dictionary.Iterator()
+	iterator, err := v.set.Iterator()
 	if err != nil {
 		panic(ExternalError{err})
 	}
 
 	index := 0
 	for {
-		key, value, err := iterator.Next()
+		element, err := iterator.NextKey()
 		if err != nil {
 			panic(ExternalError{err})
 		}
-		if key == nil {
+		if element == nil {
 			return true
 		}
 
-		entryType := dictionaryType.EntryTypes[index]
-
-		// Check the key
-
-		// atree.OrderedMap iteration provides low-level atree.Value,
-		// convert to high-level interpreter.Value
-		entryKey := MustConvertStoredValue(key)
-		if !entryKey.ConformsToDynamicType(
-			interpreter,
-			getLocationRange,
-			entryType.KeyType,
-			results,
-		) {
-			return false
-		}
-
-		// Check the value
-
 		// atree.OrderedMap iteration provides low-level atree.Value,
 		// convert to high-level interpreter.Value
-		entryValue := MustConvertStoredValue(value)
-		if !entryValue.ConformsToDynamicType(
+		if !MustConvertStoredValue(element).ConformsToDynamicType(
 			interpreter,
 			getLocationRange,
-			entryType.ValueType,
+			setType.ElementTypes[index],
 			results,
 		) {
 			return false
@@ -12727,62 +16138,38 @@ func (v *DictionaryValue) ConformsToDynamicType(
 	}
 }
 
-func (v *DictionaryValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
+func (v *SetValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
 
-	otherDictionary, ok := other.(*DictionaryValue)
+	otherSet, ok := other.(*SetValue)
 	if !ok {
 		return false
 	}
 
-	if v.Count() != otherDictionary.Count() {
+	if v.Count() != otherSet.Count() {
 		return false
 	}
 
-	if !v.Type.Equal(otherDictionary.Type) {
+	if !v.Type.Equal(otherSet.Type) {
 		return false
 	}
 
-	iterator, err := v.dictionary.Iterator()
-	if err != nil {
-		panic(ExternalError{err})
-	}
-
-	for {
-		key, value, err := iterator.Next()
-		if err != nil {
-			panic(ExternalError{err})
-		}
-		if key == nil {
-			return true
-		}
-
-		// Do NOT use an iterator, as other value may be stored in another account,
-		// leading to a different iteration order, as the storage ID is used in the seed
-		otherValue, otherValueExists :=
-			otherDictionary.Get(
-				interpreter,
-				getLocationRange,
-				MustConvertStoredValue(key),
-			)
+	// Do NOT iterate both sets in lockstep, as the other set may be stored in another account,
+	// leading to a different iteration order, as the storage ID is used in the seed
 
-		if !otherValueExists {
-			return false
-		}
+	equal := true
+	v.Iterate(func(element Value) (resume bool) {
+		equal = bool(otherSet.Contains(interpreter, getLocationRange, element))
+		return equal
+	})
 
-		equatableValue, ok := MustConvertStoredValue(value).(EquatableValue)
-		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
-			return false
-		}
-	}
+	return equal
 }
 
-func (v *DictionaryValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v *SetValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return atree.StorageIDStorable(v.StorageID()), nil
 }
 
-func (v *DictionaryValue) IsReferenceTrackedResourceKindedValue() {}
-
-func (v *DictionaryValue) Transfer(
+func (v *SetValue) Transfer(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
 	address atree.Address,
@@ -12793,204 +16180,157 @@ func (v *DictionaryValue) Transfer(
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
 		defer func() {
-			interpreter.reportDictionaryValueTransferTrace(v.Type.String(), v.Count(), time.Since(startTime))
+			interpreter.reportSetValueTransferTrace(v.Type.String(), v.Count(), time.Since(startTime))
 		}()
 	}
 
-	currentStorageID := v.StorageID()
-	currentAddress := currentStorageID.Address
-
-	dictionary := v.dictionary
-
-	needsStoreTo := address != currentAddress
-	isResourceKinded := v.IsResourceKinded(interpreter)
-
-	if needsStoreTo || !isResourceKinded {
+	// Sets are never resource-kinded,
+	// so they are always copied
 
-		valueComparator := newValueComparator(interpreter, getLocationRange)
-		hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-		iterator, err := v.dictionary.Iterator()
-		if err != nil {
-			panic(ExternalError{err})
-		}
+	iterator, err := v.set.Iterator()
+	if err != nil {
+		panic(ExternalError{err})
+	}
 
-		dictionary, err = atree.NewMapFromBatchData(
-			interpreter.Storage,
-			address,
-			atree.NewDefaultDigesterBuilder(),
-			v.dictionary.Type(),
-			valueComparator,
-			hashInputProvider,
-			v.dictionary.Seed(),
-			func() (atree.Value, atree.Value, error) {
+	set, err := atree.NewMapFromBatchData(
+		interpreter.Storage,
+		address,
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
 
-				atreeKey, atreeValue, err := iterator.Next()
-				if err != nil {
-					return nil, nil, err
-				}
-				if atreeKey == nil || atreeValue == nil {
-					return nil, nil, nil
-				}
+			atreeElement, err := iterator.NextKey()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeElement == nil {
+				return nil, nil, nil
+			}
 
-				key := MustConvertStoredValue(atreeKey).
-					Transfer(interpreter, getLocationRange, address, remove, nil)
+			element := MustConvertStoredValue(atreeElement).
+				Transfer(interpreter, getLocationRange, address, remove, nil)
 
-				value := MustConvertStoredValue(atreeValue).
-					Transfer(interpreter, getLocationRange, address, remove, nil)
+			return element, NilValue{}, nil
+		},
+	)
+	if err != nil {
+		panic(ExternalError{err})
+	}
 
-				return key, value, nil
-			},
-		)
+	if remove {
+		err = v.set.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
+			interpreter.RemoveReferencedSlab(elementStorable)
+			interpreter.RemoveReferencedSlab(valueStorable)
+		})
 		if err != nil {
 			panic(ExternalError{err})
 		}
+		interpreter.maybeValidateAtreeValue(v.set)
 
-		if remove {
-			err = v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
-				interpreter.RemoveReferencedSlab(keyStorable)
-				interpreter.RemoveReferencedSlab(valueStorable)
-			})
-			if err != nil {
-				panic(ExternalError{err})
-			}
-			interpreter.maybeValidateAtreeValue(v.dictionary)
-
-			interpreter.RemoveReferencedSlab(storable)
-		}
+		interpreter.RemoveReferencedSlab(storable)
 	}
 
-	if isResourceKinded {
-		// Update the resource in-place,
-		// and also update all values that are referencing the same value
-		// (but currently point to an outdated Go instance of the value)
-
-		v.dictionary = dictionary
-
-		newStorageID := dictionary.StorageID()
-
-		interpreter.updateReferencedResource(
-			currentStorageID,
-			newStorageID,
-			func(value ReferenceTrackedResourceKindedValue) {
-				dictionaryValue, ok := value.(*DictionaryValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
-				}
-				dictionaryValue.dictionary = dictionary
-			},
-		)
-
-		return v
-	} else {
-		return &DictionaryValue{
-			Type:             v.Type,
-			semaType:         v.semaType,
-			isResourceKinded: v.isResourceKinded,
-			dictionary:       dictionary,
-			isDestroyed:      v.isDestroyed,
-		}
+	return &SetValue{
+		Type:     v.Type,
+		semaType: v.semaType,
+		set:      set,
 	}
 }
 
-func (v *DictionaryValue) Clone(interpreter *Interpreter) Value {
+func (v *SetValue) Clone(interpreter *Interpreter) Value {
 
 	valueComparator := newValueComparator(interpreter, ReturnEmptyLocationRange)
 	hashInputProvider := newHashInputProvider(interpreter, ReturnEmptyLocationRange)
 
-	iterator, err := v.dictionary.Iterator()
+	iterator, err := v.set.Iterator()
 	if err != nil {
 		panic(ExternalError{err})
 	}
 
-	dictionary, err := atree.NewMapFromBatchData(
+	set, err := atree.NewMapFromBatchData(
 		interpreter.Storage,
 		v.StorageID().Address,
 		atree.NewDefaultDigesterBuilder(),
-		v.dictionary.Type(),
+		v.set.Type(),
 		valueComparator,
 		hashInputProvider,
-		v.dictionary.Seed(),
+		v.set.Seed(),
 		func() (atree.Value, atree.Value, error) {
 
-			atreeKey, atreeValue, err := iterator.Next()
+			atreeElement, err := iterator.NextKey()
 			if err != nil {
 				return nil, nil, err
 			}
-			if atreeKey == nil || atreeValue == nil {
+			if atreeElement == nil {
 				return nil, nil, nil
 			}
 
-			key := MustConvertStoredValue(atreeKey).
-				Clone(interpreter)
-
-			value := MustConvertStoredValue(atreeValue).
+			element := MustConvertStoredValue(atreeElement).
 				Clone(interpreter)
 
-			return key, value, nil
+			return element, NilValue{}, nil
 		},
 	)
 	if err != nil {
 		panic(ExternalError{err})
 	}
 
-	return &DictionaryValue{
-		Type:             v.Type,
-		semaType:         v.semaType,
-		isResourceKinded: v.isResourceKinded,
-		dictionary:       dictionary,
-		isDestroyed:      v.isDestroyed,
+	return &SetValue{
+		Type:     v.Type,
+		semaType: v.semaType,
+		set:      set,
 	}
 }
 
-func (v *DictionaryValue) DeepRemove(interpreter *Interpreter) {
+func (v *SetValue) DeepRemove(interpreter *Interpreter) {
 
 	// Remove nested values and storables
 
-	storage := v.dictionary.Storage
+	storage := v.set.Storage
 
-	err := v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
+	err := v.set.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
 
-		key := StoredValue(keyStorable, storage)
-		key.DeepRemove(interpreter)
-		interpreter.RemoveReferencedSlab(keyStorable)
+		element := StoredValue(elementStorable, storage)
+		element.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(elementStorable)
 
-		value := StoredValue(valueStorable, storage)
-		value.DeepRemove(interpreter)
 		interpreter.RemoveReferencedSlab(valueStorable)
 	})
 	if err != nil {
 		panic(ExternalError{err})
 	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
+	interpreter.maybeValidateAtreeValue(v.set)
 }
 
-func (v *DictionaryValue) GetOwner() common.Address {
+func (v *SetValue) GetOwner() common.Address {
 	return common.Address(v.StorageID().Address)
 }
 
-func (v *DictionaryValue) StorageID() atree.StorageID {
-	return v.dictionary.StorageID()
+func (v *SetValue) StorageID() atree.StorageID {
+	return v.set.StorageID()
 }
 
-func (v *DictionaryValue) SemaType(interpreter *Interpreter) *sema.DictionaryType {
+func (v *SetValue) SemaType(interpreter *Interpreter) *sema.SetType {
 	if v.semaType == nil {
 		// this function will panic already if this conversion fails
-		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.DictionaryType)
+		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.SetType)
 	}
 	return v.semaType
 }
 
-func (v *DictionaryValue) NeedsStoreTo(address atree.Address) bool {
+func (v *SetValue) NeedsStoreTo(address atree.Address) bool {
 	return address != v.StorageID().Address
 }
 
-func (v *DictionaryValue) IsResourceKinded(interpreter *Interpreter) bool {
-	if v.isResourceKinded == nil {
-		isResourceKinded := v.SemaType(interpreter).IsResourceType()
-		v.isResourceKinded = &isResourceKinded
-	}
-	return *v.isResourceKinded
+func (*SetValue) IsResourceKinded(_ *Interpreter) bool {
+	// Set elements must be hashable, which resources are not
+	return false
 }
 
 // OptionalValue
@@ -857,6 +857,14 @@ func TestStringer(t *testing.T) {
 			value:    Word64Value(64),
 			expected: "64",
 		},
+		"Word128": {
+			value:    NewWord128ValueFromUint64(128),
+			expected: "128",
+		},
+		"Word256": {
+			value:    NewWord256ValueFromUint64(256),
+			expected: "256",
+		},
 		"UFix64": {
 			value:    NewUFix64ValueWithInteger(64),
 			expected: "64.00000000",
@@ -1330,6 +1338,30 @@ func TestGetHashInput(t *testing.T) {
 			value:    Word64Value(math.MaxUint64),
 			expected: []byte{byte(HashInputTypeWord64), 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 		},
+		"Word128": {
+			value:    NewWord128ValueFromUint64(128),
+			expected: []byte{byte(HashInputTypeWord128), 128},
+		},
+		"Word128 min": {
+			value:    NewWord128ValueFromUint64(0),
+			expected: append([]byte{byte(HashInputTypeWord128)}, 0),
+		},
+		"Word128 max": {
+			value:    NewWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+			expected: append([]byte{byte(HashInputTypeWord128)}, sema.Word128TypeMaxIntBig.Bytes()...),
+		},
+		"Word256": {
+			value:    NewWord256ValueFromUint64(256),
+			expected: []byte{byte(HashInputTypeWord256), 1, 0},
+		},
+		"Word256 min": {
+			value:    NewWord256ValueFromUint64(0),
+			expected: append([]byte{byte(HashInputTypeWord256)}, 0),
+		},
+		"Word256 max": {
+			value:    NewWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+			expected: append([]byte{byte(HashInputTypeWord256)}, sema.Word256TypeMaxIntBig.Bytes()...),
+		},
 		"UFix64": {
 			value:    NewUFix64ValueWithInteger(64),
 			expected: []byte{byte(HashInputTypeUFix64), 0x0, 0x0, 0x0, 0x1, 0x7d, 0x78, 0x40, 0x0},
@@ -3062,6 +3094,8 @@ func TestNumberValue_Equal(t *testing.T) {
 		"Word16":  Word16Value(16),
 		"Word32":  Word32Value(32),
 		"Word64":  Word64Value(64),
+		"Word128": NewWord128ValueFromUint64(128),
+		"Word256": NewWord256ValueFromUint64(256),
 		"UFix64":  NewUFix64ValueWithInteger(64),
 		"Fix64":   NewFix64ValueWithInteger(-32),
 	}
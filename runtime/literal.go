@@ -195,6 +195,10 @@ func convertIntValue(intValue interpreter.IntValue, ty sema.Type) (interpreter.V
 		return interpreter.ConvertWord32(intValue), nil
 	case sema.Word64Type:
 		return interpreter.ConvertWord64(intValue), nil
+	case sema.Word128Type:
+		return interpreter.ConvertWord128(intValue), nil
+	case sema.Word256Type:
+		return interpreter.ConvertWord256(intValue), nil
 
 	default:
 		return nil, UnsupportedLiteralError
@@ -211,14 +215,18 @@ func fixedPointLiteralValue(expression ast.Expression, ty sema.Type) (cadence.Va
 		return nil, InvalidLiteralError
 	}
 
-	// TODO: adjust once/if we support more fixed point types
+	scale := sema.Fix64Scale
+	switch ty {
+	case sema.Fix128Type, sema.UFix128Type:
+		scale = sema.Fix128Scale
+	}
 
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		fixedPointExpression.Negative,
 		fixedPointExpression.UnsignedInteger,
 		fixedPointExpression.Fractional,
 		fixedPointExpression.Scale,
-		sema.Fix64Scale,
+		scale,
 	)
 
 	switch ty {
@@ -226,6 +234,10 @@ func fixedPointLiteralValue(expression ast.Expression, ty sema.Type) (cadence.Va
 		return cadence.Fix64(value.Int64()), nil
 	case sema.UFix64Type:
 		return cadence.UFix64(value.Uint64()), nil
+	case sema.Fix128Type:
+		return cadence.NewFix128FromBig(value)
+	case sema.UFix128Type:
+		return cadence.NewUFix128FromBig(value)
 	}
 
 	return nil, UnsupportedLiteralError
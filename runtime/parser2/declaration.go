@@ -88,6 +88,9 @@ func parseDeclaration(p *parser, docString string) ast.Declaration {
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordTypeAlias:
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
 			case KeywordTransaction:
 				if access != ast.AccessNotSpecified {
 					panic(fmt.Errorf("invalid access modifier for transaction"))
@@ -360,12 +363,18 @@ func parsePragmaDeclaration(p *parser) *ast.PragmaDeclaration {
 	}
 }
 
+// importHashLength is the length in bytes of the expected hash of imported code,
+// i.e. the length of a SHA3-256 hash
+//
+const importHashLength = 32
+
 // parseImportDeclaration parses an import declaration
 //
 //     importDeclaration :
 //         'import'
 //         ( identifier (',' identifier)* 'from' )?
 //         ( string | hexadecimalLiteral | identifier )
+//         ( '(' 'hash' ':' string ')' )?
 //
 func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 
@@ -376,6 +385,7 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 	var location common.Location
 	var locationPos ast.Position
 	var endPos ast.Position
+	var hash []byte
 
 	parseStringOrAddressLocation := func() {
 		locationPos = p.current.StartPos
@@ -491,6 +501,69 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 		}
 	}
 
+	// parseHash parses the optional expected hash of the imported code,
+	// which follows the location, e.g. `import A from 0x1 (hash: "...")`
+	//
+	parseHash := func() {
+		// Look ahead for an opening parenthesis,
+		// without skipping the trivia of the next declaration
+
+		p.startBuffering()
+		p.skipSpaceAndComments(true)
+		if p.current.Type != lexer.TokenParenOpen {
+			p.replayBuffered()
+			return
+		}
+		p.acceptBuffered()
+
+		// Skip the opening parenthesis
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		p.mustOneString(lexer.TokenIdentifier, keywordHash)
+		p.skipSpaceAndComments(true)
+
+		p.mustOne(lexer.TokenColon)
+		p.skipSpaceAndComments(true)
+
+		if p.current.Type != lexer.TokenString {
+			panic(fmt.Errorf(
+				"unexpected token in import declaration: got %s, expected string hash",
+				p.current.Type,
+			))
+		}
+
+		hashPos := p.current.StartPos
+		parsedString, errs := parseStringLiteral(p.current.Value.(string))
+		p.report(errs...)
+
+		var err error
+		hash, err = hex.DecodeString(parsedString)
+		if err != nil || len(hash) == 0 {
+			p.report(&SyntaxError{
+				Message: fmt.Sprintf("invalid import hash: %q", parsedString),
+				Pos:     hashPos,
+			})
+			hash = nil
+		} else if len(hash) != importHashLength {
+			p.report(&SyntaxError{
+				Message: fmt.Sprintf(
+					"invalid import hash length: expected %d bytes, got %d",
+					importHashLength,
+					len(hash),
+				),
+				Pos: hashPos,
+			})
+			hash = nil
+		}
+
+		// Skip the hash
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		endPos = p.mustOne(lexer.TokenParenClose).EndPos
+	}
+
 	maybeParseFromIdentifier := func(identifier ast.Identifier) {
 		// The current identifier is maybe the `from` keyword,
 		// in which case the given (previous) identifier was
@@ -536,7 +609,7 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 		case lexer.TokenIdentifier:
 			maybeParseFromIdentifier(identifier)
 
-		case lexer.TokenEOF:
+		case lexer.TokenEOF, lexer.TokenParenOpen:
 			// The previous identifier is the identifier location
 			setIdentifierLocation(identifier)
 
@@ -559,9 +632,12 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 		))
 	}
 
+	parseHash()
+
 	return &ast.ImportDeclaration{
 		Identifiers: identifiers,
 		Location:    location,
+		Hash:        hash,
 		Range: ast.Range{
 			StartPos: startPosition,
 			EndPos:   endPos,
@@ -675,6 +751,56 @@ func parseEventDeclaration(
 	}
 }
 
+// parseTypeAliasDeclaration parses a type alias declaration.
+//
+//     typeAliasDeclaration : 'typealias' identifier '=' typeAnnotation
+//
+func parseTypeAliasDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) *ast.TypeAliasDeclaration {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `typealias` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if !p.current.Is(lexer.TokenIdentifier) {
+		panic(fmt.Errorf(
+			"expected identifier after start of type alias declaration, got %s",
+			p.current.Type,
+		))
+	}
+
+	identifier := tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	p.mustOne(lexer.TokenEqual)
+	p.skipSpaceAndComments(true)
+
+	typeAnnotation := parseTypeAnnotation(p)
+
+	return &ast.TypeAliasDeclaration{
+		Access:         access,
+		Identifier:     identifier,
+		TypeAnnotation: typeAnnotation,
+		DocString:      docString,
+		Range: ast.Range{
+			StartPos: startPos,
+			EndPos:   typeAnnotation.EndPosition(),
+		},
+	}
+}
+
 // parseCompositeKind parses a composite kind.
 //
 //     compositeKind : 'struct' | 'resource' | 'contract' | 'enum'
@@ -960,6 +1086,9 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordTypeAlias:
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
 			case keywordPriv, keywordPub, keywordAccess:
 				if access != ast.AccessNotSpecified {
 					panic(fmt.Errorf("unexpected access modifier"))
@@ -19,8 +19,10 @@
 package parser2
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -469,6 +471,72 @@ func TestParseParameterList(t *testing.T) {
 			errs,
 		)
 	})
+
+	t.Run("one, with default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parse("( a : Int = 1 )")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.ParameterList{
+				Parameters: []*ast.Parameter{
+					{
+						Label: "",
+						Identifier: ast.Identifier{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+						},
+						TypeAnnotation: &ast.TypeAnnotation{
+							IsResource: false,
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 6, Offset: 6},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+						DefaultArgument: &ast.IntegerExpression{
+							PositiveLiteral: "1",
+							Value:           big.NewInt(1),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+								EndPos:   ast.Position{Line: 1, Column: 12, Offset: 12},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 2, Offset: 2},
+							EndPos:   ast.Position{Line: 1, Column: 12, Offset: 12},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("one, missing default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := parse("( a : Int = )")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in expression: ')'",
+					Pos:     ast.Position{Offset: 13, Line: 1, Column: 13},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseFunctionDeclaration(t *testing.T) {
@@ -1508,6 +1576,135 @@ func TestParseImportDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	// hash is a valid hash of imported code, 32 bytes
+	hash := strings.Repeat("0a1b", 16)
+	hashBytes := bytes.Repeat([]byte{0x0a, 0x1b}, 16)
+
+	t.Run("one identifier, address location, hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(fmt.Sprintf(` import foo from 0x42 (hash: "%s")`, hash))
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					LocationPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+					Hash:        hashBytes,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 95, Offset: 95},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("no identifiers, identifier location, hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(fmt.Sprintf(` import foo (hash: "%s")`, hash))
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: nil,
+					Location:    common.IdentifierLocation("foo"),
+					LocationPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+					Hash:        hashBytes,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 85, Offset: 85},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("hash, followed by declaration with doc string", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(fmt.Sprintf(
+			`
+			import "foo" (hash: "%s")
+			/// test
+			fun test() {}
+		`,
+			hash,
+		))
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+
+		importDeclaration := result[0].(*ast.ImportDeclaration)
+		require.Equal(t, hashBytes, importDeclaration.Hash)
+
+		require.Equal(t, " test", result[1].DeclarationDocString())
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import foo from 0x42 (hash: "xyz")`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: `invalid import hash: "xyz"`,
+					Pos:     ast.Position{Offset: 29, Line: 1, Column: 29},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid hash length", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import foo from 0x42 (hash: "0a1b")`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash length: expected 32 bytes, got 2",
+					Pos:     ast.Position{Offset: 29, Line: 1, Column: 29},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing hash label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import foo from 0x42 ("0a1b")`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token identifier with string value hash",
+					Pos:     ast.Position{Offset: 23, Line: 1, Column: 23},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseEvent(t *testing.T) {
@@ -4974,3 +5171,117 @@ func TestParseInvalidAccessModifiers(t *testing.T) {
 		)
 	})
 }
+
+func TestParseTypeAliasDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("simple", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub typealias Token = @R")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessPublic,
+					Identifier: ast.Identifier{
+						Identifier: "Token",
+						Pos:        ast.Position{Offset: 14, Line: 1, Column: 14},
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						IsResource: true,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "R",
+								Pos:        ast.Position{Offset: 23, Line: 1, Column: 23},
+							},
+						},
+						StartPos: ast.Position{Offset: 22, Line: 1, Column: 22},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 23, Line: 1, Column: 23},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("restricted type, doc string", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("/// test\ntypealias T = {I}")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessNotSpecified,
+					Identifier: ast.Identifier{
+						Identifier: "T",
+						Pos:        ast.Position{Offset: 19, Line: 2, Column: 10},
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.RestrictedType{
+							Restrictions: []*ast.NominalType{
+								{
+									Identifier: ast.Identifier{
+										Identifier: "I",
+										Pos:        ast.Position{Offset: 24, Line: 2, Column: 15},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Offset: 23, Line: 2, Column: 14},
+								EndPos:   ast.Position{Offset: 25, Line: 2, Column: 16},
+							},
+						},
+						StartPos: ast.Position{Offset: 23, Line: 2, Column: 14},
+					},
+					DocString: " test",
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 9, Line: 2, Column: 0},
+						EndPos:   ast.Position{Offset: 25, Line: 2, Column: 16},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("contract C { typealias T = Int }")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+
+		typeAliases := result[0].DeclarationMembers().TypeAliases()
+		require.Len(t, typeAliases, 1)
+		require.Equal(t, "T", typeAliases[0].Identifier.Identifier)
+	})
+
+	t.Run("missing equal sign", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("typealias T Int")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token '='",
+					Pos:     ast.Position{Offset: 12, Line: 1, Column: 12},
+				},
+			},
+			errs,
+		)
+	})
+}
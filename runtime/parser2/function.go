@@ -163,13 +163,27 @@ func parseParameter(p *parser) *ast.Parameter {
 
 	endPos := typeAnnotation.EndPosition()
 
+	// Parse the optional default argument
+
+	var defaultArgument ast.Expression
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenEqual) {
+		// Skip the equal sign
+		p.next()
+
+		defaultArgument = parseExpression(p, lowestBindingPower)
+		endPos = defaultArgument.EndPosition()
+	}
+
 	return &ast.Parameter{
 		Label: argumentLabel,
 		Identifier: ast.Identifier{
 			Identifier: parameterName,
 			Pos:        parameterPos,
 		},
-		TypeAnnotation: typeAnnotation,
+		TypeAnnotation:  typeAnnotation,
+		DefaultArgument: defaultArgument,
 		Range: ast.Range{
 			StartPos: startPos,
 			EndPos:   endPos,
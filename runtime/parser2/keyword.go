@@ -49,6 +49,7 @@ const (
 	keywordAccount     = "account"
 	keywordImport      = "import"
 	keywordFrom        = "from"
+	keywordHash        = "hash"
 	keywordPre         = "pre"
 	keywordPost        = "post"
 	keywordEvent       = "event"
@@ -62,4 +63,5 @@ const (
 	keywordSwitch      = "switch"
 	keywordDefault     = "default"
 	keywordEnum        = "enum"
+	keywordTypeAlias   = "typealias"
 )
@@ -99,6 +99,13 @@ func parseStatement(p *parser) ast.Statement {
 			// The `fun` keyword is ambiguous: it either introduces a function expression
 			// or a function declaration, depending on if an identifier follows, or not.
 			return parseFunctionDeclarationOrFunctionExpressionStatement(p)
+		case keywordLet, keywordVar:
+			// The `let` and `var` keywords either introduce a variable declaration,
+			// or a destructuring declaration, if an opening parenthesis follows.
+			// Variable declarations are parsed as declarations below
+			if isNextTokenParenOpen(p) {
+				return parseDestructuringDeclaration(p)
+			}
 		}
 	}
 
@@ -148,6 +155,178 @@ func parseStatement(p *parser) ast.Statement {
 	}
 }
 
+// isNextTokenParenOpen checks whether the token to follow is an opening parenthesis.
+func isNextTokenParenOpen(p *parser) bool {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	// skip the current token
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// Lookahead the next token
+	return p.current.Is(lexer.TokenParenOpen)
+}
+
+// parseDestructuringDeclaration parses a destructuring declaration.
+//
+//     destructuringDeclaration :
+//         variableKind '(' destructuringElement ( ',' destructuringElement )* ')'
+//         transfer expression
+//
+//     destructuringElement : ( identifier ':' )? identifier
+//
+// The elements must either all have labels, or none.
+//
+func parseDestructuringDeclaration(p *parser) *ast.DestructuringDeclaration {
+
+	startPos := p.current.StartPos
+
+	isLet := p.current.Value == keywordLet
+
+	// Skip the `let` or `var` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// Skip the opening paren
+	p.mustOne(lexer.TokenParenOpen)
+
+	var elements []*ast.DestructuringElement
+
+	expectElement := true
+
+	atEnd := false
+	for !atEnd {
+		p.skipSpaceAndComments(true)
+		switch p.current.Type {
+		case lexer.TokenIdentifier:
+			if !expectElement {
+				panic(fmt.Errorf(
+					"expected comma or end of destructuring declaration, got %s",
+					p.current.Type,
+				))
+			}
+
+			element := parseDestructuringElement(p)
+
+			if len(elements) > 0 &&
+				(element.Label == "") != (elements[0].Label == "") {
+
+				panic(fmt.Errorf(
+					"invalid destructuring element: either all elements or no elements must have a label",
+				))
+			}
+
+			elements = append(elements, element)
+			expectElement = false
+
+		case lexer.TokenComma:
+			if expectElement {
+				panic(fmt.Errorf(
+					"expected element of destructuring declaration, got %s",
+					p.current.Type,
+				))
+			}
+			// Skip the comma
+			p.next()
+			expectElement = true
+
+		case lexer.TokenParenClose:
+			if expectElement {
+				panic(fmt.Errorf(
+					"expected element of destructuring declaration, got %s",
+					p.current.Type,
+				))
+			}
+			// Skip the closing paren
+			p.next()
+			atEnd = true
+
+		case lexer.TokenEOF:
+			panic(fmt.Errorf(
+				"missing %s at end of destructuring declaration",
+				lexer.TokenParenClose,
+			))
+
+		default:
+			if expectElement {
+				panic(fmt.Errorf(
+					"expected element of destructuring declaration, got %s",
+					p.current.Type,
+				))
+			} else {
+				panic(fmt.Errorf(
+					"expected comma or end of destructuring declaration, got %s",
+					p.current.Type,
+				))
+			}
+		}
+	}
+
+	p.skipSpaceAndComments(true)
+	transfer := parseTransfer(p)
+	if transfer == nil {
+		panic(fmt.Errorf("expected transfer"))
+	}
+
+	value := parseExpression(p, lowestBindingPower)
+
+	return &ast.DestructuringDeclaration{
+		IsConstant: isLet,
+		Elements:   elements,
+		Transfer:   transfer,
+		Value:      value,
+		StartPos:   startPos,
+	}
+}
+
+// parseDestructuringElement parses an element of a destructuring declaration.
+//
+//     destructuringElement : ( identifier ':' )? identifier
+//
+func parseDestructuringElement(p *parser) *ast.DestructuringElement {
+
+	identifier := tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenColon) {
+		return &ast.DestructuringElement{
+			Identifier: identifier,
+		}
+	}
+
+	// The first identifier is the label
+
+	labelStartPos := identifier.StartPosition()
+	labelEndPos := identifier.EndPosition()
+
+	// Skip the colon
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenIdentifier) {
+		panic(fmt.Errorf(
+			"expected identifier after label of destructuring element, got %s",
+			p.current.Type,
+		))
+	}
+
+	labeledIdentifier := tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+
+	return &ast.DestructuringElement{
+		Label:         identifier.Identifier,
+		LabelStartPos: &labelStartPos,
+		LabelEndPos:   &labelEndPos,
+		Identifier:    labeledIdentifier,
+	}
+}
+
 func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) ast.Statement {
 
 	startPos := p.current.StartPos
@@ -593,7 +772,7 @@ func parseSwitchCases(p *parser) (cases []*ast.SwitchCase) {
 // parseSwitchCase parses a switch case (hasExpression == true)
 // or default case (hasExpression == false)
 //
-//     switchCase : `case` expression `:` statements
+//     switchCase : `case` ( expression | switchCasePattern ) `:` statements
 //                | `default` `:` statements
 //
 func parseSwitchCase(p *parser, hasExpression bool) *ast.SwitchCase {
@@ -604,8 +783,20 @@ func parseSwitchCase(p *parser, hasExpression bool) *ast.SwitchCase {
 	p.next()
 
 	var expression ast.Expression
+	var pattern *ast.SwitchCasePattern
+
 	if hasExpression {
-		expression = parseExpression(p, lowestBindingPower)
+		p.skipSpaceAndComments(true)
+
+		// The `let` keyword can not start an expression,
+		// so it unambiguously introduces a pattern
+
+		if p.current.IsString(lexer.TokenIdentifier, keywordLet) {
+			pattern = parseSwitchCasePattern(p)
+			p.skipSpaceAndComments(true)
+		} else {
+			expression = parseExpression(p, lowestBindingPower)
+		}
 	} else {
 		p.skipSpaceAndComments(true)
 	}
@@ -649,6 +840,7 @@ func parseSwitchCase(p *parser, hasExpression bool) *ast.SwitchCase {
 
 	return &ast.SwitchCase{
 		Expression: expression,
+		Pattern:    pattern,
 		Statements: statements,
 		Range: ast.Range{
 			StartPos: startPos,
@@ -656,3 +848,70 @@ func parseSwitchCase(p *parser, hasExpression bool) *ast.SwitchCase {
 		},
 	}
 }
+
+// parseSwitchCasePattern parses the pattern of a switch case.
+//
+//     switchCasePattern : 'let' identifier ( '?' | 'as' typeAnnotation )
+//
+func parseSwitchCasePattern(p *parser) *ast.SwitchCasePattern {
+
+	startPos := p.current.StartPos
+
+	// Skip the `let` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenIdentifier) {
+		panic(fmt.Errorf(
+			"expected identifier in switch case pattern, got %s",
+			p.current.Type,
+		))
+	}
+
+	identifier := tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	switch {
+	case p.current.Is(lexer.TokenQuestionMark):
+		endPos := p.current.EndPos
+
+		// Skip the question mark
+		p.next()
+
+		return &ast.SwitchCasePattern{
+			Identifier: identifier,
+			IsOptional: true,
+			Range: ast.Range{
+				StartPos: startPos,
+				EndPos:   endPos,
+			},
+		}
+
+	case p.current.IsString(lexer.TokenIdentifier, keywordAs):
+		// Skip the `as` keyword
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeAnnotation := parseTypeAnnotation(p)
+
+		return &ast.SwitchCasePattern{
+			Identifier:     identifier,
+			TypeAnnotation: typeAnnotation,
+			Range: ast.Range{
+				StartPos: startPos,
+				EndPos:   typeAnnotation.EndPosition(),
+			},
+		}
+
+	default:
+		panic(fmt.Errorf(
+			"expected %s or %q in switch case pattern, got %s",
+			lexer.TokenQuestionMark,
+			keywordAs,
+			p.current.Type,
+		))
+	}
+}
@@ -1242,6 +1242,279 @@ func TestParseSwitchStatement(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("patterns", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("switch x { case let v as Int: a case let o?: b case nil: c }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.SwitchCasePattern{
+								Identifier: ast.Identifier{
+									Identifier: "v",
+									Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 25, Offset: 25},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 25, Offset: 25},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+									EndPos:   ast.Position{Line: 1, Column: 27, Offset: 27},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 30, Offset: 30},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 30, Offset: 30},
+							},
+						},
+						{
+							Pattern: &ast.SwitchCasePattern{
+								Identifier: ast.Identifier{
+									Identifier: "o",
+									Pos:        ast.Position{Line: 1, Column: 41, Offset: 41},
+								},
+								IsOptional: true,
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 37, Offset: 37},
+									EndPos:   ast.Position{Line: 1, Column: 42, Offset: 42},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "b",
+											Pos:        ast.Position{Line: 1, Column: 45, Offset: 45},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 32, Offset: 32},
+								EndPos:   ast.Position{Line: 1, Column: 45, Offset: 45},
+							},
+						},
+						{
+							Expression: &ast.NilExpression{
+								Pos: ast.Position{Line: 1, Column: 52, Offset: 52},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "c",
+											Pos:        ast.Position{Line: 1, Column: 57, Offset: 57},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 47, Offset: 47},
+								EndPos:   ast.Position{Line: 1, Column: 57, Offset: 57},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 59, Offset: 59},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("switch x { case let v: a }")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected '?' or \"as\" in switch case pattern, got ':'",
+					Pos:     ast.Position{Line: 1, Column: 21, Offset: 21},
+				},
+			},
+			errs,
+		)
+	})
+}
+
+func TestParseDestructuringDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("unlabeled", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("let (a, b) = xs")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.DestructuringDeclaration{
+					IsConstant: true,
+					Elements: []*ast.DestructuringElement{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "a",
+								Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+							},
+						},
+						{
+							Identifier: ast.Identifier{
+								Identifier: "b",
+								Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+							},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "xs",
+							Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("labeled, move", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("var (x: a, y: b) <- s")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.DestructuringDeclaration{
+					IsConstant: false,
+					Elements: []*ast.DestructuringElement{
+						{
+							Label:         "x",
+							LabelStartPos: &ast.Position{Line: 1, Column: 5, Offset: 5},
+							LabelEndPos:   &ast.Position{Line: 1, Column: 5, Offset: 5},
+							Identifier: ast.Identifier{
+								Identifier: "a",
+								Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+							},
+						},
+						{
+							Label:         "y",
+							LabelStartPos: &ast.Position{Line: 1, Column: 11, Offset: 11},
+							LabelEndPos:   &ast.Position{Line: 1, Column: 11, Offset: 11},
+							Identifier: ast.Identifier{
+								Identifier: "b",
+								Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+							},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationMove,
+						Pos:       ast.Position{Line: 1, Column: 17, Offset: 17},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "s",
+							Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("mixed labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("let (x: a, b) = s")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid destructuring element: either all elements or no elements must have a label",
+					Pos:     ast.Position{Line: 1, Column: 12, Offset: 12},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing closing paren", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("let (a, b")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "missing ')' at end of destructuring declaration",
+					Pos:     ast.Position{Line: 1, Column: 9, Offset: 9},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing transfer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("let (a, b) xs")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected transfer",
+					Pos:     ast.Position{Line: 1, Column: 11, Offset: 11},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseIfStatementInFunctionDeclaration(t *testing.T) {
@@ -0,0 +1,421 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// ProfileSampleType is a kind of value which is recorded for each call stack of a profile
+//
+type ProfileSampleType int
+
+const (
+	// ProfileSampleTypeStatements is the number of executed statements
+	ProfileSampleTypeStatements ProfileSampleType = iota
+	// ProfileSampleTypeComputation is the number of used computation units,
+	// i.e. executed statements, loop iterations, and function invocations
+	ProfileSampleTypeComputation
+	// ProfileSampleTypeWallTime is the elapsed wall time, in nanoseconds
+	ProfileSampleTypeWallTime
+	profileSampleTypeCount
+)
+
+func (t ProfileSampleType) String() string {
+	switch t {
+	case ProfileSampleTypeStatements:
+		return "statements"
+	case ProfileSampleTypeComputation:
+		return "computation"
+	case ProfileSampleTypeWallTime:
+		return "wall"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (t ProfileSampleType) unit() string {
+	switch t {
+	case ProfileSampleTypeStatements,
+		ProfileSampleTypeComputation:
+
+		return "count"
+	case ProfileSampleTypeWallTime:
+		return "nanoseconds"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+const (
+	profileFrameNameTopLevel     = "<top-level>"
+	profileFrameNameHostFunction = "<host function>"
+	profileFrameNameUnknown      = "<unknown>"
+)
+
+// ProfileFrame is a frame of a profiled call stack, i.e. a function of a program
+//
+type ProfileFrame struct {
+	Location common.Location
+	Function string
+	Line     int
+}
+
+func (f ProfileFrame) String() string {
+	if f.Location == nil {
+		return f.Function
+	}
+	return fmt.Sprintf("%s:%s", f.Location, f.Function)
+}
+
+type profileFrameKey struct {
+	locationID common.LocationID
+	function   string
+	line       int
+}
+
+// profileNode is a node in the tree of profiled call stacks.
+// A node is identified by its parent node and its frame
+//
+type profileNode struct {
+	parent int
+	frame  int
+	values [profileSampleTypeCount]int64
+}
+
+type profileNodeKey struct {
+	parent int
+	frame  int
+}
+
+// profileStackEntry is an entry of the current call stack.
+//
+// Function invocations push a pending entry,
+// which is filled in when the invoked function is entered.
+// If the invoked function is a host function, the entry stays pending
+// until the invoked function returns.
+//
+// The wall time elapsed while an entry is pending is attributed
+// to the entered function, or to the host function
+//
+type profileStackEntry struct {
+	node        int
+	pending     bool
+	pendingTime int64
+}
+
+// noProfileNode is the parent of the root nodes
+//
+const noProfileNode = -1
+
+// Profiler is a deterministic profiler, which attributes executed statements,
+// used computation, and elapsed wall time to the call stacks of programs.
+//
+// The profiler is driven by the interpreter's statement, loop iteration,
+// function invocation, and function entry and exit handlers
+//
+type Profiler struct {
+	frames       []ProfileFrame
+	frameIndices map[profileFrameKey]int
+	nodes        []*profileNode
+	nodeIndices  map[profileNodeKey]int
+	stack        []profileStackEntry
+	// functions are the inspected programs, used to determine function names
+	functions map[common.LocationID]*LocationCoverage
+	now       func() time.Time
+	start     time.Time
+	last      time.Time
+}
+
+func NewProfiler() *Profiler {
+	return &Profiler{
+		frameIndices: map[profileFrameKey]int{},
+		nodeIndices:  map[profileNodeKey]int{},
+		functions:    map[common.LocationID]*LocationCoverage{},
+		now:          time.Now,
+	}
+}
+
+// InterpreterOptions returns the interpreter options which drive the profiler
+//
+func (p *Profiler) InterpreterOptions() []interpreter.Option {
+	return []interpreter.Option{
+		interpreter.WithOnStatementHandler(p.OnStatement),
+		interpreter.WithOnLoopIterationHandler(p.OnLoopIteration),
+		interpreter.WithOnFunctionInvocationHandler(p.OnFunctionInvocation),
+		interpreter.WithOnInvokedFunctionReturnHandler(p.OnInvokedFunctionReturn),
+		interpreter.WithOnFunctionEntryHandler(p.OnFunctionEntry),
+		interpreter.WithOnFunctionExitHandler(p.OnFunctionExit),
+	}
+}
+
+// OnStatement records the execution of a statement
+//
+func (p *Profiler) OnStatement(inter *interpreter.Interpreter, _ ast.Statement) {
+	p.checkpoint()
+
+	node := p.currentNode(inter)
+	node.values[ProfileSampleTypeStatements]++
+	node.values[ProfileSampleTypeComputation]++
+}
+
+// OnLoopIteration records the iteration of a loop
+//
+func (p *Profiler) OnLoopIteration(inter *interpreter.Interpreter, _ int) {
+	p.checkpoint()
+
+	p.currentNode(inter).values[ProfileSampleTypeComputation]++
+}
+
+// OnFunctionInvocation records the invocation of a function.
+// The invoked function is only known once it is entered,
+// so a pending entry is pushed onto the call stack
+//
+func (p *Profiler) OnFunctionInvocation(inter *interpreter.Interpreter, _ int) {
+	p.checkpoint()
+
+	p.currentNode(inter).values[ProfileSampleTypeComputation]++
+
+	frame := p.frame(ProfileFrame{
+		Location: inter.Location,
+		Function: profileFrameNameHostFunction,
+	})
+	p.stack = append(p.stack, profileStackEntry{
+		node:    p.node(p.parentNode(), frame),
+		pending: true,
+	})
+}
+
+// OnInvokedFunctionReturn records the return of an invoked function.
+// If the invoked function was never entered, i.e. it is a host function,
+// its pending entry is popped from the call stack
+//
+func (p *Profiler) OnInvokedFunctionReturn(_ *interpreter.Interpreter, _ int) {
+	p.checkpoint()
+
+	count := len(p.stack)
+	if count > 0 && p.stack[count-1].pending {
+		entry := p.stack[count-1]
+		p.nodes[entry.node].values[ProfileSampleTypeWallTime] += entry.pendingTime
+		p.stack = p.stack[:count-1]
+	}
+}
+
+// OnFunctionEntry records the entry of an interpreted function
+//
+func (p *Profiler) OnFunctionEntry(inter *interpreter.Interpreter, function *interpreter.InterpretedFunctionValue) {
+	p.checkpoint()
+
+	frame := p.frame(p.functionFrame(inter, function))
+
+	// If the function was invoked by an invocation expression,
+	// the pending entry of the invocation is filled in.
+	// Otherwise, e.g. if the function is a transaction's prepare function,
+	// or is called by a host function, a new entry is pushed
+
+	var pendingTime int64
+
+	count := len(p.stack)
+	if count > 0 && p.stack[count-1].pending {
+		pendingTime = p.stack[count-1].pendingTime
+		p.stack = p.stack[:count-1]
+	}
+
+	node := p.node(p.parentNode(), frame)
+	p.nodes[node].values[ProfileSampleTypeWallTime] += pendingTime
+
+	p.stack = append(p.stack, profileStackEntry{
+		node: node,
+	})
+}
+
+// OnFunctionExit records the exit of an interpreted function.
+//
+// Entries which are still pending, e.g. because the execution failed,
+// are popped from the call stack, together with the function's entry
+//
+func (p *Profiler) OnFunctionExit(_ *interpreter.Interpreter, _ *interpreter.InterpretedFunctionValue) {
+	p.checkpoint()
+
+	for len(p.stack) > 0 {
+		count := len(p.stack)
+		entry := p.stack[count-1]
+		p.stack = p.stack[:count-1]
+		if !entry.pending {
+			break
+		}
+	}
+}
+
+// checkpoint attributes the wall time elapsed since the last event
+// to the current call stack, if any
+//
+func (p *Profiler) checkpoint() {
+	now := p.now()
+
+	if p.start.IsZero() {
+		p.start = now
+	}
+
+	count := len(p.stack)
+	if count > 0 {
+		elapsed := int64(now.Sub(p.last))
+		entry := &p.stack[count-1]
+		if entry.pending {
+			entry.pendingTime += elapsed
+		} else {
+			p.nodes[entry.node].values[ProfileSampleTypeWallTime] += elapsed
+		}
+	}
+
+	p.last = now
+}
+
+// parentNode returns the node of the current call stack,
+// or noProfileNode if the call stack is empty
+//
+func (p *Profiler) parentNode() int {
+	count := len(p.stack)
+	if count == 0 {
+		return noProfileNode
+	}
+	return p.stack[count-1].node
+}
+
+// currentNode returns the node of the current call stack.
+// If the call stack is empty, the node of the top-level code of the interpreter's program is returned
+//
+func (p *Profiler) currentNode(inter *interpreter.Interpreter) *profileNode {
+	count := len(p.stack)
+	if count > 0 {
+		return p.nodes[p.stack[count-1].node]
+	}
+
+	frame := p.frame(ProfileFrame{
+		Location: inter.Location,
+		Function: profileFrameNameTopLevel,
+	})
+	return p.nodes[p.node(noProfileNode, frame)]
+}
+
+func (p *Profiler) frame(frame ProfileFrame) int {
+	var locationID common.LocationID
+	if frame.Location != nil {
+		locationID = frame.Location.ID()
+	}
+
+	key := profileFrameKey{
+		locationID: locationID,
+		function:   frame.Function,
+		line:       frame.Line,
+	}
+
+	index, ok := p.frameIndices[key]
+	if !ok {
+		index = len(p.frames)
+		p.frames = append(p.frames, frame)
+		p.frameIndices[key] = index
+	}
+	return index
+}
+
+func (p *Profiler) node(parent int, frame int) int {
+	key := profileNodeKey{
+		parent: parent,
+		frame:  frame,
+	}
+
+	index, ok := p.nodeIndices[key]
+	if !ok {
+		index = len(p.nodes)
+		p.nodes = append(p.nodes, &profileNode{
+			parent: parent,
+			frame:  frame,
+		})
+		p.nodeIndices[key] = index
+	}
+	return index
+}
+
+// functionFrame returns the frame for the given interpreted function.
+// The function's name is determined by inspecting the program of the interpreter
+//
+func (p *Profiler) functionFrame(
+	inter *interpreter.Interpreter,
+	function *interpreter.InterpretedFunctionValue,
+) ProfileFrame {
+
+	frame := ProfileFrame{
+		Location: inter.Location,
+		Function: profileFrameNameUnknown,
+	}
+
+	element := function.Element
+	if element == nil {
+		return frame
+	}
+
+	frame.Line = element.StartPosition().Line
+
+	var locationID common.LocationID
+	if inter.Location != nil {
+		locationID = inter.Location.ID()
+	}
+
+	functions, ok := p.functions[locationID]
+	if !ok {
+		functions = NewLocationCoverage()
+		if inter.Program != nil && inter.Program.Program != nil {
+			functions.inspectProgram(inter.Program.Program)
+		}
+		p.functions[locationID] = functions
+	}
+
+	functionCoverage := functions.functionCoverage(element)
+	if functionCoverage != nil {
+		frame.Function = functionCoverage.Name
+	} else if functionDeclaration, ok := element.(*ast.FunctionDeclaration); ok {
+		frame.Function = functionDeclaration.Identifier.Identifier
+	}
+
+	return frame
+}
+
+// nodeStack returns the frames of the call stack of the given node, from the root to the node
+//
+func (p *Profiler) nodeStack(index int) []ProfileFrame {
+	var frames []ProfileFrame
+	for index != noProfileNode {
+		node := p.nodes[index]
+		frames = append(frames, p.frames[node.frame])
+		index = node.parent
+	}
+
+	for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+
+	return frames
+}
@@ -0,0 +1,281 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteCollapsedStacks writes the profile in the collapsed stack format,
+// which can be rendered as a flame graph, e.g. using flamegraph.pl or speedscope.
+//
+// Each line consists of the semicolon-separated frames of a call stack,
+// from the root to the leaf, followed by the value of the given sample type.
+// Call stacks with a zero value are omitted
+//
+func (p *Profiler) WriteCollapsedStacks(w io.Writer, sampleType ProfileSampleType) error {
+	writer := bufio.NewWriter(w)
+
+	for index, node := range p.nodes {
+		value := node.values[sampleType]
+		if value == 0 {
+			continue
+		}
+
+		frames := p.nodeStack(index)
+		names := make([]string, len(frames))
+		for i, frame := range frames {
+			names[i] = frame.String()
+		}
+
+		_, err := fmt.Fprintf(writer, "%s %d\n", strings.Join(names, ";"), value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+// Field numbers of the pprof profile format,
+// see https://github.com/google/pprof/blob/master/proto/profile.proto
+
+const (
+	pprofProfileSampleType        = 1
+	pprofProfileSample            = 2
+	pprofProfileLocation          = 4
+	pprofProfileFunction          = 5
+	pprofProfileStringTable       = 6
+	pprofProfileTimeNanos         = 9
+	pprofProfileDurationNanos     = 10
+	pprofProfileDefaultSampleType = 14
+
+	pprofValueTypeType = 1
+	pprofValueTypeUnit = 2
+
+	pprofSampleLocationID = 1
+	pprofSampleValue      = 2
+
+	pprofLocationID   = 1
+	pprofLocationLine = 4
+
+	pprofLineFunctionID = 1
+	pprofLineLine       = 2
+
+	pprofFunctionID         = 1
+	pprofFunctionName       = 2
+	pprofFunctionSystemName = 3
+	pprofFunctionFilename   = 4
+	pprofFunctionStartLine  = 5
+)
+
+// WritePprof writes the profile in the gzip-compressed protocol buffer format of pprof,
+// which can be analyzed with `go tool pprof`.
+//
+// Each frame is reported as a function and a location, and each call stack as a sample
+//
+func (p *Profiler) WritePprof(w io.Writer) error {
+
+	stringTable := newPprofStringTable()
+
+	profile := &protobufEncoder{}
+
+	for sampleType := ProfileSampleType(0); sampleType < profileSampleTypeCount; sampleType++ {
+		valueType := &protobufEncoder{}
+		valueType.int64Field(pprofValueTypeType, stringTable.index(sampleType.String()))
+		valueType.int64Field(pprofValueTypeUnit, stringTable.index(sampleType.unit()))
+		profile.messageField(pprofProfileSampleType, valueType)
+	}
+
+	for index, node := range p.nodes {
+		isZero := true
+		for _, value := range node.values {
+			if value != 0 {
+				isZero = false
+				break
+			}
+		}
+		if isZero {
+			continue
+		}
+
+		// Locations are ordered from the leaf to the root
+
+		var locationIDs []uint64
+		for current := index; current != noProfileNode; current = p.nodes[current].parent {
+			locationIDs = append(locationIDs, uint64(p.nodes[current].frame+1))
+		}
+
+		sample := &protobufEncoder{}
+		sample.packedUint64Field(pprofSampleLocationID, locationIDs)
+		sample.packedInt64Field(pprofSampleValue, node.values[:])
+		profile.messageField(pprofProfileSample, sample)
+	}
+
+	for index, frame := range p.frames {
+		id := uint64(index + 1)
+
+		line := &protobufEncoder{}
+		line.uint64Field(pprofLineFunctionID, id)
+		line.int64Field(pprofLineLine, int64(frame.Line))
+
+		location := &protobufEncoder{}
+		location.uint64Field(pprofLocationID, id)
+		location.messageField(pprofLocationLine, line)
+		profile.messageField(pprofProfileLocation, location)
+
+		var filename string
+		if frame.Location != nil {
+			filename = frame.Location.String()
+		}
+
+		name := stringTable.index(frame.Function)
+
+		function := &protobufEncoder{}
+		function.uint64Field(pprofFunctionID, id)
+		function.int64Field(pprofFunctionName, name)
+		function.int64Field(pprofFunctionSystemName, name)
+		function.int64Field(pprofFunctionFilename, stringTable.index(filename))
+		function.int64Field(pprofFunctionStartLine, int64(frame.Line))
+		profile.messageField(pprofProfileFunction, function)
+	}
+
+	if !p.start.IsZero() {
+		profile.int64Field(pprofProfileTimeNanos, p.start.UnixNano())
+		profile.int64Field(pprofProfileDurationNanos, int64(p.last.Sub(p.start)))
+	}
+
+	profile.int64Field(
+		pprofProfileDefaultSampleType,
+		stringTable.index(ProfileSampleTypeComputation.String()),
+	)
+
+	// The string table must be written last,
+	// as the other fields add to it
+
+	for _, s := range stringTable.strings {
+		profile.stringField(pprofProfileStringTable, s)
+	}
+
+	writer := gzip.NewWriter(w)
+
+	_, err := writer.Write(profile.buffer)
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// pprofStringTable is the string table of a pprof profile.
+// The first string must be the empty string
+//
+type pprofStringTable struct {
+	strings []string
+	indices map[string]int64
+}
+
+func newPprofStringTable() *pprofStringTable {
+	return &pprofStringTable{
+		strings: []string{""},
+		indices: map[string]int64{"": 0},
+	}
+}
+
+func (t *pprofStringTable) index(s string) int64 {
+	index, ok := t.indices[s]
+	if !ok {
+		index = int64(len(t.strings))
+		t.strings = append(t.strings, s)
+		t.indices[s] = index
+	}
+	return index
+}
+
+// protobufEncoder is a minimal encoder for the protocol buffer wire format
+//
+type protobufEncoder struct {
+	buffer []byte
+}
+
+const (
+	protobufWireTypeVarint          = 0
+	protobufWireTypeLengthDelimited = 2
+)
+
+func (e *protobufEncoder) varint(value uint64) {
+	for value >= 0x80 {
+		e.buffer = append(e.buffer, byte(value)|0x80)
+		value >>= 7
+	}
+	e.buffer = append(e.buffer, byte(value))
+}
+
+func (e *protobufEncoder) tag(field int, wireType int) {
+	e.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (e *protobufEncoder) uint64Field(field int, value uint64) {
+	if value == 0 {
+		return
+	}
+	e.tag(field, protobufWireTypeVarint)
+	e.varint(value)
+}
+
+func (e *protobufEncoder) int64Field(field int, value int64) {
+	e.uint64Field(field, uint64(value))
+}
+
+func (e *protobufEncoder) bytesField(field int, value []byte) {
+	e.tag(field, protobufWireTypeLengthDelimited)
+	e.varint(uint64(len(value)))
+	e.buffer = append(e.buffer, value...)
+}
+
+// stringField writes the given string, even if it is empty,
+// as the positions of strings in repeated fields are significant
+//
+func (e *protobufEncoder) stringField(field int, value string) {
+	e.bytesField(field, []byte(value))
+}
+
+func (e *protobufEncoder) messageField(field int, message *protobufEncoder) {
+	e.bytesField(field, message.buffer)
+}
+
+func (e *protobufEncoder) packedUint64Field(field int, values []uint64) {
+	packed := &protobufEncoder{}
+	for _, value := range values {
+		packed.varint(value)
+	}
+	e.bytesField(field, packed.buffer)
+}
+
+func (e *protobufEncoder) packedInt64Field(field int, values []int64) {
+	packed := &protobufEncoder{}
+	for _, value := range values {
+		packed.varint(uint64(value))
+	}
+	e.bytesField(field, packed.buffer)
+}
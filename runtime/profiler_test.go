@@ -0,0 +1,193 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// newTestProfiler returns a profiler with a clock which advances by one nanosecond on each event
+//
+func newTestProfiler() *Profiler {
+	profiler := NewProfiler()
+
+	var now time.Time
+	profiler.now = func() time.Time {
+		now = now.Add(time.Nanosecond)
+		return now
+	}
+
+	return profiler
+}
+
+func TestRuntimeProfiler(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	script := []byte(`
+      pub struct S {
+          pub fun double(_ x: Int): Int {
+              return x * 2
+          }
+      }
+
+      pub fun sum(_ values: [Int]): Int {
+          var sum = 0
+          for value in values {
+              sum = sum + value
+          }
+          return sum
+      }
+
+      pub fun main(): Int {
+          let values = [1, 2]
+          values.append(3)
+          return S().double(sum(values))
+      }
+    `)
+
+	profiler := newTestProfiler()
+	runtime.SetProfiler(profiler)
+
+	location := common.StringLocation("test")
+
+	for _, computationLimit := range []uint64{0, 1000} {
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: &testRuntimeInterface{
+					computationLimit: computationLimit,
+				},
+				Location: location,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	var computation bytes.Buffer
+	err := profiler.WriteCollapsedStacks(&computation, ProfileSampleTypeComputation)
+	require.NoError(t, err)
+
+	// Per execution:
+	// - main: 3 statements and 4 invocations (append, S, double, sum)
+	// - sum: 6 statements (3 in the loop body) and 3 loop iterations
+	// - S.double: 1 statement
+
+	assert.Equal(t,
+		"test:main 14\n"+
+			"test:main;test:sum 18\n"+
+			"test:main;test:S.double 2\n",
+		computation.String(),
+	)
+
+	var statements bytes.Buffer
+	err = profiler.WriteCollapsedStacks(&statements, ProfileSampleTypeStatements)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"test:main 6\n"+
+			"test:main;test:sum 12\n"+
+			"test:main;test:S.double 2\n",
+		statements.String(),
+	)
+
+	var wallTime bytes.Buffer
+	err = profiler.WriteCollapsedStacks(&wallTime, ProfileSampleTypeWallTime)
+	require.NoError(t, err)
+
+	assert.Contains(t, wallTime.String(), "test:main;test:<host function> ")
+
+	var pprof bytes.Buffer
+	err = profiler.WritePprof(&pprof)
+	require.NoError(t, err)
+
+	reader, err := gzip.NewReader(&pprof)
+	require.NoError(t, err)
+
+	decoded, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+
+	for _, s := range []string{"computation", "statements", "wall", "main", "sum", "S.double", "test"} {
+		assert.True(t, bytes.Contains(decoded, []byte(s)), s)
+	}
+}
+
+func TestRuntimeProfilerTransaction(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	transaction := []byte(`
+      pub fun check(_ value: Bool) {
+          assert(value)
+      }
+
+      transaction {
+          prepare() {
+              check(true)
+          }
+
+          execute {
+              check(true)
+              check(true)
+          }
+      }
+    `)
+
+	profiler := newTestProfiler()
+	runtime.SetProfiler(profiler)
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: transaction,
+		},
+		Context{
+			Interface: &testRuntimeInterface{},
+			Location:  common.StringLocation("test"),
+		},
+	)
+	require.NoError(t, err)
+
+	var statements bytes.Buffer
+	err = profiler.WriteCollapsedStacks(&statements, ProfileSampleTypeStatements)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"test:prepare 1\n"+
+			"test:prepare;test:check 1\n"+
+			"test:execute 2\n"+
+			"test:execute;test:check 2\n",
+		statements.String(),
+	)
+}
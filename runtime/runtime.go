@@ -19,6 +19,7 @@
 package runtime
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
@@ -82,6 +83,11 @@ type Runtime interface {
 	//
 	SetCoverageReport(coverageReport *CoverageReport)
 
+	// SetProfiler activates profiling with the given profiler.
+	// Passing nil disables profiling (default).
+	//
+	SetProfiler(profiler *Profiler)
+
 	// SetContractUpdateValidationEnabled configures if contract update validation is enabled.
 	//
 	SetContractUpdateValidationEnabled(enabled bool)
@@ -114,6 +120,7 @@ type ImportResolver = func(location common.Location) (program *ast.Program, e er
 var validTopLevelDeclarationsInTransaction = []common.DeclarationKind{
 	common.DeclarationKindImport,
 	common.DeclarationKindFunction,
+	common.DeclarationKindTypeAlias,
 	common.DeclarationKindTransaction,
 }
 
@@ -156,6 +163,7 @@ func reportMetric(
 // interpreterRuntime is a interpreter-based version of the Flow runtime.
 type interpreterRuntime struct {
 	coverageReport                    *CoverageReport
+	profiler                          *Profiler
 	contractUpdateValidationEnabled   bool
 	atreeValidationEnabled            bool
 	tracingEnabled                    bool
@@ -231,6 +239,10 @@ func (r *interpreterRuntime) SetCoverageReport(coverageReport *CoverageReport) {
 	r.coverageReport = coverageReport
 }
 
+func (r *interpreterRuntime) SetProfiler(profiler *Profiler) {
+	r.profiler = profiler
+}
+
 func (r *interpreterRuntime) SetContractUpdateValidationEnabled(enabled bool) {
 	r.contractUpdateValidationEnabled = enabled
 }
@@ -810,7 +822,12 @@ func validateArgumentParams(
 	argumentCount := len(arguments)
 	parameterCount := len(parameters)
 
-	if argumentCount != parameterCount {
+	// Arguments may be omitted for trailing parameters which have a default argument,
+	// the interpreter fills them in when invoking the entry point
+
+	if argumentCount > parameterCount ||
+		(argumentCount < parameterCount && parameters[argumentCount].DefaultArgument == nil) {
+
 		return nil, InvalidEntryPointParameterCountError{
 			Expected: parameterCount,
 			Actual:   argumentCount,
@@ -820,7 +837,7 @@ func validateArgumentParams(
 	argumentValues := make([]interpreter.Value, len(arguments))
 
 	// Decode arguments against parameter types
-	for i, parameter := range parameters {
+	for i, parameter := range parameters[:argumentCount] {
 		parameterType := parameter.TypeAnnotation.Type
 		argument := arguments[i]
 
@@ -911,6 +928,8 @@ func hasValidStaticType(value interpreter.Value) bool {
 	case *interpreter.DictionaryValue:
 		return value.Type.KeyType != nil &&
 			value.Type.ValueType != nil
+	case *interpreter.SetValue:
+		return value.Type.ElementType != nil
 	default:
 		// For other values, static type is NOT inferred.
 		// Hence no need to validate it here.
@@ -1091,6 +1110,11 @@ func (r *interpreterRuntime) check(
 								defer delete(checkedImports, importedLocation.ID())
 							}
 
+							err := r.checkImportHashes(checker, context)
+							if err != nil {
+								return nil, err
+							}
+
 							program, err := r.getProgram(context, functions, values, checkerOptions, checkedImports)
 							if err != nil {
 								return nil, err
@@ -1141,6 +1165,8 @@ func (r *interpreterRuntime) newInterpreter(
 	checkerOptions []sema.Option,
 ) (*interpreter.Interpreter, error) {
 
+	r.inspectProgramCoverage(context.Location, program)
+
 	preDeclaredValues := functions.ToInterpreterValueDeclarations()
 	preDeclaredValues = append(preDeclaredValues, values.ToInterpreterValueDeclarations()...)
 
@@ -1212,6 +1238,24 @@ func (r *interpreterRuntime) newInterpreter(
 		interpreter.WithOnStatementHandler(
 			r.onStatementHandler(),
 		),
+		interpreter.WithOnLoopIterationHandler(
+			r.onLoopIterationHandler(),
+		),
+		interpreter.WithOnFunctionInvocationHandler(
+			r.onFunctionInvocationHandler(),
+		),
+		interpreter.WithOnInvokedFunctionReturnHandler(
+			r.onInvokedFunctionReturnHandler(),
+		),
+		interpreter.WithOnFunctionEntryHandler(
+			r.onFunctionEntryHandler(),
+		),
+		interpreter.WithOnFunctionExitHandler(
+			r.onFunctionExitHandler(),
+		),
+		interpreter.WithOnBranchHandler(
+			r.onBranchHandler(),
+		),
 		interpreter.WithPublicAccountHandler(
 			func(_ *interpreter.Interpreter, address interpreter.AddressValue) interpreter.Value {
 				return r.getPublicAccount(
@@ -1351,6 +1395,8 @@ func (r *interpreterRuntime) importLocationHandler(
 				panic(err)
 			}
 
+			r.inspectProgramCoverage(location, program)
+
 			subInterpreter, err := inter.NewSubInterpreter(program, location)
 			if err != nil {
 				panic(err)
@@ -1507,19 +1553,40 @@ func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interfa
 		})
 	}
 
+	// NOTE: the metering handlers replace the default handlers,
+	// e.g. the handlers for coverage reporting and profiling,
+	// so call them, if any
+
+	onStatement := r.onStatementHandler()
+	onLoopIteration := r.onLoopIterationHandler()
+	onFunctionInvocation := r.onFunctionInvocationHandler()
+	onInvokedFunctionReturn := r.onInvokedFunctionReturnHandler()
+
 	return []interpreter.Option{
 		interpreter.WithOnStatementHandler(
-			func(_ *interpreter.Interpreter, _ ast.Statement) {
+			func(inter *interpreter.Interpreter, statement ast.Statement) {
+				if onStatement != nil {
+					onStatement(inter, statement)
+				}
+
 				checkComputationLimit(1)
 			},
 		),
 		interpreter.WithOnLoopIterationHandler(
-			func(_ *interpreter.Interpreter, _ int) {
+			func(inter *interpreter.Interpreter, line int) {
+				if onLoopIteration != nil {
+					onLoopIteration(inter, line)
+				}
+
 				checkComputationLimit(1)
 			},
 		),
 		interpreter.WithOnFunctionInvocationHandler(
-			func(_ *interpreter.Interpreter, _ int) {
+			func(inter *interpreter.Interpreter, line int) {
+				if onFunctionInvocation != nil {
+					onFunctionInvocation(inter, line)
+				}
+
 				callStackDepth++
 				checkCallStackDepth()
 
@@ -1527,7 +1594,11 @@ func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interfa
 			},
 		),
 		interpreter.WithOnInvokedFunctionReturnHandler(
-			func(_ *interpreter.Interpreter, _ int) {
+			func(inter *interpreter.Interpreter, line int) {
+				if onInvokedFunctionReturn != nil {
+					onInvokedFunctionReturn(inter, line)
+				}
+
 				callStackDepth--
 			},
 		),
@@ -1602,6 +1673,71 @@ func (r *interpreterRuntime) getCode(context Context) (code []byte, err error) {
 	return code, nil
 }
 
+// checkImportHashes checks the hash of the code at the context's location
+// against the hashes expected by the import declarations of the importing program.
+//
+// The code is hashed with the runtime interface, using SHA3-256
+//
+func (r *interpreterRuntime) checkImportHashes(checker *sema.Checker, context Context) error {
+	expectedHashes := ImportDeclarationHashes(checker, context.Location)
+	if len(expectedHashes) == 0 {
+		return nil
+	}
+
+	code, err := r.getCode(context)
+	if err != nil {
+		return err
+	}
+
+	var actualHash []byte
+	wrapPanic(func() {
+		actualHash, err = context.Interface.Hash(code, "", sema.HashAlgorithmSHA3_256)
+	})
+	if err != nil {
+		return err
+	}
+
+	return CheckImportHashes(context.Location, actualHash, expectedHashes)
+}
+
+// CheckImportHashes returns an ImportHashMismatchError if the given actual hash
+// of the code imported from the given location does not match all of the given expected hashes
+//
+func CheckImportHashes(location common.Location, actualHash []byte, expectedHashes [][]byte) error {
+	for _, expectedHash := range expectedHashes {
+		if !bytes.Equal(expectedHash, actualHash) {
+			return &ImportHashMismatchError{
+				Location:     location,
+				ExpectedHash: expectedHash,
+				ActualHash:   actualHash,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ImportDeclarationHashes returns the hashes expected by the import declarations
+// of the given checker's program, which resolved to the given location
+//
+func ImportDeclarationHashes(checker *sema.Checker, location common.Location) (hashes [][]byte) {
+	for _, declaration := range checker.Program.ImportDeclarations() {
+		if declaration.Hash == nil {
+			continue
+		}
+
+		resolvedLocations := checker.Elaboration.ImportDeclarationsResolvedLocations[declaration]
+		for _, resolvedLocation := range resolvedLocations {
+			if resolvedLocation.Location == location {
+				hashes = append(hashes, declaration.Hash)
+				break
+			}
+		}
+	}
+
+	return hashes
+}
+
 // emitEvent converts an event value to native Go types and emits it to the runtime interface.
 func (r *interpreterRuntime) emitEvent(
 	inter *interpreter.Interpreter,
@@ -1666,9 +1802,15 @@ func (r *interpreterRuntime) emitAccountEvent(
 	}
 }
 
-func CodeToHashValue(inter *interpreter.Interpreter, code []byte) *interpreter.ArrayValue {
+// CodeHash returns the SHA3-256 hash of the given code
+//
+func CodeHash(code []byte) []byte {
 	codeHash := sha3.Sum256(code)
-	return interpreter.ByteSliceToByteArrayValue(inter, codeHash[:])
+	return codeHash[:]
+}
+
+func CodeToHashValue(inter *interpreter.Interpreter, code []byte) *interpreter.ArrayValue {
+	return interpreter.ByteSliceToByteArrayValue(inter, CodeHash(code))
 }
 
 func (r *interpreterRuntime) newCreateAccountFunction(
@@ -2876,15 +3018,86 @@ func (r *interpreterRuntime) newAccountContractsGetNamesFunction(
 }
 
 func (r *interpreterRuntime) onStatementHandler() interpreter.OnStatementFunc {
-	if r.coverageReport == nil {
+	if r.coverageReport == nil && r.profiler == nil {
 		return nil
 	}
 
 	return func(inter *interpreter.Interpreter, statement ast.Statement) {
-		location := inter.Location
-		line := statement.StartPosition().Line
-		r.coverageReport.AddLineHit(location, line)
+		if r.coverageReport != nil {
+			r.coverageReport.AddStatementHit(inter.Location, statement)
+		}
+		if r.profiler != nil {
+			r.profiler.OnStatement(inter, statement)
+		}
+	}
+}
+
+func (r *interpreterRuntime) onLoopIterationHandler() interpreter.OnLoopIterationFunc {
+	if r.profiler == nil {
+		return nil
+	}
+
+	return r.profiler.OnLoopIteration
+}
+
+func (r *interpreterRuntime) onFunctionInvocationHandler() interpreter.OnFunctionInvocationFunc {
+	if r.profiler == nil {
+		return nil
 	}
+
+	return r.profiler.OnFunctionInvocation
+}
+
+func (r *interpreterRuntime) onInvokedFunctionReturnHandler() interpreter.OnInvokedFunctionReturnFunc {
+	if r.profiler == nil {
+		return nil
+	}
+
+	return r.profiler.OnInvokedFunctionReturn
+}
+
+func (r *interpreterRuntime) onFunctionEntryHandler() interpreter.OnFunctionEntryFunc {
+	if r.coverageReport == nil && r.profiler == nil {
+		return nil
+	}
+
+	return func(inter *interpreter.Interpreter, function *interpreter.InterpretedFunctionValue) {
+		if r.coverageReport != nil && function.Element != nil {
+			r.coverageReport.AddFunctionHit(inter.Location, function.Element)
+		}
+		if r.profiler != nil {
+			r.profiler.OnFunctionEntry(inter, function)
+		}
+	}
+}
+
+func (r *interpreterRuntime) onFunctionExitHandler() interpreter.OnFunctionExitFunc {
+	if r.profiler == nil {
+		return nil
+	}
+
+	return r.profiler.OnFunctionExit
+}
+
+func (r *interpreterRuntime) onBranchHandler() interpreter.OnBranchFunc {
+	if r.coverageReport == nil {
+		return nil
+	}
+
+	return func(inter *interpreter.Interpreter, element ast.Element, branch int) {
+		r.coverageReport.AddBranchHit(inter.Location, element, branch)
+	}
+}
+
+// inspectProgramCoverage records the coverable elements of the given program,
+// if coverage reporting is enabled
+//
+func (r *interpreterRuntime) inspectProgramCoverage(location common.Location, program *interpreter.Program) {
+	if r.coverageReport == nil || program == nil {
+		return
+	}
+
+	r.coverageReport.InspectProgram(location, program.Program)
 }
 
 func (r *interpreterRuntime) executeNonProgram(interpret interpretFunc, context Context) (cadence.Value, error) {
@@ -35,6 +35,7 @@ import (
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
@@ -543,6 +544,99 @@ func TestRuntimeImport(t *testing.T) {
 	require.Equal(t, transactionCount+1, checkCount)
 }
 
+func TestRuntimeImportHash(t *testing.T) {
+
+	t.Parallel()
+
+	importedScript := []byte(`
+      pub fun answer(): Int {
+          return 42
+      }
+    `)
+
+	importedScriptHash := sha3.Sum256(importedScript)
+
+	newRuntimeInterface := func() *testRuntimeInterface {
+		return &testRuntimeInterface{
+			getCode: func(location Location) (bytes []byte, err error) {
+				switch location {
+				case common.StringLocation("imported"):
+					return importedScript, nil
+				default:
+					return nil, fmt.Errorf("unknown import location: %s", location)
+				}
+			},
+			hash: func(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error) {
+				require.Equal(t, "", tag)
+				require.Equal(t, HashAlgorithmSHA3_256, hashAlgorithm)
+
+				hash := sha3.Sum256(data)
+				return hash[:], nil
+			},
+		}
+	}
+
+	executeScript := func(hash []byte) (cadence.Value, error) {
+		script := []byte(fmt.Sprintf(
+			`
+              import "imported" (hash: "%x")
+
+              pub fun main(): Int {
+                  return answer()
+              }
+            `,
+			hash,
+		))
+
+		runtime := newTestInterpreterRuntime()
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: newRuntimeInterface(),
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	t.Run("matching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		value, err := executeScript(importedScriptHash[:])
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewInt(42), value)
+	})
+
+	t.Run("mismatching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		mismatchingHash := make([]byte, 32)
+
+		_, err := executeScript(mismatchingHash)
+		require.Error(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+
+		errs := checker.ExpectCheckerErrors(t, checkerErr, 2)
+
+		var importedProgramErr *sema.ImportedProgramError
+		require.ErrorAs(t, errs[0], &importedProgramErr)
+
+		var mismatchErr *ImportHashMismatchError
+		require.ErrorAs(t, importedProgramErr.Err, &mismatchErr)
+
+		assert.Equal(t, common.StringLocation("imported"), mismatchErr.Location)
+		assert.Equal(t, mismatchingHash, mismatchErr.ExpectedHash)
+		assert.Equal(t, importedScriptHash[:], mismatchErr.ActualHash)
+	})
+}
+
 func TestRuntimeConcurrentImport(t *testing.T) {
 
 	t.Parallel()
@@ -1211,6 +1305,30 @@ func TestRuntimeScriptArguments(t *testing.T) {
 			},
 			expectedLogs: []string{"42", `"foo"`},
 		},
+		{
+			name: "Default argument",
+			script: `
+                pub fun main(x: Int, y: String = "bar") {
+                    log(x)
+                    log(y)
+                }
+            `,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewInt(42)),
+			},
+			expectedLogs: []string{"42", `"bar"`},
+		},
+		{
+			name: "Missing argument",
+			script: `
+                pub fun main(x: Int, y: String = "bar") {}
+            `,
+			args: nil,
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.IsType(t, InvalidEntryPointParameterCountError{}, errors.Unwrap(err))
+			},
+		},
 		{
 			name: "Invalid bytes",
 			script: `
@@ -4477,6 +4595,41 @@ func TestRuntimeTransactionTopLevelDeclarations(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("transaction with type alias", func(t *testing.T) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(`
+          pub typealias Count = Int
+
+          transaction {
+              let count: Count
+
+              prepare() {
+                  self.count = 1
+              }
+          }
+        `)
+
+		runtimeInterface := &testRuntimeInterface{
+			getSigningAccounts: func() ([]Address, error) {
+				return nil, nil
+			},
+		}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	})
+
 	t.Run("transaction with resource", func(t *testing.T) {
 		runtime := newTestInterpreterRuntime()
 
@@ -41,6 +41,12 @@ const AuthAccountGetCapabilityField = "getCapability"
 const AuthAccountGetLinkTargetField = "getLinkTarget"
 const AuthAccountContractsField = "contracts"
 const AuthAccountKeysField = "keys"
+const AuthAccountStoragePathsField = "storagePaths"
+const AuthAccountPublicPathsField = "publicPaths"
+const AuthAccountPrivatePathsField = "privatePaths"
+const AuthAccountForEachStoredField = "forEachStored"
+const AuthAccountForEachPublicField = "forEachPublic"
+const AuthAccountForEachPrivateField = "forEachPrivate"
 
 // AuthAccountType represents the authorized access to an account.
 // Access to an AuthAccount means having full access to its storage, public keys, and code.
@@ -171,6 +177,42 @@ var AuthAccountType = func() *CompositeType {
 			AuthAccountKeysType,
 			accountTypeKeysFieldDocString,
 		),
+		NewPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountStoragePathsField,
+			AuthAccountStoragePathsType,
+			authAccountTypeStoragePathsFieldDocString,
+		),
+		NewPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountPublicPathsField,
+			AccountPublicPathsType,
+			accountTypePublicPathsFieldDocString,
+		),
+		NewPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountPrivatePathsField,
+			AuthAccountPrivatePathsType,
+			authAccountTypePrivatePathsFieldDocString,
+		),
+		NewPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachStoredField,
+			AuthAccountForEachStoredFunctionType,
+			authAccountTypeForEachStoredFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachPublicField,
+			AccountForEachPublicFunctionType,
+			accountTypeForEachPublicFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachPrivateField,
+			AuthAccountForEachPrivateFunctionType,
+			authAccountTypeForEachPrivateFunctionDocString,
+		),
 	}
 
 	authAccountType.Members = GetMembersAsMap(members)
@@ -521,6 +563,58 @@ var AccountTypeGetLinkTargetFunctionType = &FunctionType{
 	),
 }
 
+var AuthAccountStoragePathsType = &VariableSizedType{
+	Type: StoragePathType,
+}
+
+var AccountPublicPathsType = &VariableSizedType{
+	Type: PublicPathType,
+}
+
+var AuthAccountPrivatePathsType = &VariableSizedType{
+	Type: PrivatePathType,
+}
+
+// accountForEachFunctionType returns the type of a function
+// which iterates over the paths of the given type in an account.
+// The iteration function is called with each path and the type of the object stored under it,
+// and continues the iteration if it returns true
+//
+func accountForEachFunctionType(pathType Type) *FunctionType {
+	iterationFunctionType := &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "path",
+				TypeAnnotation: NewTypeAnnotation(pathType),
+			},
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "type",
+				TypeAnnotation: NewTypeAnnotation(MetaType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+	}
+
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(iterationFunctionType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
+var AuthAccountForEachStoredFunctionType = accountForEachFunctionType(StoragePathType)
+
+var AccountForEachPublicFunctionType = accountForEachFunctionType(PublicPathType)
+
+var AuthAccountForEachPrivateFunctionType = accountForEachFunctionType(PrivatePathType)
+
 // AuthAccountKeysType represents the keys associated with an auth account.
 var AuthAccountKeysType = func() *CompositeType {
 
@@ -650,3 +744,39 @@ Retrieves the key at the given index of the account.
 const authAccountKeysTypeRevokeFunctionDocString = `
 Revokes the key at the given index of the account.
 `
+
+const authAccountTypeStoragePathsFieldDocString = `
+All storage paths of the account, under which objects are stored
+`
+
+const accountTypePublicPathsFieldDocString = `
+All public paths of the account, under which capabilities are linked
+`
+
+const authAccountTypePrivatePathsFieldDocString = `
+All private paths of the account, under which capabilities are linked
+`
+
+const authAccountTypeForEachStoredFunctionDocString = `
+Iterates over all objects in the account's storage, calling the given function with the storage path and the type of each object.
+
+The objects are not loaded. The iteration stops when the function returns false.
+
+Objects which are saved during the iteration are not visited, and objects which are removed during the iteration are skipped
+`
+
+const accountTypeForEachPublicFunctionDocString = `
+Iterates over all public links of the account, calling the given function with the public path and the type of each capability.
+
+The iteration stops when the function returns false.
+
+Links which are created during the iteration are not visited, and links which are removed during the iteration are skipped
+`
+
+const authAccountTypeForEachPrivateFunctionDocString = `
+Iterates over all private links of the account, calling the given function with the private path and the type of each capability.
+
+The iteration stops when the function returns false.
+
+Links which are created during the iteration are not visited, and links which are removed during the iteration are skipped
+`
@@ -26,6 +26,12 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 
 	expectedType := UnwrapOptionalType(checker.expectedType)
 
+	// If a set is expected, the array literal is a set literal
+
+	if setType, ok := expectedType.(*SetType); ok && setType.ElementType != nil {
+		return checker.checkSetLiteral(expression, setType)
+	}
+
 	var elementType Type
 	var resultType ArrayType
 
@@ -100,3 +106,20 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 
 	return resultType
 }
+
+func (checker *Checker) checkSetLiteral(expression *ast.ArrayExpression, setType *SetType) Type {
+
+	// visit all elements, ensure they are all of the element type.
+	// Set elements are never resources, so they do not need to be moved
+
+	argumentTypes := make([]Type, len(expression.Values))
+
+	for i, value := range expression.Values {
+		argumentTypes[i] = checker.VisitExpression(value, setType.ElementType)
+	}
+
+	checker.Elaboration.ArrayExpressionArgumentTypes[expression] = argumentTypes
+	checker.Elaboration.ArrayExpressionSetType[expression] = setType
+
+	return setType
+}
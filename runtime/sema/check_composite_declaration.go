@@ -197,6 +197,10 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	for _, nestedComposite := range declaration.Members.Composites() {
 		nestedComposite.Accept(checker)
 	}
+
+	for _, nestedTypeAlias := range declaration.Members.TypeAliases() {
+		nestedTypeAlias.Accept(checker)
+	}
 }
 
 // declareCompositeNestedTypes declares the types nested in a composite,
@@ -283,6 +287,8 @@ func (checker *Checker) declareCompositeNestedTypes(
 			}
 		}
 	})
+
+	checker.redeclareTypeAliases(declaration.Members.TypeAliases())
 }
 
 func (checker *Checker) declareNestedDeclarations(
@@ -521,7 +527,11 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		// and after declaring nested types as the initializer may use nested type in parameters
 
 		initializers := declaration.Members.Initializers()
-		compositeType.ConstructorParameters = checker.initializerParameters(initializers)
+		compositeType.ConstructorParameters = checker.initializerParameters(
+			initializers,
+			kind == ContainerKindComposite &&
+				supportsInitializerOverloading(declaration.CompositeKind),
+		)
 
 		// Declare nested declarations' members
 
@@ -553,18 +563,39 @@ func (checker *Checker) declareCompositeMembersAndValue(
 			nestedCompositeDeclarationVariable :=
 				checker.valueActivations.Find(identifier.Identifier)
 
+			member := &Member{
+				Identifier:            identifier,
+				Access:                nestedCompositeDeclaration.Access,
+				ContainerType:         compositeType,
+				TypeAnnotation:        NewTypeAnnotation(nestedCompositeDeclarationVariable.Type),
+				DeclarationKind:       nestedCompositeDeclarationVariable.DeclarationKind,
+				VariableKind:          ast.VariableKindConstant,
+				IgnoreInSerialization: true,
+				DocString:             nestedCompositeDeclaration.DocString,
+			}
+
+			// Declare the overloads of the constructor, if any
+
+			for _, overload := range nestedCompositeDeclarationVariable.Overloads {
+				member.Overloads = append(member.Overloads,
+					&Member{
+						Identifier:            identifier,
+						Access:                nestedCompositeDeclaration.Access,
+						ContainerType:         compositeType,
+						TypeAnnotation:        NewTypeAnnotation(overload.Type),
+						DeclarationKind:       overload.DeclarationKind,
+						VariableKind:          ast.VariableKindConstant,
+						ArgumentLabels:        overload.ArgumentLabels,
+						IgnoreInSerialization: true,
+						DocString:             overload.DocString,
+					},
+				)
+			}
+
 			declarationMembers.Set(
 				nestedCompositeDeclarationVariable.Identifier,
-				&Member{
-					Identifier:            identifier,
-					Access:                nestedCompositeDeclaration.Access,
-					ContainerType:         compositeType,
-					TypeAnnotation:        NewTypeAnnotation(nestedCompositeDeclarationVariable.Type),
-					DeclarationKind:       nestedCompositeDeclarationVariable.DeclarationKind,
-					VariableKind:          ast.VariableKindConstant,
-					IgnoreInSerialization: true,
-					DocString:             nestedCompositeDeclaration.DocString,
-				})
+				member,
+			)
 		}
 
 		// Declare implicit type requirement conformances, if any,
@@ -710,6 +741,59 @@ func (checker *Checker) declareCompositeConstructor(
 		allowOuterScopeShadowing: false,
 	})
 	checker.report(err)
+
+	checker.declareConstructorOverloads(declaration, constructorType)
+}
+
+// declareConstructorOverloads declares the constructors of the initializers
+// which overload the first initializer as overloads of the constructor.
+//
+// The function types of the initializers were determined in `declareInitializerOverloads`
+//
+func (checker *Checker) declareConstructorOverloads(
+	declaration *ast.CompositeDeclaration,
+	constructorType *FunctionType,
+) {
+	initializers := declaration.Members.Initializers()
+	if len(initializers) < 2 {
+		return
+	}
+
+	identifier := declaration.Identifier.Identifier
+
+	for _, initializer := range initializers[1:] {
+		initializerType, ok := checker.Elaboration.ConstructorFunctionTypes[initializer]
+		if !ok {
+			continue
+		}
+
+		argumentLabels := initializer.FunctionDeclaration.ParameterList.EffectiveArgumentLabels()
+
+		overload := checker.valueActivations.DeclareOverload(variableDeclaration{
+			identifier: identifier,
+			ty: &FunctionType{
+				IsConstructor:         true,
+				Parameters:            initializerType.Parameters,
+				ReturnTypeAnnotation:  constructorType.ReturnTypeAnnotation,
+				RequiredArgumentCount: initializerType.RequiredArgumentCount,
+				Members:               constructorType.Members,
+			},
+			docString:      initializer.FunctionDeclaration.DocString,
+			access:         declaration.Access,
+			kind:           declaration.DeclarationKind(),
+			pos:            declaration.Identifier.Pos,
+			isConstant:     true,
+			argumentLabels: argumentLabels,
+		})
+		if overload == nil {
+			continue
+		}
+
+		checker.Elaboration.ConstructorOverloadNames[initializer] =
+			OverloadedFunctionName(identifier, argumentLabels)
+
+		checker.checkVariableOverloadAmbiguity(overload, initializer)
+	}
 }
 
 func (checker *Checker) declareContractValue(
@@ -841,8 +925,15 @@ func (checker *Checker) checkMemberStorability(members *StringMemberOrderedMap)
 	})
 }
 
-func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctionDeclaration) []*Parameter {
-	// TODO: support multiple overloaded initializers
+// initializerParameters returns the parameters of the first initializer.
+//
+// If overloading is allowed, the function types of all further initializers are determined,
+// otherwise declaring more than one initializer is reported as an error
+//
+func (checker *Checker) initializerParameters(
+	initializers []*ast.SpecialFunctionDeclaration,
+	allowOverloading bool,
+) []*Parameter {
 	var parameters []*Parameter
 
 	initializerCount := len(initializers)
@@ -851,19 +942,76 @@ func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctio
 		parameters = checker.parameters(firstInitializer.FunctionDeclaration.ParameterList)
 
 		if initializerCount > 1 {
-			secondInitializer := initializers[1]
+			if allowOverloading {
+				checker.declareInitializerOverloads(initializers)
+			} else {
+				secondInitializer := initializers[1]
 
-			checker.report(
-				&UnsupportedOverloadingError{
-					DeclarationKind: common.DeclarationKindInitializer,
-					Range:           ast.NewRangeFromPositioned(secondInitializer),
-				},
-			)
+				checker.report(
+					&UnsupportedOverloadingError{
+						DeclarationKind: common.DeclarationKindInitializer,
+						Range:           ast.NewRangeFromPositioned(secondInitializer),
+					},
+				)
+			}
 		}
 	}
 	return parameters
 }
 
+// supportsInitializerOverloading returns true if composites of the given kind
+// may declare multiple initializers with different argument labels
+//
+func supportsInitializerOverloading(kind common.CompositeKind) bool {
+	switch kind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource:
+
+		return true
+	}
+
+	return false
+}
+
+// declareInitializerOverloads determines the function types of the initializers
+// which overload the first initializer.
+//
+// Each initializer must have different argument labels
+//
+func (checker *Checker) declareInitializerOverloads(initializers []*ast.SpecialFunctionDeclaration) {
+
+	argumentLabels := make([][]string, 0, len(initializers))
+
+	for i, initializer := range initializers {
+		parameterList := initializer.FunctionDeclaration.ParameterList
+		initializerArgumentLabels := parameterList.EffectiveArgumentLabels()
+
+		if i > 0 {
+			if isOverload(initializerArgumentLabels, argumentLabels) {
+				parameters := checker.parameters(parameterList)
+
+				checker.Elaboration.ConstructorFunctionTypes[initializer] =
+					&FunctionType{
+						IsConstructor:         true,
+						Parameters:            parameters,
+						ReturnTypeAnnotation:  NewTypeAnnotation(VoidType),
+						RequiredArgumentCount: requiredArgumentCount(parameters),
+					}
+			} else {
+				checker.report(
+					&RedeclarationError{
+						Kind: common.DeclarationKindInitializer,
+						Name: common.DeclarationKindInitializer.Keywords(),
+						Pos:  initializer.StartPosition(),
+					},
+				)
+			}
+		}
+
+		argumentLabels = append(argumentLabels, initializerArgumentLabels)
+	}
+}
+
 func (checker *Checker) explicitInterfaceConformances(
 	declaration *ast.CompositeDeclaration,
 	compositeType *CompositeType,
@@ -989,9 +1137,10 @@ func (checker *Checker) checkCompositeConformance(
 		)
 	}
 
-	// Check initializer requirement
-
-	// TODO: add support for overloaded initializers
+	// Check initializer requirement.
+	//
+	// NOTE: The initializer requirement must be satisfied by the first initializer,
+	//   overloads of it are not considered
 
 	if interfaceType.InitializerParameters != nil {
 
@@ -1032,13 +1181,27 @@ func (checker *Checker) checkCompositeConformance(
 			return
 		}
 
-		if !checker.memberSatisfied(compositeMember, interfaceMember) {
-			memberMismatches = append(memberMismatches,
-				MemberMismatch{
-					CompositeMember: compositeMember,
-					InterfaceMember: interfaceMember,
-				},
-			)
+		// Each overload of an interface function must be satisfied
+		// by the overload of the composite function with the same argument labels.
+		// If there is no such overload, the first declaration must satisfy it
+
+		interfaceMembers := append([]*Member{interfaceMember}, interfaceMember.Overloads...)
+
+		for _, interfaceMember := range interfaceMembers {
+
+			compositeMemberOverload := compositeMember.Overload(interfaceMember.ArgumentLabels)
+			if compositeMemberOverload == nil {
+				compositeMemberOverload = compositeMember
+			}
+
+			if !checker.memberSatisfied(compositeMemberOverload, interfaceMember) {
+				memberMismatches = append(memberMismatches,
+					MemberMismatch{
+						CompositeMember: compositeMemberOverload,
+						InterfaceMember: interfaceMember,
+					},
+				)
+			}
 		}
 	})
 
@@ -1316,7 +1479,9 @@ func (checker *Checker) compositeConstructorType(
 		ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
 	}
 
-	// TODO: support multiple overloaded initializers
+	// NOTE: The constructor is the constructor of the first initializer.
+	//   Constructors of further initializers are declared as overloads,
+	//   see `declareConstructorOverloads`
 
 	initializers := compositeDeclaration.Members.Initializers()
 	if len(initializers) > 0 {
@@ -1328,15 +1493,17 @@ func (checker *Checker) compositeConstructorType(
 			EffectiveArgumentLabels()
 
 		constructorFunctionType.Parameters = compositeType.ConstructorParameters
+		constructorFunctionType.RequiredArgumentCount = requiredArgumentCount(compositeType.ConstructorParameters)
 
 		// NOTE: Don't use `constructorFunctionType`, as it has a return type.
 		//   The initializer itself has a `Void` return type.
 
 		checker.Elaboration.ConstructorFunctionTypes[firstInitializer] =
 			&FunctionType{
-				IsConstructor:        true,
-				Parameters:           constructorFunctionType.Parameters,
-				ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+				IsConstructor:         true,
+				Parameters:            constructorFunctionType.Parameters,
+				ReturnTypeAnnotation:  NewTypeAnnotation(VoidType),
+				RequiredArgumentCount: constructorFunctionType.RequiredArgumentCount,
 			}
 	}
 
@@ -1471,6 +1638,9 @@ func (checker *Checker) defaultMembersAndOrigins(
 	}
 
 	// declare a member for each function
+
+	firstFunctions := map[string]*ast.FunctionDeclaration{}
+
 	for _, function := range functions {
 		if !checkInvalidIdentifier(function) {
 			continue
@@ -1501,25 +1671,63 @@ func (checker *Checker) defaultMembersAndOrigins(
 			)
 		}
 
-		members.Set(
-			identifier,
-			&Member{
-				ContainerType:   containerType,
-				Access:          function.Access,
-				Identifier:      function.Identifier,
-				DeclarationKind: declarationKind,
-				TypeAnnotation:  fieldTypeAnnotation,
-				VariableKind:    ast.VariableKindConstant,
-				ArgumentLabels:  argumentLabels,
-				DocString:       function.DocString,
-			})
+		member := &Member{
+			ContainerType:   containerType,
+			Access:          function.Access,
+			Identifier:      function.Identifier,
+			DeclarationKind: declarationKind,
+			TypeAnnotation:  fieldTypeAnnotation,
+			VariableKind:    ast.VariableKindConstant,
+			ArgumentLabels:  argumentLabels,
+			DocString:       function.DocString,
+		}
+
+		// A function with the same identifier as a previously declared function
+		// is an overload of it, if the argument labels are different.
+		// Invalid redeclarations are reported in `checkNestedIdentifiers`
+
+		originName := identifier
+
+		existingMember, ok := members.Get(identifier)
+		if ok &&
+			existingMember.DeclarationKind == declarationKind &&
+			existingMember.Overload(argumentLabels) == nil {
+
+			previousTypes := []Type{existingMember.TypeAnnotation.Type}
+			for _, previousOverload := range existingMember.Overloads {
+				previousTypes = append(previousTypes, previousOverload.TypeAnnotation.Type)
+			}
+			checker.checkOverloadAmbiguity(identifier, functionType, previousTypes, function.Identifier)
+
+			existingMember.Overloads = append(existingMember.Overloads, member)
+
+			originName = OverloadedFunctionName(identifier, argumentLabels)
+			checker.Elaboration.FunctionDeclarationOverloadNames[function] = originName
+		} else {
+			members.Set(identifier, member)
+			firstFunctions[identifier] = function
+		}
 
 		if checker.positionInfoEnabled && origins != nil {
-			origins[identifier] =
+			origins[originName] =
 				checker.recordFunctionDeclarationOrigin(function, functionType)
 		}
 	}
 
+	// The first declaration of an overloaded member function
+	// is named by its argument labels, just like its overloads,
+	// so the name of each overload is independent of the declaration order
+
+	for identifier, function := range firstFunctions { //nolint:maprangecheck
+		member, ok := members.Get(identifier)
+		if !ok || len(member.Overloads) == 0 {
+			continue
+		}
+
+		checker.Elaboration.FunctionDeclarationOverloadNames[function] =
+			OverloadedFunctionName(identifier, member.ArgumentLabels)
+	}
+
 	return members, fieldNames, origins
 }
 
@@ -1663,9 +1871,6 @@ func (checker *Checker) checkInitializers(
 		return
 	}
 
-	// TODO: check all initializers:
-	//  parameter initializerParameterTypeAnnotations needs to be a slice
-
 	initializer := initializers[0]
 	checker.checkSpecialFunction(
 		initializer,
@@ -1677,6 +1882,37 @@ func (checker *Checker) checkInitializers(
 		initializationInfo,
 	)
 
+	// Check the initializers which overload the first initializer, if any.
+	// Initializers which are not valid overloads have no function type,
+	// see `declareInitializerOverloads`
+
+	for _, overloadInitializer := range initializers[1:] {
+		functionType, ok := checker.Elaboration.ConstructorFunctionTypes[overloadInitializer]
+		if !ok {
+			continue
+		}
+
+		// Each initializer must initialize all fields
+
+		var overloadInitializationInfo *InitializationInfo
+		if initializationInfo != nil {
+			overloadInitializationInfo = NewInitializationInfo(
+				initializationInfo.ContainerType,
+				initializationInfo.FieldMembers,
+			)
+		}
+
+		checker.checkSpecialFunction(
+			overloadInitializer,
+			containerType,
+			containerDeclarationKind,
+			containerDocString,
+			functionType.Parameters,
+			containerKind,
+			overloadInitializationInfo,
+		)
+	}
+
 	// If the initializer is for an event,
 	// ensure all parameters are valid
 
@@ -1846,10 +2082,14 @@ func (checker *Checker) declareSelfValue(selfType Type, selfDocString string) {
 }
 
 // checkNestedIdentifiers checks that nested identifiers, i.e. fields, functions,
-// and nested interfaces and composites, are unique and aren't named `init` or `destroy`
+// and nested interfaces and composites, are unique and aren't named `init` or `destroy`.
+//
+// Functions may have the same identifier if their argument labels are different,
+// i.e. they are overloads
 //
 func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 	positions := map[string]ast.Position{}
+	functionArgumentLabels := map[string][][]string{}
 
 	for _, declaration := range members.Declarations() {
 
@@ -1862,6 +2102,18 @@ func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 			continue
 		}
 
+		if function, ok := declaration.(*ast.FunctionDeclaration); ok {
+			name := identifier.Identifier
+			argumentLabels := function.ParameterList.EffectiveArgumentLabels()
+
+			previousArgumentLabels := functionArgumentLabels[name]
+			functionArgumentLabels[name] = append(previousArgumentLabels, argumentLabels)
+
+			if isOverload(argumentLabels, previousArgumentLabels) {
+				continue
+			}
+		}
+
 		checker.checkNestedIdentifier(
 			*identifier,
 			declaration.DeclarationKind(),
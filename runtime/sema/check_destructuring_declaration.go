@@ -0,0 +1,199 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// DestructuringIgnoredIdentifier is the identifier of destructuring elements
+// which ignore the corresponding component of the value
+const DestructuringIgnoredIdentifier = "_"
+
+func (checker *Checker) VisitDestructuringDeclaration(declaration *ast.DestructuringDeclaration) ast.Repr {
+
+	valueType := checker.VisitExpression(declaration.Value, nil)
+
+	checker.Elaboration.DestructuringDeclarationValueTypes[declaration] = valueType
+
+	elementTypes := checker.destructuringElementTypes(declaration, valueType)
+
+	checker.Elaboration.DestructuringElementTypes[declaration] = elementTypes
+
+	checker.checkTransfer(declaration.Transfer, valueType)
+
+	checker.checkVariableMove(declaration.Value)
+
+	// The value is invalidated as a whole (if it has a resource type):
+	// Its components are moved into the declared variables
+
+	checker.recordResourceInvalidation(
+		declaration.Value,
+		valueType,
+		ResourceInvalidationKindMoveDefinite,
+	)
+
+	// Finally, declare a variable for each element in the current value activation
+
+	kind := common.DeclarationKindVariable
+	if declaration.IsConstant {
+		kind = common.DeclarationKindConstant
+	}
+
+	for i, element := range declaration.Elements {
+		elementType := elementTypes[i]
+
+		identifier := element.Identifier.Identifier
+
+		if identifier == DestructuringIgnoredIdentifier {
+
+			// Ignoring a resource component would lose it
+
+			if elementType.IsResourceType() {
+				checker.report(
+					&ResourceLossError{
+						Range: ast.NewRangeFromPositioned(element),
+					},
+				)
+			}
+
+			continue
+		}
+
+		variable, err := checker.valueActivations.Declare(variableDeclaration{
+			identifier:               identifier,
+			ty:                       elementType,
+			access:                   ast.AccessNotSpecified,
+			kind:                     kind,
+			pos:                      element.Identifier.Pos,
+			isConstant:               declaration.IsConstant,
+			argumentLabels:           nil,
+			allowOuterScopeShadowing: true,
+		})
+		checker.report(err)
+
+		if checker.positionInfoEnabled {
+			checker.recordVariableDeclarationOccurrence(identifier, variable)
+		}
+	}
+
+	return nil
+}
+
+// destructuringElementTypes returns the types of the elements of the given destructuring declaration.
+//
+// Unlabeled elements destructure the elements of a constant-sized array,
+// labeled elements destructure the fields of a structure.
+//
+// If the value cannot be destructured, the element types are invalid
+func (checker *Checker) destructuringElementTypes(
+	declaration *ast.DestructuringDeclaration,
+	valueType Type,
+) []Type {
+
+	elementCount := len(declaration.Elements)
+
+	elementTypes := make([]Type, elementCount)
+	for i := range elementTypes {
+		elementTypes[i] = InvalidType
+	}
+
+	if valueType.IsInvalidType() {
+		return elementTypes
+	}
+
+	isLabeled := declaration.IsLabeled()
+
+	reportInvalidType := func() {
+		checker.report(
+			&InvalidDestructuringTypeError{
+				Type:      valueType,
+				IsLabeled: isLabeled,
+				Range:     ast.NewRangeFromPositioned(declaration.Value),
+			},
+		)
+	}
+
+	if !isLabeled {
+		arrayType, ok := valueType.(*ConstantSizedType)
+		if !ok {
+			reportInvalidType()
+			return elementTypes
+		}
+
+		if arrayType.Size != int64(elementCount) {
+			checker.report(
+				&DestructuringElementCountMismatchError{
+					ExpectedCount: int(arrayType.Size),
+					ActualCount:   elementCount,
+					Range:         ast.NewRangeFromPositioned(declaration.Value),
+				},
+			)
+			return elementTypes
+		}
+
+		for i := range elementTypes {
+			elementTypes[i] = arrayType.Type
+		}
+
+		return elementTypes
+	}
+
+	// NOTE: Fields can only be destructured from structures:
+	// Moving fields out of a resource would leave it partially invalidated
+
+	compositeType, ok := valueType.(*CompositeType)
+	if !ok || compositeType.Kind != common.CompositeKindStructure {
+		reportInvalidType()
+		return elementTypes
+	}
+
+	for i, element := range declaration.Elements {
+		member, ok := compositeType.Members.Get(element.Label)
+		if !ok || member.DeclarationKind != common.DeclarationKindField {
+			checker.report(
+				&NotDeclaredMemberError{
+					Type: compositeType,
+					Name: element.Label,
+					Range: ast.Range{
+						StartPos: *element.LabelStartPos,
+						EndPos:   *element.LabelEndPos,
+					},
+				},
+			)
+			continue
+		}
+
+		if !checker.isReadableMember(member) {
+			checker.report(
+				&InvalidAccessError{
+					Name:              member.Identifier.Identifier,
+					RestrictingAccess: member.Access,
+					DeclarationKind:   member.DeclarationKind,
+					Range:             ast.NewRangeFromPositioned(element),
+				},
+			)
+		}
+
+		elementTypes[i] = member.TypeAnnotation.Type
+	}
+
+	return elementTypes
+}
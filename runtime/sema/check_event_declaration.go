@@ -75,6 +75,10 @@ func IsValidEventParameterType(t Type, results map[*Member]bool) bool {
 		return IsValidEventParameterType(t.KeyType, results) &&
 			IsValidEventParameterType(t.ValueType, results)
 
+	case *SetType:
+		return t.ElementType != nil &&
+			IsValidEventParameterType(t.ElementType, results)
+
 	case *CompositeType:
 		if t.Kind != common.CompositeKindStructure {
 			return false
@@ -30,7 +30,7 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 
 	valueExpression := statement.Value
 
-	// iterations are only supported for non-resource arrays.
+	// iterations are only supported for non-resource arrays and sets.
 	// Hence, if the array is empty and no context type is available,
 	// then default it to [AnyStruct].
 	var expectedType Type
@@ -60,6 +60,8 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 			)
 		} else if arrayType, ok := valueType.(ArrayType); ok {
 			elementType = arrayType.ElementType(false)
+		} else if setType, ok := valueType.(*SetType); ok && setType.ElementType != nil {
+			elementType = setType.ElementType
 		} else {
 			checker.report(
 				&TypeMismatchWithDescriptionError{
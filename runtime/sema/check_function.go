@@ -91,10 +91,11 @@ func (checker *Checker) declareFunctionDeclaration(
 	declaration *ast.FunctionDeclaration,
 	functionType *FunctionType,
 ) {
+	identifier := declaration.Identifier.Identifier
 	argumentLabels := declaration.ParameterList.EffectiveArgumentLabels()
 
-	_, err := checker.valueActivations.Declare(variableDeclaration{
-		identifier:               declaration.Identifier.Identifier,
+	functionDeclaration := variableDeclaration{
+		identifier:               identifier,
 		ty:                       functionType,
 		docString:                declaration.DocString,
 		access:                   declaration.Access,
@@ -103,8 +104,20 @@ func (checker *Checker) declareFunctionDeclaration(
 		isConstant:               true,
 		argumentLabels:           argumentLabels,
 		allowOuterScopeShadowing: false,
-	})
-	checker.report(err)
+	}
+
+	// A function with the same identifier as a function declared in the same scope
+	// is an overload of it, if the argument labels are different
+
+	if overload := checker.valueActivations.DeclareOverload(functionDeclaration); overload != nil {
+		checker.Elaboration.FunctionDeclarationOverloadNames[declaration] =
+			OverloadedFunctionName(identifier, argumentLabels)
+
+		checker.checkVariableOverloadAmbiguity(overload, declaration.Identifier)
+	} else {
+		_, err := checker.valueActivations.Declare(functionDeclaration)
+		checker.report(err)
+	}
 
 	if checker.positionInfoEnabled {
 		checker.recordFunctionDeclarationOrigin(declaration, functionType)
@@ -347,6 +347,17 @@ func (checker *Checker) importElements(
 				allowOuterScopeShadowing: false,
 			})
 			checker.report(err)
+
+			for _, overload := range element.Overloads {
+				valueActivations.DeclareOverload(variableDeclaration{
+					identifier:     name,
+					ty:             overload.Type,
+					access:         overload.Access,
+					kind:           overload.DeclarationKind,
+					isConstant:     true,
+					argumentLabels: overload.ArgumentLabels,
+				})
+			}
 		})
 	}
 
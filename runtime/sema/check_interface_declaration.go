@@ -120,6 +120,10 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 		checker.visitCompositeDeclaration(nestedComposite, kind)
 	}
 
+	for _, nestedTypeAlias := range declaration.Members.TypeAliases() {
+		nestedTypeAlias.Accept(checker)
+	}
+
 	return nil
 }
 
@@ -158,6 +162,8 @@ func (checker *Checker) declareInterfaceNestedTypes(
 		})
 		checker.report(err)
 	})
+
+	checker.redeclareTypeAliases(declaration.Members.TypeAliases())
 }
 
 func (checker *Checker) checkInterfaceFunctions(
@@ -326,7 +332,7 @@ func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclar
 	// and after declaring nested types as the initializer may use nested type in parameters
 
 	interfaceType.InitializerParameters =
-		checker.initializerParameters(declaration.Members.Initializers())
+		checker.initializerParameters(declaration.Members.Initializers(), false)
 
 	// Declare nested declarations' members
 
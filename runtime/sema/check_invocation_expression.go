@@ -55,6 +55,15 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 		checker.inInvocation = inInvocation
 	}()
 
+	// Overloaded functions are resolved based on the argument labels of the invocation,
+	// see `resolveVariableOverload` and `resolveMemberOverload`
+
+	previousInvocationExpression := checker.currentInvocationExpression
+	checker.currentInvocationExpression = invocationExpression
+	defer func() {
+		checker.currentInvocationExpression = previousInvocationExpression
+	}()
+
 	// check the invoked expression can be invoked
 
 	invokedExpression := invocationExpression.InvokedExpression
@@ -450,6 +459,16 @@ func (checker *Checker) checkInvocation(
 		argumentTypes[i] = checker.VisitExpression(argument.Expression, nil)
 	}
 
+	// Add the default arguments for the parameters which have no argument
+
+	if argumentCount < parameterCount {
+		defaultArgumentTypes, defaultParameterTypes :=
+			checker.checkInvocationDefaultArguments(invocationExpression, functionType)
+
+		argumentTypes = append(argumentTypes, defaultArgumentTypes...)
+		parameterTypes = append(parameterTypes, defaultParameterTypes...)
+	}
+
 	// The invokable type might have special checks for the arguments
 
 	argumentExpressions := make([]ast.Expression, argumentCount)
@@ -534,7 +553,29 @@ func (checker *Checker) checkInvocationRequiredArgument(
 	if len(functionType.TypeParameters) == 0 {
 		// If the function doesn't use generic types, then the
 		// param types can be used to infer the types for arguments.
-		argumentType = checker.VisitExpression(argument.Expression, parameterType)
+		expectedType := parameterType
+
+		// The function might expect literal arguments to have a more specific type,
+		// e.g. a number conversion function expects a literal of the same kind
+		// to have the target type, so the literal is not limited
+		// to the range and scale of its default type
+
+		literalArgumentType := functionType.LiteralArgumentType
+		if literalArgumentType != nil {
+			switch argument.Expression.(type) {
+			case *ast.IntegerExpression:
+				if IsSameTypeKind(literalArgumentType, IntegerType) {
+					expectedType = literalArgumentType
+				}
+
+			case *ast.FixedPointExpression:
+				if IsSameTypeKind(literalArgumentType, FixedPointType) {
+					expectedType = literalArgumentType
+				}
+			}
+		}
+
+		argumentType = checker.VisitExpression(argument.Expression, expectedType)
 	} else {
 		// TODO: pass the expected type to support for parameters
 		argumentType = checker.VisitExpression(argument.Expression, nil)
@@ -156,6 +156,10 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 	// i.e. a Go type switch would be sufficient.
 	// However, for some types (e.g. reference types) this depends on what type is referenced
 
+	// The origin of an overload is recorded under its overloaded function name
+
+	originName := identifier
+
 	getMemberForType := func(expressionType Type) {
 		resolver, ok := expressionType.GetMembers()[identifier]
 		if !ok {
@@ -163,6 +167,15 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 		}
 		targetRange := ast.NewRangeFromPositioned(expression.Expression)
 		member = resolver.Resolve(identifier, targetRange, checker.report)
+		if member == nil {
+			return
+		}
+
+		overload := checker.resolveMemberOverload(expression, member)
+		if overload != member {
+			originName = OverloadedFunctionName(identifier, overload.ArgumentLabels)
+			member = overload
+		}
 	}
 
 	// Get the member from the accessed value based
@@ -225,7 +238,7 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 
 		if checker.positionInfoEnabled {
 			origins := checker.memberOrigins[accessedType]
-			origin := origins[identifier]
+			origin := origins[originName]
 			checker.Occurrences.Put(
 				identifierStartPosition,
 				identifierEndPosition,
@@ -64,14 +64,5 @@ func CheckPathLiteral(domainString, identifier string, domainRangeThunk, idRange
 		}
 	}
 
-	switch domain {
-	case common.PathDomainStorage:
-		return StoragePathType, nil
-	case common.PathDomainPublic:
-		return PublicPathType, nil
-	case common.PathDomainPrivate:
-		return PrivatePathType, nil
-	default:
-		return PathType, nil
-	}
+	return PathTypeForDomain(domain), nil
 }
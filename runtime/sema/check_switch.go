@@ -20,17 +20,24 @@ package sema
 
 import (
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 )
 
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast.Repr {
 
 	testType := checker.VisitExpression(statement.Expression, nil)
 
+	checker.Elaboration.SwitchStatementTestTypes[statement] = testType
+
 	testTypeIsValid := !testType.IsInvalidType()
 
-	// The test expression must be equatable
+	// The test expression must be equatable,
+	// unless all cases only match patterns or `nil`
+
+	if testTypeIsValid &&
+		!testType.IsEquatable() &&
+		!hasOnlyPatternOrNilCases(statement.Cases) {
 
-	if testTypeIsValid && !testType.IsEquatable() {
 		checker.report(
 			&NotEquatableTypeError{
 				Type:  testType,
@@ -56,6 +63,33 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 	return nil
 }
 
+// hasOnlyPatternOrNilCases returns true if there is at least one case,
+// and all non-default cases are patterns or `nil`,
+// i.e. no case requires the test value to be compared for equality
+//
+func hasOnlyPatternOrNilCases(cases []*ast.SwitchCase) bool {
+	result := false
+
+	for _, switchCase := range cases {
+		switch {
+		case switchCase.Pattern != nil,
+			isNilCase(switchCase):
+
+			result = true
+
+		case switchCase.Expression != nil:
+			return false
+		}
+	}
+
+	return result
+}
+
+func isNilCase(switchCase *ast.SwitchCase) bool {
+	_, ok := switchCase.Expression.(*ast.NilExpression)
+	return ok
+}
+
 func (checker *Checker) visitSwitchCase(
 	switchCase *ast.SwitchCase,
 	defaultAllowed bool,
@@ -64,9 +98,9 @@ func (checker *Checker) visitSwitchCase(
 ) {
 	caseExpression := switchCase.Expression
 
-	// If the case has no expression, it is a default case
+	// If the case has neither an expression nor a pattern, it is a default case
 
-	if caseExpression == nil {
+	if switchCase.IsDefault() {
 
 		// Only one default case is allowed, as the last case
 		if !defaultAllowed {
@@ -76,11 +110,92 @@ func (checker *Checker) visitSwitchCase(
 				},
 			)
 		}
+	} else if switchCase.Pattern != nil {
+		checker.checkSwitchCasePattern(switchCase, testType, testTypeIsValid)
+	} else if isNilCase(switchCase) {
+		checker.checkSwitchCaseNil(caseExpression, testType, testTypeIsValid)
 	} else {
 		checker.checkSwitchCaseExpression(caseExpression, testType, testTypeIsValid)
 	}
 }
 
+// checkSwitchCaseNil checks a `nil` case.
+// The test value must be optional
+//
+func (checker *Checker) checkSwitchCaseNil(
+	caseExpression ast.Expression,
+	testType Type,
+	testTypeIsValid bool,
+) {
+	checker.VisitExpression(caseExpression, nil)
+
+	if !testTypeIsValid {
+		return
+	}
+
+	if _, ok := testType.(*OptionalType); !ok {
+		checker.report(
+			&TypeMismatchError{
+				ExpectedType: &OptionalType{},
+				ActualType:   testType,
+				Range:        ast.NewRangeFromPositioned(caseExpression),
+			},
+		)
+	}
+}
+
+// checkSwitchCasePattern checks the pattern of a switch case,
+// and determines the type of the value bound by it.
+//
+// The pattern `let v as T` binds a value of type `T`,
+// the pattern `let v?` binds the unwrapped value of an optional test value
+//
+func (checker *Checker) checkSwitchCasePattern(
+	switchCase *ast.SwitchCase,
+	testType Type,
+	testTypeIsValid bool,
+) {
+	pattern := switchCase.Pattern
+
+	var patternType Type = InvalidType
+
+	if pattern.TypeAnnotation != nil {
+		typeAnnotation := checker.ConvertTypeAnnotation(pattern.TypeAnnotation)
+		checker.checkTypeAnnotation(typeAnnotation, pattern.TypeAnnotation)
+		patternType = typeAnnotation.Type
+	}
+
+	if testTypeIsValid {
+
+		// NOTE: Resources cannot be matched:
+		// The resource would be moved into the binding only if the pattern matches
+
+		if testType.IsResourceType() {
+			checker.report(
+				&InvalidResourceSwitchPatternError{
+					Type:  testType,
+					Range: ast.NewRangeFromPositioned(pattern),
+				},
+			)
+		} else if pattern.IsOptional {
+			optionalType, ok := testType.(*OptionalType)
+			if ok {
+				patternType = optionalType.Type
+			} else {
+				checker.report(
+					&TypeMismatchError{
+						ExpectedType: &OptionalType{},
+						ActualType:   testType,
+						Range:        ast.NewRangeFromPositioned(pattern),
+					},
+				)
+			}
+		}
+	}
+
+	checker.Elaboration.SwitchCasePatternTypes[switchCase] = patternType
+}
+
 func (checker *Checker) checkSwitchCaseExpression(
 	caseExpression ast.Expression,
 	testType Type,
@@ -138,7 +253,7 @@ func (checker *Checker) checkSwitchCasesStatements(cases []*ast.SwitchCase) {
 
 	switchCase := cases[0]
 
-	if caseCount == 1 && switchCase.Expression == nil {
+	if caseCount == 1 && switchCase.IsDefault() {
 		checker.checkSwitchCaseStatements(switchCase)
 		return
 	}
@@ -169,6 +284,16 @@ func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
 		return
 	}
 
+	// If the case has a pattern, the matched value is bound
+	// in a new scope which also contains the statements
+
+	if switchCase.Pattern != nil {
+		checker.enterValueScope()
+		defer checker.leaveValueScope(switchCase.EndPosition, true)
+
+		checker.declareSwitchCasePatternVariable(switchCase)
+	}
+
 	// NOTE: the block ensures that the statements are checked in a new scope
 
 	block := &ast.Block{
@@ -180,3 +305,23 @@ func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
 	}
 	block.Accept(checker)
 }
+
+func (checker *Checker) declareSwitchCasePatternVariable(switchCase *ast.SwitchCase) {
+	identifier := switchCase.Pattern.Identifier
+
+	variable, err := checker.valueActivations.Declare(variableDeclaration{
+		identifier:               identifier.Identifier,
+		ty:                       checker.Elaboration.SwitchCasePatternTypes[switchCase],
+		access:                   ast.AccessNotSpecified,
+		kind:                     common.DeclarationKindConstant,
+		pos:                      identifier.Pos,
+		isConstant:               true,
+		argumentLabels:           nil,
+		allowOuterScopeShadowing: true,
+	})
+	checker.report(err)
+
+	if checker.positionInfoEnabled {
+		checker.recordVariableDeclarationOccurrence(identifier.Identifier, variable)
+	}
+}
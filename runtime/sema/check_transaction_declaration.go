@@ -247,6 +247,10 @@ func (checker *Checker) declareTransactionDeclaration(declaration *ast.Transacti
 	transactionType := &TransactionType{}
 
 	if declaration.ParameterList != nil {
+		checker.reportUnsupportedDefaultArguments(
+			declaration.ParameterList,
+			declaration.DeclarationKind(),
+		)
 		transactionType.Parameters = checker.parameters(declaration.ParameterList)
 	}
 
@@ -272,6 +276,10 @@ func (checker *Checker) declareTransactionDeclaration(declaration *ast.Transacti
 
 	if declaration.Prepare != nil {
 		parameterList := declaration.Prepare.FunctionDeclaration.ParameterList
+		checker.reportUnsupportedDefaultArguments(
+			parameterList,
+			declaration.Prepare.DeclarationKind(),
+		)
 		transactionType.PrepareParameters = checker.parameters(parameterList)
 	}
 
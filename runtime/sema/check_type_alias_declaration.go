@@ -0,0 +1,314 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// VisitTypeAliasDeclaration checks the given type alias declaration.
+//
+// NOTE: The target type of the alias was already resolved and the alias was already declared
+// in `declareTypeAliasDeclaration`, as other declarations may refer to the alias
+func (checker *Checker) VisitTypeAliasDeclaration(declaration *ast.TypeAliasDeclaration) ast.Repr {
+
+	checker.checkDeclarationAccessModifier(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.StartPos,
+		true,
+	)
+
+	return nil
+}
+
+// declareTypeAliasDeclarations resolves and declares the given type alias declarations
+// of the same scope, and returns their target types.
+//
+// Type aliases are resolved in the order they are declared,
+// so an alias may only refer to aliases declared before it.
+// Aliases which cyclically refer to themselves are reported
+// and declared with the invalid type
+func (checker *Checker) declareTypeAliasDeclarations(declarations []*ast.TypeAliasDeclaration) []Type {
+
+	cyclicDeclarations := cyclicTypeAliasDeclarations(declarations)
+
+	types := make([]Type, len(declarations))
+
+	for i, declaration := range declarations {
+		_, cyclic := cyclicDeclarations[declaration]
+		types[i] = checker.declareTypeAliasDeclaration(declaration, cyclic)
+	}
+
+	return types
+}
+
+// declareTypeAliasDeclaration resolves the target type of the given type alias declaration,
+// declares the alias in the current scope, and records the target type in the elaboration.
+//
+// The target type of a cyclic alias is not resolved, as it would refer to an undeclared alias
+func (checker *Checker) declareTypeAliasDeclaration(declaration *ast.TypeAliasDeclaration, cyclic bool) Type {
+
+	var ty Type
+
+	if cyclic {
+		checker.report(
+			&CyclicTypeAliasError{
+				Name:  declaration.Identifier.Identifier,
+				Range: ast.NewRangeFromPositioned(declaration.Identifier),
+			},
+		)
+
+		ty = InvalidType
+	} else {
+		typeAnnotation := checker.ConvertTypeAnnotation(declaration.TypeAnnotation)
+		checker.checkTypeAnnotation(typeAnnotation, declaration.TypeAnnotation)
+
+		ty = typeAnnotation.Type
+	}
+
+	checker.Elaboration.TypeAliasDeclarationTypes[declaration] = ty
+
+	variable, err := checker.typeActivations.DeclareType(typeDeclaration{
+		identifier:               declaration.Identifier,
+		ty:                       ty,
+		declarationKind:          declaration.DeclarationKind(),
+		access:                   declaration.Access,
+		docString:                declaration.DocString,
+		allowOuterScopeShadowing: false,
+	})
+	checker.report(err)
+
+	if checker.positionInfoEnabled {
+		checker.recordVariableDeclarationOccurrence(
+			declaration.Identifier.Identifier,
+			variable,
+		)
+	}
+
+	return ty
+}
+
+// redeclareTypeAliases declares the given type aliases again in the current scope,
+// i.e. when the scope for the nested declarations of a composite or interface is entered again.
+//
+// It assumes the target types were previously resolved in `declareTypeAliasDeclaration`.
+// Aliases which are not resolved yet are skipped, i.e. when they are currently being declared
+func (checker *Checker) redeclareTypeAliases(declarations []*ast.TypeAliasDeclaration) {
+	for _, declaration := range declarations {
+		ty, ok := checker.Elaboration.TypeAliasDeclarationTypes[declaration]
+		if !ok {
+			continue
+		}
+
+		// NOTE: Ignore redeclaration errors,
+		// they were already reported when the alias was first declared
+
+		_, _ = checker.typeActivations.DeclareType(typeDeclaration{
+			identifier:               declaration.Identifier,
+			ty:                       ty,
+			declarationKind:          declaration.DeclarationKind(),
+			access:                   declaration.Access,
+			docString:                declaration.DocString,
+			allowOuterScopeShadowing: true,
+		})
+	}
+}
+
+// declareCompositeTypeAliases resolves and declares the type aliases
+// of the given composite declaration, and recursively for all nested declarations.
+//
+// The aliases become available as nested types of the composite type,
+// e.g. `C.Token` for the alias `Token` declared in the contract `C`.
+//
+// NOTE: This function assumes that the composite type and the nested declarations' types
+// were previously declared using `declareCompositeType`.
+func (checker *Checker) declareCompositeTypeAliases(declaration *ast.CompositeDeclaration) {
+
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration]
+	if compositeType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	// Activate new scope for nested declarations
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareCompositeNestedTypes(declaration, ContainerKindComposite, false)
+
+	typeAliasDeclarations := declaration.Members.TypeAliases()
+	typeAliasTypes := checker.declareTypeAliasDeclarations(typeAliasDeclarations)
+
+	for i, typeAliasDeclaration := range typeAliasDeclarations {
+		compositeType.setTypeAlias(typeAliasDeclaration.Identifier.Identifier, typeAliasTypes[i])
+	}
+
+	for _, nestedInterfaceDeclaration := range declaration.Members.Interfaces() {
+		checker.declareInterfaceTypeAliases(nestedInterfaceDeclaration)
+	}
+
+	for _, nestedCompositeDeclaration := range declaration.Members.Composites() {
+		checker.declareCompositeTypeAliases(nestedCompositeDeclaration)
+	}
+}
+
+// declareInterfaceTypeAliases resolves and declares the type aliases
+// of the given interface declaration, and recursively for all nested declarations.
+//
+// NOTE: This function assumes that the interface type and the nested declarations' types
+// were previously declared using `declareInterfaceType`.
+func (checker *Checker) declareInterfaceTypeAliases(declaration *ast.InterfaceDeclaration) {
+
+	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration]
+	if interfaceType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	// Activate new scope for nested declarations
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareInterfaceNestedTypes(declaration)
+
+	typeAliasDeclarations := declaration.Members.TypeAliases()
+	typeAliasTypes := checker.declareTypeAliasDeclarations(typeAliasDeclarations)
+
+	for i, typeAliasDeclaration := range typeAliasDeclarations {
+		interfaceType.setTypeAlias(typeAliasDeclaration.Identifier.Identifier, typeAliasTypes[i])
+	}
+
+	for _, nestedInterfaceDeclaration := range declaration.Members.Interfaces() {
+		checker.declareInterfaceTypeAliases(nestedInterfaceDeclaration)
+	}
+
+	for _, nestedCompositeDeclaration := range declaration.Members.Composites() {
+		checker.declareCompositeTypeAliases(nestedCompositeDeclaration)
+	}
+}
+
+// cyclicTypeAliasDeclarations returns the given type alias declarations of the same scope
+// which directly or indirectly refer to themselves, e.g. both aliases in
+// `typealias A = B` and `typealias B = A`
+func cyclicTypeAliasDeclarations(
+	declarations []*ast.TypeAliasDeclaration,
+) map[*ast.TypeAliasDeclaration]struct{} {
+
+	declarationsByName := map[string]*ast.TypeAliasDeclaration{}
+	for _, declaration := range declarations {
+		name := declaration.Identifier.Identifier
+		if _, ok := declarationsByName[name]; !ok {
+			declarationsByName[name] = declaration
+		}
+	}
+
+	// referencedDeclarations returns the aliases of the scope
+	// which the target type of the given alias refers to
+
+	referencedDeclarations := func(declaration *ast.TypeAliasDeclaration) []*ast.TypeAliasDeclaration {
+		var result []*ast.TypeAliasDeclaration
+		forEachNominalTypeIdentifier(
+			declaration.TypeAnnotation.Type,
+			func(identifier string) {
+				if referenced, ok := declarationsByName[identifier]; ok {
+					result = append(result, referenced)
+				}
+			},
+		)
+		return result
+	}
+
+	result := map[*ast.TypeAliasDeclaration]struct{}{}
+
+	for _, declaration := range declarations {
+
+		// Check if the alias is reachable from itself
+
+		visited := map[*ast.TypeAliasDeclaration]struct{}{}
+		pending := referencedDeclarations(declaration)
+
+		for len(pending) > 0 {
+			current := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+
+			if current == declaration {
+				result[declaration] = struct{}{}
+				break
+			}
+
+			if _, ok := visited[current]; ok {
+				continue
+			}
+			visited[current] = struct{}{}
+
+			pending = append(pending, referencedDeclarations(current)...)
+		}
+	}
+
+	return result
+}
+
+// forEachNominalTypeIdentifier calls the given function with the identifier
+// of each nominal type in the given type.
+//
+// For nested types, e.g. `C.R`, only the identifier of the outermost type is passed
+func forEachNominalTypeIdentifier(ty ast.Type, f func(identifier string)) {
+	switch ty := ty.(type) {
+	case *ast.NominalType:
+		f(ty.Identifier.Identifier)
+
+	case *ast.OptionalType:
+		forEachNominalTypeIdentifier(ty.Type, f)
+
+	case *ast.VariableSizedType:
+		forEachNominalTypeIdentifier(ty.Type, f)
+
+	case *ast.ConstantSizedType:
+		forEachNominalTypeIdentifier(ty.Type, f)
+
+	case *ast.DictionaryType:
+		forEachNominalTypeIdentifier(ty.KeyType, f)
+		forEachNominalTypeIdentifier(ty.ValueType, f)
+
+	case *ast.FunctionType:
+		for _, parameterTypeAnnotation := range ty.ParameterTypeAnnotations {
+			forEachNominalTypeIdentifier(parameterTypeAnnotation.Type, f)
+		}
+		if ty.ReturnTypeAnnotation != nil {
+			forEachNominalTypeIdentifier(ty.ReturnTypeAnnotation.Type, f)
+		}
+
+	case *ast.ReferenceType:
+		forEachNominalTypeIdentifier(ty.Type, f)
+
+	case *ast.RestrictedType:
+		forEachNominalTypeIdentifier(ty.Type, f)
+		for _, restriction := range ty.Restrictions {
+			forEachNominalTypeIdentifier(restriction, f)
+		}
+
+	case *ast.InstantiationType:
+		forEachNominalTypeIdentifier(ty.Type, f)
+		for _, typeArgument := range ty.TypeArguments {
+			forEachNominalTypeIdentifier(typeArgument.Type, f)
+		}
+	}
+}
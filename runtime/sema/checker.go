@@ -108,6 +108,7 @@ type Checker struct {
 	allowSelfResourceFieldInvalidation bool
 	Elaboration                        *Elaboration
 	currentMemberExpression            *ast.MemberExpression
+	currentInvocationExpression        *ast.InvocationExpression
 	validTopLevelDeclarationsHandler   ValidTopLevelDeclarationsHandlerFunc
 	beforeExtractor                    *BeforeExtractor
 	locationHandler                    LocationHandlerFunc
@@ -116,6 +117,7 @@ type Checker struct {
 	expectedType                       Type
 	memberAccountAccessHandler         MemberAccountAccessHandlerFunc
 	lintEnabled                        bool
+	checkedDefaultArguments            map[*ast.Parameter]bool
 }
 
 type Option func(*Checker) error
@@ -269,6 +271,8 @@ func NewChecker(program *ast.Program, location common.Location, options ...Optio
 		functionActivations: functionActivations,
 		containerTypes:      map[Type]bool{},
 		Elaboration:         NewElaboration(),
+
+		checkedDefaultArguments: map[*ast.Parameter]bool{},
 	}
 
 	checker.beforeExtractor = NewBeforeExtractor(checker.report)
@@ -476,6 +480,22 @@ func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 		VisitThisAndNested(compositeType, registerInElaboration)
 	}
 
+	// Declare type aliases.
+	//
+	// NOTE: after all interface and composite types are declared,
+	// so aliases can refer to them, and before their members are declared,
+	// so members can refer to aliases
+
+	checker.declareTypeAliasDeclarations(program.TypeAliasDeclarations())
+
+	for _, declaration := range program.InterfaceDeclarations() {
+		checker.declareInterfaceTypeAliases(declaration)
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		checker.declareCompositeTypeAliases(declaration)
+	}
+
 	// Declare interfaces' and composites' members
 
 	for _, declaration := range program.InterfaceDeclarations() {
@@ -894,6 +914,8 @@ func (checker *Checker) findAndCheckValueVariable(identifierExpression *ast.Iden
 		return nil
 	}
 
+	variable = checker.resolveVariableOverload(identifierExpression, variable)
+
 	if checker.positionInfoEnabled && recordOccurrence && identifier.Identifier != "" {
 		checker.recordVariableReferenceOccurrence(
 			identifier.StartPosition(),
@@ -1125,10 +1147,31 @@ func (checker *Checker) convertRestrictedType(t *ast.RestrictedType) Type {
 
 	// Convert the restrictions
 
+	restrictionResults := make([]Type, len(t.Restrictions))
+
+	for i, restriction := range t.Restrictions {
+		restrictionResults[i] = checker.ConvertType(restriction)
+	}
+
+	// A restricted type without a restricted type
+	// and with a single restriction which is not an interface type,
+	// e.g. `{Int}`, is a set type
+
+	if t.Type == nil && len(restrictionResults) == 1 {
+		elementType := restrictionResults[0]
+		if _, ok := elementType.(*InterfaceType); !ok && !elementType.IsInvalidType() {
+			checker.checkSetElementType(elementType, t.Restrictions[0])
+
+			return &SetType{
+				ElementType: elementType,
+			}
+		}
+	}
+
 	var restrictions []*InterfaceType
 
-	for _, restriction := range t.Restrictions {
-		restrictionResult := checker.ConvertType(restriction)
+	for i, restriction := range t.Restrictions {
+		restrictionResult := restrictionResults[i]
 
 		// The restriction must be a resource or structure interface type
 
@@ -1197,6 +1240,17 @@ func (checker *Checker) convertDictionaryType(t *ast.DictionaryType) Type {
 	}
 }
 
+func (checker *Checker) checkSetElementType(elementType Type, pos ast.HasPosition) {
+	if !IsValidDictionaryKeyType(elementType) {
+		checker.report(
+			&InvalidSetElementTypeError{
+				Type:  elementType,
+				Range: ast.NewRangeFromPositioned(pos),
+			},
+		)
+	}
+}
+
 func (checker *Checker) convertOptionalType(t *ast.OptionalType) Type {
 	ty := checker.ConvertType(t.Type)
 	return &OptionalType{
@@ -1283,6 +1337,9 @@ func (checker *Checker) convertVariableSizedType(t *ast.VariableSizedType) Type
 
 func (checker *Checker) findAndCheckTypeVariable(identifier ast.Identifier, recordOccurrence bool) *Variable {
 	variable := checker.typeActivations.Find(identifier.Identifier)
+	if variable == nil && identifier.Identifier == setTypeVariable.Identifier {
+		variable = setTypeVariable
+	}
 	if variable == nil {
 		checker.report(
 			&NotDeclaredError{
@@ -1318,7 +1375,16 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 
 	for _, identifier := range t.NestedIdentifiers {
 		if containerType, ok := ty.(ContainerType); ok && containerType.IsContainerType() {
-			ty, _ = containerType.GetNestedTypes().Get(identifier.Identifier)
+			var found bool
+			ty, found = containerType.GetNestedTypes().Get(identifier.Identifier)
+
+			// The nested identifier might also refer to a type alias declared in the container
+
+			if !found {
+				if typeAliasContainerType, ok := containerType.(TypeAliasContainerType); ok {
+					ty, _ = typeAliasContainerType.GetTypeAlias(identifier.Identifier)
+				}
+			}
 		} else {
 			if !ty.IsInvalidType() {
 				checker.report(
@@ -1378,8 +1444,9 @@ func (checker *Checker) functionType(
 		checker.ConvertTypeAnnotation(returnTypeAnnotation)
 
 	return &FunctionType{
-		Parameters:           convertedParameters,
-		ReturnTypeAnnotation: convertedReturnTypeAnnotation,
+		Parameters:            convertedParameters,
+		ReturnTypeAnnotation:  convertedReturnTypeAnnotation,
+		RequiredArgumentCount: requiredArgumentCount(convertedParameters),
 	}
 }
 
@@ -1387,6 +1454,8 @@ func (checker *Checker) parameters(parameterList *ast.ParameterList) []*Paramete
 
 	parameters := make([]*Parameter, len(parameterList.Parameters))
 
+	hasDefaultArgument := false
+
 	for i, parameter := range parameterList.Parameters {
 		convertedParameterType := checker.ConvertType(parameter.TypeAnnotation.Type)
 
@@ -1401,6 +1470,17 @@ func (checker *Checker) parameters(parameterList *ast.ParameterList) []*Paramete
 				Type:       convertedParameterType,
 			},
 		}
+
+		// Only keep valid default arguments,
+		// so invocations do not report errors for them again
+
+		if checker.checkParameterDefaultArgument(parameter, convertedParameterType, hasDefaultArgument) {
+			parameters[i].DefaultArgument = parameter.DefaultArgument
+		}
+
+		if parameter.DefaultArgument != nil {
+			hasDefaultArgument = true
+		}
 	}
 
 	return parameters
@@ -2212,6 +2292,13 @@ func (checker *Checker) checkTypeAnnotation(typeAnnotation *TypeAnnotation, pos
 				Range: ast.NewRangeFromPositioned(pos),
 			},
 		)
+
+	case TypeAnnotationStateMissingTypeArguments:
+		checker.report(
+			&MissingTypeArgumentsError{
+				Range: ast.NewRangeFromPositioned(pos),
+			},
+		)
 	}
 
 	checker.checkInvalidInterfaceAsType(typeAnnotation.Type, pos)
@@ -2357,6 +2444,13 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 		return ty
 	}
 
+	if _, ok := parameterizedType.(*SetType); ok {
+		elementType := typeArguments[0]
+		if !elementType.IsInvalidType() {
+			checker.checkSetElementType(elementType, t.TypeArguments[0])
+		}
+	}
+
 	return parameterizedType.Instantiate(typeArguments, checker.report)
 }
 
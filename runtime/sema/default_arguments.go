@@ -0,0 +1,195 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// isConstantExpression returns true if the given expression is a constant,
+// i.e. a literal, or an array or dictionary literal of constants
+//
+func isConstantExpression(expression ast.Expression) bool {
+	switch expression := expression.(type) {
+	case *ast.BoolExpression,
+		*ast.NilExpression,
+		*ast.IntegerExpression,
+		*ast.FixedPointExpression,
+		*ast.StringExpression,
+		*ast.PathExpression:
+
+		return true
+
+	case *ast.ArrayExpression:
+		for _, value := range expression.Values {
+			if !isConstantExpression(value) {
+				return false
+			}
+		}
+		return true
+
+	case *ast.DictionaryExpression:
+		for _, entry := range expression.Entries {
+			if !isConstantExpression(entry.Key) ||
+				!isConstantExpression(entry.Value) {
+
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// requiredArgumentCount returns the number of arguments an invocation
+// of a function with the given parameters must provide,
+// i.e. the number of parameters preceding the first parameter with a default argument.
+//
+// Returns nil if no parameter has a default argument
+//
+func requiredArgumentCount(parameters []*Parameter) *int {
+	for i, parameter := range parameters {
+		if parameter.DefaultArgument != nil {
+			return RequiredArgumentCount(i)
+		}
+	}
+	return nil
+}
+
+// checkParameterDefaultArgument checks the default argument of the given parameter, if any,
+// and returns true if the parameter has a valid default argument.
+//
+// Default arguments must be constant expressions of the parameter's type,
+// and all parameters following a parameter with a default argument must also have a default argument.
+//
+// Parameters may be converted multiple times, so the result is memoized,
+// which ensures errors are only reported once
+//
+func (checker *Checker) checkParameterDefaultArgument(
+	parameter *ast.Parameter,
+	parameterType Type,
+	requiresDefaultArgument bool,
+) bool {
+	if valid, ok := checker.checkedDefaultArguments[parameter]; ok {
+		return valid
+	}
+
+	valid := checker.checkDefaultArgument(parameter, parameterType, requiresDefaultArgument)
+	checker.checkedDefaultArguments[parameter] = valid
+	return valid
+}
+
+func (checker *Checker) checkDefaultArgument(
+	parameter *ast.Parameter,
+	parameterType Type,
+	requiresDefaultArgument bool,
+) bool {
+	defaultArgument := parameter.DefaultArgument
+
+	if defaultArgument == nil {
+		if requiresDefaultArgument {
+			checker.report(
+				&MissingDefaultArgumentError{
+					Name:  parameter.Identifier.Identifier,
+					Range: ast.NewRangeFromPositioned(parameter),
+				},
+			)
+		}
+		return false
+	}
+
+	if !isConstantExpression(defaultArgument) {
+		checker.report(
+			&InvalidDefaultArgumentError{
+				Range: ast.NewRangeFromPositioned(defaultArgument),
+			},
+		)
+		return false
+	}
+
+	errorCount := len(checker.errors)
+	checker.VisitExpression(defaultArgument, parameterType)
+	return len(checker.errors) == errorCount
+}
+
+// reportUnsupportedDefaultArguments reports an error for each parameter
+// of the given parameter list which has a default argument
+//
+func (checker *Checker) reportUnsupportedDefaultArguments(
+	parameterList *ast.ParameterList,
+	declarationKind common.DeclarationKind,
+) {
+	for _, parameter := range parameterList.Parameters {
+		if parameter.DefaultArgument == nil {
+			continue
+		}
+
+		checker.report(
+			&UnsupportedDefaultArgumentError{
+				DeclarationKind: declarationKind,
+				Range:           ast.NewRangeFromPositioned(parameter.DefaultArgument),
+			},
+		)
+	}
+}
+
+// checkInvocationDefaultArguments checks the default arguments of the parameters
+// for which the given invocation provides no argument,
+// records them in the elaboration, and returns their types and the parameter types.
+//
+// The default arguments are checked again in the context of the invocation,
+// as the invoked function might be declared in another program
+//
+func (checker *Checker) checkInvocationDefaultArguments(
+	invocationExpression *ast.InvocationExpression,
+	functionType *FunctionType,
+) (
+	argumentTypes []Type,
+	parameterTypes []Type,
+) {
+	var defaultArguments []ast.Expression
+
+	argumentCount := len(invocationExpression.Arguments)
+
+	for i := argumentCount; i < len(functionType.Parameters); i++ {
+		parameter := functionType.Parameters[i]
+
+		defaultArgument := parameter.DefaultArgument
+		if defaultArgument == nil {
+			break
+		}
+
+		parameterType := parameter.TypeAnnotation.Type
+
+		defaultArguments = append(defaultArguments, defaultArgument)
+		argumentTypes = append(
+			argumentTypes,
+			checker.VisitExpression(defaultArgument, parameterType),
+		)
+		parameterTypes = append(parameterTypes, parameterType)
+	}
+
+	if len(defaultArguments) > 0 {
+		checker.Elaboration.InvocationDefaultArguments[invocationExpression] = defaultArguments
+	}
+
+	return
+}
@@ -58,6 +58,7 @@ type Elaboration struct {
 	MemberExpressionExpectedTypes       map[*ast.MemberExpression]Type
 	ArrayExpressionArgumentTypes        map[*ast.ArrayExpression][]Type
 	ArrayExpressionArrayType            map[*ast.ArrayExpression]ArrayType
+	ArrayExpressionSetType              map[*ast.ArrayExpression]*SetType
 	DictionaryExpressionType            map[*ast.DictionaryExpression]*DictionaryType
 	DictionaryExpressionEntryTypes      map[*ast.DictionaryExpression][]DictionaryEntryType
 	IntegerExpressionType               map[*ast.IntegerExpression]Type
@@ -65,11 +66,30 @@ type Elaboration struct {
 	TransactionDeclarationTypes         map[*ast.TransactionDeclaration]*TransactionType
 	SwapStatementLeftTypes              map[*ast.SwapStatement]Type
 	SwapStatementRightTypes             map[*ast.SwapStatement]Type
+	DestructuringDeclarationValueTypes  map[*ast.DestructuringDeclaration]Type
+	DestructuringElementTypes           map[*ast.DestructuringDeclaration][]Type
+	SwitchStatementTestTypes            map[*ast.SwitchStatement]Type
+	SwitchCasePatternTypes              map[*ast.SwitchCase]Type
+	// FunctionDeclarationOverloadNames are the names of function declarations
+	// which overload a previously declared function with the same identifier,
+	// and of all declarations of overloaded member functions
+	FunctionDeclarationOverloadNames map[*ast.FunctionDeclaration]string
+	// ConstructorOverloadNames are the names of the constructors
+	// of initializers which overload the first initializer of a composite
+	ConstructorOverloadNames map[*ast.SpecialFunctionDeclaration]string
+	// InvocationExpressionOverloadNames are the names of the overloads
+	// invoked by invocation expressions, if the invocation resolved to an overload,
+	// or to a member function which may be declared under its overloaded function name
+	InvocationExpressionOverloadNames map[*ast.InvocationExpression]string
+	// InvocationDefaultArguments are the default arguments
+	// for the parameters for which an invocation provides no argument
+	InvocationDefaultArguments map[*ast.InvocationExpression][]ast.Expression
 	// IsNestedResourceMoveExpression indicates if the access the index or member expression
 	// is implicitly moving a resource out of the container, e.g. in a shift or swap statement.
 	IsNestedResourceMoveExpression      map[ast.Expression]struct{}
 	CompositeNestedDeclarations         map[*ast.CompositeDeclaration]map[string]ast.Declaration
 	InterfaceNestedDeclarations         map[*ast.InterfaceDeclaration]map[string]ast.Declaration
+	TypeAliasDeclarationTypes           map[*ast.TypeAliasDeclaration]Type
 	PostConditionsRewrite               map[*ast.Conditions]PostConditionsRewrite
 	EmitStatementEventTypes             map[*ast.EmitStatement]*CompositeType
 	CompositeTypes                      map[TypeID]*CompositeType
@@ -114,6 +134,7 @@ func NewElaboration() *Elaboration {
 		MemberExpressionExpectedTypes:       map[*ast.MemberExpression]Type{},
 		ArrayExpressionArgumentTypes:        map[*ast.ArrayExpression][]Type{},
 		ArrayExpressionArrayType:            map[*ast.ArrayExpression]ArrayType{},
+		ArrayExpressionSetType:              map[*ast.ArrayExpression]*SetType{},
 		DictionaryExpressionType:            map[*ast.DictionaryExpression]*DictionaryType{},
 		DictionaryExpressionEntryTypes:      map[*ast.DictionaryExpression][]DictionaryEntryType{},
 		IntegerExpressionType:               map[*ast.IntegerExpression]Type{},
@@ -121,9 +142,18 @@ func NewElaboration() *Elaboration {
 		TransactionDeclarationTypes:         map[*ast.TransactionDeclaration]*TransactionType{},
 		SwapStatementLeftTypes:              map[*ast.SwapStatement]Type{},
 		SwapStatementRightTypes:             map[*ast.SwapStatement]Type{},
+		DestructuringDeclarationValueTypes:  map[*ast.DestructuringDeclaration]Type{},
+		DestructuringElementTypes:           map[*ast.DestructuringDeclaration][]Type{},
+		SwitchStatementTestTypes:            map[*ast.SwitchStatement]Type{},
+		SwitchCasePatternTypes:              map[*ast.SwitchCase]Type{},
+		FunctionDeclarationOverloadNames:    map[*ast.FunctionDeclaration]string{},
+		ConstructorOverloadNames:            map[*ast.SpecialFunctionDeclaration]string{},
+		InvocationExpressionOverloadNames:   map[*ast.InvocationExpression]string{},
+		InvocationDefaultArguments:          map[*ast.InvocationExpression][]ast.Expression{},
 		IsNestedResourceMoveExpression:      map[ast.Expression]struct{}{},
 		CompositeNestedDeclarations:         map[*ast.CompositeDeclaration]map[string]ast.Declaration{},
 		InterfaceNestedDeclarations:         map[*ast.InterfaceDeclaration]map[string]ast.Declaration{},
+		TypeAliasDeclarationTypes:           map[*ast.TypeAliasDeclaration]Type{},
 		PostConditionsRewrite:               map[*ast.Conditions]PostConditionsRewrite{},
 		EmitStatementEventTypes:             map[*ast.EmitStatement]*CompositeType{},
 		CompositeTypes:                      map[TypeID]*CompositeType{},
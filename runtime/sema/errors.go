@@ -1161,6 +1161,99 @@ func (e *UnsupportedOverloadingError) Error() string {
 
 func (*UnsupportedOverloadingError) isSemanticError() {}
 
+// AmbiguousOverloadError
+
+type AmbiguousOverloadError struct {
+	Name string
+	ast.Range
+}
+
+func (e *AmbiguousOverloadError) Error() string {
+	return fmt.Sprintf(
+		"ambiguous overload of `%s`",
+		e.Name,
+	)
+}
+
+func (e *AmbiguousOverloadError) SecondaryError() string {
+	return "an invocation could match this and a previous declaration, " +
+		"as their argument labels only differ for parameters with default arguments"
+}
+
+func (*AmbiguousOverloadError) isSemanticError() {}
+
+// AmbiguousOverloadReferenceError
+
+type AmbiguousOverloadReferenceError struct {
+	Name string
+	ast.Range
+}
+
+func (e *AmbiguousOverloadReferenceError) Error() string {
+	return fmt.Sprintf(
+		"ambiguous reference to overloaded function `%s`",
+		e.Name,
+	)
+}
+
+func (e *AmbiguousOverloadReferenceError) SecondaryError() string {
+	return "overloaded functions can only be invoked"
+}
+
+func (*AmbiguousOverloadReferenceError) isSemanticError() {}
+
+// InvalidDefaultArgumentError
+
+type InvalidDefaultArgumentError struct {
+	ast.Range
+}
+
+func (e *InvalidDefaultArgumentError) Error() string {
+	return "invalid default argument"
+}
+
+func (e *InvalidDefaultArgumentError) SecondaryError() string {
+	return "default arguments must be constant expressions, e.g. literals"
+}
+
+func (*InvalidDefaultArgumentError) isSemanticError() {}
+
+// MissingDefaultArgumentError
+
+type MissingDefaultArgumentError struct {
+	Name string
+	ast.Range
+}
+
+func (e *MissingDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"missing default argument for parameter `%s`",
+		e.Name,
+	)
+}
+
+func (e *MissingDefaultArgumentError) SecondaryError() string {
+	return "parameters following a parameter with a default argument must also have a default argument"
+}
+
+func (*MissingDefaultArgumentError) isSemanticError() {}
+
+// UnsupportedDefaultArgumentError
+
+type UnsupportedDefaultArgumentError struct {
+	DeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *UnsupportedDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"default arguments are not supported for %s parameters",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (*UnsupportedDefaultArgumentError) isSemanticError() {}
+
 // CompositeKindMismatchError
 
 type CompositeKindMismatchError struct {
@@ -2102,6 +2195,34 @@ func (e *InvalidDictionaryKeyTypeError) Error() string {
 
 func (*InvalidDictionaryKeyTypeError) isSemanticError() {}
 
+// InvalidSetElementTypeError
+
+type InvalidSetElementTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidSetElementTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot use type as set element type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidSetElementTypeError) isSemanticError() {}
+
+// MissingTypeArgumentsError
+
+type MissingTypeArgumentsError struct {
+	ast.Range
+}
+
+func (e *MissingTypeArgumentsError) Error() string {
+	return "missing type arguments"
+}
+
+func (*MissingTypeArgumentsError) isSemanticError() {}
+
 // MissingFunctionBodyError
 
 type MissingFunctionBodyError struct {
@@ -2912,6 +3033,23 @@ func (e *CyclicImportsError) Error() string {
 
 func (*CyclicImportsError) isSemanticError() {}
 
+// CyclicTypeAliasError
+
+type CyclicTypeAliasError struct {
+	Name string
+	ast.Range
+}
+
+func (e *CyclicTypeAliasError) Error() string {
+	return fmt.Sprintf("cyclic type alias `%s`", e.Name)
+}
+
+func (e *CyclicTypeAliasError) SecondaryError() string {
+	return "the target type of the alias refers back to the alias"
+}
+
+func (*CyclicTypeAliasError) isSemanticError() {}
+
 // SwitchDefaultPositionError
 
 type SwitchDefaultPositionError struct {
@@ -2944,6 +3082,68 @@ func (e *MissingSwitchCaseStatementsError) EndPosition() ast.Position {
 	return e.Pos
 }
 
+// InvalidDestructuringTypeError
+
+type InvalidDestructuringTypeError struct {
+	Type      Type
+	IsLabeled bool
+	ast.Range
+}
+
+func (e *InvalidDestructuringTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot destructure value of type `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidDestructuringTypeError) SecondaryError() string {
+	if e.IsLabeled {
+		return "labeled elements require a structure"
+	}
+	return "unlabeled elements require a constant-sized array"
+}
+
+func (*InvalidDestructuringTypeError) isSemanticError() {}
+
+// DestructuringElementCountMismatchError
+
+type DestructuringElementCountMismatchError struct {
+	ExpectedCount int
+	ActualCount   int
+	ast.Range
+}
+
+func (e *DestructuringElementCountMismatchError) Error() string {
+	return "incorrect number of destructuring elements"
+}
+
+func (e *DestructuringElementCountMismatchError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected %d, got %d",
+		e.ExpectedCount,
+		e.ActualCount,
+	)
+}
+
+func (*DestructuringElementCountMismatchError) isSemanticError() {}
+
+// InvalidResourceSwitchPatternError
+
+type InvalidResourceSwitchPatternError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidResourceSwitchPatternError) Error() string {
+	return fmt.Sprintf(
+		"cannot match pattern against resource of type `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidResourceSwitchPatternError) isSemanticError() {}
+
 // MissingEntryPointError
 
 type MissingEntryPointError struct {
@@ -40,6 +40,7 @@ type ImportElement struct {
 	Access          ast.Access
 	Type            Type
 	ArgumentLabels  []string
+	Overloads       []ImportElement
 }
 
 // ElaborationImport
@@ -53,18 +54,30 @@ func variablesToImportElements(variables *StringVariableOrderedMap) *StringImpor
 	elements := NewStringImportElementOrderedMap()
 
 	variables.Foreach(func(name string, variable *Variable) {
-
-		elements.Set(name, ImportElement{
-			DeclarationKind: variable.DeclarationKind,
-			Access:          variable.Access,
-			Type:            variable.Type,
-			ArgumentLabels:  variable.ArgumentLabels,
-		})
+		elements.Set(name, variableToImportElement(variable))
 	})
 
 	return elements
 }
 
+func variableToImportElement(variable *Variable) ImportElement {
+	element := ImportElement{
+		DeclarationKind: variable.DeclarationKind,
+		Access:          variable.Access,
+		Type:            variable.Type,
+		ArgumentLabels:  variable.ArgumentLabels,
+	}
+
+	for _, overload := range variable.Overloads {
+		element.Overloads = append(
+			element.Overloads,
+			variableToImportElement(overload),
+		)
+	}
+
+	return element
+}
+
 func (i ElaborationImport) AllValueElements() *StringImportElementOrderedMap {
 	return variablesToImportElements(i.Elaboration.GlobalValues)
 }
@@ -0,0 +1,321 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// OverloadedFunctionName returns the name of the overload of the function
+// with the given identifier and argument labels, e.g. `add(_:to:)`.
+//
+// The first declaration of a global function is declared with its identifier,
+// all further declarations which overload it are declared with their overloaded function name.
+//
+// All declarations of an overloaded member function are declared with their overloaded function name,
+// so the members of composites are independent of the order of declaration.
+//
+func OverloadedFunctionName(identifier string, argumentLabels []string) string {
+	var builder strings.Builder
+	builder.WriteString(identifier)
+	builder.WriteByte('(')
+	for _, argumentLabel := range argumentLabels {
+		builder.WriteString(argumentLabel)
+		builder.WriteByte(':')
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func argumentLabelsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, argumentLabel := range a {
+		if argumentLabel != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// invocationArgumentLabelsMatch returns true if the given arguments
+// have the argument labels required by the given function type.
+//
+// Arguments may be omitted for trailing parameters which have a default argument
+//
+func invocationArgumentLabelsMatch(arguments []*ast.Argument, ty Type) bool {
+	functionType, ok := ty.(*FunctionType)
+	if !ok {
+		return false
+	}
+
+	argumentLabels := functionType.ArgumentLabels()
+	if len(arguments) > len(argumentLabels) {
+		return false
+	}
+
+	for _, parameter := range functionType.Parameters[len(arguments):] {
+		if parameter.DefaultArgument == nil {
+			return false
+		}
+	}
+
+	for i, argument := range arguments {
+		label := argument.Label
+		if label == "" {
+			label = ArgumentLabelNotRequired
+		}
+		if label != argumentLabels[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// minimumArgumentCount returns the number of arguments which an invocation
+// of the given function type must at least provide,
+// i.e. the number of parameters up to the last parameter without a default argument
+//
+func minimumArgumentCount(functionType *FunctionType) int {
+	for i := len(functionType.Parameters); i > 0; i-- {
+		if functionType.Parameters[i-1].DefaultArgument == nil {
+			return i
+		}
+	}
+	return 0
+}
+
+// overloadsOverlap returns true if an invocation could match both given function types,
+// i.e. if both accept the same number of arguments with the same argument labels.
+//
+// Function types without default arguments only overlap if their argument labels are equal,
+// but default arguments allow invocations with fewer arguments
+//
+func overloadsOverlap(a, b Type) bool {
+	aFunctionType, ok := a.(*FunctionType)
+	if !ok {
+		return false
+	}
+
+	bFunctionType, ok := b.(*FunctionType)
+	if !ok {
+		return false
+	}
+
+	// If both function types accept some number of arguments,
+	// they also accept the smallest number of arguments both accept,
+	// so only this number has to be considered
+
+	count := minimumArgumentCount(aFunctionType)
+	if bCount := minimumArgumentCount(bFunctionType); bCount > count {
+		count = bCount
+	}
+
+	if count > len(aFunctionType.Parameters) ||
+		count > len(bFunctionType.Parameters) {
+
+		return false
+	}
+
+	return argumentLabelsEqual(
+		aFunctionType.ArgumentLabels()[:count],
+		bFunctionType.ArgumentLabels()[:count],
+	)
+}
+
+// checkOverloadAmbiguity reports an error if an invocation could match both the given overload
+// and one of the given previously declared functions with the same name
+//
+func (checker *Checker) checkOverloadAmbiguity(
+	name string,
+	overloadType Type,
+	previousTypes []Type,
+	hasPosition ast.HasPosition,
+) {
+	for _, previousType := range previousTypes {
+		if overloadsOverlap(overloadType, previousType) {
+			checker.report(
+				&AmbiguousOverloadError{
+					Name:  name,
+					Range: ast.NewRangeFromPositioned(hasPosition),
+				},
+			)
+			return
+		}
+	}
+}
+
+// checkVariableOverloadAmbiguity checks that an invocation cannot match both the given overload
+// and the function it overloads, or one of the overloads declared before it
+//
+func (checker *Checker) checkVariableOverloadAmbiguity(overload *Variable, hasPosition ast.HasPosition) {
+	variable := checker.valueActivations.Find(overload.Identifier)
+	if variable == nil {
+		return
+	}
+
+	previousTypes := []Type{variable.Type}
+	for _, previousOverload := range variable.Overloads {
+		if previousOverload == overload {
+			break
+		}
+		previousTypes = append(previousTypes, previousOverload.Type)
+	}
+
+	checker.checkOverloadAmbiguity(overload.Identifier, overload.Type, previousTypes, hasPosition)
+}
+
+// overloadedInvocation returns the invocation which is currently checked,
+// if it invokes the given expression
+//
+func (checker *Checker) overloadedInvocation(expression ast.Expression) *ast.InvocationExpression {
+	invocationExpression := checker.currentInvocationExpression
+	if invocationExpression == nil ||
+		invocationExpression.InvokedExpression != expression {
+
+		return nil
+	}
+	return invocationExpression
+}
+
+// recordInvokedOverload records that the given invocation invokes
+// the overload of the function with the given identifier and type
+//
+func (checker *Checker) recordInvokedOverload(
+	invocationExpression *ast.InvocationExpression,
+	identifier string,
+	ty Type,
+) {
+	functionType := ty.(*FunctionType)
+
+	checker.Elaboration.InvocationExpressionOverloadNames[invocationExpression] =
+		OverloadedFunctionName(identifier, functionType.ArgumentLabels())
+}
+
+// reportAmbiguousOverloadReference reports that the given expression
+// refers to an overloaded function without invoking it,
+// so it is unknown which of the overloads is referred to
+//
+func (checker *Checker) reportAmbiguousOverloadReference(expression ast.Expression, name string) {
+	checker.report(
+		&AmbiguousOverloadReferenceError{
+			Name:  name,
+			Range: ast.NewRangeFromPositioned(expression),
+		},
+	)
+}
+
+// resolveVariableOverload returns the overload of the given variable
+// which is invoked by the invocation of the given expression.
+//
+// If the expression is not invoked, an error is reported and the variable itself is returned.
+// If no overload matches the argument labels of the invocation, the variable itself is returned
+//
+func (checker *Checker) resolveVariableOverload(expression ast.Expression, variable *Variable) *Variable {
+	if len(variable.Overloads) == 0 {
+		return variable
+	}
+
+	invocationExpression := checker.overloadedInvocation(expression)
+	if invocationExpression == nil {
+		checker.reportAmbiguousOverloadReference(expression, variable.Identifier)
+		return variable
+	}
+
+	if invocationArgumentLabelsMatch(invocationExpression.Arguments, variable.Type) {
+		return variable
+	}
+
+	for _, overload := range variable.Overloads {
+		if invocationArgumentLabelsMatch(invocationExpression.Arguments, overload.Type) {
+			checker.recordInvokedOverload(invocationExpression, variable.Identifier, overload.Type)
+			return overload
+		}
+	}
+
+	return variable
+}
+
+// resolveMemberOverload returns the overload of the given member
+// which is invoked by the invocation of the given expression.
+//
+// If the expression is not invoked, an error is reported and the member itself is returned.
+// If no overload matches the argument labels of the invocation, the member itself is returned
+//
+func (checker *Checker) resolveMemberOverload(expression ast.Expression, member *Member) *Member {
+	if len(member.Overloads) == 0 {
+
+		// The function of an interface may be implemented by an overload
+		// of a function of the conforming composite,
+		// so the invocation must look up the implementation by its overloaded function name
+
+		if _, ok := member.ContainerType.(*InterfaceType); ok &&
+			member.DeclarationKind == common.DeclarationKindFunction {
+
+			invocationExpression := checker.overloadedInvocation(expression)
+			if invocationExpression != nil {
+				checker.recordInvokedOverload(invocationExpression, member.Identifier.Identifier, member.TypeAnnotation.Type)
+			}
+		}
+
+		return member
+	}
+
+	invocationExpression := checker.overloadedInvocation(expression)
+	if invocationExpression == nil {
+		checker.reportAmbiguousOverloadReference(expression, member.Identifier.Identifier)
+		return member
+	}
+
+	if invocationArgumentLabelsMatch(invocationExpression.Arguments, member.TypeAnnotation.Type) {
+		checker.recordInvokedOverload(invocationExpression, member.Identifier.Identifier, member.TypeAnnotation.Type)
+		return member
+	}
+
+	for _, overload := range member.Overloads {
+		overloadType := overload.TypeAnnotation.Type
+		if invocationArgumentLabelsMatch(invocationExpression.Arguments, overloadType) {
+			checker.recordInvokedOverload(invocationExpression, member.Identifier.Identifier, overloadType)
+			return overload
+		}
+	}
+
+	return member
+}
+
+// isOverload returns true if a function with the given argument labels
+// overloads the previously declared functions with the given argument labels,
+// i.e. there are previous declarations, and all have different argument labels
+//
+func isOverload(argumentLabels []string, previousArgumentLabels [][]string) bool {
+	if len(previousArgumentLabels) == 0 {
+		return false
+	}
+	for _, previous := range previousArgumentLabels {
+		if argumentLabelsEqual(argumentLabels, previous) {
+			return false
+		}
+	}
+	return true
+}
@@ -18,6 +18,10 @@
 
 package sema
 
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
 // PathType
 //
 var PathType = &SimpleType{
@@ -101,3 +105,18 @@ var PrivatePathType = &SimpleType{
 	ExternallyReturnable: true,
 	Importable:           true,
 }
+
+// PathTypeForDomain returns the type of paths in the given domain
+//
+func PathTypeForDomain(domain common.PathDomain) Type {
+	switch domain {
+	case common.PathDomainStorage:
+		return StoragePathType
+	case common.PathDomainPublic:
+		return PublicPathType
+	case common.PathDomainPrivate:
+		return PrivatePathType
+	default:
+		return PathType
+	}
+}
@@ -28,6 +28,22 @@ const StringTypeEncodeHexFunctionDocString = `
 Returns a hexadecimal string for the given byte array
 `
 
+const StringTypeFromUTF8FunctionName = "fromUTF8"
+const StringTypeFromUTF8FunctionDocString = `
+Attempts to convert a UTF-8 encoded byte array into a string.
+Returns nil if the byte array contains invalid UTF-8
+`
+
+const StringTypeFromCharactersFunctionName = "fromCharacters"
+const StringTypeFromCharactersFunctionDocString = `
+Returns a string created by concatenating the given characters
+`
+
+const StringTypeJoinFunctionName = "join"
+const StringTypeJoinFunctionDocString = `
+Returns a string created by concatenating the given strings, with the given separator between each string
+`
+
 // StringType represents the string type
 //
 var StringType = &SimpleType{
@@ -120,6 +136,94 @@ func init() {
 					)
 				},
 			},
+			"split": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeSplitFunctionType,
+						stringTypeSplitFunctionDocString,
+					)
+				},
+			},
+			"replaceAll": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeReplaceAllFunctionType,
+						stringTypeReplaceAllFunctionDocString,
+					)
+				},
+			},
+			"contains": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeContainsFunctionType,
+						stringTypeContainsFunctionDocString,
+					)
+				},
+			},
+			"index": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeIndexFunctionType,
+						stringTypeIndexFunctionDocString,
+					)
+				},
+			},
+			"hasPrefix": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeHasPrefixFunctionType,
+						stringTypeHasPrefixFunctionDocString,
+					)
+				},
+			},
+			"hasSuffix": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeHasSuffixFunctionType,
+						stringTypeHasSuffixFunctionDocString,
+					)
+				},
+			},
+			"toUpper": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeToUpperFunctionType,
+						stringTypeToUpperFunctionDocString,
+					)
+				},
+			},
+			"trim": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeTrimFunctionType,
+						stringTypeTrimFunctionDocString,
+					)
+				},
+			},
 		}
 	}
 }
@@ -195,3 +299,128 @@ var StringTypeToLowerFunctionType = &FunctionType{
 const stringTypeToLowerFunctionDocString = `
 Returns the string with upper case letters replaced with lowercase
 `
+
+var StringTypeSplitFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "separator",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&VariableSizedType{
+			Type: StringType,
+		},
+	),
+}
+
+const stringTypeSplitFunctionDocString = `
+Returns an array of the substrings of the string which are separated by the given separator.
+
+If the separator is empty, the string is split into its characters
+`
+
+var StringTypeReplaceAllFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "of",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+		{
+			Identifier:     "with",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+const stringTypeReplaceAllFunctionDocString = `
+Returns a new string with all occurrences of the string ` + "`of`" + ` replaced by the string ` + "`with`" + `.
+
+If ` + "`of`" + ` is empty, the string is returned unchanged
+`
+
+var StringTypeContainsFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "other",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const stringTypeContainsFunctionDocString = `
+Returns true if the string contains the given string
+`
+
+var StringTypeIndexFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "of",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		IntType,
+	),
+}
+
+const stringTypeIndexFunctionDocString = `
+Returns the index of the first character of the first occurrence of the given string, or -1 if the string does not contain it
+`
+
+var StringTypeHasPrefixFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "prefix",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const stringTypeHasPrefixFunctionDocString = `
+Returns true if the string begins with the given prefix
+`
+
+var StringTypeHasSuffixFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "suffix",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const stringTypeHasSuffixFunctionDocString = `
+Returns true if the string ends with the given suffix
+`
+
+var StringTypeToUpperFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(StringType),
+}
+
+const stringTypeToUpperFunctionDocString = `
+Returns the string with lowercase letters replaced with upper case
+`
+
+var StringTypeTrimFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(StringType),
+}
+
+const stringTypeTrimFunctionDocString = `
+Returns the string with leading and trailing whitespace characters removed
+`
@@ -202,6 +202,14 @@ type ContainerType interface {
 	GetNestedTypes() *StringTypeOrderedMap
 }
 
+// TypeAliasContainerType is a container type which may declare type aliases,
+// e.g. a contract
+//
+type TypeAliasContainerType interface {
+	ContainerType
+	GetTypeAlias(identifier string) (Type, bool)
+}
+
 func VisitThisAndNested(t Type, visit func(ty Type)) {
 	visit(t)
 
@@ -1357,6 +1365,18 @@ var (
 			WithTag(Word64TypeTag).
 			WithIntRange(Word64TypeMinInt, Word64TypeMaxInt)
 
+	// Word128Type represents the 128-bit unsigned integer type `Word128`
+	// which does NOT check for overflow and underflow
+	Word128Type = NewNumericType(Word128TypeName).
+			WithTag(Word128TypeTag).
+			WithIntRange(Word128TypeMinIntBig, Word128TypeMaxIntBig)
+
+	// Word256Type represents the 256-bit unsigned integer type `Word256`
+	// which does NOT check for overflow and underflow
+	Word256Type = NewNumericType(Word256TypeName).
+			WithTag(Word256TypeTag).
+			WithIntRange(Word256TypeMinIntBig, Word256TypeMaxIntBig)
+
 	// FixedPointType represents the super-type of all fixed-point types
 	FixedPointType = NewNumericType(FixedPointTypeName).
 			WithTag(FixedPointTypeTag)
@@ -1387,6 +1407,29 @@ var (
 			WithSaturatingAdd().
 			WithSaturatingSubtract().
 			WithSaturatingMultiply()
+
+	// Fix128Type represents the 128-bit signed decimal fixed-point type `Fix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	Fix128Type = NewFixedPointNumericType(Fix128TypeName).
+			WithTag(Fix128TypeTag).
+			WithIntRange(Fix128TypeMinIntBig, Fix128TypeMaxIntBig).
+			WithFractionalRange(Fix128TypeMinFractionalBig, Fix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply().
+			WithSaturatingDivide()
+
+	// UFix128Type represents the 128-bit unsigned decimal fixed-point type `UFix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	UFix128Type = NewFixedPointNumericType(UFix128TypeName).
+			WithTag(UFix128TypeTag).
+			WithIntRange(UFix128TypeMinIntBig, UFix128TypeMaxIntBig).
+			WithFractionalRange(UFix128TypeMinFractionalBig, UFix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply()
 )
 
 // Numeric type ranges
@@ -1474,6 +1517,12 @@ var (
 	Word64TypeMinInt = new(big.Int)
 	Word64TypeMaxInt = new(big.Int).SetUint64(math.MaxUint64)
 
+	Word128TypeMinIntBig = new(big.Int)
+	Word128TypeMaxIntBig = UInt128TypeMaxIntBig
+
+	Word256TypeMinIntBig = new(big.Int)
+	Word256TypeMaxIntBig = UInt256TypeMaxIntBig
+
 	Fix64FactorBig = new(big.Int).SetUint64(uint64(Fix64Factor))
 
 	Fix64TypeMinIntBig = fixedpoint.Fix64TypeMinIntBig
@@ -1487,6 +1536,20 @@ var (
 
 	UFix64TypeMinFractionalBig = fixedpoint.UFix64TypeMinFractionalBig
 	UFix64TypeMaxFractionalBig = fixedpoint.UFix64TypeMaxFractionalBig
+
+	Fix128FactorBig = fixedpoint.Fix128FactorBig
+
+	Fix128TypeMinIntBig = fixedpoint.Fix128TypeMinIntBig
+	Fix128TypeMaxIntBig = fixedpoint.Fix128TypeMaxIntBig
+
+	Fix128TypeMinFractionalBig = fixedpoint.Fix128TypeMinFractionalBig
+	Fix128TypeMaxFractionalBig = fixedpoint.Fix128TypeMaxFractionalBig
+
+	UFix128TypeMinIntBig = fixedpoint.UFix128TypeMinIntBig
+	UFix128TypeMaxIntBig = fixedpoint.UFix128TypeMaxIntBig
+
+	UFix128TypeMinFractionalBig = fixedpoint.UFix128TypeMinFractionalBig
+	UFix128TypeMaxFractionalBig = fixedpoint.UFix128TypeMaxFractionalBig
 )
 
 const Fix64Scale = fixedpoint.Fix64Scale
@@ -1504,11 +1567,16 @@ const UFix64TypeMaxInt = fixedpoint.UFix64TypeMaxInt
 const UFix64TypeMinFractional = fixedpoint.UFix64TypeMinFractional
 const UFix64TypeMaxFractional = fixedpoint.UFix64TypeMaxFractional
 
+const Fix128Scale = fixedpoint.Fix128Scale
+
 // ArrayType
 
 type ArrayType interface {
 	ValueIndexableType
 	isArrayType()
+	// getReferenceMembers returns the members of the array type
+	// when it is accessed through a reference, see getArrayMembers
+	getReferenceMembers() map[string]MemberResolver
 }
 
 const arrayTypeContainsFunctionDocString = `
@@ -1569,7 +1637,62 @@ It does not modify the original array.
 If either of the parameters are out of the bounds of the array, or the indices are invalid (` + "`from > upTo`" + `), then the function will fail.
 `
 
-func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
+const arrayTypeFirstIndexFunctionDocString = `
+Returns the index of the first element which is equal to the given element, or nil if the array does not contain the element
+`
+
+const arrayTypeFilterFunctionDocString = `
+Returns a new variable-sized array containing the elements of the array for which the given function returns true, in the same order
+`
+
+const arrayTypeMapFunctionDocString = `
+Returns a new variable-sized array containing the results of calling the given function with each element of the array
+`
+
+const arrayTypeReverseFunctionDocString = `
+Returns a new array containing the elements of the array in reverse order
+`
+
+const arrayTypeSortFunctionDocString = `
+Sorts the array in place, using the given function to compare elements.
+
+The function must return true if the first argument should be ordered before the second argument.
+The sort is stable, i.e. the order of equal elements is preserved
+`
+
+// getArrayMembers returns the members of the given array type.
+//
+// Functions which copy elements, like `filter`, are invalid for arrays of resources.
+// When accessed through a reference, i.e. viaReference is true,
+// they are valid, and resource elements are provided as references
+//
+func getArrayMembers(arrayType ArrayType, viaReference bool) map[string]MemberResolver {
+
+	reportCopyingResourceMember := func(identifier string, targetRange ast.Range, report func(error)) {
+		if viaReference || !arrayType.ElementType(false).IsResourceType() {
+			return
+		}
+
+		report(
+			&InvalidResourceArrayMemberError{
+				Name:            identifier,
+				DeclarationKind: common.DeclarationKindFunction,
+				Range:           targetRange,
+			},
+		)
+	}
+
+	// functionElementType returns the type of the elements
+	// which are passed to and returned from the functions which copy elements.
+	// Through a reference, elements of resource arrays are provided as references
+
+	functionElementType := func() Type {
+		elementType := arrayType.ElementType(false)
+		if viaReference {
+			return ArrayFunctionElementType(elementType)
+		}
+		return elementType
+	}
 
 	members := map[string]MemberResolver{
 		"contains": {
@@ -1621,6 +1744,102 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
+		"firstIndex": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// Like for the function `contains`,
+				// it is impossible for an array of resources to have a `firstIndex` function
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				if !elementType.IsEquatable() {
+					report(
+						&NotEquatableTypeError{
+							Type:  elementType,
+							Range: targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayFirstIndexFunctionType(elementType),
+					arrayTypeFirstIndexFunctionDocString,
+				)
+			},
+		},
+		"filter": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				reportCopyingResourceMember(identifier, targetRange, report)
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayFilterFunctionType(functionElementType()),
+					arrayTypeFilterFunctionDocString,
+				)
+			},
+		},
+		"map": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				reportCopyingResourceMember(identifier, targetRange, report)
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayMapFunctionType(functionElementType()),
+					arrayTypeMapFunctionDocString,
+				)
+			},
+		},
+		"reverse": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				reportCopyingResourceMember(identifier, targetRange, report)
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayReverseFunctionType(arrayType, functionElementType()),
+					arrayTypeReverseFunctionDocString,
+				)
+			},
+		},
+		"sort": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+
+				// Sorting does not copy elements,
+				// so it is also valid for arrays of resources,
+				// and the elements are always compared through references
+
+				elementType := ArrayFunctionElementType(arrayType.ElementType(false))
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArraySortFunctionType(elementType),
+					arrayTypeSortFunctionDocString,
+				)
+			},
+		},
 	}
 
 	// TODO: maybe still return members but report a helpful error?
@@ -1696,22 +1915,12 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
 
-				elementType := arrayType.ElementType(false)
-
-				if elementType.IsResourceType() {
-					report(
-						&InvalidResourceArrayMemberError{
-							Name:            identifier,
-							DeclarationKind: common.DeclarationKindFunction,
-							Range:           targetRange,
-						},
-					)
-				}
+				reportCopyingResourceMember(identifier, targetRange, report)
 
 				return NewPublicFunctionMember(
 					arrayType,
 					identifier,
-					ArraySliceFunctionType(elementType),
+					ArraySliceFunctionType(functionElementType()),
 					arrayTypeSliceFunctionDocString,
 				)
 			},
@@ -1906,11 +2115,158 @@ func ArraySliceFunctionType(elementType Type) *FunctionType {
 	}
 }
 
+// ArrayFunctionElementType returns the type of the elements of the given type
+// when they are passed to and returned from array functions like `filter`:
+// Elements of resource arrays are provided as references,
+// so they are neither moved nor copied
+//
+func ArrayFunctionElementType(elementType Type) Type {
+	if elementType.IsResourceType() {
+		return &ReferenceType{
+			Type: elementType,
+		}
+	}
+	return elementType
+}
+
+func ArrayFirstIndexFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Identifier:     "of",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: IntType,
+			},
+		),
+	}
+}
+
+func ArrayFilterFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "isIncluded",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&VariableSizedType{
+				Type: elementType,
+			},
+		),
+	}
+}
+
+func ArrayMapFunctionType(elementType Type) *FunctionType {
+	typeParameter := &TypeParameter{
+		Name: "T",
+	}
+
+	resultType := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "transform",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							resultType,
+						),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&VariableSizedType{
+				Type: resultType,
+			},
+		),
+	}
+}
+
+func ArrayReverseFunctionType(arrayType ArrayType, elementType Type) *FunctionType {
+	var resultType Type
+	if constantSizedType, ok := arrayType.(*ConstantSizedType); ok {
+		resultType = &ConstantSizedType{
+			Type: elementType,
+			Size: constantSizedType.Size,
+		}
+	} else {
+		resultType = &VariableSizedType{
+			Type: elementType,
+		}
+	}
+
+	return &FunctionType{
+		ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+	}
+}
+
+func ArraySortFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Identifier: "by",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "a",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "b",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
 // VariableSizedType is a variable sized array type
 type VariableSizedType struct {
-	Type                Type
-	memberResolvers     map[string]MemberResolver
-	memberResolversOnce sync.Once
+	Type                         Type
+	memberResolvers              map[string]MemberResolver
+	memberResolversOnce          sync.Once
+	referenceMemberResolvers     map[string]MemberResolver
+	referenceMemberResolversOnce sync.Once
 }
 
 func (*VariableSizedType) IsType() {}
@@ -1949,10 +2305,17 @@ func (t *VariableSizedType) GetMembers() map[string]MemberResolver {
 
 func (t *VariableSizedType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
-		t.memberResolvers = getArrayMembers(t)
+		t.memberResolvers = getArrayMembers(t, false)
 	})
 }
 
+func (t *VariableSizedType) getReferenceMembers() map[string]MemberResolver {
+	t.referenceMemberResolversOnce.Do(func() {
+		t.referenceMemberResolvers = getArrayMembers(t, true)
+	})
+	return t.referenceMemberResolvers
+}
+
 func (t *VariableSizedType) IsResourceType() bool {
 	return t.Type.IsResourceType()
 }
@@ -2037,10 +2400,12 @@ func (t *VariableSizedType) Resolve(typeArguments *TypeParameterTypeOrderedMap)
 
 // ConstantSizedType is a constant sized array type
 type ConstantSizedType struct {
-	Type                Type
-	Size                int64
-	memberResolvers     map[string]MemberResolver
-	memberResolversOnce sync.Once
+	Type                         Type
+	Size                         int64
+	memberResolvers              map[string]MemberResolver
+	memberResolversOnce          sync.Once
+	referenceMemberResolvers     map[string]MemberResolver
+	referenceMemberResolversOnce sync.Once
 }
 
 func (*ConstantSizedType) IsType() {}
@@ -2080,8 +2445,15 @@ func (t *ConstantSizedType) GetMembers() map[string]MemberResolver {
 
 func (t *ConstantSizedType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
-		t.memberResolvers = getArrayMembers(t)
+		t.memberResolvers = getArrayMembers(t, false)
+	})
+}
+
+func (t *ConstantSizedType) getReferenceMembers() map[string]MemberResolver {
+	t.referenceMemberResolversOnce.Do(func() {
+		t.referenceMemberResolvers = getArrayMembers(t, true)
 	})
+	return t.referenceMemberResolvers
 }
 
 func (t *ConstantSizedType) IsResourceType() bool {
@@ -2201,6 +2573,9 @@ type Parameter struct {
 	Label          string
 	Identifier     string
 	TypeAnnotation *TypeAnnotation
+	// DefaultArgument is the constant expression which is used
+	// as the argument when an invocation does not provide one
+	DefaultArgument ast.Expression
 }
 
 func (p *Parameter) String() string {
@@ -2355,6 +2730,7 @@ type FunctionType struct {
 	ReturnTypeAnnotation     *TypeAnnotation
 	RequiredArgumentCount    *int
 	ArgumentExpressionsCheck ArgumentExpressionsCheck
+	LiteralArgumentType      Type
 	Members                  *StringMemberOrderedMap
 }
 
@@ -2861,6 +3237,14 @@ func init() {
 	)
 }
 
+// setTypeVariable is the variable for the built-in set type.
+//
+// The type is not declared in the base type activation,
+// as existing programs may already declare a type named `Set`,
+// which then takes precedence over the built-in type
+//
+var setTypeVariable = baseTypeVariable("Set", &SetType{})
+
 func baseTypeVariable(name string, ty Type) *Variable {
 	return &Variable{
 		Identifier:      name,
@@ -2879,10 +3263,12 @@ var BaseValueActivation = NewVariableActivation(nil)
 
 var AllSignedFixedPointTypes = []Type{
 	Fix64Type,
+	Fix128Type,
 }
 
 var AllUnsignedFixedPointTypes = []Type{
 	UFix64Type,
+	UFix128Type,
 }
 
 var AllFixedPointTypes = append(
@@ -2918,6 +3304,8 @@ var AllUnsignedIntegerTypes = []Type{
 	Word16Type,
 	Word32Type,
 	Word64Type,
+	Word128Type,
+	Word256Type,
 }
 
 var AllIntegerTypes = append(
@@ -2982,6 +3370,7 @@ func init() {
 				},
 				ReturnTypeAnnotation:     NewTypeAnnotation(numberType),
 				ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
+				LiteralArgumentType:      numberType,
 			}
 
 			addMember := func(member *Member) {
@@ -3134,16 +3523,29 @@ func numberFunctionArgumentExpressionsChecker(targetType Type) ArgumentExpressio
 
 		argument := arguments[0]
 
-		switch argument := argument.(type) {
+		// A literal argument is typed with the target type, if possible,
+		// see `LiteralArgumentType`, in which case errors were already reported
+
+		report := checker.report
+
+		switch argument := argument.(type) {
 		case *ast.IntegerExpression:
-			if CheckIntegerLiteral(argument, targetType, checker.report) {
+			if checker.Elaboration.IntegerExpressionType[argument] == targetType {
+				report = nil
+			}
+
+			if CheckIntegerLiteral(argument, targetType, report) {
 				if checker.lintEnabled {
 					suggestIntegerLiteralConversionReplacement(checker, argument, targetType, invocationRange)
 				}
 			}
 
 		case *ast.FixedPointExpression:
-			if CheckFixedPointLiteral(argument, targetType, checker.report) {
+			if checker.Elaboration.FixedPointExpression[argument] == targetType {
+				report = nil
+			}
+
+			if CheckFixedPointLiteral(argument, targetType, report) {
 				if checker.lintEnabled {
 					suggestFixedPointLiteralConversionReplacement(checker, targetType, argument, invocationRange)
 				}
@@ -3188,6 +3590,27 @@ func init() {
 		StringTypeEncodeHexFunctionDocString,
 	))
 
+	addMember(NewPublicFunctionMember(
+		functionType,
+		StringTypeFromUTF8FunctionName,
+		StringTypeFromUTF8FunctionType,
+		StringTypeFromUTF8FunctionDocString,
+	))
+
+	addMember(NewPublicFunctionMember(
+		functionType,
+		StringTypeFromCharactersFunctionName,
+		StringTypeFromCharactersFunctionType,
+		StringTypeFromCharactersFunctionDocString,
+	))
+
+	addMember(NewPublicFunctionMember(
+		functionType,
+		StringTypeJoinFunctionName,
+		StringTypeJoinFunctionType,
+		StringTypeJoinFunctionDocString,
+	))
+
 	BaseValueActivation.Set(
 		typeName,
 		baseFunctionVariable(
@@ -3213,6 +3636,65 @@ var StringTypeEncodeHexFunctionType = &FunctionType{
 	),
 }
 
+var StringTypeFromUTF8FunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "bytes",
+			TypeAnnotation: NewTypeAnnotation(
+				ByteArrayType,
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: StringType,
+		},
+	),
+}
+
+var CharacterArrayType = &VariableSizedType{
+	Type: CharacterType,
+}
+
+var StringTypeFromCharactersFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "characters",
+			TypeAnnotation: NewTypeAnnotation(
+				CharacterArrayType,
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+var StringTypeJoinFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "strings",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					Type: StringType,
+				},
+			),
+		},
+		{
+			Identifier: "separator",
+			TypeAnnotation: NewTypeAnnotation(
+				StringType,
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
 func suggestIntegerLiteralConversionReplacement(
 	checker *Checker,
 	argument *ast.IntegerExpression,
@@ -3421,9 +3903,11 @@ type CompositeType struct {
 	memberResolvers                     map[string]MemberResolver
 	memberResolversOnce                 sync.Once
 	Fields                              []string
-	// TODO: add support for overloaded initializers
+	// ConstructorParameters are the parameters of the first initializer.
+	// Further initializers are declared as overloads of the constructor
 	ConstructorParameters []*Parameter
 	nestedTypes           *StringTypeOrderedMap
+	typeAliases           *StringTypeOrderedMap
 	containerType         Type
 	EnumRawType           Type
 	hasComputedMembers    bool
@@ -3723,6 +4207,23 @@ func (t *CompositeType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.nestedTypes
 }
 
+// GetTypeAlias returns the target type of the type alias
+// with the given identifier, if the type declares it
+//
+func (t *CompositeType) GetTypeAlias(identifier string) (Type, bool) {
+	if t.typeAliases == nil {
+		return nil, false
+	}
+	return t.typeAliases.Get(identifier)
+}
+
+func (t *CompositeType) setTypeAlias(identifier string, ty Type) {
+	if t.typeAliases == nil {
+		t.typeAliases = NewStringTypeOrderedMap()
+	}
+	t.typeAliases.Set(identifier, ty)
+}
+
 func (t *CompositeType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
 		members := make(map[string]MemberResolver, t.Members.Len())
@@ -3768,6 +4269,9 @@ type Member struct {
 	DeclarationKind common.DeclarationKind
 	VariableKind    ast.VariableKind
 	ArgumentLabels  []string
+	// Overloads are the functions declared with the same identifier,
+	// but different argument labels
+	Overloads []*Member
 	// Predeclared fields can be considered initialized
 	Predeclared bool
 	// IgnoreInSerialization fields are ignored in serialization
@@ -3775,6 +4279,21 @@ type Member struct {
 	DocString             string
 }
 
+// Overload returns the member or the overload of the member
+// which has the given argument labels, if any
+//
+func (m *Member) Overload(argumentLabels []string) *Member {
+	if argumentLabelsEqual(m.ArgumentLabels, argumentLabels) {
+		return m
+	}
+	for _, overload := range m.Overloads {
+		if argumentLabelsEqual(overload.ArgumentLabels, argumentLabels) {
+			return overload
+		}
+	}
+	return nil
+}
+
 func NewPublicFunctionMember(
 	containerType Type,
 	identifier string,
@@ -3898,6 +4417,7 @@ type InterfaceType struct {
 	InitializerParameters []*Parameter
 	containerType         Type
 	nestedTypes           *StringTypeOrderedMap
+	typeAliases           *StringTypeOrderedMap
 	cachedIdentifiers     *struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
@@ -4120,6 +4640,23 @@ func (t *InterfaceType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.nestedTypes
 }
 
+// GetTypeAlias returns the target type of the type alias
+// with the given identifier, if the type declares it
+//
+func (t *InterfaceType) GetTypeAlias(identifier string) (Type, bool) {
+	if t.typeAliases == nil {
+		return nil, false
+	}
+	return t.typeAliases.Get(identifier)
+}
+
+func (t *InterfaceType) setTypeAlias(identifier string, ty Type) {
+	if t.typeAliases == nil {
+		t.typeAliases = NewStringTypeOrderedMap()
+	}
+	t.typeAliases.Set(identifier, ty)
+}
+
 // DictionaryType consists of the key and value type
 // for all key-value pairs in the dictionary:
 // All keys have to be a subtype of the key type,
@@ -4252,6 +4789,12 @@ Inserts the given value into the dictionary under the given key.
 Returns the previous value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
 `
 
+const dictionaryTypeForEachKeyFunctionDocString = `
+Calls the given function with each key of the dictionary, without creating an array of the keys.
+
+Iteration stops when the function returns false
+`
+
 const dictionaryTypeRemoveFunctionDocString = `
 Removes the value for the given key from the dictionary.
 
@@ -4356,10 +4899,55 @@ func (t *DictionaryType) initializeMemberResolvers() {
 					)
 				},
 			},
+			"forEachKey": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					if t.KeyType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicFunctionMember(t,
+						identifier,
+						DictionaryForEachKeyFunctionType(t),
+						dictionaryTypeForEachKeyFunctionDocString,
+					)
+				},
+			},
 		})
 	})
 }
 
+func DictionaryForEachKeyFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "key",
+								TypeAnnotation: NewTypeAnnotation(t.KeyType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
 func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
 	return &FunctionType{
 		Parameters: []*Parameter{
@@ -4467,6 +5055,383 @@ func (t *DictionaryType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Typ
 	}
 }
 
+// SetType consists of the element type
+// for all elements in the set:
+// All elements have to be a subtype of the element type.
+// The element type must be a valid dictionary key type, i.e. be hashable.
+//
+// The element type is nil for the unparameterized type `Set`.
+
+type SetType struct {
+	ElementType         Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+func (*SetType) IsType() {}
+
+func (t *SetType) Tag() TypeTag {
+	return SetTypeTag
+}
+
+func (t *SetType) string(typeFormatter func(Type) string) string {
+	if t.ElementType == nil {
+		return "Set"
+	}
+	return fmt.Sprintf("{%s}", typeFormatter(t.ElementType))
+}
+
+func (t *SetType) String() string {
+	return t.string(func(t Type) string {
+		return t.String()
+	})
+}
+
+func (t *SetType) QualifiedString() string {
+	return t.string(func(t Type) string {
+		return t.QualifiedString()
+	})
+}
+
+func (t *SetType) ID() TypeID {
+	return TypeID(t.string(func(t Type) string {
+		return string(t.ID())
+	}))
+}
+
+func (t *SetType) Equal(other Type) bool {
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+	if otherSet.ElementType == nil {
+		return t.ElementType == nil
+	}
+	return otherSet.ElementType.Equal(t.ElementType)
+}
+
+func (*SetType) IsResourceType() bool {
+	// Resources are not hashable,
+	// so they can never be elements of a set
+	return false
+}
+
+func (t *SetType) IsInvalidType() bool {
+	if t.ElementType == nil {
+		return false
+	}
+	return t.ElementType.IsInvalidType()
+}
+
+func (t *SetType) IsStorable(results map[*Member]bool) bool {
+	if t.ElementType == nil {
+		return true
+	}
+	return t.ElementType.IsStorable(results)
+}
+
+func (t *SetType) IsExternallyReturnable(results map[*Member]bool) bool {
+	if t.ElementType == nil {
+		return true
+	}
+	return t.ElementType.IsExternallyReturnable(results)
+}
+
+func (t *SetType) IsImportable(results map[*Member]bool) bool {
+	if t.ElementType == nil {
+		return true
+	}
+	return t.ElementType.IsImportable(results)
+}
+
+func (t *SetType) IsEquatable() bool {
+	if t.ElementType == nil {
+		return false
+	}
+	return t.ElementType.IsEquatable()
+}
+
+func (t *SetType) TypeAnnotationState() TypeAnnotationState {
+	if t.ElementType == nil {
+		return TypeAnnotationStateMissingTypeArguments
+	}
+	return t.ElementType.TypeAnnotationState()
+}
+
+func (t *SetType) RewriteWithRestrictedTypes() (Type, bool) {
+	if t.ElementType == nil {
+		return t, false
+	}
+	rewrittenType, rewritten := t.ElementType.RewriteWithRestrictedTypes()
+	if rewritten {
+		return &SetType{
+			ElementType: rewrittenType,
+		}, true
+	} else {
+		return t, false
+	}
+}
+
+const setTypeContainsFunctionDocString = `
+Returns true if the given element is in the set
+`
+
+const setTypeLengthFieldDocString = `
+The number of elements in the set
+`
+
+const setTypeInsertFunctionDocString = `
+Inserts the given element into the set.
+
+Returns true if the element was inserted, or false if the set already contained the element
+`
+
+const setTypeRemoveFunctionDocString = `
+Removes the given element from the set.
+
+Returns true if the element was removed, or false if the set did not contain the element
+`
+
+const setTypeUnionFunctionDocString = `
+Returns a new set containing all elements which are in this set, in the given set, or in both
+`
+
+const setTypeIntersectionFunctionDocString = `
+Returns a new set containing all elements which are in both this set and the given set
+`
+
+const setTypeDifferenceFunctionDocString = `
+Returns a new set containing all elements which are in this set, but not in the given set
+`
+
+func (t *SetType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+func (t *SetType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+
+		// The unparameterized type `Set` has no element type,
+		// so only the built-in members are available
+
+		if t.ElementType == nil {
+			t.memberResolvers = withBuiltinMembers(t, map[string]MemberResolver{})
+			return
+		}
+
+		t.memberResolvers = withBuiltinMembers(t, map[string]MemberResolver{
+			"contains": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						SetContainsFunctionType(t),
+						setTypeContainsFunctionDocString,
+					)
+				},
+			},
+			"length": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						IntType,
+						setTypeLengthFieldDocString,
+					)
+				},
+			},
+			"insert": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						SetInsertFunctionType(t),
+						setTypeInsertFunctionDocString,
+					)
+				},
+			},
+			"remove": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						SetRemoveFunctionType(t),
+						setTypeRemoveFunctionDocString,
+					)
+				},
+			},
+			"union": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						SetOperationFunctionType(t),
+						setTypeUnionFunctionDocString,
+					)
+				},
+			},
+			"intersection": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						SetOperationFunctionType(t),
+						setTypeIntersectionFunctionDocString,
+					)
+				},
+			},
+			"difference": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						SetOperationFunctionType(t),
+						setTypeDifferenceFunctionDocString,
+					)
+				},
+			},
+		})
+	})
+}
+
+func SetContainsFunctionType(t *SetType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			BoolType,
+		),
+	}
+}
+
+func SetInsertFunctionType(t *SetType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			BoolType,
+		),
+	}
+}
+
+func SetRemoveFunctionType(t *SetType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			BoolType,
+		),
+	}
+}
+
+// SetOperationFunctionType returns the type of the functions `union`, `intersection`, and `difference`
+//
+func SetOperationFunctionType(t *SetType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(t),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			t,
+		),
+	}
+}
+
+func (t *SetType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+
+	if t.ElementType == nil {
+		return false
+	}
+
+	return t.ElementType.Unify(otherSet.ElementType, typeParameters, report, outerRange)
+}
+
+func (t *SetType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	if t.ElementType == nil {
+		return t
+	}
+
+	newElementType := t.ElementType.Resolve(typeArguments)
+	if newElementType == nil {
+		return nil
+	}
+
+	return &SetType{
+		ElementType: newElementType,
+	}
+}
+
+var setTypeParameter = &TypeParameter{
+	Name: "T",
+}
+
+func (t *SetType) TypeParameters() []*TypeParameter {
+	return []*TypeParameter{
+		setTypeParameter,
+	}
+}
+
+func (t *SetType) Instantiate(typeArguments []Type, _ func(err error)) Type {
+	elementType := typeArguments[0]
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (t *SetType) BaseType() Type {
+	if t.ElementType == nil {
+		return nil
+	}
+	return &SetType{}
+}
+
+func (t *SetType) TypeArguments() []Type {
+	elementType := t.ElementType
+	if elementType == nil {
+		elementType = AnyStructType
+	}
+	return []Type{
+		elementType,
+	}
+}
+
 // ReferenceType represents the reference to a value
 type ReferenceType struct {
 	Authorized bool
@@ -4566,6 +5531,15 @@ func (t *ReferenceType) RewriteWithRestrictedTypes() (Type, bool) {
 }
 
 func (t *ReferenceType) GetMembers() map[string]MemberResolver {
+	// Functions of arrays of resources which copy elements
+	// are only available through a reference
+
+	if arrayType, ok := t.Type.(ArrayType); ok &&
+		arrayType.ElementType(false).IsResourceType() {
+
+		return arrayType.getReferenceMembers()
+	}
+
 	return t.Type.GetMembers()
 }
 
@@ -4824,7 +5798,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		case IntegerType, SignedIntegerType,
 			UIntType,
 			UInt8Type, UInt16Type, UInt32Type, UInt64Type, UInt128Type, UInt256Type,
-			Word8Type, Word16Type, Word32Type, Word64Type:
+			Word8Type, Word16Type, Word32Type, Word64Type, Word128Type, Word256Type:
 
 			return true
 
@@ -4847,7 +5821,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 	case FixedPointType:
 		switch subType {
 		case FixedPointType, SignedFixedPointType,
-			UFix64Type:
+			UFix64Type, UFix128Type:
 
 			return true
 
@@ -4857,7 +5831,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 	case SignedFixedPointType:
 		switch subType {
-		case SignedFixedPointType, Fix64Type:
+		case SignedFixedPointType, Fix64Type, Fix128Type:
 			return true
 
 		default:
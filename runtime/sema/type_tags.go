@@ -172,14 +172,14 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	fix64TypeMask
-	_ // future: Fix128
+	fix128TypeMask
 	_ // future: Fix256
 
 	_ // future: UFix8
 	_ // future: UFix16
 	_ // future: UFix32
 	ufix64TypeMask
-	_ // future: UFix128
+	ufix128TypeMask
 	_ // future: UFix256
 
 	stringTypeMask
@@ -219,6 +219,9 @@ const (
 	capabilityTypeMask uint64 = 1 << iota
 	restrictedTypeMask
 	transactionTypeMask
+	setTypeMask
+	word128TypeMask
+	word256TypeMask
 
 	invalidTypeMask
 )
@@ -247,17 +250,21 @@ var (
 				Or(Word8TypeTag).
 				Or(Word16TypeTag).
 				Or(Word32TypeTag).
-				Or(Word64TypeTag)
+				Or(Word64TypeTag).
+				Or(Word128TypeTag).
+				Or(Word256TypeTag)
 
 	IntegerTypeTag = newTypeTagFromLowerMask(integerTypeMask).
 			Or(SignedIntegerTypeTag).
 			Or(UnsignedIntegerTypeTag)
 
 	SignedFixedPointTypeTag = newTypeTagFromLowerMask(signedFixedPointTypeMask).
-				Or(Fix64TypeTag)
+				Or(Fix64TypeTag).
+				Or(Fix128TypeTag)
 
 	UnsignedFixedPointTypeTag = newTypeTagFromLowerMask(unsignedFixedPointTypeMask).
-					Or(UFix64TypeTag)
+					Or(UFix64TypeTag).
+					Or(UFix128TypeTag)
 
 	FixedPointTypeTag = newTypeTagFromLowerMask(fixedPointTypeMask).
 				Or(SignedFixedPointTypeTag).
@@ -288,13 +295,17 @@ var (
 	Int128TypeTag = newTypeTagFromLowerMask(int128TypeMask)
 	Int256TypeTag = newTypeTagFromLowerMask(int256TypeMask)
 
-	Word8TypeTag  = newTypeTagFromLowerMask(word8TypeMask)
-	Word16TypeTag = newTypeTagFromLowerMask(word16TypeMask)
-	Word32TypeTag = newTypeTagFromLowerMask(word32TypeMask)
-	Word64TypeTag = newTypeTagFromLowerMask(word64TypeMask)
+	Word8TypeTag   = newTypeTagFromLowerMask(word8TypeMask)
+	Word16TypeTag  = newTypeTagFromLowerMask(word16TypeMask)
+	Word32TypeTag  = newTypeTagFromLowerMask(word32TypeMask)
+	Word64TypeTag  = newTypeTagFromLowerMask(word64TypeMask)
+	Word128TypeTag = newTypeTagFromUpperMask(word128TypeMask)
+	Word256TypeTag = newTypeTagFromUpperMask(word256TypeMask)
 
-	Fix64TypeTag  = newTypeTagFromLowerMask(fix64TypeMask)
-	UFix64TypeTag = newTypeTagFromLowerMask(ufix64TypeMask)
+	Fix64TypeTag   = newTypeTagFromLowerMask(fix64TypeMask)
+	Fix128TypeTag  = newTypeTagFromLowerMask(fix128TypeMask)
+	UFix64TypeTag  = newTypeTagFromLowerMask(ufix64TypeMask)
+	UFix128TypeTag = newTypeTagFromLowerMask(ufix128TypeMask)
 
 	StringTypeTag           = newTypeTagFromLowerMask(stringTypeMask)
 	CharacterTypeTag        = newTypeTagFromLowerMask(characterTypeMask)
@@ -332,6 +343,7 @@ var (
 	CapabilityTypeTag  = newTypeTagFromUpperMask(capabilityTypeMask)
 	InvalidTypeTag     = newTypeTagFromUpperMask(invalidTypeMask)
 	TransactionTypeTag = newTypeTagFromUpperMask(transactionTypeMask)
+	SetTypeTag         = newTypeTagFromUpperMask(setTypeMask)
 
 	// AnyStructTypeTag only includes the types that are pre-known
 	// to belong to AnyStruct type. This is more of an optimization.
@@ -353,6 +365,7 @@ var (
 				Or(BlockTypeTag).
 				Or(DeployedContractTypeTag).
 				Or(CapabilityTypeTag).
+				Or(SetTypeTag).
 				Or(FunctionTypeTag)
 
 	AnyResourceTypeTag = newTypeTagFromLowerMask(anyResourceTypeMask)
@@ -523,8 +536,12 @@ func findSuperTypeFromLowerMask(joinedTypeTag TypeTag, types []Type) Type {
 
 	case fix64TypeMask:
 		return Fix64Type
+	case fix128TypeMask:
+		return Fix128Type
 	case ufix64TypeMask:
 		return UFix64Type
+	case ufix128TypeMask:
+		return UFix128Type
 
 	case stringTypeMask:
 		return StringType
@@ -615,10 +632,26 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 	case invalidTypeMask:
 		return InvalidType
 
+	// The number types which do not fit into the lower mask
+	// are only their own supertype if no other types are joined.
+	// Otherwise, fall back to the number supertypes.
+	case word128TypeMask:
+		if joinedTypeTag.lowerMask == noTypeMask {
+			return Word128Type
+		}
+		return nil
+
+	case word256TypeMask:
+		if joinedTypeTag.lowerMask == noTypeMask {
+			return Word256Type
+		}
+		return nil
+
 	// All derived types goes here.
 	case capabilityTypeMask,
 		restrictedTypeMask,
-		transactionTypeMask:
+		transactionTypeMask,
+		setTypeMask:
 		return getSuperTypeOfDerivedTypes(types)
 	default:
 		return nil
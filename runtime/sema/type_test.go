@@ -798,6 +798,23 @@ func TestCommonSuperType(t *testing.T) {
 				},
 				expectedSuperType: IntegerType,
 			},
+			{
+				name: "homogenous large word types",
+				types: []Type{
+					Word256Type,
+					Word256Type,
+				},
+				expectedSuperType: Word256Type,
+			},
+			{
+				name: "heterogeneous word types",
+				types: []Type{
+					Word64Type,
+					Word128Type,
+					Word256Type,
+				},
+				expectedSuperType: IntegerType,
+			},
 			{
 				name: "heterogeneous fixed-point types",
 				types: []Type{
@@ -1677,3 +1694,45 @@ func TestTypeInclusions(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestReferenceType_GetMembers(t *testing.T) {
+
+	t.Parallel()
+
+	resourceType := &CompositeType{
+		Location:   common.StringLocation("test"),
+		Identifier: "R",
+		Kind:       common.CompositeKindResource,
+	}
+
+	arrayType := &VariableSizedType{
+		Type: resourceType,
+	}
+
+	referenceType := &ReferenceType{
+		Type: arrayType,
+	}
+
+	members := referenceType.GetMembers()
+
+	require.Contains(t, members, "filter")
+
+	// The members of the reference to the array are only determined once
+
+	assert.Equal(t,
+		fmt.Sprintf("%p", members),
+		fmt.Sprintf("%p", referenceType.GetMembers()),
+	)
+
+	assert.Equal(t,
+		fmt.Sprintf("%p", members),
+		fmt.Sprintf("%p", (&ReferenceType{Type: arrayType}).GetMembers()),
+	)
+
+	// The members through a reference differ from the members of the array itself
+
+	assert.NotEqual(t,
+		fmt.Sprintf("%p", members),
+		fmt.Sprintf("%p", arrayType.GetMembers()),
+	)
+}
@@ -39,8 +39,26 @@ type Variable struct {
 	ActivationDepth int
 	// ArgumentLabels are the argument labels that must be used in an invocation of the variable
 	ArgumentLabels []string
+	// Overloads are the functions declared in the same scope with the same identifier,
+	// but different argument labels
+	Overloads []*Variable
 	// Pos is the position where the variable was declared
 	Pos *ast.Position
 	// DocString is the optional docstring
 	DocString string
 }
+
+// Overload returns the variable or the overload of the variable
+// which has the given argument labels, if any
+//
+func (v *Variable) Overload(argumentLabels []string) *Variable {
+	if argumentLabelsEqual(v.ArgumentLabels, argumentLabels) {
+		return v
+	}
+	for _, overload := range v.Overloads {
+		if argumentLabelsEqual(overload.ArgumentLabels, argumentLabels) {
+			return overload
+		}
+	}
+	return nil
+}
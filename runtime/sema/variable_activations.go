@@ -288,6 +288,39 @@ func (a *VariableActivations) Declare(declaration variableDeclaration) (variable
 	return variable, err
 }
 
+// DeclareOverload declares the given function declaration as an overload
+// of the function with the same identifier declared in the current scope.
+//
+// Returns nil if there is no such function,
+// or if it already has an overload with the same argument labels,
+// in which case the declaration is a redeclaration.
+//
+func (a *VariableActivations) DeclareOverload(declaration variableDeclaration) *Variable {
+
+	existingVariable := a.Find(declaration.identifier)
+	if existingVariable == nil ||
+		existingVariable.ActivationDepth != a.Depth() ||
+		existingVariable.DeclarationKind != declaration.kind ||
+		existingVariable.Overload(declaration.argumentLabels) != nil {
+
+		return nil
+	}
+
+	variable := &Variable{
+		Identifier:      declaration.identifier,
+		Access:          declaration.access,
+		DeclarationKind: declaration.kind,
+		IsConstant:      declaration.isConstant,
+		ActivationDepth: existingVariable.ActivationDepth,
+		Type:            declaration.ty,
+		Pos:             &declaration.pos,
+		ArgumentLabels:  declaration.argumentLabels,
+		DocString:       declaration.docString,
+	}
+	existingVariable.Overloads = append(existingVariable.Overloads, variable)
+	return variable
+}
+
 type typeDeclaration struct {
 	identifier               ast.Identifier
 	ty                       Type
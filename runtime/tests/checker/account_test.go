@@ -1393,6 +1393,122 @@ func TestCheckAccount_StorageFields(t *testing.T) {
 	}
 }
 
+func TestCheckAccount_storageIteration(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(domain common.PathDomain, accountType, accountVariable string) {
+
+		pathType := sema.PathTypeForDomain(domain)
+
+		functionName := map[common.PathDomain]string{
+			common.PathDomainStorage: "forEachStored",
+			common.PathDomainPublic:  "forEachPublic",
+			common.PathDomainPrivate: "forEachPrivate",
+		}[domain]
+		fieldName := domain.Identifier() + "Paths"
+
+		isAvailable := accountType == "AuthAccount" ||
+			domain == common.PathDomainPublic
+
+		t.Run(fmt.Sprintf("%s.%s", accountType, fieldName), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      let paths = %s.%s
+                    `,
+					accountVariable,
+					fieldName,
+				),
+			)
+
+			if !isAvailable {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.VariableSizedType{
+					Type: pathType,
+				},
+				RequireGlobalValue(t, checker.Elaboration, "paths"),
+			)
+		})
+
+		t.Run(fmt.Sprintf("%s.%s", accountType, functionName), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          %s.%s(fun (path: %s, type: Type): Bool {
+                              return true
+                          })
+                      }
+                    `,
+					accountVariable,
+					functionName,
+					pathType,
+				),
+			)
+
+			if !isAvailable {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+				return
+			}
+
+			require.NoError(t, err)
+		})
+
+		if !isAvailable {
+			return
+		}
+
+		t.Run(fmt.Sprintf("%s.%s, invalid function", accountType, functionName), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          %s.%s(fun (path: %s, type: Type) {})
+                      }
+                    `,
+					accountVariable,
+					functionName,
+					pathType,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		})
+	}
+
+	for _, domain := range common.AllPathDomains {
+
+		for accountType, accountVariable := range map[string]string{
+			"AuthAccount":   "authAccount",
+			"PublicAccount": "publicAccount",
+		} {
+			test(domain, accountType, accountVariable)
+		}
+	}
+}
+
 func TestAuthAccountContracts(t *testing.T) {
 
 	t.Parallel()
@@ -1170,3 +1170,247 @@ func TestNilAssignmentToDictionary(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCheckArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let xs = [3, 1, 2]
+        let constant: [Int; 3] = [3, 1, 2]
+
+        let firstIndex = xs.firstIndex(of: 1)
+        let filtered = xs.filter(fun (x: Int): Bool { return x > 1 })
+        let mapped = xs.map(fun (x: Int): String { return x.toString() })
+        let reversed = xs.reverse()
+        let reversedConstant = constant.reverse()
+        let sliced = xs.slice(from: 0, upTo: 2)
+
+        fun test() {
+            xs.sort(by: fun (a: Int, b: Int): Bool { return a < b })
+        }
+    `)
+
+	require.NoError(t, err)
+
+	for name, expectedType := range map[string]sema.Type{
+		"firstIndex":       &sema.OptionalType{Type: sema.IntType},
+		"filtered":         &sema.VariableSizedType{Type: sema.IntType},
+		"mapped":           &sema.VariableSizedType{Type: sema.StringType},
+		"reversed":         &sema.VariableSizedType{Type: sema.IntType},
+		"reversedConstant": &sema.ConstantSizedType{Type: sema.IntType, Size: 3},
+		"sliced":           &sema.VariableSizedType{Type: sema.IntType},
+	} {
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+			name,
+		)
+	}
+}
+
+func TestCheckInvalidArrayFilterFunctionType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        let xs = [1, 2]
+        let ys = xs.filter(fun (x: String): Bool { return true })
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidConstantSizedArraySlice(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        let xs: [Int; 3] = [1, 2, 3]
+        let ys = xs.slice(from: 0, upTo: 2)
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
+func TestCheckResourceArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("copying, invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, statements := range []string{
+			`
+              let result <- rs.filter(isIncluded)
+              destroy result
+            `,
+			`
+              let result = rs.map(transform)
+            `,
+			`
+              let result <- rs.reverse()
+              destroy result
+            `,
+		} {
+			_, err := ParseAndCheck(t, fmt.Sprintf(`
+                resource R {}
+
+                fun isIncluded(_ r: @R): Bool {
+                    destroy r
+                    return true
+                }
+
+                fun transform(_ r: @R): Int {
+                    destroy r
+                    return 1
+                }
+
+                fun test() {
+                    let rs <- [<-create R()]
+                    %s
+                    destroy rs
+                }
+            `, statements))
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+		}
+	})
+
+	t.Run("first index, invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {}
+
+            fun test() {
+                let rs <- [<-create R()]
+                rs.firstIndex(of: <-create R())
+                destroy rs
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+		assert.IsType(t, &sema.NotEquatableTypeError{}, errs[1])
+	})
+
+	t.Run("via reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {
+                let n: Int
+
+                init(n: Int) {
+                    self.n = n
+                }
+            }
+
+            fun isIncluded(_ r: &R): Bool {
+                return r.n > 1
+            }
+
+            fun transform(_ r: &R): Int {
+                return r.n
+            }
+
+            fun test(): [Int] {
+                let rs <- [<-create R(n: 2), <-create R(n: 1)]
+                let ref = &rs as &[R]
+
+                let filtered: [&R] = ref.filter(isIncluded)
+                let mapped = ref.map(transform)
+                let reversed: [&R] = ref.reverse()
+                let sliced: [&R] = ref.slice(from: 0, upTo: 1)
+
+                destroy rs
+                return mapped
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("sort", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {
+                let n: Int
+
+                init(n: Int) {
+                    self.n = n
+                }
+            }
+
+            fun less(a: &R, b: &R): Bool {
+                return a.n < b.n
+            }
+
+            fun test() {
+                let rs <- [<-create R(n: 2), <-create R(n: 1)]
+                rs.sort(by: less)
+                destroy rs
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            resource R {}
+
+            fun all(_ key: String): Bool {
+                return true
+            }
+
+            fun test() {
+                let xs = {"a": 1}
+                xs.forEachKey(all)
+
+                let rs <- {"a": <-create R()}
+                rs.forEachKey(all)
+                destroy rs
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            fun test() {
+                let xs = {"a": 1}
+                xs.forEachKey(fun (key: String) {})
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
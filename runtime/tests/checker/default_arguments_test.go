@@ -0,0 +1,392 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckFunctionDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(_ a: Int, b: String = "b", c: [Int8]? = nil, d: {String: Fix64} = {"d": -1.5}): Int {
+              return a
+          }
+
+          let x = test(1)
+          let y = test(1, b: "c")
+          let z = test(1, b: "c", c: [1], d: {})
+        `)
+		require.NoError(t, err)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType)
+
+		require.NotNil(t, functionType.RequiredArgumentCount)
+		assert.Equal(t, 1, *functionType.RequiredArgumentCount)
+
+		assert.Nil(t, functionType.Parameters[0].DefaultArgument)
+		assert.IsType(t, &ast.StringExpression{}, functionType.Parameters[1].DefaultArgument)
+		assert.IsType(t, &ast.NilExpression{}, functionType.Parameters[2].DefaultArgument)
+		assert.IsType(t, &ast.DictionaryExpression{}, functionType.Parameters[3].DefaultArgument)
+	})
+
+	t.Run("missing required argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 1) {}
+
+          let x = test()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let test = fun (a: Int = 1): Int {
+              return a
+          }
+
+          let x = test()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("non-constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let one = 1
+
+          fun test(a: Int = one) {}
+
+          let x = test()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidDefaultArgumentError{}, errs[0])
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[1])
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = "1") {}
+
+          let x = test()
+          let y = test()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[1])
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[2])
+	})
+
+	t.Run("missing default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1, b: Int) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("member function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int, b: Bool = true): Bool {
+                  return b
+              }
+          }
+
+          let x = S().test(a: 1)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("overloading", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(x: Int, y: Int = 2): Int {
+              return x + y
+          }
+
+          fun test(z: String): String {
+              return z
+          }
+
+          let x = test(x: 1)
+          let z = test(z: "a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("ambiguous overloading", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The invocation `test(a: 1)` could match both declarations
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 2): Int {
+              return a + b
+          }
+
+          fun test(a: Int): Int {
+              return a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadError{}, errs[0])
+	})
+
+	t.Run("ambiguous overloading, all default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The invocation `test()` could match both declarations
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1) {}
+
+          fun test(b: Int = 2) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadError{}, errs[0])
+	})
+
+	t.Run("ambiguous member overloading", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int) {}
+
+              fun test(a: Int, b: Int = 2) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadError{}, errs[0])
+	})
+
+	t.Run("ambiguous initializer overloading", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let name: String
+
+              init() {
+                  self.name = ""
+              }
+
+              init(name: String = "x") {
+                  self.name = name
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadError{}, errs[0])
+	})
+
+	t.Run("overloading, different required argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 2) {}
+
+          fun test(a: Int, c: Int) {}
+
+          fun test(d: Int = 1, e: Int = 2) {}
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckInitializerDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	for _, kind := range common.CompositeKindsWithFieldsAndFunctions {
+
+		// Contracts can't be constructed
+		if kind == common.CompositeKindContract {
+			continue
+		}
+
+		kind := kind
+
+		t.Run(kind.Keyword(), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      %[1]s X {
+                          let name: String
+
+                          init(name: String = "x") {
+                              self.name = name
+                          }
+                      }
+
+                      fun test() {
+                          let x %[2]s %[3]s X()
+                          %[4]s x
+                      }
+                    `,
+					kind.Keyword(),
+					kind.TransferOperator(),
+					kind.ConstructionKeyword(),
+					kind.DestructionKeyword(),
+				),
+			)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckEventDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      event Test(a: Int, b: Bool = false)
+
+      fun test() {
+          emit Test(a: 1)
+      }
+    `)
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidTransactionDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      transaction(a: Int = 1) {
+          prepare(signer: AuthAccount) {}
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.UnsupportedDefaultArgumentError{}, errs[0])
+}
+
+func TestCheckImportedFunctionDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub fun test(x: Int, y: [String] = ["y"]): [String] {
+              return y
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          import test from "imported"
+
+          let x = test(x: 1)
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithImportHandler(
+					func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: sema.StringType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
@@ -0,0 +1,373 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckDestructuringDeclarationArray(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              let xs: [Int; 3] = [1, 2, 3]
+              let (a, _, c) = xs
+              return a + c
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("variable-sized array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(xs: [Int]) {
+              let (a, b) = xs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringTypeError{}, errs[0])
+	})
+
+	t.Run("element count mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(xs: [Int; 3]) {
+              let (a, b) = xs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.DestructuringElementCountMismatchError{}, errs[0])
+	})
+
+	t.Run("constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(xs: [Int; 2]) {
+              let (a, b) = xs
+              a = 3
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(xs: [Int; 2]) {
+              var (a, b) = xs
+              a = 3
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckDestructuringDeclarationStruct(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point {
+              let x: Int
+              let y: Int
+
+              init(x: Int, y: Int) {
+                  self.x = x
+                  self.y = y
+              }
+          }
+
+          fun test(): Int {
+              let (x: a, y: b) = Point(x: 1, y: 2)
+              return a + b
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test() {
+              let (z: a) = Point(x: 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point {
+              fun x(): Int {
+                  return 1
+              }
+          }
+
+          fun test() {
+              let (x: a) = Point()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("inaccessible field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point {
+              priv let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test() {
+              let (x: a) = Point(x: 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test() {
+              let (x: a) <- create R(x: 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringTypeError{}, errs[0])
+	})
+}
+
+func TestCheckDestructuringDeclarationResourceArray(t *testing.T) {
+
+	t.Parallel()
+
+	const resourceDeclaration = `
+      resource R {}
+    `
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, resourceDeclaration+`
+          fun test() {
+              let rs: @[R; 2] <- [<-create R(), <-create R()]
+              let (a, b) <- rs
+              destroy a
+              destroy b
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, resourceDeclaration+`
+          fun test() {
+              let rs: @[R; 2] <- [<-create R(), <-create R()]
+              let (a, b) = rs
+              destroy a
+              destroy b
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[0])
+	})
+
+	t.Run("use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, resourceDeclaration+`
+          fun test() {
+              let rs: @[R; 2] <- [<-create R(), <-create R()]
+              let (a, b) <- rs
+              destroy a
+              destroy b
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("potential use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, resourceDeclaration+`
+          fun test(b: Bool) {
+              let rs: @[R; 2] <- [<-create R(), <-create R()]
+              if b {
+                  let (r1, r2) <- rs
+                  destroy r1
+                  destroy r2
+              }
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("element loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, resourceDeclaration+`
+          fun test() {
+              let rs: @[R; 2] <- [<-create R(), <-create R()]
+              let (a, b) <- rs
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("ignored element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, resourceDeclaration+`
+          fun test() {
+              let rs: @[R; 2] <- [<-create R(), <-create R()]
+              let (a, _) <- rs
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, resourceDeclaration+`
+          resource Holder {
+              let rs: @[R; 2]
+
+              init() {
+                  self.rs <- [<-create R(), <-create R()]
+              }
+
+              destroy() {
+                  destroy self.rs
+              }
+          }
+
+          fun test(holder: &Holder) {
+              let (a, b) <- holder.rs
+              destroy a
+              destroy b
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNestedResourceMoveError{}, errs[0])
+	})
+}
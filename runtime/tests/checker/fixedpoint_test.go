@@ -658,19 +658,122 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 					),
 				)
 
-				if i <= scale {
+				// The literal without a type annotation is inferred
+				// to have a type with the default scale
+
+				expectedErrorCount := 0
+				if i > scale {
+					expectedErrorCount++
+				}
+				if i > sema.Fix64Scale {
+					expectedErrorCount++
+				}
+
+				if expectedErrorCount == 0 {
 					assert.NoError(t, err)
 				} else {
-					errs := ExpectCheckerErrors(t, err, 2)
+					errs := ExpectCheckerErrors(t, err, expectedErrorCount)
 
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[1])
+					for _, err := range errs {
+						assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, err)
+					}
 				}
 			}
 		})
 	}
 }
 
+func TestCheckFixedPointConversionLiteralArgument(t *testing.T) {
+
+	t.Parallel()
+
+	// The literal argument of a conversion function
+	// is checked against the target type only
+
+	t.Run("Fix128, scale", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = Fix128(-0.000000000000000000000001)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("UFix128, scale", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = UFix128(0.000000001)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Fix128, range", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = Fix128(200000000000.0)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("UFix128, range", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = UFix128(340282366920938.463463374607431768211455)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid UFix128, scale", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = UFix128(0.0000000000000000000000001)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
+	})
+
+	t.Run("invalid UFix128, range", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = UFix128(340282366920939.0)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidFixedPointLiteralRangeError{}, errs[0])
+	})
+
+	t.Run("invalid UFix64, scale", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = UFix64(0.000000001)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
+	})
+}
+
 func TestCheckFixedPointMinMax(t *testing.T) {
 
 	t.Parallel()
@@ -23,9 +23,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
@@ -50,6 +53,16 @@ func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
 				interfaceKeyword,
 			)
 
+			// Structures and resources support initializer overloading,
+			// see TestCheckCompositeInitializerOverloading
+
+			if !isInterface &&
+				(kind == common.CompositeKindStructure ||
+					kind == common.CompositeKindResource) {
+
+				continue
+			}
+
 			t.Run(testName, func(t *testing.T) {
 
 				_, err := ParseAndCheck(t,
@@ -111,3 +124,479 @@ func TestCheckInvalidResourceDestructorOverloading(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckCompositeInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	for _, kind := range []common.CompositeKind{
+		common.CompositeKindStructure,
+		common.CompositeKindResource,
+	} {
+
+		kind := kind
+
+		t.Run(kind.Keyword(), func(t *testing.T) {
+
+			t.Parallel()
+
+			t.Run("valid", func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          %[1]s X {
+                              let name: String
+                              let symbol: String
+
+                              init(name: String) {
+                                  self.name = name
+                                  self.symbol = ""
+                              }
+
+                              init(name: String, symbol: String) {
+                                  self.name = name
+                                  self.symbol = symbol
+                              }
+                          }
+
+                          fun test() {
+                              let x1 %[2]s %[3]s X(name: "a")
+                              let x2 %[2]s %[3]s X(name: "a", symbol: "b")
+                              %[4]s x1
+                              %[4]s x2
+                          }
+                        `,
+						kind.Keyword(),
+						kind.TransferOperator(),
+						kind.ConstructionKeyword(),
+						kind.DestructionKeyword(),
+					),
+				)
+
+				require.NoError(t, err)
+			})
+
+			t.Run("same argument labels", func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          %[1]s X {
+                              init(y: Int) {}
+                              init(y: String) {}
+                          }
+                        `,
+						kind.Keyword(),
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+			})
+
+			t.Run("uninitialized field", func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          %[1]s X {
+                              let y: Int
+
+                              init() {
+                                  self.y = 0
+                              }
+
+                              init(y: Int) {}
+                          }
+                        `,
+						kind.Keyword(),
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.FieldUninitializedError{}, errs[0])
+			})
+		})
+	}
+}
+
+func TestCheckNestedCompositeInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      contract C {
+
+          struct S {
+              let value: Int
+
+              init() {
+                  self.value = 0
+              }
+
+              init(value: Int) {
+                  self.value = value
+              }
+          }
+
+          init() {}
+      }
+
+      let s1 = C.S()
+      let s2 = C.S(value: 1)
+    `)
+
+	require.NoError(t, err)
+
+	assert.IsType(t,
+		&sema.CompositeType{},
+		RequireGlobalValue(t, checker.Elaboration, "s2"),
+	)
+}
+
+func TestCheckFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun add(_ a: Int, _ b: Int): Int {
+              return a + b
+          }
+
+          fun add(_ a: String, to b: String): String {
+              return b.concat(a)
+          }
+
+          let x = add(1, 2)
+          let y = add("a", to: "b")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("local", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              fun double(_ x: Int): Int {
+                  return x * 2
+              }
+
+              fun double(string x: String): String {
+                  return x.concat(x)
+              }
+
+              let x: Int = double(1)
+              let y: String = double(string: "a")
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("member", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              fun get(index: Int): Int {
+                  return index
+              }
+
+              fun get(key: String): String {
+                  return key
+              }
+          }
+
+          let s = S()
+          let x = s.get(index: 1)
+          let y = s.get(key: "a")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("member access", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              pub fun get(index: Int): Int {
+                  return index
+              }
+
+              priv fun get(key: String): String {
+                  return key
+              }
+          }
+
+          let s = S()
+          let x = s.get(index: 1)
+          let y = s.get(key: "a")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("same argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {}
+
+          fun test(x: String) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("same member argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(x: Int) {}
+
+              fun test(x: String) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("not a function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let test = 1
+
+          fun test(x: String) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("no matching overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {}
+
+          fun test(y: String) {}
+
+          let z = test(z: 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		// A reference to an overloaded function which is not invoked
+		// is ambiguous, as it is unknown which overload it refers to
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              return x
+          }
+
+          fun test(y: String): String {
+              return y
+          }
+
+          let f = test
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadReferenceError{}, errs[0])
+	})
+
+	t.Run("member reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun get(index: Int): Int {
+                  return index
+              }
+
+              fun get(key: String): String {
+                  return key
+              }
+          }
+
+          let s = S()
+          let f = s.get
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadReferenceError{}, errs[0])
+	})
+
+	t.Run("reference without overloads", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              return x
+          }
+
+          let f = test
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckInterfaceFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SI {
+              fun get(index: Int): Int
+              fun get(key: String): String
+          }
+
+          struct S: SI {
+              fun get(key: String): String {
+                  return key
+              }
+
+              fun get(index: Int): Int {
+                  return index
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SI {
+              fun get(index: Int): Int
+              fun get(key: String): String
+          }
+
+          struct S: SI {
+              fun get(index: Int): Int {
+                  return index
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+}
+
+func TestCheckImportedFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub fun test(x: Int): Int {
+              return x
+          }
+
+          pub fun test(y: String): String {
+              return y
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          import test from "imported"
+
+          let x = test(x: 1)
+          let y = test(y: "a")
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithImportHandler(
+					func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+}
@@ -0,0 +1,362 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckSetType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literal syntax", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let set: {Int} = [1, 2, 3]
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.SetType{
+				ElementType: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "set"),
+		)
+	})
+
+	t.Run("instantiation syntax", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let set: Set<String> = ["a", "b"]
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.SetType{
+				ElementType: sema.StringType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "set"),
+		)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let set: {Address}? = [0x1]
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let set: {S} = []
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidSetElementTypeError{}, errs[0])
+	})
+
+	t.Run("invalid element type, instantiation syntax", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let set: Set<[Int]> = []
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidSetElementTypeError{}, errs[0])
+	})
+
+	t.Run("resource element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          let set: {R} = []
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidSetElementTypeError{}, errs[0])
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(set: Set) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingTypeArgumentsError{}, errs[0])
+	})
+
+	t.Run("invalid element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let set: {Int} = [1, "2"]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("restricted type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface I {}
+
+          struct S: I {}
+
+          let s: {I} = S()
+        `)
+
+		require.NoError(t, err)
+
+		assert.IsType(t,
+			&sema.RestrictedType{},
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+	})
+}
+
+func TestCheckSetSubtyping(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("covariant element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let ints: {Int} = [1, 2]
+          let integers: {Integer} = ints
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("AnyStruct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let ints: {Int} = [1, 2]
+          let any: AnyStruct = ints
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("array is not a set", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let ints = [1, 2]
+          let set: {Int} = ints
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("declared type named Set", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Programs which declare a type named `Set`
+		// use the declared type instead of the built-in type
+
+		checker, err := ParseAndCheck(t, `
+          struct Set {}
+
+          let set: Set = Set()
+        `)
+
+		require.NoError(t, err)
+
+		assert.IsType(t,
+			&sema.CompositeType{},
+			RequireGlobalValue(t, checker.Elaboration, "set"),
+		)
+	})
+}
+
+func TestCheckSetMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let set: {Int} = [1, 2]
+      let other: {Int} = [2, 3]
+
+      let inserted = set.insert(4)
+      let removed = set.remove(1)
+      let contains = set.contains(2)
+      let length = set.length
+      let union = set.union(other)
+      let intersection = set.intersection(other)
+      let difference = set.difference(other)
+    `)
+
+	require.NoError(t, err)
+
+	for _, name := range []string{"inserted", "removed", "contains"} {
+		assert.Equal(t,
+			sema.BoolType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+		)
+	}
+
+	assert.Equal(t,
+		sema.IntType,
+		RequireGlobalValue(t, checker.Elaboration, "length"),
+	)
+
+	for _, name := range []string{"union", "intersection", "difference"} {
+		assert.Equal(t,
+			&sema.SetType{
+				ElementType: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, name),
+		)
+	}
+}
+
+func TestCheckSetIteration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let set: {String} = ["a", "b"]
+              for element in set {
+                  let s: String = element
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let set: {String} = ["a", "b"]
+              for element in set {
+                  let i: Int = element
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckSetEquality(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: {Int} = [1, 2]
+          let b: {Int} = [2, 1]
+          let equal = a == b
+          let notEqual = a != b
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: {Int}? = [1, 2]
+          let b: {Int} = [2, 1]
+          let equal = a == b
+          let isNil = a == nil
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("different element types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: {Int} = [1, 2]
+          let b: {String} = ["1", "2"]
+          let equal = a == b
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+}
@@ -324,3 +324,76 @@ func TestCheckStringToLower(t *testing.T) {
 		RequireGlobalValue(t, checker.Elaboration, "x"),
 	)
 }
+
+func TestCheckStringFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let split = "a,b".split(separator: ",")
+        let replaced = "abc".replaceAll(of: "b", with: "x")
+        let contains = "abc".contains("b")
+        let index = "abc".index(of: "c")
+        let hasPrefix = "abc".hasPrefix("a")
+        let hasSuffix = "abc".hasSuffix("c")
+        let upper = "abc".toUpper()
+        let trimmed = "  abc ".trim()
+        let fromUTF8 = String.fromUTF8([0x61, 0x62])
+        let fromCharacters = String.fromCharacters(["a", "b"])
+        let joined = String.join(["a", "b"], separator: ",")
+	`)
+
+	require.NoError(t, err)
+
+	for name, expectedType := range map[string]sema.Type{
+		"split":          &sema.VariableSizedType{Type: sema.StringType},
+		"replaced":       sema.StringType,
+		"contains":       sema.BoolType,
+		"index":          sema.IntType,
+		"hasPrefix":      sema.BoolType,
+		"hasSuffix":      sema.BoolType,
+		"upper":          sema.StringType,
+		"trimmed":        sema.StringType,
+		"fromUTF8":       &sema.OptionalType{Type: sema.StringType},
+		"fromCharacters": sema.StringType,
+		"joined":         sema.StringType,
+	} {
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+			name,
+		)
+	}
+}
+
+func TestCheckInvalidStringFunctionArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("fromCharacters with strings", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let strings: [String] = ["a", "bc"]
+            let x = String.fromCharacters(strings)
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("split without label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let x = "a,b".split(",")
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+}
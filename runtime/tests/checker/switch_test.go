@@ -401,3 +401,185 @@ func TestCheckSwitchStatementWithUnreachableReturn(t *testing.T) {
 	assert.IsType(t, &sema.UnreachableStatementError{}, errs[0])
 	assert.IsType(t, &sema.MissingReturnStatementError{}, errs[1])
 }
+
+func TestCheckSwitchStatementCasePattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test(x: AnyStruct): Int {
+              switch x {
+              case let n as Int:
+                  return n
+              case let s as S:
+                  return 1
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int?): Int {
+              switch x {
+              case nil:
+                  return 0
+              case let n?:
+                  return n
+              }
+              return -1
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("binding is scoped to case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: AnyStruct): Int {
+              switch x {
+              case let n as Int:
+                  return n
+              default:
+                  return n
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("binding is constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: AnyStruct) {
+              switch x {
+              case let n as Int:
+                  n = 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("optional pattern, non-optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              switch x {
+              case let n?:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("nil, non-optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              switch x {
+              case nil:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("non-equatable, only patterns", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test(s: S?) {
+              switch s {
+              case nil:
+                  return
+              case let s2?:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-equatable, pattern and expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: AnyStruct) {
+              switch x {
+              case let n as Int:
+                  return
+              case 1:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[1])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @AnyResource) {
+              switch r {
+              case let r2 as @R:
+                  destroy r2
+              }
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceSwitchPatternError{}, errs[0])
+	})
+}
@@ -0,0 +1,465 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("simple", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias Count = Int
+
+          let x: Count = 1
+          let y: [Count] = [x]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Count = Int
+
+          let x: Count = "1"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("alias of alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias Count = Int
+          typealias Counts = [Count]
+
+          let x: Counts = [1]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("alias declared later", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Counts = [Count]
+          typealias Count = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("self-referential", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias T = [T]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = B
+          typealias B = A
+
+          let a: A = 1
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[1])
+	})
+
+	t.Run("cyclic, indirect", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = Int
+          typealias B = {String: C}
+          typealias C = D?
+          typealias D = ((A): B)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		for _, err := range errs {
+			assert.IsType(t, &sema.CyclicTypeAliasError{}, err)
+		}
+	})
+
+	t.Run("composite declared later", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias T = S
+
+          let s: T = S()
+
+          struct S {}
+        `)
+		require.NoError(t, err)
+
+		sType := RequireGlobalType(t, checker.Elaboration, "S")
+
+		assert.Equal(t,
+			sType,
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+
+		assert.Equal(t,
+			sType,
+			RequireGlobalType(t, checker.Elaboration, "T"),
+		)
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          typealias S = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("invalid access modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub(set) typealias T = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
+	})
+}
+
+func TestCheckTypeAliasResource(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("resource annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias Token = @R
+
+          fun test(token: @Token): @Token {
+              return <-token
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing resource annotation in alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias Token = R
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+
+	t.Run("missing resource annotation in use", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias Token = @R
+
+          fun test(token: Token) {
+              destroy token
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+
+	t.Run("restricted type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          resource interface Receiver {}
+
+          resource interface Balance {}
+
+          resource Vault: Receiver, Balance {}
+
+          typealias Token = @{Receiver, Balance}
+
+          fun test(): @Token {
+              return <-create Vault()
+          }
+        `)
+		require.NoError(t, err)
+
+		tokenType := RequireGlobalType(t, checker.Elaboration, "Token")
+
+		require.IsType(t, &sema.RestrictedType{}, tokenType)
+		restrictedType := tokenType.(*sema.RestrictedType)
+
+		assert.Equal(t, sema.AnyResourceType, restrictedType.Type)
+		assert.Len(t, restrictedType.Restrictions, 2)
+	})
+}
+
+func TestCheckTypeAliasNested(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              pub typealias Count = Int
+
+              pub resource R {
+                  pub let count: Count
+
+                  init(count: Count) {
+                      self.count = count
+                  }
+              }
+
+              pub typealias Token = @R
+
+              pub fun createToken(): @Token {
+                  return <-create R(count: 1)
+              }
+          }
+
+          let x: C.Count = 1
+
+          fun test(): @C.Token {
+              return <-C.createToken()
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              pub struct S {
+                  pub typealias Count = Int
+              }
+          }
+
+          let x: C.S.Count = 1
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("contract interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+
+              pub typealias Count = Int
+
+              pub fun test(count: Count)
+          }
+
+          let x: CI.Count = 1
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              typealias T = Int
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNonEnumCaseError{}, errs[0])
+	})
+
+	t.Run("not in scope outside", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              pub typealias Count = Int
+          }
+
+          let x: Count = 1
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              pub typealias A = &B
+              pub typealias B = Capability<A>
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[1])
+	})
+}
+
+func TestCheckTypeAliasImported(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub contract C {
+
+              pub resource interface Receiver {}
+
+              pub resource interface Balance {}
+
+              pub typealias Token = @{Receiver, Balance}
+          }
+
+          pub typealias Count = Int
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = ParseAndCheckWithOptions(t,
+		`
+          import C, Count from "imported"
+
+          let x: Count = 1
+
+          fun test(token: @C.Token) {
+              destroy token
+          }
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithImportHandler(
+					func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+}
@@ -1681,3 +1681,239 @@ func TestInterpretAccount_StorageFields(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretAccount_storageIteration(t *testing.T) {
+
+	t.Parallel()
+
+	const setupCode = `
+      struct S {}
+
+      resource R {}
+
+      fun setup() {
+          authAccount.save(S(), to: /storage/s)
+          authAccount.save(<-create R(), to: /storage/r)
+          authAccount.link<&S>(/public/s, target: /storage/s)
+          authAccount.link<&R>(/private/r, target: /storage/r)
+      }
+    `
+
+	address := interpreter.NewAddressValueFromBytes([]byte{42})
+
+	newInterpreter := func(t *testing.T, code string) *interpreter.Interpreter {
+		inter, _ := testAccount(t, address, true, setupCode+code)
+
+		_, err := inter.Invoke("setup")
+		require.NoError(t, err)
+
+		return inter
+	}
+
+	invokeStrings := func(t *testing.T, inter *interpreter.Interpreter) []string {
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.ArrayValue{}, value)
+
+		var result []string
+		for _, element := range arrayElements(inter, value.(*interpreter.ArrayValue)) {
+			require.IsType(t, &interpreter.StringValue{}, element)
+			result = append(result, element.(*interpreter.StringValue).Str)
+		}
+		return result
+	}
+
+	t.Run("paths", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, `
+          fun test(): [String] {
+              let entries: [String] = []
+              for path in authAccount.storagePaths {
+                  entries.append(path.toString())
+              }
+              for path in authAccount.publicPaths {
+                  entries.append(path.toString())
+              }
+              for path in authAccount.privatePaths {
+                  entries.append(path.toString())
+              }
+              for path in pubAccount.publicPaths {
+                  entries.append(path.toString())
+              }
+              return entries
+          }
+        `)
+
+		assert.ElementsMatch(t,
+			[]string{
+				"/storage/s",
+				"/storage/r",
+				"/public/s",
+				"/private/r",
+				"/public/s",
+			},
+			invokeStrings(t, inter),
+		)
+	})
+
+	t.Run("forEach", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, `
+          fun test(): [String] {
+              let entries: [String] = []
+              let collect = fun (path: Path, type: Type): Bool {
+                  entries.append(path.toString().concat(": ").concat(type.identifier))
+                  return true
+              }
+              authAccount.forEachStored(collect)
+              authAccount.forEachPublic(collect)
+              authAccount.forEachPrivate(collect)
+              pubAccount.forEachPublic(collect)
+              return entries
+          }
+        `)
+
+		assert.ElementsMatch(t,
+			[]string{
+				"/storage/s: S.test.S",
+				"/storage/r: S.test.R",
+				"/public/s: Capability<&S.test.S>",
+				"/private/r: Capability<&S.test.R>",
+				"/public/s: Capability<&S.test.S>",
+			},
+			invokeStrings(t, inter),
+		)
+	})
+
+	t.Run("forEach, value types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, `
+          fun test(): [String] {
+              authAccount.save([1, 2, 3], to: /storage/array)
+              authAccount.save({"a": true}, to: /storage/dictionary)
+              authAccount.save(1 as Int8?, to: /storage/optional)
+              authAccount.save("test", to: /storage/string)
+              authAccount.save(<-[<-create R()], to: /storage/resources)
+
+              let entries: [String] = []
+              authAccount.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                  entries.append(path.toString().concat(": ").concat(type.identifier))
+                  return true
+              })
+              return entries
+          }
+        `)
+
+		assert.ElementsMatch(t,
+			[]string{
+				"/storage/s: S.test.S",
+				"/storage/r: S.test.R",
+				"/storage/array: [Int]",
+				"/storage/dictionary: {String:Bool}",
+				"/storage/optional: Int8?",
+				"/storage/string: String",
+				"/storage/resources: [S.test.R]",
+			},
+			invokeStrings(t, inter),
+		)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, `
+          fun test(): [String] {
+              let entries: [String] = []
+              authAccount.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                  entries.append(path.toString())
+                  return false
+              })
+              return entries
+          }
+        `)
+
+		assert.Len(t, invokeStrings(t, inter), 1)
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The first iteration removes both stored objects and saves a new one.
+		// The removed object is skipped, if it was not visited yet,
+		// and the saved object is not visited
+
+		inter := newInterpreter(t, `
+          fun test(): [String] {
+              let entries: [String] = []
+              authAccount.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                  entries.append(path.toString())
+                  if entries.length == 1 {
+                      authAccount.load<S>(from: /storage/s)
+                      destroy authAccount.load<@R>(from: /storage/r)
+                      authAccount.save(S(), to: /storage/t)
+                  }
+                  return true
+              })
+              return entries
+          }
+        `)
+
+		result := invokeStrings(t, inter)
+		require.Len(t, result, 1)
+		assert.Contains(t, []string{"/storage/s", "/storage/r"}, result[0])
+	})
+
+	t.Run("metering", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, `
+          fun test() {
+              authAccount.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                  return true
+              })
+          }
+        `)
+
+		iterations := 0
+		inter.SetOnLoopIterationHandler(func(_ *interpreter.Interpreter, _ int) {
+			iterations++
+		})
+
+		_, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, iterations)
+	})
+
+	t.Run("paths metering", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newInterpreter(t, `
+          fun test(): Int {
+              return authAccount.storagePaths.length
+          }
+        `)
+
+		iterations := 0
+		inter.SetOnLoopIterationHandler(func(_ *interpreter.Interpreter, _ int) {
+			iterations++
+		})
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.NewIntValueFromInt64(2), result)
+		assert.Equal(t, 2, iterations)
+	})
+}
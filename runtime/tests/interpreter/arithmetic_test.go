@@ -21,6 +21,7 @@ package interpreter_test
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -51,10 +52,12 @@ var integerTestValues = map[string]interpreter.NumberValue{
 	"UInt128": interpreter.NewUInt128ValueFromUint64(60),
 	"UInt256": interpreter.NewUInt256ValueFromUint64(60),
 	// Word*
-	"Word8":  interpreter.Word8Value(60),
-	"Word16": interpreter.Word16Value(60),
-	"Word32": interpreter.Word32Value(60),
-	"Word64": interpreter.Word64Value(60),
+	"Word8":   interpreter.Word8Value(60),
+	"Word16":  interpreter.Word16Value(60),
+	"Word32":  interpreter.Word32Value(60),
+	"Word64":  interpreter.Word64Value(60),
+	"Word128": interpreter.NewWord128ValueFromUint64(60),
+	"Word256": interpreter.NewWord256ValueFromUint64(60),
 }
 
 func init() {
@@ -216,6 +219,72 @@ func TestInterpretModOperator(t *testing.T) {
 	}
 }
 
+func TestInterpretWordOverflowAndUnderflow(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		zero, one, two, max, maxMinusOne interpreter.Value
+	}
+
+	testCases := map[sema.Type]testCase{
+		sema.Word128Type: {
+			zero: interpreter.NewWord128ValueFromUint64(0),
+			one:  interpreter.NewWord128ValueFromUint64(1),
+			two:  interpreter.NewWord128ValueFromUint64(2),
+			max:  interpreter.NewWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+			maxMinusOne: interpreter.NewWord128ValueFromBigInt(
+				new(big.Int).Sub(sema.Word128TypeMaxIntBig, big.NewInt(1)),
+			),
+		},
+		sema.Word256Type: {
+			zero: interpreter.NewWord256ValueFromUint64(0),
+			one:  interpreter.NewWord256ValueFromUint64(1),
+			two:  interpreter.NewWord256ValueFromUint64(2),
+			max:  interpreter.NewWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+			maxMinusOne: interpreter.NewWord256ValueFromBigInt(
+				new(big.Int).Sub(sema.Word256TypeMaxIntBig, big.NewInt(1)),
+			),
+		},
+	}
+
+	for ty, testCase := range testCases {
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let max = %[1]s.max
+                      let a = max + 3
+                      let b = (1 as %[1]s) - 2
+                      let c = max * max
+                      let d = max << 1
+                      let e = max >> 1000
+                    `,
+					ty,
+				),
+			)
+
+			for name, expected := range map[string]interpreter.Value{
+				"a": testCase.two,
+				"b": testCase.max,
+				// (2^n - 1)^2 = 2^2n - 2^(n+1) + 1, i.e. 1 (mod 2^n)
+				"c": testCase.one,
+				"d": testCase.maxMinusOne,
+				"e": testCase.zero,
+			} {
+				AssertValuesEqual(
+					t,
+					inter,
+					expected,
+					inter.Globals[name].GetValue(),
+				)
+			}
+		})
+	}
+}
+
 func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 
 	t.Parallel()
@@ -549,6 +618,51 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 				},
 			},
 		},
+		sema.Fix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+					interpreter.NewFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+				},
+				underflow: testCall{
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+					interpreter.NewFix128ValueWithInteger(big.NewInt(-2)),
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+				},
+			},
+			subtract: testCalls{
+				overflow: testCall{
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+					interpreter.NewFix128ValueWithInteger(big.NewInt(-2)),
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+				},
+				underflow: testCall{
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+					interpreter.NewFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+					interpreter.NewFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+				},
+				underflow: testCall{
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+					interpreter.NewFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+				},
+			},
+			divide: testCalls{
+				overflow: testCall{
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+					interpreter.NewFix128ValueWithInteger(big.NewInt(-1)),
+					interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+				},
+			},
+		},
 		sema.UIntType: {
 			subtract: testCalls{
 				underflow: testCall{
@@ -719,6 +833,29 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 				},
 			},
 		},
+		sema.UFix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+					interpreter.NewUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+				},
+			},
+			subtract: testCalls{
+				underflow: testCall{
+					interpreter.NewUFix128ValueFromBigInt(big.NewInt(0)),
+					interpreter.NewUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUFix128ValueFromBigInt(big.NewInt(0)),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+					interpreter.NewUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+				},
+			},
+		},
 	}
 
 	// Verify all test cases exist
@@ -877,6 +1014,8 @@ func TestInterpretArithmeticOnSubTypes(t *testing.T) {
 			interpreter.PrimitiveStaticTypeWord16,
 			interpreter.PrimitiveStaticTypeWord32,
 			interpreter.PrimitiveStaticTypeWord64,
+			interpreter.PrimitiveStaticTypeWord128,
+			interpreter.PrimitiveStaticTypeWord256,
 		}
 
 		for _, subtype := range intSubtypes {
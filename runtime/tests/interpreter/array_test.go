@@ -19,7 +19,14 @@
 package interpreter_test
 
 import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func arrayElements(inter *interpreter.Interpreter, array *interpreter.ArrayValue) []interpreter.Value {
@@ -44,3 +51,297 @@ func dictionaryKeyValues(dict *interpreter.DictionaryValue) []interpreter.Value
 	})
 	return result
 }
+
+func intValues(values ...int64) []interpreter.Value {
+	result := make([]interpreter.Value, len(values))
+	for i, value := range values {
+		result[i] = interpreter.NewIntValueFromInt64(value)
+	}
+	return result
+}
+
+func TestInterpretArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [3, 1, 4, 1, 5]
+
+      let filtered = xs.filter(fun (x: Int): Bool { return x > 1 })
+      let mapped = xs.map(fun (x: Int): Int { return x * 2 })
+      let reversed = xs.reverse()
+      let sliced = xs.slice(from: 1, upTo: 3)
+
+      fun sorted(): [Int] {
+          let ys = [3, 1, 4, 1, 5]
+          ys.sort(by: fun (a: Int, b: Int): Bool { return a < b })
+          return ys
+      }
+    `)
+
+	for name, expected := range map[string][]interpreter.Value{
+		"xs":       intValues(3, 1, 4, 1, 5),
+		"filtered": intValues(3, 4, 5),
+		"mapped":   intValues(6, 2, 8, 2, 10),
+		"reversed": intValues(5, 1, 4, 1, 3),
+		"sliced":   intValues(1, 4),
+	} {
+		AssertValueSlicesEqual(
+			t,
+			inter,
+			expected,
+			arrayElements(inter, inter.Globals[name].GetValue().(*interpreter.ArrayValue)),
+		)
+	}
+
+	value, err := inter.Invoke("sorted")
+	require.NoError(t, err)
+
+	AssertValueSlicesEqual(
+		t,
+		inter,
+		intValues(1, 1, 3, 4, 5),
+		arrayElements(inter, value.(*interpreter.ArrayValue)),
+	)
+}
+
+func TestInterpretArrayFirstIndex(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [3, 1, 4, 1, 5]
+      let found = xs.firstIndex(of: 1)
+      let notFound = xs.firstIndex(of: 2)
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewSomeValueNonCopying(interpreter.NewIntValueFromInt64(1)),
+		inter.Globals["found"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NilValue{},
+		inter.Globals["notFound"].GetValue(),
+	)
+}
+
+func TestInterpretResourceArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {
+          let n: Int
+
+          init(n: Int) {
+              self.n = n
+          }
+      }
+
+      fun isIncluded(_ r: &R): Bool {
+          return r.n > 1
+      }
+
+      fun transform(_ r: &R): Int {
+          return r.n
+      }
+
+      fun less(a: &R, b: &R): Bool {
+          return a.n < b.n
+      }
+
+      fun test(): [Int] {
+          let rs <- [<-create R(n: 3), <-create R(n: 1), <-create R(n: 2)]
+          let ref = &rs as &[R]
+
+          let filtered = ref.filter(isIncluded)
+          let reversed = ref.reverse()
+
+          rs.sort(by: less)
+
+          let values = [filtered.length, reversed[0].n]
+          values.appendAll(ref.map(transform))
+          destroy rs
+          return values
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValueSlicesEqual(
+		t,
+		inter,
+		intValues(2, 2, 1, 2, 3),
+		arrayElements(inter, value.(*interpreter.ArrayValue)),
+	)
+}
+
+func TestInterpretArrayHigherOrderFunctionMetering(t *testing.T) {
+
+	t.Parallel()
+
+	iterations := 0
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test() {
+              let xs = [1, 2, 3]
+              xs.filter(fun (x: Int): Bool { return true })
+              xs.map(fun (x: Int): Int { return x })
+              xs.firstIndex(of: 2)
+
+              let dict = {"a": 1, "b": 2}
+              dict.forEachKey(fun (key: String): Bool { return true })
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnLoopIterationHandler(
+					func(_ *interpreter.Interpreter, _ int) {
+						iterations++
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	// 3 elements for filter, 3 elements for map, 2 elements for firstIndex, and 2 keys
+
+	assert.Equal(t, 10, iterations)
+}
+
+func TestInterpretArrayMutationDuringIteration(t *testing.T) {
+
+	t.Parallel()
+
+	// Mutations which keep the number of elements unchanged are rejected as well
+
+	tests := map[string]string{
+		"append": `
+          xs.filter(fun (x: Int): Bool {
+              xs.append(x)
+              return true
+          })
+        `,
+		"append and remove": `
+          xs.map(fun (x: Int): Int {
+              xs.append(x)
+              xs.removeFirst()
+              return x
+          })
+        `,
+		"set": `
+          xs.filter(fun (x: Int): Bool {
+              xs[0] = x
+              return true
+          })
+        `,
+		"sort, insert and remove": `
+          xs.sort(by: fun (a: Int, b: Int): Bool {
+              xs.insert(at: 0, a)
+              xs.removeLast()
+              return a < b
+          })
+        `,
+	}
+
+	for name, code := range tests {
+
+		code := code
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t, fmt.Sprintf(
+				`
+                  fun test() {
+                      let xs = [1, 2, 3]
+                      %s
+                  }
+                `,
+				code,
+			))
+
+			_, err := inter.Invoke("test")
+			require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+		})
+	}
+}
+
+func TestInterpretDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): Int {
+          let xs = {"a": 1, "b": 2, "c": 3}
+          var count = 0
+          xs.forEachKey(fun (key: String): Bool {
+              count = count + 1
+              return count < 2
+          })
+          return count
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewIntValueFromInt64(2),
+		value,
+	)
+}
+
+func TestInterpretDictionaryMutationDuringIteration(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test() {
+          let xs = {"a": 1, "b": 2, "c": 3}
+          xs.forEachKey(fun (key: String): Bool {
+              let value = xs.remove(key: key)!
+              xs.insert(key: key, value)
+              return true
+          })
+      }
+
+      fun testAfterIteration(): Int {
+          let xs = {"a": 1}
+          xs.forEachKey(fun (key: String): Bool {
+              return true
+          })
+          xs.insert(key: "b", 2)
+          return xs.length
+      }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+
+	// The dictionary can be mutated once the iteration is finished
+
+	value, err := inter.Invoke("testAfterIteration")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewIntValueFromInt64(2),
+		value,
+	)
+}
@@ -85,6 +85,12 @@ var bitwiseTestValueFunctions = map[string]func(int) interpreter.NumberValue{
 	"Word64": func(v int) interpreter.NumberValue {
 		return interpreter.Word64Value(v)
 	},
+	"Word128": func(v int) interpreter.NumberValue {
+		return interpreter.NewWord128ValueFromUint64(uint64(v))
+	},
+	"Word256": func(v int) interpreter.NumberValue {
+		return interpreter.NewWord256ValueFromUint64(uint64(v))
+	},
 }
 
 func init() {
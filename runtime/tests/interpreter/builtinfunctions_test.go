@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -81,12 +82,18 @@ func TestInterpretToString(t *testing.T) {
 
 			isSigned := sema.IsSubType(ty, sema.SignedFixedPointType)
 
+			scale := sema.Fix64Scale
+			if ranged, ok := ty.(sema.FractionalRangedType); ok {
+				scale = ranged.Scale()
+			}
+			padding := strings.Repeat("0", int(scale)-2)
+
 			if isSigned {
 				literal = "-12.34"
-				expected = interpreter.NewStringValue("-12.34000000")
+				expected = interpreter.NewStringValue("-12.34" + padding)
 			} else {
 				literal = "12.34"
-				expected = interpreter.NewStringValue("12.34000000")
+				expected = interpreter.NewStringValue("12.34" + padding)
 			}
 
 			inter := parseCheckAndInterpret(t,
@@ -250,6 +257,20 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"9223372036854775808":  {128, 0, 0, 0, 0, 0, 0, 0},
 			"18446744073709551615": {255, 255, 255, 255, 255, 255, 255, 255},
 		},
+		"Word128": {
+			"0":   {0},
+			"42":  {42},
+			"127": {127},
+			"128": {128},
+			"200": {200},
+		},
+		"Word256": {
+			"0":   {0},
+			"42":  {42},
+			"127": {127},
+			"128": {128},
+			"200": {200},
+		},
 		"UInt128": {
 			"0":   {0},
 			"42":  {42},
@@ -300,12 +321,23 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 			"-1.0":  {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			"0.0":                         {0},
+			"0.000000000000000000000042":  {42},
+			"-0.000000000000000000000001": {255},
+			"1.0":                         {0, 211, 194, 27, 206, 204, 237, 161, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
 			"42.0":  {0, 0, 0, 0, 250, 86, 234, 0},
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			"0.0":                        {0},
+			"0.000000000000000000000042": {42},
+			"1.0":                        {211, 194, 27, 206, 204, 237, 161, 0, 0, 0},
+		},
 	}
 
 	// Ensure the test cases are complete
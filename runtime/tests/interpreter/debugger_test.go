@@ -0,0 +1,313 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser2"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+const debuggerTestCode = `
+fun add(_ a: Int, _ b: Int): Int {
+    let sum = a + b
+    return sum
+}
+
+fun main(): Int {
+    var i = 0
+    var total = 0
+    while i < 5 {
+        total = add(total, i)
+        i = i + 1
+    }
+    return total
+}
+`
+
+// debugMain interprets the debugger test code with the given debugger,
+// and invokes the function main in a separate goroutine.
+// The returned channel receives the result of the invocation
+//
+func debugMain(t *testing.T, debugger *interpreter.Debugger) <-chan error {
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		debuggerTestCode,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithDebugger(debugger),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := inter.Invoke("main")
+		done <- err
+	}()
+
+	return done
+}
+
+func nextStop(t *testing.T, debugger *interpreter.Debugger, done <-chan error) interpreter.Stop {
+	select {
+	case stop := <-debugger.Stops():
+		return stop
+	case err := <-done:
+		require.FailNow(t, "execution finished unexpectedly", "error: %v", err)
+		return interpreter.Stop{}
+	}
+}
+
+func requireFinished(t *testing.T, debugger *interpreter.Debugger, done <-chan error) {
+	select {
+	case stop := <-debugger.Stops():
+		require.FailNow(t,
+			"execution stopped unexpectedly",
+			"line: %d",
+			stop.Statement.StartPosition().Line,
+		)
+	case err := <-done:
+		require.NoError(t, err)
+	}
+}
+
+func stopLine(stop interpreter.Stop) int {
+	return stop.Statement.StartPosition().Line
+}
+
+func frameVariable(t *testing.T, frame interpreter.StackFrame, name string) interpreter.Value {
+	variable := frame.Activation.Find(name)
+	require.NotNil(t, variable)
+	return variable.GetValue()
+}
+
+func TestInterpretDebuggerBreakpoint(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	debugger.AddBreakpoint(&interpreter.Breakpoint{
+		Location: TestLocation,
+		Line:     4,
+	})
+
+	done := debugMain(t, debugger)
+
+	stop := nextStop(t, debugger, done)
+	assert.Equal(t, 4, stopLine(stop))
+	assert.NotNil(t, stop.Breakpoint)
+
+	callStack := debugger.CallStack()
+	require.Len(t, callStack, 2)
+
+	assert.Equal(t, "add", callStack[0].FunctionName)
+	assert.Equal(t, TestLocation, callStack[0].Location)
+	assert.Equal(t, 4, callStack[0].Position.Line)
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(0),
+		frameVariable(t, callStack[0], "sum"),
+	)
+
+	assert.Equal(t, "", callStack[1].FunctionName)
+	assert.Equal(t, 11, callStack[1].Position.Line)
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(0),
+		frameVariable(t, callStack[1], "i"),
+	)
+
+	debugger.RemoveBreakpoint(TestLocation, 4)
+
+	require.True(t, debugger.Continue())
+
+	requireFinished(t, debugger, done)
+}
+
+func TestInterpretDebuggerConditionalBreakpoint(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	condition, errs := parser2.ParseExpression("i == 3")
+	require.Empty(t, errs)
+
+	debugger.AddBreakpoint(&interpreter.Breakpoint{
+		Location:  TestLocation,
+		Line:      12,
+		Condition: condition,
+	})
+
+	done := debugMain(t, debugger)
+
+	stop := nextStop(t, debugger, done)
+	assert.Equal(t, 12, stopLine(stop))
+
+	callStack := debugger.CallStack()
+	require.Len(t, callStack, 1)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(3),
+		frameVariable(t, callStack[0], "i"),
+	)
+
+	// The frame's variables can be used in expressions
+
+	expression, errs := parser2.ParseExpression("total * 2")
+	require.Empty(t, errs)
+
+	value, err := debugger.Evaluate(callStack[0], expression)
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(12), value)
+
+	require.True(t, debugger.Continue())
+
+	requireFinished(t, debugger, done)
+}
+
+func TestInterpretDebuggerBreakpointHitCount(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	breakpoint := &interpreter.Breakpoint{
+		Location: TestLocation,
+		Line:     12,
+		HitCount: 3,
+	}
+
+	debugger.AddBreakpoint(breakpoint)
+
+	done := debugMain(t, debugger)
+
+	stop := nextStop(t, debugger, done)
+	assert.Equal(t, 12, stopLine(stop))
+	assert.Equal(t, 3, breakpoint.Hits())
+
+	callStack := debugger.CallStack()
+	require.Len(t, callStack, 1)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(2),
+		frameVariable(t, callStack[0], "i"),
+	)
+
+	// Once the hit count is reached, every hit stops
+
+	require.True(t, debugger.Continue())
+
+	stop = nextStop(t, debugger, done)
+	assert.Equal(t, 12, stopLine(stop))
+	assert.Equal(t, 4, breakpoint.Hits())
+
+	debugger.RemoveBreakpoint(TestLocation, 12)
+
+	require.True(t, debugger.Continue())
+
+	requireFinished(t, debugger, done)
+}
+
+func TestInterpretDebuggerStep(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	debugger.AddBreakpoint(&interpreter.Breakpoint{
+		Location: TestLocation,
+		Line:     11,
+	})
+
+	done := debugMain(t, debugger)
+
+	stop := nextStop(t, debugger, done)
+	assert.Equal(t, 11, stopLine(stop))
+
+	debugger.ClearBreakpoints(TestLocation)
+
+	// Stepping into the invocation stops in the invoked function
+
+	require.True(t, debugger.Step(interpreter.StepModeInto))
+	stop = nextStop(t, debugger, done)
+	assert.Equal(t, 3, stopLine(stop))
+	assert.Len(t, debugger.CallStack(), 2)
+
+	// Stepping over stays in the function
+
+	require.True(t, debugger.Step(interpreter.StepModeOver))
+	stop = nextStop(t, debugger, done)
+	assert.Equal(t, 4, stopLine(stop))
+
+	// Stepping out stops in the caller
+
+	require.True(t, debugger.Step(interpreter.StepModeOut))
+	stop = nextStop(t, debugger, done)
+	assert.Equal(t, 12, stopLine(stop))
+	assert.Len(t, debugger.CallStack(), 1)
+
+	// Stepping over the loop body does not stop in the invoked function
+
+	require.True(t, debugger.Step(interpreter.StepModeOver))
+	stop = nextStop(t, debugger, done)
+	assert.Equal(t, 11, stopLine(stop))
+
+	require.True(t, debugger.Step(interpreter.StepModeOver))
+	stop = nextStop(t, debugger, done)
+	assert.Equal(t, 12, stopLine(stop))
+
+	require.True(t, debugger.Continue())
+
+	requireFinished(t, debugger, done)
+}
+
+func TestInterpretDebuggerInvalidCondition(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	// A condition which cannot be evaluated stops the execution
+
+	condition, errs := parser2.ParseExpression("unknown > 1")
+	require.Empty(t, errs)
+
+	debugger.AddBreakpoint(&interpreter.Breakpoint{
+		Location:  TestLocation,
+		Line:      9,
+		Condition: condition,
+	})
+
+	done := debugMain(t, debugger)
+
+	stop := nextStop(t, debugger, done)
+	assert.Equal(t, 9, stopLine(stop))
+
+	require.True(t, debugger.Continue())
+
+	requireFinished(t, debugger, done)
+}
@@ -0,0 +1,282 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/checker"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretFunctionDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun greet(_ name: String, greeting: String = "Hello", punctuation: String = "!"): String {
+              return greeting.concat(", ").concat(name).concat(punctuation)
+          }
+
+          fun test(): [String] {
+              return [
+                  greet("Alice"),
+                  greet("Bob", greeting: "Hi"),
+                  greet("Carol", greeting: "Hey", punctuation: "?")
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewStringValue("Hello, Alice!"),
+				interpreter.NewStringValue("Hi, Bob!"),
+				interpreter.NewStringValue("Hey, Carol?"),
+			),
+			value,
+		)
+	})
+
+	t.Run("optional and collections", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun count(a: Int? = nil, b: [Int8] = [1, 2], c: {String: UInt} = {"c": 3}): Int {
+              return (a ?? 0) + b.length + c.length
+          }
+
+          fun test(): Int {
+              return count()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+	})
+
+	t.Run("member", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Counter {
+              var count: Int
+
+              init() {
+                  self.count = 0
+              }
+
+              fun increment(by amount: Int = 1) {
+                  self.count = self.count + amount
+              }
+          }
+
+          fun test(): Int {
+              let counter = Counter()
+              counter.increment()
+              counter.increment(by: 2)
+              return counter.count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+	})
+
+	t.Run("host invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(a: Int, b: Int = 2): Int {
+              return a * b
+          }
+        `)
+
+		value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(3))
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(6),
+			value,
+		)
+	})
+}
+
+func TestInterpretInitializerDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource Vault {
+          let balance: UFix64
+
+          init(balance: UFix64 = 10.0) {
+              self.balance = balance
+          }
+      }
+
+      fun test(): UFix64 {
+          let vault <- create Vault()
+          let balance = vault.balance
+          destroy vault
+          return balance
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUFix64ValueWithInteger(10),
+		value,
+	)
+}
+
+func TestInterpretImportedFunctionDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	importedLocation := common.AddressLocation{
+		Address: address,
+		Name:    "sum",
+	}
+
+	importedChecker, err := checker.ParseAndCheckWithOptions(t,
+		`
+          pub fun sum(_ values: [Int] = [1, 2, 3]): Int {
+              var sum = 0
+              for value in values {
+                  sum = sum + value
+              }
+              return sum
+          }
+        `,
+		checker.ParseAndCheckOptions{
+			Location: importedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	importingChecker, err := checker.ParseAndCheckWithOptions(t,
+		`
+          import sum from 0x1
+
+          pub fun test(): Int {
+              return sum()
+          }
+        `,
+		checker.ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithLocationHandler(
+					func(identifiers []ast.Identifier, _ common.Location) ([]sema.ResolvedLocation, error) {
+						return []sema.ResolvedLocation{
+							{
+								Location:    importedLocation,
+								Identifiers: identifiers,
+							},
+						}, nil
+					},
+				),
+				sema.WithImportHandler(
+					func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(importingChecker),
+		importingChecker.Location,
+		interpreter.WithStorage(interpreter.NewInMemoryStorage()),
+		interpreter.WithImportLocationHandler(
+			func(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
+				program := interpreter.ProgramFromChecker(importedChecker)
+				subInterpreter, err := inter.NewSubInterpreter(program, location)
+				if err != nil {
+					panic(err)
+				}
+
+				return interpreter.InterpreterImport{
+					Interpreter: subInterpreter,
+				}
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewIntValueFromInt64(6),
+		value,
+	)
+}
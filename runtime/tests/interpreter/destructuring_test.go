@@ -0,0 +1,157 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretDestructuringDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int; 2] {
+              let xs: [Int; 3] = [1, 2, 3]
+              let (a, _, c) = xs
+              return [a, c]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ConstantSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+					Size: 2,
+				},
+				common.Address{},
+				interpreter.NewIntValueFromInt64(1),
+				interpreter.NewIntValueFromInt64(3),
+			),
+			value,
+		)
+	})
+
+	t.Run("array, copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let xs: [[Int]; 2] = [[1], [2]]
+              var (a, b) = xs
+              a.append(3)
+              return xs[0].length
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Point {
+              let x: Int
+              let y: Int
+
+              init(x: Int, y: Int) {
+                  self.x = x
+                  self.y = y
+              }
+          }
+
+          fun test(): Int {
+              let (y: b, x: a) = Point(x: 1, y: 2)
+              return a * 10 + b
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(12),
+			value,
+		)
+	})
+
+	t.Run("resource array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(): Int {
+              let rs: @[R; 2] <- [<-create R(id: 1), <-create R(id: 2)]
+              let (a, b) <- rs
+              let sum = a.id * 10 + b.id
+              destroy a
+              destroy b
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(12),
+			value,
+		)
+	})
+}
@@ -69,6 +69,8 @@ func TestInterpretDynamicCastingNumber(t *testing.T) {
 		{sema.Word16Type, "42", interpreter.Word16Value(42)},
 		{sema.Word32Type, "42", interpreter.Word32Value(42)},
 		{sema.Word64Type, "42", interpreter.Word64Value(42)},
+		{sema.Word128Type, "42", interpreter.NewWord128ValueFromUint64(42)},
+		{sema.Word256Type, "42", interpreter.NewWord256ValueFromUint64(42)},
 		{sema.Fix64Type, "1.23", interpreter.Fix64Value(123000000)},
 		{sema.UFix64Type, "1.23", interpreter.UFix64Value(123000000)},
 	}
@@ -28,6 +28,7 @@ import (
 
 	. "github.com/onflow/cadence/runtime/tests/utils"
 
+	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -54,9 +55,11 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 
 	tests := map[string]interpreter.Value{
 		// Fix*
-		"Fix64": interpreter.Fix64Value(123000000),
+		"Fix64":  interpreter.Fix64Value(123000000),
+		"Fix128": interpreter.NewFix128ValueFromBigInt(fix128("1.23")),
 		// UFix*
-		"UFix64": interpreter.UFix64Value(123000000),
+		"UFix64":  interpreter.UFix64Value(123000000),
+		"UFix128": interpreter.NewUFix128ValueFromBigInt(fix128("1.23")),
 	}
 
 	for _, fixedPointType := range sema.AllFixedPointTypes {
@@ -111,9 +114,29 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 	}
 }
 
+// fix128 returns the raw value of the given 128-bit fixed-point literal
+func fix128(literal string) *big.Int {
+	value, err := fixedpoint.ParseFix128(literal)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// ufix128 returns the raw value of the given unsigned 128-bit fixed-point literal
+func ufix128(literal string) *big.Int {
+	value, err := fixedpoint.ParseUFix128(literal)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
 var testFixedPointValues = map[string]interpreter.Value{
-	"Fix64":  interpreter.Fix64Value(50 * sema.Fix64Factor),
-	"UFix64": interpreter.UFix64Value(50 * sema.Fix64Factor),
+	"Fix64":   interpreter.Fix64Value(50 * sema.Fix64Factor),
+	"UFix64":  interpreter.UFix64Value(50 * sema.Fix64Factor),
+	"Fix128":  interpreter.NewFix128ValueFromBigInt(fix128("50.0")),
+	"UFix128": interpreter.NewUFix128ValueFromBigInt(fix128("50.0")),
 }
 
 func init() {
@@ -385,6 +408,8 @@ func TestInterpretFixedPointConversions(t *testing.T) {
 
 		bigIntegerTypes := []sema.Type{
 			sema.Word64Type,
+			sema.Word128Type,
+			sema.Word256Type,
 			sema.UInt64Type,
 			sema.UInt128Type,
 			sema.UInt256Type,
@@ -571,6 +596,14 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 			min: interpreter.UFix64Value(0),
 			max: interpreter.UFix64Value(math.MaxUint64),
 		},
+		sema.Fix128Type: {
+			min: interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig),
+			max: interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+		},
+		sema.UFix128Type: {
+			min: interpreter.NewUFix128ValueFromBigInt(big.NewInt(0)),
+			max: interpreter.NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+		},
 	}
 
 	for _, ty := range sema.AllFixedPointTypes {
@@ -592,3 +625,282 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretFix128Conversions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("Fix64 to Fix128", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix64 = -1.23456789
+          let y = Fix128(x)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewFix128ValueFromBigInt(fix128("-1.23456789")),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("UFix64 to UFix128", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UFix64 = 184467440737.09551615
+          let y = UFix128(x)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(fix128("184467440737.09551615")),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("Fix128 to Fix64, truncated", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix128 = -1.234567891234567891234567
+          let y = Fix64(x)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.Fix64Value(-123456789),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("UFix128 to UFix64, truncated", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UFix128 = 1.234567891234567891234567
+          let y = UFix64(x)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.UFix64Value(123456789),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("Fix128 to Int", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix128 = -170141183460469.231731687303715884105728
+          let y = Int(x)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(-170141183460469),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("Fix128 literal", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = Fix128(-0.000000000000000000000001)
+          let y = Fix128(200000000000.0)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewFix128ValueFromBigInt(fix128("-0.000000000000000000000001")),
+			inter.Globals["x"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewFix128ValueFromBigInt(fix128("200000000000.0")),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("UFix128 literal", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = UFix128(0.000000001)
+          let y = UFix128(340282366920938.463463374607431768211455)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(ufix128("0.000000001")),
+			inter.Globals["x"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(ufix128("340282366920938.463463374607431768211455")),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("invalid UFix128 > max UFix64 to UFix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix64 {
+              let x: UFix128 = 184467440738.0
+              return UFix64(x)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("invalid negative Fix128 to UFix128", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              let x: Fix128 = -0.000000000000000000000001
+              return UFix128(x)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.UnderflowError{})
+	})
+
+	t.Run("invalid integer > Fix128 max int to Fix128", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              let x: UInt128 = 170141183460470
+              return Fix128(x)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+}
+
+func TestInterpretFix128Arithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("precision", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let a: UFix128 = 0.000000000000000000000001
+          let b: UFix128 = 1.0
+          let sum = a + b
+          let c: UFix128 = 0.000000000000000000000002
+          let product = UFix128(1.5) * c
+          let quotient = UFix128(1.0) / UFix128(3.0)
+          let negated = -Fix128(0.5)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(fix128("1.000000000000000000000001")),
+			inter.Globals["sum"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(fix128("0.000000000000000000000003")),
+			inter.Globals["product"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(fix128("0.333333333333333333333333")),
+			inter.Globals["quotient"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewFix128ValueFromBigInt(fix128("-0.5")),
+			inter.Globals["negated"].GetValue(),
+		)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              return Fix128.max + 0.000000000000000000000001
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("underflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              return UFix128(1.0) - UFix128(2.0)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.UnderflowError{})
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              return Fix128(1.0) / Fix128(0.0)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+
+	t.Run("saturating", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let a = Fix128.max.saturatingAdd(1.0)
+          let b = Fix128.min.saturatingSubtract(1.0)
+          let c = Fix128.max.saturatingMultiply(2.0)
+          let d = Fix128.min.saturatingDivide(0.5)
+          let e = UFix128(1.0).saturatingSubtract(2.0)
+          let f = UFix128.max.saturatingAdd(1.0)
+        `)
+
+		maxFix128 := interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMaxIntBig)
+		minFix128 := interpreter.NewFix128ValueFromBigInt(sema.Int128TypeMinIntBig)
+
+		AssertValuesEqual(t, inter, maxFix128, inter.Globals["a"].GetValue())
+		AssertValuesEqual(t, inter, minFix128, inter.Globals["b"].GetValue())
+		AssertValuesEqual(t, inter, maxFix128, inter.Globals["c"].GetValue())
+		AssertValuesEqual(t, inter, minFix128, inter.Globals["d"].GetValue())
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(big.NewInt(0)),
+			inter.Globals["e"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+			inter.Globals["f"].GetValue(),
+		)
+	})
+}
@@ -51,10 +51,12 @@ var testIntegerTypesAndValues = map[string]interpreter.Value{
 	"UInt128": interpreter.NewUInt128ValueFromUint64(50),
 	"UInt256": interpreter.NewUInt256ValueFromUint64(50),
 	// Word*
-	"Word8":  interpreter.Word8Value(50),
-	"Word16": interpreter.Word16Value(50),
-	"Word32": interpreter.Word32Value(50),
-	"Word64": interpreter.Word64Value(50),
+	"Word8":   interpreter.Word8Value(50),
+	"Word16":  interpreter.Word16Value(50),
+	"Word32":  interpreter.Word32Value(50),
+	"Word64":  interpreter.Word64Value(50),
+	"Word128": interpreter.NewWord128ValueFromUint64(50),
+	"Word256": interpreter.NewWord256ValueFromUint64(50),
 }
 
 func init() {
@@ -583,6 +585,16 @@ func TestInterpretIntegerConversion(t *testing.T) {
 			min:      interpreter.Word64Value(0),
 			max:      interpreter.Word64Value(math.MaxUint64),
 		},
+		sema.Word128Type: {
+			fortyTwo: interpreter.NewWord128ValueFromUint64(42),
+			min:      interpreter.NewWord128ValueFromUint64(0),
+			max:      interpreter.NewWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+		},
+		sema.Word256Type: {
+			fortyTwo: interpreter.NewWord256ValueFromUint64(42),
+			min:      interpreter.NewWord256ValueFromUint64(0),
+			max:      interpreter.NewWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+		},
 		sema.Int8Type: {
 			fortyTwo: interpreter.Int8Value(42),
 			min:      interpreter.Int8Value(math.MinInt8),
@@ -747,6 +759,14 @@ func TestInterpretIntegerMinMax(t *testing.T) {
 			min: interpreter.Word64Value(0),
 			max: interpreter.Word64Value(math.MaxUint64),
 		},
+		sema.Word128Type: {
+			min: interpreter.NewWord128ValueFromUint64(0),
+			max: interpreter.NewWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+		},
+		sema.Word256Type: {
+			min: interpreter.NewWord256ValueFromUint64(0),
+			max: interpreter.NewWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+		},
 		sema.Int8Type: {
 			min: interpreter.Int8Value(math.MinInt8),
 			max: interpreter.Int8Value(math.MaxInt8),
@@ -6704,16 +6704,32 @@ func TestInterpretEmitEventParameterTypes(t *testing.T) {
 			value: interpreter.Word64Value(42),
 			ty:    sema.Word64Type,
 		},
+		"Word128": {
+			value: interpreter.NewWord128ValueFromUint64(42),
+			ty:    sema.Word128Type,
+		},
+		"Word256": {
+			value: interpreter.NewWord256ValueFromUint64(42),
+			ty:    sema.Word256Type,
+		},
 		// Fix*
 		"Fix64": {
 			value: interpreter.Fix64Value(123000000),
 			ty:    sema.Fix64Type,
 		},
+		"Fix128": {
+			value: interpreter.NewFix128ValueWithInteger(big.NewInt(42)),
+			ty:    sema.Fix128Type,
+		},
 		// UFix*
 		"UFix64": {
 			value: interpreter.UFix64Value(123000000),
 			ty:    sema.UFix64Type,
 		},
+		"UFix128": {
+			value: interpreter.NewUFix128ValueWithInteger(big.NewInt(42)),
+			ty:    sema.UFix128Type,
+		},
 		// TODO:
 		//// Struct
 		//"S": {
@@ -422,3 +422,148 @@ func TestInterpretFunctionInvocationHandler(t *testing.T) {
 		occurrences,
 	)
 }
+
+func TestInterpretFunctionEntryAndExitHandler(t *testing.T) {
+
+	t.Parallel()
+
+	programChecker, err := checker.ParseAndCheck(t,
+		`
+          fun a() {}
+
+          fun b() {
+              a()
+              let f = fun () {
+                  a()
+              }
+              f()
+          }
+        `,
+	)
+	require.NoError(t, err)
+
+	var events []string
+
+	functionName := func(function *interpreter.InterpretedFunctionValue) string {
+		switch element := function.Element.(type) {
+		case *ast.FunctionDeclaration:
+			return element.Identifier.Identifier
+		case *ast.FunctionExpression:
+			return "expression"
+		default:
+			t.Fatalf("unexpected element: %T", element)
+			return ""
+		}
+	}
+
+	storage := interpreter.NewInMemoryStorage()
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(programChecker),
+		programChecker.Location,
+		interpreter.WithStorage(storage),
+		interpreter.WithOnFunctionEntryHandler(
+			func(_ *interpreter.Interpreter, function *interpreter.InterpretedFunctionValue) {
+				events = append(events, "enter "+functionName(function))
+			},
+		),
+		interpreter.WithOnFunctionExitHandler(
+			func(_ *interpreter.Interpreter, function *interpreter.InterpretedFunctionValue) {
+				events = append(events, "exit "+functionName(function))
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("b")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"enter b",
+			"enter a",
+			"exit a",
+			"enter expression",
+			"enter a",
+			"exit a",
+			"exit expression",
+			"exit b",
+		},
+		events,
+	)
+}
+
+func TestInterpretBranchHandler(t *testing.T) {
+
+	t.Parallel()
+
+	programChecker, err := checker.ParseAndCheck(t,
+		`
+          fun test(_ x: Int?) {
+              if x != nil {}
+
+              let y = x ?? 0
+
+              let z = y > 1 ? 1 : 2
+
+              switch y {
+              case 1:
+                  return
+              case 2:
+                  return
+              }
+          }
+        `,
+	)
+	require.NoError(t, err)
+
+	type occurrence struct {
+		line   int
+		branch int
+	}
+
+	var occurrences []occurrence
+
+	storage := interpreter.NewInMemoryStorage()
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(programChecker),
+		programChecker.Location,
+		interpreter.WithStorage(storage),
+		interpreter.WithOnBranchHandler(
+			func(_ *interpreter.Interpreter, element ast.Element, branch int) {
+				occurrences = append(occurrences, occurrence{
+					line:   element.StartPosition().Line,
+					branch: branch,
+				})
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test", interpreter.NewSomeValueNonCopying(interpreter.NewIntValueFromInt64(2)))
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test", interpreter.NilValue{})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]occurrence{
+			{3, 0},
+			{5, 0},
+			{7, 0},
+			{9, 1},
+			{3, 1},
+			{5, 1},
+			{7, 1},
+			{9, 2},
+		},
+		occurrences,
+	)
+}
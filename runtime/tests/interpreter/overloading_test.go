@@ -0,0 +1,530 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/checker"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun describe(_ value: Int): String {
+              return "int"
+          }
+
+          fun describe(string value: String): String {
+              return "string"
+          }
+
+          fun test(): [String] {
+              return [describe(1), describe(string: "a")]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewStringValue("int"),
+				interpreter.NewStringValue("string"),
+			),
+			value,
+		)
+	})
+
+	t.Run("local", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              fun add(_ a: Int, _ b: Int): Int {
+                  return a + b
+              }
+
+              fun add(_ a: Int, _ b: Int, _ c: Int): Int {
+                  return a + b + c
+              }
+
+              return add(1, 2) + add(1, 2, 3)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(9),
+			value,
+		)
+	})
+
+	t.Run("member", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              fun get(index: Int): Int {
+                  return index
+              }
+
+              fun get(index: Int, offset: Int): Int {
+                  return index + offset
+              }
+          }
+
+          fun test(): Int {
+              let s = S()
+              return s.get(index: 1) + s.get(index: 2, offset: 3)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(6),
+			value,
+		)
+	})
+
+	t.Run("interface conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The conditions of the interface apply to the overloads
+		// with the same argument labels, independent of the declaration order
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface SI {
+              fun get(index: Int): Int {
+                  pre { index > 0 }
+              }
+
+              fun get(key: String): Int {
+                  pre { key.length > 0 }
+              }
+          }
+
+          struct S: SI {
+              fun get(key: String): Int {
+                  return key.length
+              }
+
+              fun get(index: Int): Int {
+                  return index
+              }
+          }
+
+          fun testIndex(): Int {
+              return S().get(index: 0)
+          }
+
+          fun testKey(): Int {
+              return S().get(key: "")
+          }
+
+          fun test(): Int {
+              return S().get(index: 1) + S().get(key: "ab")
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+
+		_, err = inter.Invoke("testIndex")
+		require.ErrorAs(t, err, &interpreter.ConditionError{})
+
+		_, err = inter.Invoke("testKey")
+		require.ErrorAs(t, err, &interpreter.ConditionError{})
+	})
+
+	t.Run("interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The overloads are invoked by their argument labels,
+		// independent of the declaration order in the interface and the composite
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+              fun f(a: Int): String
+              fun f(b: Int): String
+          }
+
+          struct S: I {
+              fun f(b: Int): String {
+                  return "b"
+              }
+
+              fun f(a: Int): String {
+                  return "a"
+              }
+          }
+
+          fun test(): String {
+              let i: {I} = S()
+              return i.f(a: 1).concat(i.f(b: 1))
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewStringValue("ab"),
+			value,
+		)
+	})
+
+	t.Run("restricted type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+              fun f(a: Int): String
+              fun f(b: Int): String
+          }
+
+          struct S: I {
+              fun f(b: Int): String {
+                  return "b"
+              }
+
+              fun f(a: Int): String {
+                  return "a"
+              }
+          }
+
+          fun test(): String {
+              let s = S()
+              let restricted: S{I} = s
+              let ref: &{I} = &s as &{I}
+              return restricted.f(a: 1)
+                  .concat(restricted.f(b: 1))
+                  .concat(ref.f(a: 1))
+                  .concat(ref.f(b: 1))
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewStringValue("abab"),
+			value,
+		)
+	})
+
+	t.Run("interface, not overloaded in composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Invocations through the interface type
+		// also find functions which are not overloaded
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+              fun f(a: Int): String
+          }
+
+          struct S: I {
+              fun f(a: Int): String {
+                  return "a"
+              }
+          }
+
+          fun test(): String {
+              let i: {I} = S()
+              return i.f(a: 1)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewStringValue("a"),
+			value,
+		)
+	})
+}
+
+func TestInterpretCompositeInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Token {
+              let name: String
+              let symbol: String
+
+              init(name: String) {
+                  self.name = name
+                  self.symbol = "?"
+              }
+
+              init(name: String, symbol: String) {
+                  self.name = name
+                  self.symbol = symbol
+              }
+          }
+
+          fun test(): String {
+              let first = Token(name: "Flow")
+              let second = Token(name: "Flow", symbol: "FLOW")
+              return first.symbol.concat(second.symbol)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewStringValue("?FLOW"),
+			value,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let value: Int
+
+              init() {
+                  self.value = 1
+              }
+
+              init(value: Int) {
+                  self.value = value
+              }
+          }
+
+          fun test(): Int {
+              let r1 <- create R()
+              let r2 <- create R(value: 2)
+              let sum = r1.value + r2.value
+              destroy r1
+              destroy r2
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              contract C {
+
+                  struct S {
+                      let value: Int
+
+                      init() {
+                          self.value = 1
+                      }
+
+                      init(value: Int) {
+                          self.value = value
+                      }
+                  }
+
+                  init() {}
+              }
+
+              fun test(): Int {
+                  return C.S().value + C.S(value: 2).value
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					makeContractValueHandler(nil, nil, nil),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+	})
+}
+
+func TestInterpretImportedFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	importedLocation := common.AddressLocation{
+		Address: address,
+		Name:    "describe",
+	}
+
+	importedChecker, err := checker.ParseAndCheckWithOptions(t,
+		`
+          pub fun describe(_ value: Int): String {
+              return "int"
+          }
+
+          pub fun describe(string value: String): String {
+              return "string"
+          }
+        `,
+		checker.ParseAndCheckOptions{
+			Location: importedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	importingChecker, err := checker.ParseAndCheckWithOptions(t,
+		`
+          import describe from 0x1
+
+          pub fun test(): String {
+              return describe(string: "a")
+          }
+        `,
+		checker.ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithLocationHandler(
+					func(identifiers []ast.Identifier, _ common.Location) ([]sema.ResolvedLocation, error) {
+						return []sema.ResolvedLocation{
+							{
+								Location:    importedLocation,
+								Identifiers: identifiers,
+							},
+						}, nil
+					},
+				),
+				sema.WithImportHandler(
+					func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(importingChecker),
+		importingChecker.Location,
+		interpreter.WithStorage(interpreter.NewInMemoryStorage()),
+		interpreter.WithImportLocationHandler(
+			func(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
+				program := interpreter.ProgramFromChecker(importedChecker)
+				subInterpreter, err := inter.NewSubInterpreter(program, location)
+				if err != nil {
+					panic(err)
+				}
+
+				return interpreter.InterpreterImport{
+					Interpreter: subInterpreter,
+				}
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewStringValue("string"),
+		value,
+	)
+}
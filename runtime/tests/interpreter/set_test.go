@@ -0,0 +1,340 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func newTestSetValue(inter *interpreter.Interpreter, elements ...int64) *interpreter.SetValue {
+	values := make([]interpreter.Value, len(elements))
+	for i, element := range elements {
+		values[i] = interpreter.NewIntValueFromInt64(element)
+	}
+
+	return interpreter.NewSetValue(
+		inter,
+		interpreter.SetStaticType{
+			ElementType: interpreter.PrimitiveStaticTypeInt,
+		},
+		values...,
+	)
+}
+
+func TestInterpretSetLiteral(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x: {Int} = [1, 2, 3, 2, 1]
+      let y: Set<Int> = []
+    `)
+
+	x := inter.Globals["x"].GetValue()
+	require.IsType(t, &interpreter.SetValue{}, x)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		newTestSetValue(inter, 1, 2, 3),
+		x,
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		newTestSetValue(inter),
+		inter.Globals["y"].GetValue(),
+	)
+}
+
+func TestInterpretSetMembers(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("insert", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let set: {Int} = [1, 2]
+
+          fun test(): [Bool] {
+              return [set.insert(3), set.insert(1)]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(false),
+			),
+			value,
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestSetValue(inter, 1, 2, 3),
+			inter.Globals["set"].GetValue(),
+		)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let set: {Int} = [1, 2]
+
+          fun test(): [Bool] {
+              return [set.remove(1), set.remove(3)]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(false),
+			),
+			value,
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestSetValue(inter, 2),
+			inter.Globals["set"].GetValue(),
+		)
+	})
+
+	t.Run("contains and length", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let set: {String} = ["a", "b", "a"]
+          let containsA = set.contains("a")
+          let containsC = set.contains("c")
+          let length = set.length
+        `)
+
+		assert.Equal(t, interpreter.BoolValue(true), inter.Globals["containsA"].GetValue())
+		assert.Equal(t, interpreter.BoolValue(false), inter.Globals["containsC"].GetValue())
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(2),
+			inter.Globals["length"].GetValue(),
+		)
+	})
+
+	t.Run("union, intersection, difference", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a: {Int} = [1, 2, 3]
+          let b: {Int} = [2, 3, 4]
+          let union = a.union(b)
+          let intersection = a.intersection(b)
+          let difference = a.difference(b)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestSetValue(inter, 1, 2, 3, 4),
+			inter.Globals["union"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestSetValue(inter, 2, 3),
+			inter.Globals["intersection"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestSetValue(inter, 1),
+			inter.Globals["difference"].GetValue(),
+		)
+
+		// The operands are not modified
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestSetValue(inter, 1, 2, 3),
+			inter.Globals["a"].GetValue(),
+		)
+	})
+}
+
+func TestInterpretSetCopy(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a: {Int} = [1]
+      let b = a
+
+      fun test() {
+          b.insert(2)
+      }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		newTestSetValue(inter, 1),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		newTestSetValue(inter, 1, 2),
+		inter.Globals["b"].GetValue(),
+	)
+}
+
+func TestInterpretSetIteration(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+       fun test(): Int {
+           let set: {Int} = [1, 2, 3, 4, 4]
+           var sum = 0
+           for element in set {
+               sum = sum + element
+           }
+           return sum
+       }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewIntValueFromInt64(10),
+		value,
+	)
+}
+
+func TestInterpretSetDynamicCasting(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let set: {Int} = [1, 2]
+      let any: AnyStruct = set
+      let ints = any as? {Int}
+      let integers = any as? {Integer}
+      let strings = any as? {String}
+    `)
+
+	require.IsType(t, &interpreter.SomeValue{}, inter.Globals["ints"].GetValue())
+	require.IsType(t, &interpreter.SomeValue{}, inter.Globals["integers"].GetValue())
+	require.Equal(t, interpreter.NilValue{}, inter.Globals["strings"].GetValue())
+}
+
+func TestInterpretSetEquality(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a: {Int} = [1, 2, 3]
+
+      fun test(): [Bool] {
+          let b: {Int} = [3, 2, 1]
+          let c: {Int} = [1, 2]
+          let d: {Int} = [1, 2, 4]
+          let e: {Int}? = [2, 3, 1]
+
+          let results = [a == b, a != b, a == c, a == d, a == e, e == nil]
+
+          c.insert(3)
+          results.append(a == c)
+
+          return results
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.Address{},
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(false),
+			interpreter.BoolValue(false),
+			interpreter.BoolValue(false),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(false),
+			interpreter.BoolValue(true),
+		),
+		value,
+	)
+}
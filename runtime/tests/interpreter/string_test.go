@@ -19,8 +19,10 @@
 package interpreter_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -205,3 +207,376 @@ func TestInterpretStringToLower(t *testing.T) {
 		result,
 	)
 }
+
+func TestInterpretStringSplit(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, code string, expected ...string) {
+
+		inter := parseCheckAndInterpret(t, fmt.Sprintf(
+			`
+              fun test(): [String] {
+                  return %s
+              }
+            `,
+			code,
+		))
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		values := make([]interpreter.Value, len(expected))
+		for i, str := range expected {
+			values[i] = interpreter.NewStringValue(str)
+		}
+
+		RequireValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				values...,
+			),
+			result,
+		)
+	}
+
+	t.Run("separator", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, `"a,b,,c".split(separator: ",")`, "a", "b", "", "c")
+	})
+
+	t.Run("multi-character separator", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, `"one::two::three".split(separator: "::")`, "one", "two", "three")
+	})
+
+	t.Run("separator not found", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, `"abc".split(separator: ",")`, "abc")
+	})
+
+	t.Run("empty separator", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, `"ab\u{1F1E8}\u{1F1E6}".split(separator: "")`, "a", "b", "\U0001F1E8\U0001F1E6")
+	})
+
+	t.Run("grapheme clusters", func(t *testing.T) {
+		t.Parallel()
+
+		// The separator "e" is not split off the character "e\u{301}"
+
+		test(t, `"e\u{301}xe".split(separator: "e")`, "e\u0301x", "")
+	})
+}
+
+func TestInterpretStringReplaceAll(t *testing.T) {
+
+	t.Parallel()
+
+	for code, expected := range map[string]string{
+		`"a-b-c".replaceAll(of: "-", with: "+")`:     "a+b+c",
+		`"aaa".replaceAll(of: "aa", with: "b")`:      "ba",
+		`"abc".replaceAll(of: "", with: "x")`:        "abc",
+		`"abc".replaceAll(of: "abc", with: "")`:      "",
+		`"e\u{301}e".replaceAll(of: "e", with: "a")`: "e\u0301a",
+	} {
+		inter := parseCheckAndInterpret(t, fmt.Sprintf(
+			`
+              fun test(): String {
+                  return %s
+              }
+            `,
+			code,
+		))
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewStringValue(expected),
+			result,
+			code,
+		)
+	}
+}
+
+func TestInterpretStringSearch(t *testing.T) {
+
+	t.Parallel()
+
+	for code, expected := range map[string]interpreter.Value{
+		`"abc".contains("bc")`: interpreter.BoolValue(true),
+		`"abc".contains("ac")`: interpreter.BoolValue(false),
+		`"abc".contains("")`:   interpreter.BoolValue(true),
+		`"\u{1F1E8}\u{1F1E6}\u{1F1FA}\u{1F1F8}".contains("\u{1F1E6}\u{1F1FA}")`: interpreter.BoolValue(false),
+		`"abcbc".index(of: "bc")`: interpreter.NewIntValueFromInt64(1),
+		`"abc".index(of: "x")`:    interpreter.NewIntValueFromInt64(-1),
+		`"abc".index(of: "")`:     interpreter.NewIntValueFromInt64(0),
+		`"\u{1F1E8}\u{1F1E6}\u{1F1FA}\u{1F1F8}".index(of: "\u{1F1FA}\u{1F1F8}")`: interpreter.NewIntValueFromInt64(1),
+		`"e\u{301}e".index(of: "e")`:       interpreter.NewIntValueFromInt64(1),
+		`"abc".hasPrefix("ab")`:            interpreter.BoolValue(true),
+		`"abc".hasPrefix("bc")`:            interpreter.BoolValue(false),
+		`"e\u{301}".hasPrefix("e")`:        interpreter.BoolValue(false),
+		`"abc".hasSuffix("bc")`:            interpreter.BoolValue(true),
+		`"abc".hasSuffix("ab")`:            interpreter.BoolValue(false),
+		`"abc".hasSuffix("")`:              interpreter.BoolValue(true),
+		`"ae\u{301}".hasSuffix("\u{301}")`: interpreter.BoolValue(false),
+	} {
+		inter := parseCheckAndInterpret(t, fmt.Sprintf(
+			`
+              let result = %s
+            `,
+			code,
+		))
+
+		assert.Equal(t,
+			expected,
+			inter.Globals["result"].GetValue(),
+			code,
+		)
+	}
+}
+
+func TestInterpretStringToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          return "Flowers".toUpper()
+      }
+	`)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.Equal(t,
+		interpreter.NewStringValue("FLOWERS"),
+		result,
+	)
+}
+
+func TestInterpretStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	for code, expected := range map[string]string{
+		`"  \t flowers are\tbeautiful \n".trim()`: "flowers are\tbeautiful",
+		`"flowers".trim()`:                        "flowers",
+		`" \n ".trim()`:                           "",
+		`"".trim()`:                               "",
+		// The space and the combining accent form a single character, which is not only whitespace
+		`" \u{301}a ".trim()`: " \u0301a",
+	} {
+		inter := parseCheckAndInterpret(t, fmt.Sprintf(
+			`
+              fun test(): String {
+                  return %s
+              }
+            `,
+			code,
+		))
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewStringValue(expected),
+			result,
+			code,
+		)
+	}
+}
+
+func TestInterpretStringFromUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let valid = String.fromUTF8([70, 108, 111, 119, 101, 114, 115, 32, 240, 159, 146, 144])
+      let invalid = String.fromUTF8([0xFF, 0xFE])
+	`)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueNonCopying(
+			interpreter.NewStringValue("Flowers \U0001F490"),
+		),
+		inter.Globals["valid"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["invalid"].GetValue(),
+	)
+}
+
+func TestInterpretStringFromCharacters(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          let characters: [Character] = ["a", "\u{1F490}", "e\u{301}"]
+          return String.fromCharacters(characters)
+      }
+	`)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.Equal(t,
+		interpreter.NewStringValue("a\U0001F490e\u0301"),
+		result,
+	)
+}
+
+func TestInterpretStringJoin(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let joined = String.join(["one", "two", "three"], separator: ", ")
+      let empty = String.join([], separator: ", ")
+	`)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("one, two, three"),
+		inter.Globals["joined"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue(""),
+		inter.Globals["empty"].GetValue(),
+	)
+}
+
+func TestInterpretStringFunctionMetering(t *testing.T) {
+
+	t.Parallel()
+
+	var iterations int
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test(): String {
+              let upper = "he\u{301}llo".toUpper()
+              return String.join([upper, "ab"], separator: "-")
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnLoopIterationHandler(
+					func(_ *interpreter.Interpreter, _ int) {
+						iterations++
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	// 5 characters of "he\u{301}llo" and 8 characters of "HE\u{301}LLO-ab"
+
+	assert.Equal(t, 13, iterations)
+}
+
+func TestInterpretStringFunctionArgumentMetering(t *testing.T) {
+
+	t.Parallel()
+
+	// The characters of the arguments and of the result are metered
+
+	type test struct {
+		code       string
+		iterations int
+	}
+
+	tests := map[string]test{
+		// 3 characters of "aba", 1 character of "a", and 2 occurrences of 4 characters of "xyzw"
+		"replaceAll": {
+			code:       `"aba".replaceAll(of: "a", with: "xyzw")`,
+			iterations: 12,
+		},
+		// 3 characters of "a,b", and 1 character of ","
+		"split": {
+			code:       `"a,b".split(separator: ",")`,
+			iterations: 4,
+		},
+		// 3 characters of "abc", and 2 characters of "bc"
+		"contains": {
+			code:       `"abc".contains("bc")`,
+			iterations: 5,
+		},
+		// 3 characters of "abc", and 2 characters of "bc"
+		"index": {
+			code:       `"abc".index(of: "bc")`,
+			iterations: 5,
+		},
+		// 2 characters of "ab", 3 characters of "cde", and 2 separators of 3 characters each
+		"join": {
+			code:       `String.join(["ab", "", "cde"], separator: "---")`,
+			iterations: 11,
+		},
+		// 3 characters
+		"fromCharacters": {
+			code:       `String.fromCharacters(["a", "b", "c"])`,
+			iterations: 3,
+		},
+		// 3 bytes
+		"fromUTF8": {
+			code:       `String.fromUTF8([0x61, 0x62, 0x63])`,
+			iterations: 3,
+		},
+	}
+
+	for name, test := range tests {
+
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			var iterations int
+
+			inter, err := parseCheckAndInterpretWithOptions(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          let result = %s
+                      }
+                    `,
+					test.code,
+				),
+				ParseCheckAndInterpretOptions{
+					Options: []interpreter.Option{
+						interpreter.WithOnLoopIterationHandler(
+							func(_ *interpreter.Interpreter, _ int) {
+								iterations++
+							},
+						),
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			_, err = inter.Invoke("test")
+			require.NoError(t, err)
+
+			assert.Equal(t, test.iterations, iterations)
+		})
+	}
+}
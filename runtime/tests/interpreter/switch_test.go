@@ -272,3 +272,108 @@ func TestInterpretSwitchStatement(t *testing.T) {
 		}
 	})
 }
+
+func TestInterpretSwitchStatementCasePattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test(_ value: AnyStruct): Int {
+              switch value {
+              case let n as Int:
+                  return n
+              case let s as S:
+                  return s.x
+              default:
+                  return 0
+              }
+          }
+
+          fun testInt(): Int {
+              return test(1)
+          }
+
+          fun testS(): Int {
+              return test(S(x: 2))
+          }
+
+          fun testDefault(): Int {
+              return test("3")
+          }
+        `)
+
+		for name, expected := range map[string]int64{
+			"testInt":     1,
+			"testS":       2,
+			"testDefault": 0,
+		} {
+			actual, err := inter.Invoke(name)
+			require.NoError(t, err)
+
+			AssertValuesEqual(t, inter, interpreter.NewIntValueFromInt64(expected), actual)
+		}
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ value: Int?): Int {
+              switch value {
+              case nil:
+                  return -1
+              case let n?:
+                  return n + 1
+              }
+              return 0
+          }
+        `)
+
+		actual, err := inter.Invoke("test", interpreter.NilValue{})
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewIntValueFromInt64(-1), actual)
+
+		actual, err = inter.Invoke(
+			"test",
+			interpreter.NewSomeValueNonCopying(interpreter.NewIntValueFromInt64(2)),
+		)
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewIntValueFromInt64(3), actual)
+	})
+
+	t.Run("binding is copied", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let values: AnyStruct = [1, 2]
+              switch values {
+              case let xs as [Int]:
+                  xs.append(3)
+              }
+              return (values as! [Int]).length
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewIntValueFromInt64(2), actual)
+	})
+}
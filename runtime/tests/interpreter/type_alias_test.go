@@ -0,0 +1,85 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("run-time type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           resource interface Receiver {}
+
+           resource R: Receiver {}
+
+           typealias Token = @{Receiver}
+
+           let result = Type<@Token>() == Type<@{Receiver}>()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(true),
+			inter.Globals["result"].GetValue(),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           struct S {
+               typealias Count = Int
+
+               fun count(): Count {
+                   return 42
+               }
+           }
+
+           fun test(): S.Count {
+               let count: S.Count = S().count()
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(42),
+			value,
+		)
+	})
+}
@@ -133,3 +133,20 @@ func TestFunctionDocFormatting(t *testing.T) {
 
 	assert.Equal(t, string(expectedContent), string(docFiles["index.md"]))
 }
+
+func TestOverloadDocFormatting(t *testing.T) {
+
+	content, err := ioutil.ReadFile(path.Join("samples", "sample4.cdc"))
+	require.NoError(t, err)
+
+	docGen := docgen.NewDocGenerator()
+
+	docFiles, err := docGen.GenerateInMemory(string(content))
+	require.NoError(t, err)
+	require.Len(t, docFiles, 2)
+
+	expectedContent, err := ioutil.ReadFile(path.Join("outputs", "sample4_output.md"))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedContent), string(docFiles["Token.md"]))
+}
@@ -391,6 +391,26 @@ func (Word64Type) ID() string {
 	return "Word64"
 }
 
+// Word128Type
+
+type Word128Type struct{}
+
+func (Word128Type) isType() {}
+
+func (Word128Type) ID() string {
+	return "Word128"
+}
+
+// Word256Type
+
+type Word256Type struct{}
+
+func (Word256Type) isType() {}
+
+func (Word256Type) ID() string {
+	return "Word256"
+}
+
 // Fix64Type
 
 type Fix64Type struct{}
@@ -411,6 +431,26 @@ func (UFix64Type) ID() string {
 	return "UFix64"
 }
 
+// Fix128Type
+
+type Fix128Type struct{}
+
+func (Fix128Type) isType() {}
+
+func (Fix128Type) ID() string {
+	return "Fix128"
+}
+
+// UFix128Type
+
+type UFix128Type struct{}
+
+func (UFix128Type) isType() {}
+
+func (UFix128Type) ID() string {
+	return "UFix128"
+}
+
 type ArrayType interface {
 	Type
 	Element() Type
@@ -466,6 +506,18 @@ func (t DictionaryType) ID() string {
 	)
 }
 
+// SetType
+
+type SetType struct {
+	ElementType Type
+}
+
+func (SetType) isType() {}
+
+func (t SetType) ID() string {
+	return fmt.Sprintf("{%s}", t.ElementType.ID())
+}
+
 // Field
 
 type Field struct {
@@ -63,6 +63,8 @@ func TestType_ID(t *testing.T) {
 		{Word16Type{}, "Word16"},
 		{Word32Type{}, "Word32"},
 		{Word64Type{}, "Word64"},
+		{Word128Type{}, "Word128"},
+		{Word256Type{}, "Word256"},
 		{UFix64Type{}, "UFix64"},
 		{Fix64Type{}, "Fix64"},
 		{VoidType{}, "Void"},
@@ -115,6 +117,12 @@ func TestType_ID(t *testing.T) {
 			},
 			"{String:Int}",
 		},
+		{
+			SetType{
+				ElementType: StringType{},
+			},
+			"{String}",
+		},
 		{
 			&StructType{
 				Location:            utils.TestLocation,
@@ -816,6 +816,98 @@ func (v Word64) String() string {
 	return format.Uint(uint64(v))
 }
 
+// Word128
+
+type Word128 struct {
+	Value *big.Int
+}
+
+func NewWord128(i uint) Word128 {
+	return Word128{new(big.Int).SetUint64(uint64(i))}
+}
+
+func NewWord128FromBig(i *big.Int) (Word128, error) {
+	if i.Sign() < 0 {
+		return Word128{}, fmt.Errorf("invalid negative value for Word128: %s", i.String())
+	}
+	if i.Cmp(sema.Word128TypeMaxIntBig) > 0 {
+		return Word128{}, fmt.Errorf("value exceeds max of Word128: %s", i.String())
+	}
+	return Word128{i}, nil
+}
+
+func (Word128) isValue() {}
+
+func (Word128) Type() Type {
+	return Word128Type{}
+}
+
+func (v Word128) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v Word128) Int() int {
+	return int(v.Value.Uint64())
+}
+
+func (v Word128) Big() *big.Int {
+	return v.Value
+}
+
+func (v Word128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Word128) String() string {
+	return format.BigInt(v.Value)
+}
+
+// Word256
+
+type Word256 struct {
+	Value *big.Int
+}
+
+func NewWord256(i uint) Word256 {
+	return Word256{new(big.Int).SetUint64(uint64(i))}
+}
+
+func NewWord256FromBig(i *big.Int) (Word256, error) {
+	if i.Sign() < 0 {
+		return Word256{}, fmt.Errorf("invalid negative value for Word256: %s", i.String())
+	}
+	if i.Cmp(sema.Word256TypeMaxIntBig) > 0 {
+		return Word256{}, fmt.Errorf("value exceeds max of Word256: %s", i.String())
+	}
+	return Word256{i}, nil
+}
+
+func (Word256) isValue() {}
+
+func (Word256) Type() Type {
+	return Word256Type{}
+}
+
+func (v Word256) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v Word256) Int() int {
+	return int(v.Value.Uint64())
+}
+
+func (v Word256) Big() *big.Int {
+	return v.Value
+}
+
+func (v Word256) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Word256) String() string {
+	return format.BigInt(v.Value)
+}
+
 // Fix64
 
 type Fix64 int64
@@ -905,6 +997,98 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Fix128
+
+type Fix128 struct {
+	Value *big.Int
+}
+
+func NewFix128(s string) (Fix128, error) {
+	v, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{v}, nil
+}
+
+func NewFix128FromBig(i *big.Int) (Fix128, error) {
+	if i.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return Fix128{}, fmt.Errorf("value exceeds min of Fix128: %s", i.String())
+	}
+	if i.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return Fix128{}, fmt.Errorf("value exceeds max of Fix128: %s", i.String())
+	}
+	return Fix128{i}, nil
+}
+
+func (Fix128) isValue() {}
+
+func (Fix128) Type() Type {
+	return Fix128Type{}
+}
+
+func (v Fix128) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v Fix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v Fix128) ToBigEndianBytes() []byte {
+	return interpreter.SignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Fix128) String() string {
+	return format.Fix128(v.Value)
+}
+
+// UFix128
+
+type UFix128 struct {
+	Value *big.Int
+}
+
+func NewUFix128(s string) (UFix128, error) {
+	v, err := fixedpoint.ParseUFix128(s)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{v}, nil
+}
+
+func NewUFix128FromBig(i *big.Int) (UFix128, error) {
+	if i.Sign() < 0 {
+		return UFix128{}, fmt.Errorf("invalid negative value for UFix128: %s", i.String())
+	}
+	if i.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		return UFix128{}, fmt.Errorf("value exceeds max of UFix128: %s", i.String())
+	}
+	return UFix128{i}, nil
+}
+
+func (UFix128) isValue() {}
+
+func (UFix128) Type() Type {
+	return UFix128Type{}
+}
+
+func (v UFix128) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v UFix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v UFix128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v UFix128) String() string {
+	return format.Fix128(v.Value)
+}
+
 // Array
 
 type Array struct {
@@ -996,6 +1180,46 @@ func (v Dictionary) String() string {
 	return format.Dictionary(pairs)
 }
 
+// Set
+
+type Set struct {
+	SetType Type
+	Values  []Value
+}
+
+func NewSet(values []Value) Set {
+	return Set{Values: values}
+}
+
+func (Set) isValue() {}
+
+func (v Set) Type() Type {
+	return v.SetType
+}
+
+func (v Set) WithType(setType SetType) Set {
+	v.SetType = setType
+	return v
+}
+
+func (v Set) ToGoValue() interface{} {
+	ret := map[interface{}]struct{}{}
+
+	for _, e := range v.Values {
+		ret[e.ToGoValue()] = struct{}{}
+	}
+
+	return ret
+}
+
+func (v Set) String() string {
+	values := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		values[i] = value.String()
+	}
+	return format.Set(values)
+}
+
 // KeyValuePair
 
 type KeyValuePair struct {
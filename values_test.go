@@ -42,6 +42,8 @@ func TestStringer(t *testing.T) {
 
 	ufix64, _ := NewUFix64("64.01")
 	fix64, _ := NewFix64("-32.11")
+	ufix128, _ := NewUFix128("128.01")
+	fix128, _ := NewFix128("-64.11")
 
 	stringerTests := map[string]testCase{
 		"UInt": {
@@ -116,6 +118,14 @@ func TestStringer(t *testing.T) {
 			value:    NewWord64(64),
 			expected: "64",
 		},
+		"Word128": {
+			value:    NewWord128(128),
+			expected: "128",
+		},
+		"Word256": {
+			value:    NewWord256(256),
+			expected: "256",
+		},
 		"UFix64": {
 			value:    ufix64,
 			expected: "64.01000000",
@@ -124,6 +134,14 @@ func TestStringer(t *testing.T) {
 			value:    fix64,
 			expected: "-32.11000000",
 		},
+		"UFix128": {
+			value:    ufix128,
+			expected: "128.010000000000000000000000",
+		},
+		"Fix128": {
+			value:    fix128,
+			expected: "-64.110000000000000000000000",
+		},
 		"Void": {
 			value:    NewVoid(),
 			expected: "()",
@@ -164,6 +182,13 @@ func TestStringer(t *testing.T) {
 			}),
 			expected: "{\"key\": \"value\"}",
 		},
+		"Set": {
+			value: NewSet([]Value{
+				NewInt(1),
+				NewInt(2),
+			}),
+			expected: "{1, 2}",
+		},
 		"Bytes": {
 			value:    NewBytes([]byte{0x1, 0x2}),
 			expected: "[0x1, 0x2]",
@@ -427,6 +452,20 @@ func TestToBigEndianBytes(t *testing.T) {
 			NewWord64(9223372036854775808):  {128, 0, 0, 0, 0, 0, 0, 0},
 			NewWord64(18446744073709551615): {255, 255, 255, 255, 255, 255, 255, 255},
 		},
+		"Word128": {
+			NewWord128(0):   {0},
+			NewWord128(42):  {42},
+			NewWord128(127): {127},
+			NewWord128(128): {128},
+			NewWord128(200): {200},
+		},
+		"Word256": {
+			NewWord256(0):   {0},
+			NewWord256(42):  {42},
+			NewWord256(127): {127},
+			NewWord256(128): {128},
+			NewWord256(200): {200},
+		},
 		// Fix*
 		"Fix64": {
 			Fix64(0):           {0, 0, 0, 0, 0, 0, 0, 0},
@@ -440,6 +479,18 @@ func TestToBigEndianBytes(t *testing.T) {
 			Fix64(42_00000000): {0, 0, 0, 0, 250, 86, 234, 0},
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"Fix128": {
+			Fix128{big.NewInt(0)}:    {0},
+			Fix128{big.NewInt(42)}:   {42},
+			Fix128{big.NewInt(128)}:  {0, 128},
+			Fix128{big.NewInt(-1)}:   {255},
+			Fix128{big.NewInt(-128)}: {128},
+		},
+		"UFix128": {
+			UFix128{big.NewInt(0)}:   {0},
+			UFix128{big.NewInt(42)}:  {42},
+			UFix128{big.NewInt(128)}: {128},
+		},
 	}
 
 	// Ensure the test cases are complete
@@ -599,3 +650,74 @@ func TestNewUInt256FromBig(t *testing.T) {
 	_, err = NewUInt256FromBig(aboveMax)
 	require.Error(t, err)
 }
+
+func TestNewWord128FromBig(t *testing.T) {
+
+	_, err := NewWord128FromBig(big.NewInt(1))
+	require.NoError(t, err)
+
+	belowMin := big.NewInt(-1)
+	_, err = NewWord128FromBig(belowMin)
+	require.Error(t, err)
+
+	aboveMax := new(big.Int).Add(
+		sema.Word128TypeMaxIntBig,
+		big.NewInt(1),
+	)
+	_, err = NewWord128FromBig(aboveMax)
+	require.Error(t, err)
+}
+
+func TestNewWord256FromBig(t *testing.T) {
+
+	_, err := NewWord256FromBig(big.NewInt(1))
+	require.NoError(t, err)
+
+	belowMin := big.NewInt(-1)
+	_, err = NewWord256FromBig(belowMin)
+	require.Error(t, err)
+
+	aboveMax := new(big.Int).Add(
+		sema.Word256TypeMaxIntBig,
+		big.NewInt(1),
+	)
+	_, err = NewWord256FromBig(aboveMax)
+	require.Error(t, err)
+}
+
+func TestNewFix128FromBig(t *testing.T) {
+
+	_, err := NewFix128FromBig(big.NewInt(-1))
+	require.NoError(t, err)
+
+	belowMin := new(big.Int).Sub(
+		sema.Int128TypeMinIntBig,
+		big.NewInt(1),
+	)
+	_, err = NewFix128FromBig(belowMin)
+	require.Error(t, err)
+
+	aboveMax := new(big.Int).Add(
+		sema.Int128TypeMaxIntBig,
+		big.NewInt(1),
+	)
+	_, err = NewFix128FromBig(aboveMax)
+	require.Error(t, err)
+}
+
+func TestNewUFix128FromBig(t *testing.T) {
+
+	_, err := NewUFix128FromBig(big.NewInt(1))
+	require.NoError(t, err)
+
+	belowMin := big.NewInt(-1)
+	_, err = NewUFix128FromBig(belowMin)
+	require.Error(t, err)
+
+	aboveMax := new(big.Int).Add(
+		sema.UInt128TypeMaxIntBig,
+		big.NewInt(1),
+	)
+	_, err = NewUFix128FromBig(aboveMax)
+	require.Error(t, err)
+}
@@ -0,0 +1,302 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/compiler"
+	"github.com/onflow/cadence/runtime/compiler/wasm"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/checker"
+)
+
+// differentialTestCases are programs which are run
+// by both the interpreter and the compiled module.
+// Each program has a function named test,
+// whose results must be equal
+//
+var differentialTestCases = map[string]string{
+	"arithmetic": `
+      fun test(): Int {
+          let a = 7
+          let b = -3
+          return (a + b) * (a - b) / 3 % 7
+      }
+    `,
+	"comparisons": `
+      fun test(): Bool {
+          return 1 < 2 && 2 <= 2 && 3 > 2 && 3 >= 4 == false && 1 != 2
+      }
+    `,
+	"string equality": `
+      fun test(): Bool {
+          let hello = "hello"
+          return hello == "hello" && hello != "world"
+      }
+    `,
+	"short-circuit": `
+      fun fail(): Bool {
+          return 1 / 0 == 0
+      }
+
+      fun test(): Bool {
+          return (false && fail()) || (true || fail())
+      }
+    `,
+	"conditional": `
+      fun sign(_ x: Int): Int {
+          return x < 0 ? -1 : (x == 0 ? 0 : 1)
+      }
+
+      fun test(): Int {
+          return sign(-5) * 100 + sign(0) * 10 + sign(5)
+      }
+    `,
+	"if": `
+      fun classify(_ x: Int): String {
+          if x < 10 {
+              return "small"
+          } else if x < 100 {
+              return "medium"
+          }
+          return "large"
+      }
+
+      fun test(): Bool {
+          return classify(1) == "small"
+              && classify(50) == "medium"
+              && classify(500) == "large"
+      }
+    `,
+	"while": `
+      fun test(): Int {
+          var i = 0
+          var total = 0
+          while true {
+              i = i + 1
+              if i > 20 {
+                  break
+              }
+              if i % 3 == 0 {
+                  continue
+              }
+              var j = 0
+              while j < i {
+                  j = j + 1
+                  total = total + 1
+              }
+          }
+          return total
+      }
+    `,
+	"switch": `
+      fun describe(_ n: Int): String {
+          switch n {
+          case 1:
+              return "one"
+          case 2:
+              return "two"
+          default:
+              return "many"
+          }
+      }
+
+      fun test(): Int {
+          var i = 0
+          var total = 0
+          while i < 10 {
+              i = i + 1
+              switch i % 4 {
+              case 0:
+                  continue
+              case 1:
+                  total = total + 1
+                  break
+              case 2:
+                  if describe(i) == "two" {
+                      total = total + 100
+                      break
+                  }
+                  total = total + 10
+              default:
+                  total = total + 1000
+              }
+              total = total + 10000
+          }
+          return total
+      }
+    `,
+	"recursion": `
+      fun fib(_ n: Int): Int {
+          if n < 2 {
+              return n
+          }
+          return fib(n - 1) + fib(n - 2)
+      }
+
+      fun test(): Int {
+          return fib(15)
+      }
+    `,
+	"mutual recursion": `
+      fun isEven(_ n: Int): Bool {
+          return n == 0 ? true : isOdd(n - 1)
+      }
+
+      fun isOdd(_ n: Int): Bool {
+          return n == 0 ? false : isEven(n - 1)
+      }
+
+      fun test(): Bool {
+          return isEven(10) && !isOdd(10)
+      }
+    `,
+	"struct": `
+      struct Account {
+          var balance: Int
+          let limit: Int
+
+          init(balance: Int, limit: Int) {
+              self.balance = balance
+              self.limit = limit
+          }
+
+          fun withdraw(_ amount: Int): Bool {
+              if self.balance - amount < -self.limit {
+                  return false
+              }
+              self.balance = self.balance - amount
+              return true
+          }
+      }
+
+      fun test(): Int {
+          let account = Account(balance: 10, limit: 5)
+          var withdrawals = 0
+          while account.withdraw(4) {
+              withdrawals = withdrawals + 1
+          }
+          return withdrawals * 100 + account.balance
+      }
+    `,
+	"void functions": `
+      struct Counter {
+          var count: Int
+
+          init() {
+              self.count = 0
+          }
+
+          fun increment() {
+              if self.count >= 3 {
+                  return
+              }
+              self.count = self.count + 1
+          }
+      }
+
+      fun test(): Int {
+          let counter = Counter()
+          var i = 0
+          while i < 5 {
+              counter.increment()
+              i = i + 1
+          }
+          return counter.count
+      }
+    `,
+	"division by zero": `
+      fun test(): Int {
+          let zero = 0
+          return 1 / zero
+      }
+    `,
+}
+
+func interpretTest(t *testing.T, checker *sema.Checker) (interpreter.Value, error) {
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithStorage(interpreter.NewInMemoryStorage()),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	return inter.Invoke("test")
+}
+
+type newVMFunc func(binary []byte, location common.Location) (VM, error)
+
+func compileTest(t *testing.T, checker *sema.Checker, newVM newVMFunc) (interpreter.Value, error) {
+	funcs, err := compiler.Compile(checker)
+	require.NoError(t, err)
+
+	module := compiler.GenerateWasm(funcs)
+
+	var buf wasm.Buffer
+	w := wasm.NewWASMWriter(&buf)
+	err = w.WriteModule(module)
+	require.NoError(t, err)
+
+	vm, err := newVM(buf.Bytes(), checker.Location)
+	require.NoError(t, err)
+
+	return vm.Invoke("test")
+}
+
+// testDifferential runs the differential test cases
+// using the VM returned by the given function
+//
+func testDifferential(t *testing.T, newVM newVMFunc) {
+
+	for name, code := range differentialTestCases {
+
+		code := code
+
+		t.Run(name, func(t *testing.T) {
+
+			checker, err := checker.ParseAndCheck(t, code)
+			require.NoError(t, err)
+
+			expected, expectedErr := interpretTest(t, checker)
+			actual, actualErr := compileTest(t, checker, newVM)
+
+			if expectedErr != nil {
+				require.Error(t, actualErr)
+				return
+			}
+
+			require.NoError(t, actualErr)
+
+			require.IsType(t, expected, actual)
+			require.Equal(t, expected.String(), actual.String())
+		})
+	}
+}
+
+func TestDifferential(t *testing.T) {
+	testDifferential(t, NewVM)
+}
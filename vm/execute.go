@@ -0,0 +1,665 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/onflow/cadence/runtime/compiler/wasm"
+)
+
+var errStackUnderflow = errors.New("stack underflow")
+var errDivisionByZero = errors.New("integer divide by zero")
+var errIntegerOverflow = errors.New("integer overflow")
+
+// frame is the state of a function invocation:
+// The locals and the operand stack.
+//
+// Integers are represented as int32 and int64 values,
+// references as values of the interpreter, and nil (null reference)
+//
+type frame struct {
+	vm     *vm
+	locals []interface{}
+	stack  []interface{}
+}
+
+// branch describes how the execution of instructions ended,
+// if it did not end at the end of the instructions
+//
+type branch struct {
+	// returning is true if the function returns
+	returning bool
+	// labelIndex is the index of the label which is branched to,
+	// relative to the current block
+	labelIndex uint32
+}
+
+func (f *frame) push(value interface{}) {
+	f.stack = append(f.stack, value)
+}
+
+func (f *frame) pop() (interface{}, error) {
+	if len(f.stack) == 0 {
+		return nil, errStackUnderflow
+	}
+	lastIndex := len(f.stack) - 1
+	value := f.stack[lastIndex]
+	f.stack = f.stack[:lastIndex]
+	return value, nil
+}
+
+func (f *frame) popI32() (int32, error) {
+	value, err := f.pop()
+	if err != nil {
+		return 0, err
+	}
+	result, ok := value.(int32)
+	if !ok {
+		return 0, fmt.Errorf("expected i32, got %#+v", value)
+	}
+	return result, nil
+}
+
+func (f *frame) popI64() (int64, error) {
+	value, err := f.pop()
+	if err != nil {
+		return 0, err
+	}
+	result, ok := value.(int64)
+	if !ok {
+		return 0, fmt.Errorf("expected i64, got %#+v", value)
+	}
+	return result, nil
+}
+
+// popN pops the given number of values,
+// and returns them in the order they were pushed
+//
+func (f *frame) popN(count int) ([]interface{}, error) {
+	if len(f.stack) < count {
+		return nil, errStackUnderflow
+	}
+	start := len(f.stack) - count
+	values := make([]interface{}, count)
+	copy(values, f.stack[start:])
+	f.stack = f.stack[:start]
+	return values, nil
+}
+
+// unwind removes the values from the stack above the given height,
+// except for the given number of values on the top of the stack
+//
+func (f *frame) unwind(height int, keep int) error {
+	if len(f.stack)-keep < height {
+		return errStackUnderflow
+	}
+	copy(f.stack[height:], f.stack[len(f.stack)-keep:])
+	f.stack = f.stack[:height+keep]
+	return nil
+}
+
+func (f *frame) local(index uint32) (uint32, error) {
+	if index >= uint32(len(f.locals)) {
+		return 0, fmt.Errorf("invalid local index: %d", index)
+	}
+	return index, nil
+}
+
+func (f *frame) execute(instructions []wasm.Instruction) (*branch, error) {
+	for _, instruction := range instructions {
+		br, err := f.executeInstruction(instruction)
+		if err != nil || br != nil {
+			return br, err
+		}
+	}
+	return nil, nil
+}
+
+// blockArity returns the number of parameters and results of a block
+//
+func (f *frame) blockArity(blockType wasm.BlockType) (params int, results int, err error) {
+	switch blockType := blockType.(type) {
+	case nil:
+		return 0, 0, nil
+
+	case wasm.ValueType:
+		return 0, 1, nil
+
+	case wasm.TypeIndexBlockType:
+		types := f.vm.module.Types
+		if blockType.TypeIndex >= uint32(len(types)) {
+			return 0, 0, fmt.Errorf("invalid block type index: %d", blockType.TypeIndex)
+		}
+		functionType := types[blockType.TypeIndex]
+		return len(functionType.Params), len(functionType.Results), nil
+	}
+
+	return 0, 0, fmt.Errorf("unsupported block type: %#+v", blockType)
+}
+
+// executeBlock executes the instructions of a block, loop, or if.
+//
+// A branch to the label of a block or an if continues after the block,
+// a branch to the label of a loop starts the next iteration
+//
+func (f *frame) executeBlock(
+	blockType wasm.BlockType,
+	instructions []wasm.Instruction,
+	isLoop bool,
+) (*branch, error) {
+
+	paramCount, resultCount, err := f.blockArity(blockType)
+	if err != nil {
+		return nil, err
+	}
+
+	height := len(f.stack) - paramCount
+	if height < 0 {
+		return nil, errStackUnderflow
+	}
+
+	for {
+		br, err := f.execute(instructions)
+		if err != nil || br == nil || br.returning {
+			return br, err
+		}
+
+		if br.labelIndex > 0 {
+			return &branch{labelIndex: br.labelIndex - 1}, nil
+		}
+
+		if !isLoop {
+			return nil, f.unwind(height, resultCount)
+		}
+
+		err = f.unwind(height, paramCount)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (f *frame) call(functionIndex uint32) error {
+	functionType, err := f.vm.functionType(functionIndex)
+	if err != nil {
+		return err
+	}
+
+	arguments, err := f.popN(len(functionType.Params))
+	if err != nil {
+		return err
+	}
+
+	results, err := f.vm.call(functionIndex, arguments)
+	if err != nil {
+		return err
+	}
+
+	f.stack = append(f.stack, results...)
+
+	return nil
+}
+
+func (f *frame) executeInstruction(instruction wasm.Instruction) (*branch, error) {
+
+	switch instruction := instruction.(type) {
+
+	// control instructions
+
+	case wasm.InstructionUnreachable:
+		return nil, errors.New("unreachable executed")
+
+	case wasm.InstructionNop:
+		return nil, nil
+
+	case wasm.InstructionBlock:
+		return f.executeBlock(
+			instruction.Block.BlockType,
+			instruction.Block.Instructions1,
+			false,
+		)
+
+	case wasm.InstructionLoop:
+		return f.executeBlock(
+			instruction.Block.BlockType,
+			instruction.Block.Instructions1,
+			true,
+		)
+
+	case wasm.InstructionIf:
+		test, err := f.popI32()
+		if err != nil {
+			return nil, err
+		}
+
+		instructions := instruction.Block.Instructions1
+		if test == 0 {
+			instructions = instruction.Block.Instructions2
+		}
+
+		return f.executeBlock(
+			instruction.Block.BlockType,
+			instructions,
+			false,
+		)
+
+	case wasm.InstructionBr:
+		return &branch{labelIndex: instruction.LabelIndex}, nil
+
+	case wasm.InstructionBrIf:
+		test, err := f.popI32()
+		if err != nil || test == 0 {
+			return nil, err
+		}
+		return &branch{labelIndex: instruction.LabelIndex}, nil
+
+	case wasm.InstructionBrTable:
+		index, err := f.popI32()
+		if err != nil {
+			return nil, err
+		}
+
+		labelIndex := instruction.DefaultLabelIndex
+		if index >= 0 && int(index) < len(instruction.LabelIndices) {
+			labelIndex = instruction.LabelIndices[index]
+		}
+		return &branch{labelIndex: labelIndex}, nil
+
+	case wasm.InstructionReturn:
+		return &branch{returning: true}, nil
+
+	case wasm.InstructionCall:
+		return nil, f.call(instruction.FuncIndex)
+
+	// reference instructions
+
+	case wasm.InstructionRefNull:
+		f.push(nil)
+		return nil, nil
+
+	case wasm.InstructionRefIsNull:
+		value, err := f.pop()
+		if err != nil {
+			return nil, err
+		}
+		f.push(bool32(value == nil))
+		return nil, nil
+
+	// parametric instructions
+
+	case wasm.InstructionDrop:
+		_, err := f.pop()
+		return nil, err
+
+	case wasm.InstructionSelect:
+		test, err := f.popI32()
+		if err != nil {
+			return nil, err
+		}
+		values, err := f.popN(2)
+		if err != nil {
+			return nil, err
+		}
+		if test != 0 {
+			f.push(values[0])
+		} else {
+			f.push(values[1])
+		}
+		return nil, nil
+
+	// variable instructions
+
+	case wasm.InstructionLocalGet:
+		index, err := f.local(instruction.LocalIndex)
+		if err != nil {
+			return nil, err
+		}
+		f.push(f.locals[index])
+		return nil, nil
+
+	case wasm.InstructionLocalSet:
+		index, err := f.local(instruction.LocalIndex)
+		if err != nil {
+			return nil, err
+		}
+		value, err := f.pop()
+		if err != nil {
+			return nil, err
+		}
+		f.locals[index] = value
+		return nil, nil
+
+	case wasm.InstructionLocalTee:
+		index, err := f.local(instruction.LocalIndex)
+		if err != nil {
+			return nil, err
+		}
+		if len(f.stack) == 0 {
+			return nil, errStackUnderflow
+		}
+		f.locals[index] = f.stack[len(f.stack)-1]
+		return nil, nil
+
+	// numeric instructions
+
+	case wasm.InstructionI32Const:
+		f.push(instruction.Value)
+		return nil, nil
+
+	case wasm.InstructionI64Const:
+		f.push(instruction.Value)
+		return nil, nil
+
+	case wasm.InstructionI32WrapI64:
+		value, err := f.popI64()
+		if err != nil {
+			return nil, err
+		}
+		f.push(int32(value))
+		return nil, nil
+
+	case wasm.InstructionI64ExtendI32S:
+		value, err := f.popI32()
+		if err != nil {
+			return nil, err
+		}
+		f.push(int64(value))
+		return nil, nil
+
+	case wasm.InstructionI64ExtendI32U:
+		value, err := f.popI32()
+		if err != nil {
+			return nil, err
+		}
+		f.push(int64(uint32(value)))
+		return nil, nil
+	}
+
+	if handled, err := f.executeI32Instruction(instruction); handled {
+		return nil, err
+	}
+
+	if handled, err := f.executeI64Instruction(instruction); handled {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("unsupported instruction: %#+v", instruction)
+}
+
+func bool32(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// executeI32Instruction executes the given instruction
+// if it is an i32 test, comparison, or arithmetic instruction
+//
+func (f *frame) executeI32Instruction(instruction wasm.Instruction) (handled bool, err error) {
+
+	var unary func(a int32) int32
+	var binary func(a, b int32) (int32, error)
+
+	switch instruction.(type) {
+	case wasm.InstructionI32Eqz:
+		unary = func(a int32) int32 { return bool32(a == 0) }
+	case wasm.InstructionI32Clz:
+		unary = func(a int32) int32 { return int32(bits.LeadingZeros32(uint32(a))) }
+	case wasm.InstructionI32Ctz:
+		unary = func(a int32) int32 { return int32(bits.TrailingZeros32(uint32(a))) }
+	case wasm.InstructionI32Popcnt:
+		unary = func(a int32) int32 { return int32(bits.OnesCount32(uint32(a))) }
+
+	case wasm.InstructionI32Eq:
+		binary = func(a, b int32) (int32, error) { return bool32(a == b), nil }
+	case wasm.InstructionI32Ne:
+		binary = func(a, b int32) (int32, error) { return bool32(a != b), nil }
+	case wasm.InstructionI32LtS:
+		binary = func(a, b int32) (int32, error) { return bool32(a < b), nil }
+	case wasm.InstructionI32LtU:
+		binary = func(a, b int32) (int32, error) { return bool32(uint32(a) < uint32(b)), nil }
+	case wasm.InstructionI32GtS:
+		binary = func(a, b int32) (int32, error) { return bool32(a > b), nil }
+	case wasm.InstructionI32GtU:
+		binary = func(a, b int32) (int32, error) { return bool32(uint32(a) > uint32(b)), nil }
+	case wasm.InstructionI32LeS:
+		binary = func(a, b int32) (int32, error) { return bool32(a <= b), nil }
+	case wasm.InstructionI32LeU:
+		binary = func(a, b int32) (int32, error) { return bool32(uint32(a) <= uint32(b)), nil }
+	case wasm.InstructionI32GeS:
+		binary = func(a, b int32) (int32, error) { return bool32(a >= b), nil }
+	case wasm.InstructionI32GeU:
+		binary = func(a, b int32) (int32, error) { return bool32(uint32(a) >= uint32(b)), nil }
+
+	case wasm.InstructionI32Add:
+		binary = func(a, b int32) (int32, error) { return a + b, nil }
+	case wasm.InstructionI32Sub:
+		binary = func(a, b int32) (int32, error) { return a - b, nil }
+	case wasm.InstructionI32Mul:
+		binary = func(a, b int32) (int32, error) { return a * b, nil }
+	case wasm.InstructionI32DivS:
+		binary = func(a, b int32) (int32, error) {
+			if b == 0 {
+				return 0, errDivisionByZero
+			}
+			if a == math.MinInt32 && b == -1 {
+				return 0, errIntegerOverflow
+			}
+			return a / b, nil
+		}
+	case wasm.InstructionI32DivU:
+		binary = func(a, b int32) (int32, error) {
+			if b == 0 {
+				return 0, errDivisionByZero
+			}
+			return int32(uint32(a) / uint32(b)), nil
+		}
+	case wasm.InstructionI32RemS:
+		binary = func(a, b int32) (int32, error) {
+			if b == 0 {
+				return 0, errDivisionByZero
+			}
+			if b == -1 {
+				return 0, nil
+			}
+			return a % b, nil
+		}
+	case wasm.InstructionI32RemU:
+		binary = func(a, b int32) (int32, error) {
+			if b == 0 {
+				return 0, errDivisionByZero
+			}
+			return int32(uint32(a) % uint32(b)), nil
+		}
+	case wasm.InstructionI32And:
+		binary = func(a, b int32) (int32, error) { return a & b, nil }
+	case wasm.InstructionI32Or:
+		binary = func(a, b int32) (int32, error) { return a | b, nil }
+	case wasm.InstructionI32Xor:
+		binary = func(a, b int32) (int32, error) { return a ^ b, nil }
+	case wasm.InstructionI32Shl:
+		binary = func(a, b int32) (int32, error) { return a << (uint32(b) % 32), nil }
+	case wasm.InstructionI32ShrS:
+		binary = func(a, b int32) (int32, error) { return a >> (uint32(b) % 32), nil }
+	case wasm.InstructionI32ShrU:
+		binary = func(a, b int32) (int32, error) { return int32(uint32(a) >> (uint32(b) % 32)), nil }
+	case wasm.InstructionI32Rotl:
+		binary = func(a, b int32) (int32, error) { return int32(bits.RotateLeft32(uint32(a), int(b%32))), nil }
+	case wasm.InstructionI32Rotr:
+		binary = func(a, b int32) (int32, error) { return int32(bits.RotateLeft32(uint32(a), -int(b%32))), nil }
+
+	default:
+		return false, nil
+	}
+
+	if unary != nil {
+		a, err := f.popI32()
+		if err != nil {
+			return true, err
+		}
+		f.push(unary(a))
+		return true, nil
+	}
+
+	b, err := f.popI32()
+	if err != nil {
+		return true, err
+	}
+	a, err := f.popI32()
+	if err != nil {
+		return true, err
+	}
+	result, err := binary(a, b)
+	if err != nil {
+		return true, err
+	}
+	f.push(result)
+	return true, nil
+}
+
+// executeI64Instruction executes the given instruction
+// if it is an i64 test, comparison, or arithmetic instruction.
+// Tests and comparisons result in an i32
+//
+func (f *frame) executeI64Instruction(instruction wasm.Instruction) (handled bool, err error) {
+
+	var unary func(a int64) interface{}
+	var binary func(a, b int64) (interface{}, error)
+
+	switch instruction.(type) {
+	case wasm.InstructionI64Eqz:
+		unary = func(a int64) interface{} { return bool32(a == 0) }
+	case wasm.InstructionI64Clz:
+		unary = func(a int64) interface{} { return int64(bits.LeadingZeros64(uint64(a))) }
+	case wasm.InstructionI64Ctz:
+		unary = func(a int64) interface{} { return int64(bits.TrailingZeros64(uint64(a))) }
+	case wasm.InstructionI64Popcnt:
+		unary = func(a int64) interface{} { return int64(bits.OnesCount64(uint64(a))) }
+
+	case wasm.InstructionI64Eq:
+		binary = func(a, b int64) (interface{}, error) { return bool32(a == b), nil }
+	case wasm.InstructionI64Ne:
+		binary = func(a, b int64) (interface{}, error) { return bool32(a != b), nil }
+	case wasm.InstructionI64LtS:
+		binary = func(a, b int64) (interface{}, error) { return bool32(a < b), nil }
+	case wasm.InstructionI64LtU:
+		binary = func(a, b int64) (interface{}, error) { return bool32(uint64(a) < uint64(b)), nil }
+	case wasm.InstructionI64GtS:
+		binary = func(a, b int64) (interface{}, error) { return bool32(a > b), nil }
+	case wasm.InstructionI64GtU:
+		binary = func(a, b int64) (interface{}, error) { return bool32(uint64(a) > uint64(b)), nil }
+	case wasm.InstructionI64LeS:
+		binary = func(a, b int64) (interface{}, error) { return bool32(a <= b), nil }
+	case wasm.InstructionI64LeU:
+		binary = func(a, b int64) (interface{}, error) { return bool32(uint64(a) <= uint64(b)), nil }
+	case wasm.InstructionI64GeS:
+		binary = func(a, b int64) (interface{}, error) { return bool32(a >= b), nil }
+	case wasm.InstructionI64GeU:
+		binary = func(a, b int64) (interface{}, error) { return bool32(uint64(a) >= uint64(b)), nil }
+
+	case wasm.InstructionI64Add:
+		binary = func(a, b int64) (interface{}, error) { return a + b, nil }
+	case wasm.InstructionI64Sub:
+		binary = func(a, b int64) (interface{}, error) { return a - b, nil }
+	case wasm.InstructionI64Mul:
+		binary = func(a, b int64) (interface{}, error) { return a * b, nil }
+	case wasm.InstructionI64DivS:
+		binary = func(a, b int64) (interface{}, error) {
+			if b == 0 {
+				return nil, errDivisionByZero
+			}
+			if a == math.MinInt64 && b == -1 {
+				return nil, errIntegerOverflow
+			}
+			return a / b, nil
+		}
+	case wasm.InstructionI64DivU:
+		binary = func(a, b int64) (interface{}, error) {
+			if b == 0 {
+				return nil, errDivisionByZero
+			}
+			return int64(uint64(a) / uint64(b)), nil
+		}
+	case wasm.InstructionI64RemS:
+		binary = func(a, b int64) (interface{}, error) {
+			if b == 0 {
+				return nil, errDivisionByZero
+			}
+			if b == -1 {
+				return int64(0), nil
+			}
+			return a % b, nil
+		}
+	case wasm.InstructionI64RemU:
+		binary = func(a, b int64) (interface{}, error) {
+			if b == 0 {
+				return nil, errDivisionByZero
+			}
+			return int64(uint64(a) % uint64(b)), nil
+		}
+	case wasm.InstructionI64And:
+		binary = func(a, b int64) (interface{}, error) { return a & b, nil }
+	case wasm.InstructionI64Or:
+		binary = func(a, b int64) (interface{}, error) { return a | b, nil }
+	case wasm.InstructionI64Xor:
+		binary = func(a, b int64) (interface{}, error) { return a ^ b, nil }
+	case wasm.InstructionI64Shl:
+		binary = func(a, b int64) (interface{}, error) { return a << (uint64(b) % 64), nil }
+	case wasm.InstructionI64ShrS:
+		binary = func(a, b int64) (interface{}, error) { return a >> (uint64(b) % 64), nil }
+	case wasm.InstructionI64ShrU:
+		binary = func(a, b int64) (interface{}, error) { return int64(uint64(a) >> (uint64(b) % 64)), nil }
+	case wasm.InstructionI64Rotl:
+		binary = func(a, b int64) (interface{}, error) { return int64(bits.RotateLeft64(uint64(a), int(b%64))), nil }
+	case wasm.InstructionI64Rotr:
+		binary = func(a, b int64) (interface{}, error) { return int64(bits.RotateLeft64(uint64(a), -int(b%64))), nil }
+
+	default:
+		return false, nil
+	}
+
+	if unary != nil {
+		a, err := f.popI64()
+		if err != nil {
+			return true, err
+		}
+		f.push(unary(a))
+		return true, nil
+	}
+
+	b, err := f.popI64()
+	if err != nil {
+		return true, err
+	}
+	a, err := f.popI64()
+	if err != nil {
+		return true, err
+	}
+	result, err := binary(a, b)
+	if err != nil {
+		return true, err
+	}
+	f.push(result)
+	return true, nil
+}
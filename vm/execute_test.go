@@ -0,0 +1,238 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/compiler/wasm"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+// newTestVM returns a VM for a module with the given function,
+// which has the index 0
+//
+func newTestVM(t *testing.T, functionType *wasm.FunctionType, code *wasm.Code) *vm {
+
+	builder := &wasm.ModuleBuilder{}
+	builder.AddFunction("test", functionType, code)
+
+	var buf wasm.Buffer
+	w := wasm.NewWASMWriter(&buf)
+	err := w.WriteModule(builder.Build())
+	require.NoError(t, err)
+
+	result, err := NewVM(buf.Bytes(), utils.TestLocation)
+	require.NoError(t, err)
+
+	return result.(*vm)
+}
+
+func TestExecuteI32Arithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	m := newTestVM(t,
+		&wasm.FunctionType{
+			Params:  []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32},
+			Results: []wasm.ValueType{wasm.ValueTypeI32},
+		},
+		&wasm.Code{
+			Instructions: []wasm.Instruction{
+				// (a * b - 3) % 5
+				wasm.InstructionLocalGet{LocalIndex: 0},
+				wasm.InstructionLocalGet{LocalIndex: 1},
+				wasm.InstructionI32Mul{},
+				wasm.InstructionI32Const{Value: 3},
+				wasm.InstructionI32Sub{},
+				wasm.InstructionI32Const{Value: 5},
+				wasm.InstructionI32RemS{},
+			},
+		},
+	)
+
+	results, err := m.call(0, []interface{}{int32(4), int32(-6)})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int32(-2)}, results)
+}
+
+func TestExecuteLoop(t *testing.T) {
+
+	t.Parallel()
+
+	// factorial, using a loop
+
+	m := newTestVM(t,
+		&wasm.FunctionType{
+			Params:  []wasm.ValueType{wasm.ValueTypeI64},
+			Results: []wasm.ValueType{wasm.ValueTypeI64},
+		},
+		&wasm.Code{
+			Locals: []wasm.ValueType{wasm.ValueTypeI64},
+			Instructions: []wasm.Instruction{
+				wasm.InstructionI64Const{Value: 1},
+				wasm.InstructionLocalSet{LocalIndex: 1},
+				wasm.InstructionBlock{
+					Block: wasm.Block{
+						Instructions1: []wasm.Instruction{
+							wasm.InstructionLoop{
+								Block: wasm.Block{
+									Instructions1: []wasm.Instruction{
+										wasm.InstructionLocalGet{LocalIndex: 0},
+										wasm.InstructionI64Eqz{},
+										wasm.InstructionBrIf{LabelIndex: 1},
+										wasm.InstructionLocalGet{LocalIndex: 1},
+										wasm.InstructionLocalGet{LocalIndex: 0},
+										wasm.InstructionI64Mul{},
+										wasm.InstructionLocalSet{LocalIndex: 1},
+										wasm.InstructionLocalGet{LocalIndex: 0},
+										wasm.InstructionI64Const{Value: 1},
+										wasm.InstructionI64Sub{},
+										wasm.InstructionLocalSet{LocalIndex: 0},
+										wasm.InstructionBr{LabelIndex: 0},
+									},
+								},
+							},
+						},
+					},
+				},
+				wasm.InstructionLocalGet{LocalIndex: 1},
+			},
+		},
+	)
+
+	results, err := m.call(0, []interface{}{int64(10)})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(3628800)}, results)
+}
+
+func TestExecuteBranchTable(t *testing.T) {
+
+	t.Parallel()
+
+	// The branch unwinds the values pushed in the inner blocks,
+	// and keeps the result of the targeted block
+
+	m := newTestVM(t,
+		&wasm.FunctionType{
+			Params:  []wasm.ValueType{wasm.ValueTypeI32},
+			Results: []wasm.ValueType{wasm.ValueTypeI32},
+		},
+		&wasm.Code{
+			Instructions: []wasm.Instruction{
+				wasm.InstructionBlock{
+					Block: wasm.Block{
+						BlockType: wasm.ValueTypeI32,
+						Instructions1: []wasm.Instruction{
+							wasm.InstructionBlock{
+								Block: wasm.Block{
+									Instructions1: []wasm.Instruction{
+										wasm.InstructionI32Const{Value: 10},
+										wasm.InstructionI32Const{Value: 20},
+										wasm.InstructionLocalGet{LocalIndex: 0},
+										wasm.InstructionBrTable{
+											LabelIndices:      []uint32{0, 1},
+											DefaultLabelIndex: 2,
+										},
+									},
+								},
+							},
+							wasm.InstructionI32Const{Value: 30},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	for argument, expected := range map[int32]int32{
+		0: 30,
+		1: 20,
+		2: 20,
+		7: 20,
+	} {
+		results, err := m.call(0, []interface{}{argument})
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{expected}, results)
+	}
+}
+
+func TestExecuteTraps(t *testing.T) {
+
+	t.Parallel()
+
+	functionType := &wasm.FunctionType{
+		Results: []wasm.ValueType{wasm.ValueTypeI32},
+	}
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		t.Parallel()
+
+		m := newTestVM(t,
+			functionType,
+			&wasm.Code{
+				Instructions: []wasm.Instruction{
+					wasm.InstructionI32Const{Value: 1},
+					wasm.InstructionI32Const{Value: 0},
+					wasm.InstructionI32DivU{},
+				},
+			},
+		)
+
+		_, err := m.call(0, nil)
+		require.Equal(t, errDivisionByZero, err)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+
+		t.Parallel()
+
+		m := newTestVM(t,
+			functionType,
+			&wasm.Code{
+				Instructions: []wasm.Instruction{
+					wasm.InstructionUnreachable{},
+				},
+			},
+		)
+
+		_, err := m.call(0, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("call stack exhausted", func(t *testing.T) {
+
+		t.Parallel()
+
+		m := newTestVM(t,
+			functionType,
+			&wasm.Code{
+				Instructions: []wasm.Instruction{
+					wasm.InstructionCall{FuncIndex: 0},
+				},
+			},
+		)
+
+		_, err := m.call(0, nil)
+		require.EqualError(t, err, "call stack exhausted")
+	})
+}
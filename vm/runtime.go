@@ -0,0 +1,299 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// Memory returns the bytes of the memory of the module instance
+// in the given range
+//
+type Memory func(offset int32, length int32) ([]byte, error)
+
+// HostFunction is a function of the runtime module,
+// which is imported by compiled programs.
+//
+// Arguments and results are either int32 values,
+// or values of the interpreter, which are passed as external references.
+//
+type HostFunction func(memory Memory, arguments []interface{}) (interface{}, error)
+
+// runtimeModule implements the functions of the runtime module
+// (see compiler.RuntimeModuleName) using the values of the interpreter.
+//
+// NOTE: ensure to update the functions when the imports of the code generator change
+//
+type runtimeModule struct {
+	interpreter *interpreter.Interpreter
+	location    common.Location
+	functions   map[string]HostFunction
+}
+
+func newRuntimeModule(location common.Location) (*runtimeModule, error) {
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		location,
+		interpreter.WithStorage(interpreter.NewInMemoryStorage()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &runtimeModule{
+		interpreter: inter,
+		location:    location,
+	}
+
+	m.functions = map[string]HostFunction{
+		"Int":          m.newInt,
+		"String":       m.newString,
+		"Bool":         m.newBool,
+		"isTrue":       m.isTrue,
+		"add":          m.numberOperation(interpreter.NumberValue.Plus),
+		"subtract":     m.numberOperation(interpreter.NumberValue.Minus),
+		"multiply":     m.numberOperation(interpreter.NumberValue.Mul),
+		"divide":       m.numberOperation(interpreter.NumberValue.Div),
+		"mod":          m.numberOperation(interpreter.NumberValue.Mod),
+		"less":         m.numberComparison(interpreter.NumberValue.Less),
+		"lessEqual":    m.numberComparison(interpreter.NumberValue.LessEqual),
+		"greater":      m.numberComparison(interpreter.NumberValue.Greater),
+		"greaterEqual": m.numberComparison(interpreter.NumberValue.GreaterEqual),
+		"equal":        m.equality(true),
+		"notEqual":     m.equality(false),
+		"negate":       m.negate,
+		"not":          m.not,
+		"newComposite": m.newComposite,
+		"getField":     m.getField,
+		"setField":     m.setField,
+	}
+
+	return m, nil
+}
+
+// function returns the host function with the given name.
+// Errors which occur in the host function are returned
+//
+func (m *runtimeModule) function(name string) (HostFunction, bool) {
+	function, ok := m.functions[name]
+	if !ok {
+		return nil, false
+	}
+
+	return func(memory Memory, arguments []interface{}) (result interface{}, err error) {
+		defer m.interpreter.RecoverErrors(func(internalErr error) {
+			err = internalErr
+		})
+
+		return function(memory, arguments)
+	}, true
+}
+
+func (m *runtimeModule) newInt(memory Memory, arguments []interface{}) (interface{}, error) {
+	offset, length := arguments[0].(int32), arguments[1].(int32)
+
+	if length < 1 {
+		return nil, fmt.Errorf("Int: invalid length: %d", length)
+	}
+
+	bytes, err := memory(offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first byte is the sign, followed by the big-endian magnitude
+
+	value := new(big.Int).SetBytes(bytes[1:])
+	if bytes[0] == 0 {
+		value = value.Neg(value)
+	}
+
+	return interpreter.NewIntValueFromBigInt(value), nil
+}
+
+func (m *runtimeModule) newString(memory Memory, arguments []interface{}) (interface{}, error) {
+	bytes, err := memory(arguments[0].(int32), arguments[1].(int32))
+	if err != nil {
+		return nil, err
+	}
+
+	return interpreter.NewStringValue(string(bytes)), nil
+}
+
+func (m *runtimeModule) newBool(_ Memory, arguments []interface{}) (interface{}, error) {
+	return interpreter.BoolValue(arguments[0].(int32) != 0), nil
+}
+
+func (m *runtimeModule) isTrue(_ Memory, arguments []interface{}) (interface{}, error) {
+	value, ok := arguments[0].(interpreter.BoolValue)
+	if !ok {
+		return nil, fmt.Errorf("isTrue: invalid value: %#+v", arguments[0])
+	}
+
+	if value {
+		return int32(1), nil
+	}
+	return int32(0), nil
+}
+
+func numberArguments(name string, arguments []interface{}) (left, right interpreter.NumberValue, err error) {
+	left, ok := arguments[0].(interpreter.NumberValue)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: invalid left: %#+v", name, arguments[0])
+	}
+
+	right, ok = arguments[1].(interpreter.NumberValue)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: invalid right: %#+v", name, arguments[1])
+	}
+
+	return left, right, nil
+}
+
+func (m *runtimeModule) numberOperation(
+	operation func(interpreter.NumberValue, interpreter.NumberValue) interpreter.NumberValue,
+) HostFunction {
+	return func(_ Memory, arguments []interface{}) (interface{}, error) {
+		left, right, err := numberArguments("operation", arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		return operation(left, right), nil
+	}
+}
+
+func (m *runtimeModule) numberComparison(
+	comparison func(interpreter.NumberValue, interpreter.NumberValue) interpreter.BoolValue,
+) HostFunction {
+	return func(_ Memory, arguments []interface{}) (interface{}, error) {
+		left, right, err := numberArguments("comparison", arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		return comparison(left, right), nil
+	}
+}
+
+func (m *runtimeModule) equality(expected bool) HostFunction {
+	return func(_ Memory, arguments []interface{}) (interface{}, error) {
+		left, ok := arguments[0].(interpreter.EquatableValue)
+		if !ok {
+			return nil, fmt.Errorf("equal: invalid left: %#+v", arguments[0])
+		}
+
+		right, ok := arguments[1].(interpreter.Value)
+		if !ok {
+			return nil, fmt.Errorf("equal: invalid right: %#+v", arguments[1])
+		}
+
+		equal := left.Equal(m.interpreter, interpreter.ReturnEmptyLocationRange, right)
+
+		return interpreter.BoolValue(equal == expected), nil
+	}
+}
+
+func (m *runtimeModule) negate(_ Memory, arguments []interface{}) (interface{}, error) {
+	value, ok := arguments[0].(interpreter.NumberValue)
+	if !ok {
+		return nil, fmt.Errorf("negate: invalid value: %#+v", arguments[0])
+	}
+
+	return value.Negate(), nil
+}
+
+func (m *runtimeModule) not(_ Memory, arguments []interface{}) (interface{}, error) {
+	value, ok := arguments[0].(interpreter.BoolValue)
+	if !ok {
+		return nil, fmt.Errorf("not: invalid value: %#+v", arguments[0])
+	}
+
+	return value.Negate(), nil
+}
+
+func (m *runtimeModule) newComposite(memory Memory, arguments []interface{}) (interface{}, error) {
+	bytes, err := memory(arguments[0].(int32), arguments[1].(int32))
+	if err != nil {
+		return nil, err
+	}
+
+	return interpreter.NewCompositeValue(
+		m.interpreter,
+		m.location,
+		string(bytes),
+		common.CompositeKindStructure,
+		nil,
+		common.Address{},
+	), nil
+}
+
+func (m *runtimeModule) compositeAndFieldName(
+	name string,
+	memory Memory,
+	arguments []interface{},
+) (*interpreter.CompositeValue, string, error) {
+
+	composite, ok := arguments[0].(*interpreter.CompositeValue)
+	if !ok {
+		return nil, "", fmt.Errorf("%s: invalid composite: %#+v", name, arguments[0])
+	}
+
+	bytes, err := memory(arguments[1].(int32), arguments[2].(int32))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return composite, string(bytes), nil
+}
+
+func (m *runtimeModule) getField(memory Memory, arguments []interface{}) (interface{}, error) {
+	composite, fieldName, err := m.compositeAndFieldName("getField", memory, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	value := composite.GetMember(m.interpreter, interpreter.ReturnEmptyLocationRange, fieldName)
+	if value == nil {
+		return nil, fmt.Errorf("getField: missing field: %s", fieldName)
+	}
+
+	return value, nil
+}
+
+func (m *runtimeModule) setField(memory Memory, arguments []interface{}) (interface{}, error) {
+	composite, fieldName, err := m.compositeAndFieldName("setField", memory, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := arguments[3].(interpreter.Value)
+	if !ok {
+		return nil, fmt.Errorf("setField: invalid value: %#+v", arguments[3])
+	}
+
+	composite.SetMember(m.interpreter, interpreter.ReturnEmptyLocationRange, fieldName, value)
+
+	return nil, nil
+}
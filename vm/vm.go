@@ -1,9 +1,7 @@
-// +build wasmtime
-
 /*
  * Cadence - The resource-oriented smart contract programming language
  *
- * Copyright 2019-2020 Dapper Labs, Inc.
+ * Copyright 2019-2021 Dapper Labs, Inc.
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
  * you may not use this file except in compliance with the License.
@@ -22,12 +20,10 @@ package vm
 
 import (
 	"fmt"
-	"math/big"
-
-	"C"
-
-	"github.com/bytecodealliance/wasmtime-go"
 
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/compiler"
+	"github.com/onflow/cadence/runtime/compiler/wasm"
 	"github.com/onflow/cadence/runtime/interpreter"
 )
 
@@ -35,116 +31,303 @@ type VM interface {
 	Invoke(name string, arguments ...interpreter.Value) (interpreter.Value, error)
 }
 
+// maxCallDepth is the maximum number of nested function calls
+//
+const maxCallDepth = 1024
+
+// maxMemoryPages is the maximum number of pages of the memory
+//
+const maxMemoryPages = 1024
+
+// vm is a VM which interprets WebAssembly modules.
+//
+// It supports the subset of WebAssembly which is generated by the compiler:
+// Functions, a single memory, data segments, exports,
+// and the instructions for control flow, locals, references, and integers.
+//
 type vm struct {
-	instance *wasmtime.Instance
+	module    *wasm.Module
+	functions []HostFunction
+	memory    []byte
+	exports   map[string]uint32
+	callDepth int
 }
 
-func (m *vm) Invoke(name string, arguments ...interpreter.Value) (interpreter.Value, error) {
-	f := m.instance.GetExport(name).Func()
+var _ VM = &vm{}
 
-	rawArguments := make([]interface{}, len(arguments))
-	for i, argument := range arguments {
-		rawArguments[i] = argument
+// NewVM returns a VM which executes the given WebAssembly binary.
+//
+// The imports of the binary must be functions of the runtime module.
+// Composites created by the program have the given location.
+//
+func NewVM(binary []byte, location common.Location) (VM, error) {
+
+	reader := wasm.NewWASMReader(wasm.NewBuffer(binary))
+	err := reader.ReadModule()
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := f.Call(rawArguments...)
+	module := &reader.Module
+
+	runtime, err := newRuntimeModule(location)
 	if err != nil {
 		return nil, err
 	}
 
-	if res == nil {
-		return nil, nil
+	m := &vm{
+		module:  module,
+		exports: map[string]uint32{},
+	}
+
+	// Resolve the imports
+
+	for _, imp := range module.Imports {
+		function, ok := runtime.function(imp.Name)
+		if !ok || imp.Module != compiler.RuntimeModuleName {
+			return nil, fmt.Errorf("missing import: %s.%s", imp.Module, imp.Name)
+		}
+
+		m.functions = append(m.functions, function)
+	}
+
+	for _, function := range module.Functions {
+		if function.Code == nil || int(function.TypeIndex) >= len(module.Types) {
+			return nil, fmt.Errorf("invalid function")
+		}
 	}
 
-	return res.(interpreter.Value), nil
+	// Initialize the memory
+
+	switch len(module.Memories) {
+	case 0:
+		break
+	case 1:
+		pages := module.Memories[0].Min
+		if pages > maxMemoryPages {
+			return nil, fmt.Errorf("unsupported memory size: %d pages", pages)
+		}
+		m.memory = make([]byte, int(pages)*wasm.MemoryPageSize)
+	default:
+		return nil, fmt.Errorf("unsupported number of memories: %d", len(module.Memories))
+	}
+
+	for _, data := range module.Data {
+		err := m.initializeData(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Collect the exported functions
+
+	for _, export := range module.Exports {
+		if descriptor, ok := export.Descriptor.(wasm.FunctionExport); ok {
+			m.exports[export.Name] = descriptor.FunctionIndex
+		}
+	}
+
+	if module.StartFunctionIndex != nil {
+		_, err := m.call(*module.StartFunctionIndex, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
 }
 
-func NewVM(wasm []byte) (VM, error) {
+func (m *vm) initializeData(data *wasm.Data) error {
+	if data.MemoryIndex != 0 || len(m.memory) == 0 {
+		return fmt.Errorf("invalid data memory index: %d", data.MemoryIndex)
+	}
+
+	// The offset is a constant expression
+
+	if len(data.Offset) != 1 {
+		return fmt.Errorf("unsupported data offset")
+	}
+
+	offsetInstruction, ok := data.Offset[0].(wasm.InstructionI32Const)
+	if !ok {
+		return fmt.Errorf("unsupported data offset: %#+v", data.Offset[0])
+	}
+
+	offset := int(uint32(offsetInstruction.Value))
+	if offset+len(data.Init) > len(m.memory) {
+		return fmt.Errorf("data out of bounds: offset %d, length %d", offset, len(data.Init))
+	}
+
+	copy(m.memory[offset:], data.Init)
+
+	return nil
+}
 
-	config := wasmtime.NewConfig()
-	config.SetWasmReferenceTypes(true)
+// readMemory returns a copy of the memory in the given range
+//
+func (m *vm) readMemory(offset int32, length int32) ([]byte, error) {
+	if offset < 0 || length < 0 || int(offset)+int(length) > len(m.memory) {
+		return nil, fmt.Errorf("invalid memory range: offset %d, length %d", offset, length)
+	}
 
-	engine := wasmtime.NewEngineWithConfig(config)
+	result := make([]byte, length)
+	copy(result, m.memory[offset:offset+length])
+	return result, nil
+}
 
-	store := wasmtime.NewStore(engine)
+func (m *vm) Invoke(name string, arguments ...interpreter.Value) (interpreter.Value, error) {
+	functionIndex, ok := m.exports[name]
+	if !ok {
+		return nil, fmt.Errorf("missing exported function: %s", name)
+	}
 
-	module, err := wasmtime.NewModule(store.Engine, wasm)
+	functionType, err := m.functionType(functionIndex)
 	if err != nil {
 		return nil, err
 	}
 
-	intFunc := wasmtime.WrapFunc(
-		store,
-		func(caller *wasmtime.Caller, offset int32, length int32) (interface{}, *wasmtime.Trap) {
-			if offset < 0 {
-				return nil, wasmtime.NewTrap(store, fmt.Sprintf("Int: invalid offset: %d", offset))
-			}
+	if len(arguments) != len(functionType.Params) {
+		return nil, fmt.Errorf(
+			"invalid number of arguments for %s: expected %d, got %d",
+			name,
+			len(functionType.Params),
+			len(arguments),
+		)
+	}
 
-			if length < 2 {
-				return nil, wasmtime.NewTrap(store, fmt.Sprintf("Int: invalid length: %d", length))
-			}
+	rawArguments := make([]interface{}, len(arguments))
+	for i, argument := range arguments {
+		if functionType.Params[i] != wasm.ValueTypeExternRef {
+			return nil, fmt.Errorf("unsupported parameter type for %s: 0x%x", name, functionType.Params[i])
+		}
+		rawArguments[i] = argument
+	}
 
-			mem := caller.GetExport("mem").Memory()
+	m.callDepth = 0
 
-			bytes := C.GoBytes(mem.Data(), C.int(length))
+	results, err := m.call(functionIndex, rawArguments)
+	if err != nil {
+		return nil, err
+	}
 
-			value := new(big.Int).SetBytes(bytes[1:])
-			if bytes[0] == 0 {
-				value = value.Neg(value)
-			}
+	if len(results) == 0 || results[0] == nil {
+		return nil, nil
+	}
 
-			return interpreter.NewIntValueFromBigInt(value), nil
-		},
-	)
+	result, ok := results[0].(interpreter.Value)
+	if !ok {
+		return nil, fmt.Errorf("unsupported result of %s: %#+v", name, results[0])
+	}
 
-	stringFunc := wasmtime.WrapFunc(store, func(caller *wasmtime.Caller, offset int32, length int32) (interface{}, *wasmtime.Trap) {
-		if offset < 0 {
-			return nil, wasmtime.NewTrap(store, fmt.Sprintf("String: invalid offset: %d", offset))
-		}
+	return result, nil
+}
 
-		if length < 0 {
-			return nil, wasmtime.NewTrap(store, fmt.Sprintf("String: invalid length: %d", length))
+// functionType returns the type of the function with the given index.
+// Function indices include the imported functions
+//
+func (m *vm) functionType(functionIndex uint32) (*wasm.FunctionType, error) {
+	var typeIndex uint32
+
+	importCount := uint32(len(m.module.Imports))
+	if functionIndex < importCount {
+		typeIndex = m.module.Imports[functionIndex].TypeIndex
+	} else {
+		functionIndex -= importCount
+		if functionIndex >= uint32(len(m.module.Functions)) {
+			return nil, fmt.Errorf("invalid function index: %d", functionIndex+importCount)
 		}
+		typeIndex = m.module.Functions[functionIndex].TypeIndex
+	}
 
-		mem := caller.GetExport("mem").Memory()
+	if typeIndex >= uint32(len(m.module.Types)) {
+		return nil, fmt.Errorf("invalid type index: %d", typeIndex)
+	}
 
-		bytes := C.GoBytes(mem.Data(), C.int(length))
+	return m.module.Types[typeIndex], nil
+}
 
-		return interpreter.NewStringValue(string(bytes)), nil
-	})
+// call calls the function with the given index with the given arguments,
+// and returns the results
+//
+func (m *vm) call(functionIndex uint32, arguments []interface{}) ([]interface{}, error) {
 
-	addFunc := wasmtime.WrapFunc(store, func(left, right interface{}) (interface{}, *wasmtime.Trap) {
-		leftNumber, ok := left.(interpreter.NumberValue)
-		if !ok {
-			return nil, wasmtime.NewTrap(store, fmt.Sprintf("add: invalid left: %#+v", left))
+	functionType, err := m.functionType(functionIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	importCount := uint32(len(m.module.Imports))
+
+	// Imported functions are host functions
+
+	if functionIndex < importCount {
+		result, err := m.functions[functionIndex](m.readMemory, arguments)
+		if err != nil {
+			return nil, err
 		}
 
-		rightNumber, ok := right.(interpreter.NumberValue)
-		if !ok {
-			return nil, wasmtime.NewTrap(store, fmt.Sprintf("add: invalid right: %#+v", right))
+		if len(functionType.Results) == 0 {
+			return nil, nil
 		}
 
-		return leftNumber.Plus(rightNumber), nil
-	})
-
-	// NOTE: wasmtime currently does not support specifying imports by name,
-	// unlike other WebAssembly APIs like wasmer, JavaScript, etc.,
-	// i.e. imports are imported in the order they are given.
-
-	instance, err := wasmtime.NewInstance(
-		store,
-		module,
-		[]*wasmtime.Extern{
-			intFunc.AsExtern(),
-			stringFunc.AsExtern(),
-			addFunc.AsExtern(),
-		},
-	)
+		return []interface{}{result}, nil
+	}
+
+	m.callDepth++
+	defer func() {
+		m.callDepth--
+	}()
+
+	if m.callDepth > maxCallDepth {
+		return nil, fmt.Errorf("call stack exhausted")
+	}
+
+	function := m.module.Functions[functionIndex-importCount]
+
+	// The locals of the function are the parameters,
+	// followed by the declared locals, which are zero-initialized
+
+	locals := make([]interface{}, 0, len(arguments)+len(function.Code.Locals))
+	locals = append(locals, arguments...)
+	for _, localType := range function.Code.Locals {
+		locals = append(locals, zeroValue(localType))
+	}
+
+	f := &frame{
+		vm:     m,
+		locals: locals,
+	}
+
+	br, err := f.execute(function.Code.Instructions)
 	if err != nil {
 		return nil, err
 	}
 
-	return &vm{
-		instance: instance,
-	}, nil
+	// A branch to the outermost label is a return
+
+	if br != nil && !br.returning && br.labelIndex > 0 {
+		return nil, fmt.Errorf("invalid label index: %d", br.labelIndex-1)
+	}
+
+	// The results are on the top of the stack,
+	// both when the function returned explicitly, or implicitly at the end
+
+	resultCount := len(functionType.Results)
+	if len(f.stack) < resultCount {
+		return nil, fmt.Errorf("missing results")
+	}
+
+	return f.stack[len(f.stack)-resultCount:], nil
+}
+
+func zeroValue(valueType wasm.ValueType) interface{} {
+	switch valueType {
+	case wasm.ValueTypeI32:
+		return int32(0)
+	case wasm.ValueTypeI64:
+		return int64(0)
+	default:
+		// null reference
+		return nil
+	}
 }
@@ -0,0 +1,163 @@
+// +build wasmtime
+
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vm
+
+import (
+	"fmt"
+
+	"github.com/bytecodealliance/wasmtime-go"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/compiler"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+type wasmtimeVM struct {
+	instance *wasmtime.Instance
+}
+
+func (m *wasmtimeVM) Invoke(name string, arguments ...interpreter.Value) (interpreter.Value, error) {
+	export := m.instance.GetExport(name)
+	if export == nil || export.Func() == nil {
+		return nil, fmt.Errorf("missing exported function: %s", name)
+	}
+
+	f := export.Func()
+
+	rawArguments := make([]interface{}, len(arguments))
+	for i, argument := range arguments {
+		rawArguments[i] = argument
+	}
+
+	res, err := f.Call(rawArguments...)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, nil
+	}
+
+	return res.(interpreter.Value), nil
+}
+
+// NewWasmtimeVM returns a VM which executes the given WebAssembly binary using wasmtime.
+//
+// NOTE: The VM requires the wasmtime library, see NewVM for a VM which is implemented in Go
+//
+func NewWasmtimeVM(wasm []byte, location common.Location) (VM, error) {
+
+	config := wasmtime.NewConfig()
+	config.SetWasmReferenceTypes(true)
+
+	engine := wasmtime.NewEngineWithConfig(config)
+
+	store := wasmtime.NewStore(engine)
+
+	module, err := wasmtime.NewModule(store.Engine, wasm)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := newRuntimeModule(location)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the imported functions of the runtime module by name
+
+	linker := wasmtime.NewLinker(store)
+
+	for _, importType := range module.Imports() {
+		name := *importType.Name()
+
+		function, ok := runtime.function(name)
+		if !ok || importType.Module() != compiler.RuntimeModuleName {
+			return nil, fmt.Errorf("missing import: %s.%s", importType.Module(), name)
+		}
+
+		funcType := importType.Type().FuncType()
+		if funcType == nil {
+			return nil, fmt.Errorf("invalid import: %s.%s", importType.Module(), name)
+		}
+
+		err = linker.Define(
+			importType.Module(),
+			name,
+			newHostFunc(store, funcType, function),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instance, err := linker.Instantiate(module)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wasmtimeVM{
+		instance: instance,
+	}, nil
+}
+
+func newHostFunc(store *wasmtime.Store, funcType *wasmtime.FuncType, function HostFunction) *wasmtime.Func {
+	return wasmtime.NewFunc(
+		store,
+		funcType,
+		func(caller *wasmtime.Caller, args []wasmtime.Val) ([]wasmtime.Val, *wasmtime.Trap) {
+
+			memory := func(offset int32, length int32) ([]byte, error) {
+				data := caller.GetExport("mem").Memory().UnsafeData()
+				if offset < 0 || length < 0 || int(offset)+int(length) > len(data) {
+					return nil, fmt.Errorf("invalid memory range: offset %d, length %d", offset, length)
+				}
+
+				// copy the data, the memory may be modified or grow
+				result := make([]byte, length)
+				copy(result, data[offset:offset+length])
+				return result, nil
+			}
+
+			arguments := make([]interface{}, len(args))
+			for i, arg := range args {
+				arguments[i] = arg.Get()
+			}
+
+			result, err := function(memory, arguments)
+			if err != nil {
+				return nil, wasmtime.NewTrap(store, err.Error())
+			}
+
+			results := funcType.Results()
+			if len(results) == 0 {
+				return nil, nil
+			}
+
+			switch results[0].Kind() {
+			case wasmtime.KindI32:
+				return []wasmtime.Val{wasmtime.ValI32(result.(int32))}, nil
+			default:
+				return []wasmtime.Val{wasmtime.ValExternref(result)}, nil
+			}
+		},
+	)
+}
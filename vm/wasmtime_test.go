@@ -0,0 +1,32 @@
+// +build wasmtime
+
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vm
+
+import (
+	"testing"
+)
+
+// TestWasmtimeDifferential additionally runs the differential test cases using Wasmtime.
+// The test cases are always run using the pure Go executor, see TestDifferential
+//
+func TestWasmtimeDifferential(t *testing.T) {
+	testDifferential(t, NewWasmtimeVM)
+}